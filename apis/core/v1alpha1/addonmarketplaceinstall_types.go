@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AddonMarketplaceInstallParameters are the configurable fields of an
+// addon marketplace install on an Argo CD instance. The platform writes
+// the addon into the target addon repository; only the Helm chart
+// dependencies can be changed afterwards, so every other field is used
+// at install time only.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.instanceId) || has(self.instanceRef)",message="instanceId or instanceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.instanceId) || (has(self.instanceId) && self.instanceId == oldSelf.instanceId)) && (!has(oldSelf.instanceRef) || (has(self.instanceRef) && self.instanceRef.name == oldSelf.instanceRef.name))",message="instanceId/instanceRef are immutable"
+// +kubebuilder:validation:XValidation:rule="self.repoUrl == oldSelf.repoUrl && self.revision == oldSelf.revision && self.addonName == oldSelf.addonName",message="repoUrl, revision and addonName are immutable"
+type AddonMarketplaceInstallParameters struct {
+	// InstanceID references the target Argo CD Instance by its opaque
+	// Akuity ID. At least one of InstanceID or InstanceRef must be set;
+	// when both are present, InstanceID is used.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceRef references the target Argo CD Instance managed
+	// resource. The controller reads the referenced Instance's
+	// Status.AtProvider.ID. At least one of InstanceID or InstanceRef
+	// must be set.
+	// +optional
+	InstanceRef *LocalReference `json:"instanceRef,omitempty"`
+
+	// RepoURL is the Git URL of the addon repository the addon is
+	// written to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoUrl"`
+
+	// Revision is the branch the addon is written to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Revision string `json:"revision"`
+
+	// AddonName is the name of the addon in the repository.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	AddonName string `json:"addonName"`
+
+	// Type is the marketplace addon type.
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// HelmChart configures the Helm chart the addon wraps.
+	// +optional
+	HelmChart *AddonMarketplaceHelmChart `json:"helmChart,omitempty"`
+
+	// Overrides creates per-environment and per-cluster override files
+	// for the addon.
+	// +optional
+	Overrides *AddonMarketplaceInstallOverrides `json:"overrides,omitempty"`
+}
+
+// AddonMarketplaceHelmChart configures the Helm chart of a marketplace
+// addon.
+type AddonMarketplaceHelmChart struct {
+	// Name of the wrapper chart.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Version of the wrapper chart.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Description of the wrapper chart.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Dependencies are the charts the addon installs. Changing them
+	// updates the install in place.
+	// +optional
+	Dependencies []AddonChartDependency `json:"dependencies,omitempty"`
+}
+
+// AddonChartDependency is a Helm chart dependency of a marketplace addon.
+type AddonChartDependency struct {
+	// Name of the chart.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Version of the chart.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+
+	// Repository is the Helm repository URL of the chart.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Repository string `json:"repository"`
+
+	// RepositoryName is an optional alias for the repository.
+	// +optional
+	RepositoryName string `json:"repositoryName,omitempty"`
+}
+
+// AddonMarketplaceInstallOverrides names the environments and clusters
+// that get an override file.
+type AddonMarketplaceInstallOverrides struct {
+	// Envs are environment names.
+	// +optional
+	Envs []string `json:"envs,omitempty"`
+
+	// Clusters are cluster names.
+	// +optional
+	Clusters []string `json:"clusters,omitempty"`
+}
+
+// AddonMarketplaceInstallObservation reflects the observed state of an
+// addon marketplace install.
+type AddonMarketplaceInstallObservation struct {
+	// ID is the Akuity-assigned install ID.
+	ID string `json:"id,omitempty"`
+
+	// InstanceID is the resolved opaque Akuity ID of the target
+	// Instance, cached so Delete can remove the install even if the
+	// referenced Instance MR has already been removed.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// RepoURL reported by the Akuity platform.
+	RepoURL string `json:"repoUrl,omitempty"`
+
+	// Revision reported by the Akuity platform.
+	Revision string `json:"revision,omitempty"`
+
+	// AddonName reported by the Akuity platform.
+	AddonName string `json:"addonName,omitempty"`
+
+	// Dependencies reported by the Akuity platform.
+	Dependencies []AddonChartDependency `json:"dependencies,omitempty"`
+
+	// AddonFound reports whether the addon has been found in the
+	// repository.
+	AddonFound bool `json:"addonFound,omitempty"`
+
+	// ChecksumMatched reports whether the addon in the repository still
+	// matches what was installed.
+	ChecksumMatched bool `json:"checksumMatched,omitempty"`
+
+	// Processing reports whether the platform is still writing the
+	// addon.
+	Processing bool `json:"processing,omitempty"`
+
+	// LastEvent is the most recent install event.
+	LastEvent *AddonMarketplaceEvent `json:"lastEvent,omitempty"`
+}
+
+// AddonMarketplaceEvent is an install event reported by the platform.
+type AddonMarketplaceEvent struct {
+	// Type of the event.
+	Type string `json:"type,omitempty"`
+
+	// Message of the event.
+	Message string `json:"message,omitempty"`
+
+	// Time of the event.
+	Time *metav1.Time `json:"time,omitempty"`
+}
+
+// An AddonMarketplaceInstallSpec defines the desired state of an
+// AddonMarketplaceInstall.
+type AddonMarketplaceInstallSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AddonMarketplaceInstallParameters `json:"forProvider"`
+}
+
+// An AddonMarketplaceInstallStatus represents the observed state of an
+// AddonMarketplaceInstall.
+type AddonMarketplaceInstallStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AddonMarketplaceInstallObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AddonMarketplaceInstall is a managed resource that represents an
+// addon installed from the Akuity addon marketplace into an addon
+// repository of an Argo CD instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ADDON",type="string",JSONPath=".status.atProvider.addonName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type AddonMarketplaceInstall struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AddonMarketplaceInstallSpec   `json:"spec"`
+	Status AddonMarketplaceInstallStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AddonMarketplaceInstallList contains a list of AddonMarketplaceInstall.
+type AddonMarketplaceInstallList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AddonMarketplaceInstall `json:"items"`
+}
+
+// AddonMarketplaceInstall type metadata.
+var (
+	AddonMarketplaceInstallKind             = reflect.TypeOf(AddonMarketplaceInstall{}).Name()
+	AddonMarketplaceInstallGroupKind        = schema.GroupKind{Group: Group, Kind: AddonMarketplaceInstallKind}.String()
+	AddonMarketplaceInstallKindAPIVersion   = AddonMarketplaceInstallKind + "." + SchemeGroupVersion.String()
+	AddonMarketplaceInstallGroupVersionKind = SchemeGroupVersion.WithKind(AddonMarketplaceInstallKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AddonMarketplaceInstall{}, &AddonMarketplaceInstallList{})
+}
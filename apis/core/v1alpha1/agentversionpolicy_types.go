@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// AgentRolloutPhase is the state of an agent version rollout.
+type AgentRolloutPhase string
+
+// AgentRolloutPhase values.
+const (
+	// AgentRolloutProgressing means a wave has been started and the
+	// controller is waiting for its agents to report the target version
+	// and a healthy status.
+	AgentRolloutProgressing AgentRolloutPhase = "Progressing"
+	// AgentRolloutPaused means the previous wave succeeded and the
+	// controller is waiting out PauseBetweenWaves before the next one.
+	AgentRolloutPaused AgentRolloutPhase = "Paused"
+	// AgentRolloutCompleted means every selected agent runs the target
+	// version and is healthy.
+	AgentRolloutCompleted AgentRolloutPhase = "Completed"
+	// AgentRolloutHalted means an upgraded agent turned unhealthy or
+	// failed to reconcile, or a wave did not succeed within
+	// WaveTimeout. No further waves are started until the target
+	// version changes.
+	AgentRolloutHalted AgentRolloutPhase = "Halted"
+)
+
+// AgentVersionPolicyParameters describe a wave-based rollout of an
+// agent version across the Cluster managed resources of one Argo CD
+// instance. Callers supply the instance ID directly on InstanceID or
+// point at an Instance managed resource via InstanceRef.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.instanceId) || has(self.instanceRef)",message="instanceId or instanceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.instanceId) || (has(self.instanceId) && self.instanceId == oldSelf.instanceId)) && (!has(oldSelf.instanceRef) || (has(self.instanceRef) && self.instanceRef.name == oldSelf.instanceRef.name))",message="instanceId/instanceRef are immutable"
+type AgentVersionPolicyParameters struct {
+	// InstanceID references the Argo CD instance by its opaque Akuity
+	// ID. At least one of InstanceID or InstanceRef must be set; when
+	// both are present, InstanceID is used.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceRef references the Argo CD Instance managed resource by
+	// name. The controller reads the referenced resource's
+	// Status.AtProvider.ID. At least one of InstanceID or InstanceRef
+	// must be set.
+	// +optional
+	InstanceRef *LocalReference `json:"instanceRef,omitempty"`
+
+	// ClusterSelector selects the Cluster managed resources, by their
+	// labels, whose agents this policy upgrades. Only Clusters that
+	// belong to the target instance are considered.
+	// +kubebuilder:validation:Required
+	ClusterSelector metav1.LabelSelector `json:"clusterSelector"`
+
+	// TargetVersion is the agent version to roll out.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	TargetVersion string `json:"targetVersion"`
+
+	// WaveSize is the number of clusters upgraded per wave, either as
+	// an absolute count or as a percentage of the selected clusters
+	// (for example "25%"). Percentages round up, and every wave
+	// upgrades at least one cluster. Defaults to 1.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:XIntOrString
+	WaveSize intstr.IntOrString `json:"waveSize,omitempty"`
+
+	// PauseBetweenWaves is how long to wait after a wave has succeeded
+	// before the next wave is started. Defaults to no pause.
+	// +optional
+	PauseBetweenWaves *metav1.Duration `json:"pauseBetweenWaves,omitempty"`
+
+	// WaveTimeout is how long a wave may take for all of its clusters
+	// to report TargetVersion and a healthy status. A wave that has not
+	// succeeded by then halts the rollout. Defaults to 30m.
+	// +optional
+	WaveTimeout *metav1.Duration `json:"waveTimeout,omitempty"`
+}
+
+// AgentVersionPolicyObservation reports the progress of the rollout.
+type AgentVersionPolicyObservation struct {
+	// InstanceID is the resolved opaque Akuity ID of the target
+	// instance.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// TargetVersion is the version the rollout state below refers to.
+	// Changing spec.forProvider.targetVersion starts a new rollout.
+	TargetVersion string `json:"targetVersion,omitempty"`
+
+	// Phase is the state of the rollout.
+	Phase AgentRolloutPhase `json:"phase,omitempty"`
+
+	// Wave is the number of waves started for TargetVersion.
+	Wave int32 `json:"wave,omitempty"`
+
+	// WaveClusters are the names of the clusters upgraded by the most
+	// recent wave.
+	WaveClusters []string `json:"waveClusters,omitempty"`
+
+	// WaveStartTime is when the most recent wave was started. The wave
+	// halts the rollout if it has not succeeded WaveTimeout later.
+	WaveStartTime *metav1.Time `json:"waveStartTime,omitempty"`
+
+	// WaveCompletionTime is when the most recent wave was observed to
+	// have succeeded. The next wave starts PauseBetweenWaves later.
+	WaveCompletionTime *metav1.Time `json:"waveCompletionTime,omitempty"`
+
+	// SelectedClusters is the number of clusters the policy selects.
+	SelectedClusters int32 `json:"selectedClusters,omitempty"`
+
+	// UpdatedClusters is the number of selected clusters whose agent
+	// reports TargetVersion and a healthy status.
+	UpdatedClusters int32 `json:"updatedClusters,omitempty"`
+
+	// Message explains the current phase, such as which cluster halted
+	// the rollout.
+	Message string `json:"message,omitempty"`
+}
+
+// An AgentVersionPolicySpec defines the desired state of an
+// AgentVersionPolicy.
+type AgentVersionPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AgentVersionPolicyParameters `json:"forProvider"`
+}
+
+// An AgentVersionPolicyStatus represents the observed state of an
+// AgentVersionPolicy.
+type AgentVersionPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AgentVersionPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AgentVersionPolicy rolls an agent version out to the Clusters of
+// an Akuity Argo CD instance in waves.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.targetVersion"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".status.atProvider.phase"
+// +kubebuilder:printcolumn:name="UPDATED",type="integer",JSONPath=".status.atProvider.updatedClusters"
+// +kubebuilder:printcolumn:name="SELECTED",type="integer",JSONPath=".status.atProvider.selectedClusters"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type AgentVersionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AgentVersionPolicySpec   `json:"spec"`
+	Status AgentVersionPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AgentVersionPolicyList contains a list of AgentVersionPolicy.
+type AgentVersionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AgentVersionPolicy `json:"items"`
+}
+
+// AgentVersionPolicy type metadata.
+var (
+	AgentVersionPolicyKind             = reflect.TypeOf(AgentVersionPolicy{}).Name()
+	AgentVersionPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: AgentVersionPolicyKind}.String()
+	AgentVersionPolicyKindAPIVersion   = AgentVersionPolicyKind + "." + SchemeGroupVersion.String()
+	AgentVersionPolicyGroupVersionKind = SchemeGroupVersion.WithKind(AgentVersionPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AgentVersionPolicy{}, &AgentVersionPolicyList{})
+}
@@ -43,6 +43,8 @@ import (
 // +kubebuilder:validation:XValidation:rule="has(self.instanceId) || has(self.instanceRef)",message="instanceId or instanceRef must be set"
 // +kubebuilder:validation:XValidation:rule="(!has(oldSelf.instanceId) || (has(self.instanceId) && self.instanceId == oldSelf.instanceId)) && (!has(oldSelf.instanceRef) || (has(self.instanceRef) && self.instanceRef.name == oldSelf.instanceRef.name))",message="instanceId/instanceRef are immutable"
 // +kubebuilder:validation:XValidation:rule="self.name == oldSelf.name",message="name is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(self.maintenanceWindows) || size(self.maintenanceWindows) == 0 || !has(self.clusterSpec) || !has(self.clusterSpec.data) || (!has(self.clusterSpec.data.maintenanceMode) && !has(self.clusterSpec.data.maintenanceModeExpiry))",message="maintenanceWindows and clusterSpec.data.maintenanceMode/maintenanceModeExpiry are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.manifestsSink) || ((!has(self.kubeconfigSecretRef) || !has(self.kubeconfigSecretRef.name) || size(self.kubeconfigSecretRef.name) == 0) && (!has(self.enableInClusterKubeconfig) || !self.enableInClusterKubeconfig))",message="manifestsSink is mutually exclusive with kubeconfigSecretRef and enableInClusterKubeconfig"
 type ClusterParameters struct {
 	// InstanceID is the Akuity Argo CD instance ID this cluster belongs
 	// to. At least one of InstanceID or InstanceRef must be set; when
@@ -71,9 +73,43 @@ type ClusterParameters struct {
 	// EnableInClusterKubeConfig uses the provider pod's in-cluster
 	// configuration when the managed cluster is the provider cluster.
 	EnableInClusterKubeConfig bool `json:"enableInClusterKubeconfig,omitempty"`
+	// ManifestsSink writes the agent install manifests to a Secret or
+	// ConfigMap instead of applying them, for clusters the provider
+	// cannot reach. The objects are rewritten when the spec or the
+	// agent version changes. Mutually exclusive with
+	// KubeConfigSecretRef and EnableInClusterKubeConfig.
+	// +optional
+	ManifestsSink *ManifestsSink `json:"manifestsSink,omitempty"`
 	// RemoveAgentResourcesOnDestroy removes Akuity agent Kubernetes
 	// resources from the managed cluster during deletion. Defaults to true.
+	// With ManifestsSink set, the sink objects are deleted instead.
 	RemoveAgentResourcesOnDestroy bool `json:"removeAgentResourcesOnDestroy,omitempty"`
+	// MaintenanceWindows schedules recurring maintenance. While a
+	// window is open the controller enables maintenance mode with an
+	// expiry at the window's end, and disables it once all windows are
+	// closed. Mutually exclusive with data.maintenanceMode and
+	// data.maintenanceModeExpiry.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+	// SupportAccess grants Akuity support engineers access to this
+	// cluster. Leaving it unset means the controller never changes the
+	// platform's support-access setting.
+	// +optional
+	SupportAccess *ClusterSupportAccess `json:"supportAccess,omitempty"`
+}
+
+// ClusterSupportAccess controls Akuity support access to a cluster.
+type ClusterSupportAccess struct {
+	// Enabled grants support access while true. Setting it to false
+	// revokes access.
+	Enabled bool `json:"enabled"`
+	// ExpiresAt bounds the grant. The platform is given the matching
+	// duration, and the controller revokes access once ExpiresAt has
+	// passed even if Enabled is still true. Omit to keep access until
+	// Enabled is set to false.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 }
 
 // ClusterObservation contains the observable fields of a Cluster.
@@ -142,6 +178,64 @@ type ClusterObservation struct {
 	// grepping a dozen flat fields.
 	// +optional
 	ClusterSpec crossplanetypes.ClusterSpec `json:"clusterSpec,omitempty"`
+
+	CredentialRotationStatus `json:",inline"`
+	// MaintenanceWindow reports the state of spec.forProvider.maintenanceWindows.
+	// +optional
+	MaintenanceWindow *MaintenanceWindowStatus `json:"maintenanceWindow,omitempty"`
+	// SupportAccessUntil is when the platform's current support-access
+	// grant lapses. Unset when support access is not granted.
+	// +optional
+	SupportAccessUntil *metav1.Time `json:"supportAccessUntil,omitempty"`
+	// SupportAccessGranted reports whether support access is in effect.
+	// A grant without an expiry has no supportAccessUntil, so this flag
+	// is what records it.
+	// +optional
+	SupportAccessGranted bool `json:"supportAccessGranted,omitempty"`
+	// ManifestsSinkRevision identifies the spec generation and agent
+	// version last written to spec.forProvider.manifestsSink.
+	// +optional
+	ManifestsSinkRevision string `json:"manifestsSinkRevision,omitempty"`
+	// ConnectionDetailsRevision identifies the spec generation, agent
+	// version and manifest availability of the bootstrap material last
+	// published to the connection Secret.
+	// +optional
+	ConnectionDetailsRevision string `json:"connectionDetailsRevision,omitempty"`
+	// ConnectionDetailsRefreshTime is when the bootstrap material in the
+	// connection Secret was last fetched from the platform.
+	// +optional
+	ConnectionDetailsRefreshTime *metav1.Time `json:"connectionDetailsRefreshTime,omitempty"`
+	// ClusterInfo reports facts about the managed cluster itself.
+	// +optional
+	ClusterInfo *ClusterInfo `json:"clusterInfo,omitempty"`
+}
+
+// ClusterInfo reports facts about a managed cluster as seen by its
+// agent. The platform does not report node count or cloud platform.
+type ClusterInfo struct {
+	// KubernetesVersion is the cluster's Kubernetes version.
+	// +optional
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// APIResourceCount is the number of API resource types the agent
+	// discovered on the cluster.
+	// +optional
+	APIResourceCount int64 `json:"apiResourceCount,omitempty"`
+	// ObjectCount is the number of Kubernetes objects the agent tracks
+	// on the cluster.
+	// +optional
+	ObjectCount int64 `json:"objectCount,omitempty"`
+	// HasApplications reports whether any Argo CD Application deploys
+	// to the cluster. It is refreshed less often than the other fields;
+	// see LastRefreshTime.
+	// +optional
+	HasApplications *bool `json:"hasApplications,omitempty"`
+	// LastRefreshTime is when HasApplications was last fetched.
+	// +optional
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+	// LastAttemptTime is when fetching HasApplications was last
+	// attempted, whether or not it succeeded.
+	// +optional
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
 }
 
 type ClusterObservationAgentState struct {
@@ -173,6 +267,7 @@ type ClusterStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="K8S-VERSION",type="string",JSONPath=".status.atProvider.clusterInfo.kubernetesVersion"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // LocalReference is a cluster-wide reference to another managed
 // resource by name. Cluster-scoped MRs in v1alpha1 do not live in
@@ -29,6 +33,42 @@ type LocalReference struct {
 	Name string `json:"name"`
 }
 
+// ManifestsSink names a Secret or ConfigMap on the provider's cluster
+// that receives agent install manifests instead of having the
+// controller apply them. Manifests larger than a single object are
+// split at document boundaries across objects named <name>,
+// <name>-1, <name>-2, and so on; the first object's
+// akuity.crossplane.io/manifests-chunks annotation records the count.
+// Objects are labelled akuity.crossplane.io/manifests-sink-owner with
+// the managed resource's UID; existing objects without that label are
+// never overwritten or deleted.
+// Each object stores its part under the "manifests.yaml" key.
+type ManifestsSink struct {
+	// Kind of object to write. Defaults to Secret.
+	// +optional
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	// +kubebuilder:default=Secret
+	Kind string `json:"kind,omitempty"`
+	// Name of the object, and the prefix of any additional chunk objects.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace of the object.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
+
+// WorkspaceResolution records the canonical workspace ID that a
+// spec.forProvider.workspace value last resolved to, so a workspace
+// given by name is looked up again only when spec changes.
+type WorkspaceResolution struct {
+	// Workspace is the spec.forProvider.workspace value that was resolved.
+	Workspace string `json:"workspace,omitempty"`
+	// ID is the canonical workspace ID Workspace resolved to.
+	ID string `json:"id,omitempty"`
+}
+
 // ResourceStatusCode captures the Akuity API status code and message pair
 // exposed on most observable resources.
 type ResourceStatusCode struct {
@@ -93,3 +133,120 @@ type KargoRepoCredentialSecretRef struct {
 	// +kubebuilder:validation:Enum=git;helm;generic;image
 	CredType string `json:"credType,omitempty"`
 }
+
+// APIKeyPermissions scopes what an Akuity API key may do. Roles and
+// CustomRoles grant the permissions of the named roles; Actions grants
+// individual actions on top of them.
+type APIKeyPermissions struct {
+	// Actions lists individual actions granted to the key.
+	// +optional
+	Actions []string `json:"actions,omitempty"`
+	// Roles lists built-in roles granted to the key.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+	// CustomRoles lists the IDs of custom roles granted to the key.
+	// +optional
+	CustomRoles []string `json:"customRoles,omitempty"`
+}
+
+// AnnotationRefresh asks the controller to refresh the external
+// resource from its source. Any value not yet recorded in
+// status.atProvider.lastRefresh triggers one refresh; a timestamp is a
+// convenient value. Honoured by InstanceAddonRepo and InstanceAddon.
+const AnnotationRefresh = "akuity.crossplane.io/refresh"
+
+// AnnotationRotateCredentials asks the controller to rotate the agent
+// credentials and re-apply the install manifests. Any value not yet
+// recorded in status.atProvider.lastCredentialRotation triggers one
+// rotation. Honoured by Cluster and KargoAgent.
+const AnnotationRotateCredentials = "akuity.crossplane.io/rotate-credentials"
+
+// AnnotationManifestsSinkCreated records the generation under which
+// Create wrote the manifests sink. Create cannot record the sink
+// revision in status: the managed reconciler reverts status changes
+// made during Create when it persists the external-name annotation.
+// Set by Cluster and KargoAgent.
+const AnnotationManifestsSinkCreated = "akuity.crossplane.io/manifests-sink-created"
+
+// CredentialRotationStatus records how far the controller has acted on
+// the akuity.crossplane.io/rotate-credentials annotation.
+type CredentialRotationStatus struct {
+	// LastCredentialRotation is the
+	// akuity.crossplane.io/rotate-credentials annotation value last
+	// acted on.
+	// +optional
+	LastCredentialRotation string `json:"lastCredentialRotation,omitempty"`
+	// LastCredentialRotationTime is when the agent credentials were
+	// last rotated by this controller.
+	// +optional
+	LastCredentialRotationTime *metav1.Time `json:"lastCredentialRotationTime,omitempty"`
+	// CredentialRotationManifestsPending is set while the install
+	// manifests carrying the rotated credentials have not been
+	// delivered. Delivery is retried without rotating again.
+	// +optional
+	CredentialRotationManifestsPending bool `json:"credentialRotationManifestsPending,omitempty"`
+}
+
+// Condition type and reasons reported by Instance and KargoInstance for
+// the organization quota and plan checks run before each write.
+const (
+	TypePreflight xpv1.ConditionType = "Preflight"
+
+	ReasonPreflightPassed    xpv1.ConditionReason = "PreflightPassed"
+	ReasonQuotaExceeded      xpv1.ConditionReason = "QuotaExceeded"
+	ReasonFeatureNotEntitled xpv1.ConditionReason = "FeatureNotEntitled"
+)
+
+// PreflightPassed returns a condition reporting that the organization's
+// quota and plan allow the desired spec.
+func PreflightPassed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePreflight,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPreflightPassed,
+	}
+}
+
+// PreflightFailed returns a condition reporting why the organization's
+// quota or plan rejects the desired spec.
+func PreflightFailed(r xpv1.ConditionReason, err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePreflight,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            err.Error(),
+	}
+}
+
+// MaintenanceWindow is a recurring period during which the controller
+// holds an agent in maintenance mode.
+type MaintenanceWindow struct {
+	// Schedule is a standard five-field cron expression (minute, hour,
+	// day of month, month, day of week) marking the start of each
+	// window, for example "0 2 * * 0" for Sundays at 02:00.
+	// Descriptors such as @weekly are accepted; @every is not.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// Duration is how long each window lasts, for example "2h".
+	Duration metav1.Duration `json:"duration"`
+	// Timezone is the IANA time zone Schedule is evaluated in.
+	// Defaults to UTC.
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// MaintenanceWindowStatus reports the evaluation of maintenanceWindows
+// on the most recent reconcile.
+type MaintenanceWindowStatus struct {
+	// Active is true while a maintenance window is open.
+	Active bool `json:"active"`
+	// ActiveUntil is when the open window closes. Overlapping and
+	// back-to-back windows are merged. Set only while Active.
+	// +optional
+	ActiveUntil *metav1.Time `json:"activeUntil,omitempty"`
+	// NextStart is when the next window opens after the current state.
+	// +optional
+	NextStart *metav1.Time `json:"nextStart,omitempty"`
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// CustomRoleParameters are the configurable fields of an Akuity
+// organization custom role.
+type CustomRoleParameters struct {
+	// Name of the custom role. Must be unique within the organization.
+	// Required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Description of the custom role.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Policy lists the permissions granted by the role, one rule per
+	// line. Rule order, blank lines, and surrounding whitespace are not
+	// significant.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Policy string `json:"policy"`
+}
+
+// CustomRoleObservation reflects the observed state of an Akuity custom
+// role.
+type CustomRoleObservation struct {
+	// ID is the platform-assigned custom role ID. Team resolves
+	// spec.forProvider.customRoleRefs through this field.
+	ID string `json:"id,omitempty"`
+	// Name of the role as reported by the Akuity platform.
+	Name string `json:"name,omitempty"`
+	// Description of the role as reported by the Akuity platform.
+	Description string `json:"description,omitempty"`
+	// Policy of the role as reported by the Akuity platform.
+	Policy string `json:"policy,omitempty"`
+}
+
+// A CustomRoleSpec defines the desired state of a CustomRole.
+type CustomRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CustomRoleParameters `json:"forProvider"`
+}
+
+// A CustomRoleStatus represents the observed state of a CustomRole.
+type CustomRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CustomRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CustomRole is a managed resource that represents an Akuity
+// organization custom role.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type CustomRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomRoleSpec   `json:"spec"`
+	Status CustomRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomRoleList contains a list of CustomRole.
+type CustomRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomRole `json:"items"`
+}
+
+// CustomRole type metadata.
+var (
+	CustomRoleKind             = reflect.TypeOf(CustomRole{}).Name()
+	CustomRoleGroupKind        = schema.GroupKind{Group: Group, Kind: CustomRoleKind}.String()
+	CustomRoleKindAPIVersion   = CustomRoleKind + "." + SchemeGroupVersion.String()
+	CustomRoleGroupVersionKind = SchemeGroupVersion.WithKind(CustomRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CustomRole{}, &CustomRoleList{})
+}
@@ -45,11 +45,19 @@ type InstanceParameters struct {
 	// preferred value is the workspace ID because workspace-scoped gateway calls
 	// are routed by ID. A workspace name is also accepted and resolved before
 	// gateway calls. When omitted, the organization default workspace is used on
-	// create. The canonical workspace ID is reported in
+	// create. Changing it on an existing instance moves the instance to the
+	// new workspace. The canonical workspace ID is reported in
 	// status.atProvider.workspace.
 	// +optional
 	Workspace string `json:"workspace,omitempty"`
 
+	// WorkspaceRef references a Workspace managed resource by name. The
+	// controller reads the referenced Workspace's Status.AtProvider.ID
+	// and routes gateway calls to that workspace. When set, WorkspaceRef
+	// takes precedence over Workspace.
+	// +optional
+	WorkspaceRef *LocalReference `json:"workspaceRef,omitempty"`
+
 	// ArgoCDConfigMap sets options in the argocd-cm ConfigMap.
 	// Refer to the example in github.com/akuity/provider-crossplane-akuity/examples/instance.yaml
 	// for required keys when using this option.
@@ -89,11 +97,20 @@ type InstanceParameters struct {
 	// server.secretkey, dex.config, webhook.*.secret,
 	// oidc.*.clientSecret). Removing this ref stops applying the
 	// platform-side Secret, but does not delete it from the Akuity
-	// platform.
+	// platform. When PublishAdminPassword is set and the Secret has
+	// admin.password, its value is also published as the
+	// adminPasswordHash connection detail.
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="has(self.name) && size(self.name) > 0 && has(self.__namespace__) && size(self.__namespace__) > 0",message="argocdSecretRef.name and argocdSecretRef.namespace are required"
 	ArgoCDSecretRef *xpv1.SecretReference `json:"argocdSecretRef,omitempty"`
 
+	// PublishAdminPassword publishes the admin.password value from
+	// ArgoCDSecretRef as the adminPasswordHash connection detail. It is
+	// off by default so the hash is not copied into the connection
+	// Secret unless asked for. Not sent to the Akuity platform.
+	// +optional
+	PublishAdminPassword bool `json:"publishAdminPassword,omitempty"`
+
 	// ArgoCDNotificationsSecretRef references a namespaced Secret
 	// whose data is sent verbatim as the argocd-notifications-secret
 	// payload (SMTP, Slack, webhook tokens). Removing this ref stops
@@ -176,6 +193,20 @@ type InstanceObservation struct {
 	// Used as the drift signal for Secret rotation.
 	// +optional
 	SecretHash string `json:"secretHash,omitempty"`
+
+	// ResolvedWorkspace caches the canonical ID spec.forProvider.workspace
+	// last resolved to. Used to detect a pending workspace move without
+	// resolving a workspace name on every poll.
+	// +optional
+	ResolvedWorkspace *WorkspaceResolution `json:"resolvedWorkspace,omitempty"`
+
+	// PreflightFeaturesHash identifies the plan-gated fields set in
+	// spec.forProvider when the organization's plan was last found to
+	// include their features. The plan lookup is skipped while those
+	// fields are unchanged and cleared after a failed apply, so a plan
+	// downgrade is caught on the next write.
+	// +optional
+	PreflightFeaturesHash string `json:"preflightFeaturesHash,omitempty"`
 }
 
 // An InstanceSpec defines the desired state of an Instance.
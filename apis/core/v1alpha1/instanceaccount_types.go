@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AnnotationRotatePassword triggers a password rotation on an
+// InstanceAccount. Setting it to any value the controller has not yet
+// handled (for example a timestamp) generates a new password, or
+// re-applies passwordSecretRef, and republishes the connection details.
+const AnnotationRotatePassword = "akuity.crossplane.io/rotate-password"
+
+// InstanceAccountParameters are the configurable fields of an Argo CD
+// local account on an Akuity instance. The account is keyed by name on
+// the instance; callers supply the instance ID directly on InstanceID
+// or point at an Instance managed resource via InstanceRef.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.instanceId) || has(self.instanceRef)",message="instanceId or instanceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.instanceId) || (has(self.instanceId) && self.instanceId == oldSelf.instanceId)) && (!has(oldSelf.instanceRef) || (has(self.instanceRef) && self.instanceRef.name == oldSelf.instanceRef.name))",message="instanceId/instanceRef are immutable"
+// +kubebuilder:validation:XValidation:rule="self.name == oldSelf.name",message="name is immutable"
+type InstanceAccountParameters struct {
+	// InstanceID references the target Argo CD Instance by its opaque
+	// Akuity ID. At least one of InstanceID or InstanceRef must be set;
+	// when both are present, InstanceID is used.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceRef references the target Argo CD Instance managed
+	// resource. The controller reads the referenced Instance's
+	// Status.AtProvider.ID. At least one of InstanceID or InstanceRef
+	// must be set.
+	// +optional
+	InstanceRef *LocalReference `json:"instanceRef,omitempty"`
+
+	// Name is the Argo CD account name.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Capabilities granted to the account.
+	// +optional
+	Capabilities InstanceAccountCapabilities `json:"capabilities,omitempty"`
+
+	// Disabled disables the account without deleting it.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// PasswordSecretRef selects a Secret key holding the account
+	// password. When omitted and the account has the login capability,
+	// the platform generates the password. Either way the password is
+	// published to the connection Secret.
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// InstanceAccountCapabilities are the Argo CD account capabilities.
+type InstanceAccountCapabilities struct {
+	// Login allows the account to log in with a password.
+	// +optional
+	Login bool `json:"login,omitempty"`
+
+	// APIKey allows the account to generate API tokens.
+	// +optional
+	APIKey bool `json:"apiKey,omitempty"`
+}
+
+// InstanceAccountObservation reflects the observed state of an Argo CD
+// local account. The password is never part of the observation.
+type InstanceAccountObservation struct {
+	// InstanceID is the resolved opaque Akuity ID of the target
+	// Instance, cached so Delete can remove the account even if the
+	// referenced Instance MR has already been removed.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// Name is the account name as reported by the Akuity platform.
+	Name string `json:"name,omitempty"`
+
+	// Capabilities reported by the Akuity platform.
+	Capabilities InstanceAccountCapabilities `json:"capabilities,omitempty"`
+
+	// Disabled reports whether the account is disabled.
+	Disabled bool `json:"disabled,omitempty"`
+
+	// PasswordUpdateTime is the RFC 3339 time at which the controller
+	// last set the account password.
+	PasswordUpdateTime string `json:"passwordUpdateTime,omitempty"`
+
+	// PasswordRotation is the last value of the
+	// akuity.crossplane.io/rotate-password annotation the controller
+	// handled.
+	PasswordRotation string `json:"passwordRotation,omitempty"`
+
+	// SecretHash is a digest of the passwordSecretRef value last
+	// written. It lets the controller detect a rotated Secret without
+	// storing the password.
+	SecretHash string `json:"secretHash,omitempty"`
+}
+
+// An InstanceAccountSpec defines the desired state of an
+// InstanceAccount.
+type InstanceAccountSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceAccountParameters `json:"forProvider"`
+}
+
+// An InstanceAccountStatus represents the observed state of an
+// InstanceAccount.
+type InstanceAccountStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceAccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InstanceAccount is a managed resource that represents an Argo CD
+// local account on an Akuity instance. The account name and password
+// are written to the writeConnectionSecretToRef Secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PASSWORD-UPDATED",type="string",JSONPath=".status.atProvider.passwordUpdateTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type InstanceAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceAccountSpec   `json:"spec"`
+	Status InstanceAccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceAccountList contains a list of InstanceAccount.
+type InstanceAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceAccount `json:"items"`
+}
+
+// InstanceAccount type metadata.
+var (
+	InstanceAccountKind             = reflect.TypeOf(InstanceAccount{}).Name()
+	InstanceAccountGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceAccountKind}.String()
+	InstanceAccountKindAPIVersion   = InstanceAccountKind + "." + SchemeGroupVersion.String()
+	InstanceAccountGroupVersionKind = SchemeGroupVersion.WithKind(InstanceAccountKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&InstanceAccount{}, &InstanceAccountList{})
+}
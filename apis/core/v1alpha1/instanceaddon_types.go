@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// InstanceAddonParameters are the configurable fields of an addon on an
+// Argo CD instance. Addons are discovered from an addon repository, so
+// the resource takes over an addon the platform has already found by
+// name and manages only the fields set here; unset fields are left as
+// the repository defines them.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.instanceId) || has(self.instanceRef)",message="instanceId or instanceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.instanceId) || (has(self.instanceId) && self.instanceId == oldSelf.instanceId)) && (!has(oldSelf.instanceRef) || (has(self.instanceRef) && self.instanceRef.name == oldSelf.instanceRef.name))",message="instanceId/instanceRef are immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.repoId) || has(self.repoRef)",message="repoId or repoRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.repoId) || (has(self.repoId) && self.repoId == oldSelf.repoId)) && (!has(oldSelf.repoRef) || (has(self.repoRef) && self.repoRef.name == oldSelf.repoRef.name))",message="repoId/repoRef are immutable"
+// +kubebuilder:validation:XValidation:rule="self.name == oldSelf.name",message="name is immutable"
+type InstanceAddonParameters struct {
+	// InstanceID references the target Argo CD Instance by its opaque
+	// Akuity ID. At least one of InstanceID or InstanceRef must be set;
+	// when both are present, InstanceID is used.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceRef references the target Argo CD Instance managed
+	// resource. The controller reads the referenced Instance's
+	// Status.AtProvider.ID. At least one of InstanceID or InstanceRef
+	// must be set.
+	// +optional
+	InstanceRef *LocalReference `json:"instanceRef,omitempty"`
+
+	// RepoID references the addon repository the addon is defined in
+	// by its Akuity ID. At least one of RepoID or RepoRef must be set;
+	// when both are present, RepoID is used.
+	// +optional
+	RepoID string `json:"repoId,omitempty"`
+
+	// RepoRef references the InstanceAddonRepo managed resource the
+	// addon is defined in. The controller reads the referenced
+	// repository's Status.AtProvider.ID.
+	// +optional
+	RepoRef *LocalReference `json:"repoRef,omitempty"`
+
+	// Name is the addon name as defined in the repository.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Enabled turns the addon on or off. Left as the repository defines
+	// it when unset.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ClusterSelector selects the clusters the addon is installed on.
+	// Left as the repository defines it when unset.
+	// +optional
+	ClusterSelector *AddonClusterSelector `json:"clusterSelector,omitempty"`
+}
+
+// AddonClusterSelector selects clusters by name and by label. A cluster
+// must match every filter to be selected.
+type AddonClusterSelector struct {
+	// NameFilters match on the cluster name. Key is ignored.
+	// +optional
+	NameFilters []AddonSelector `json:"nameFilters,omitempty"`
+
+	// LabelFilters match on the value of the cluster label named by
+	// Key.
+	// +optional
+	LabelFilters []AddonSelector `json:"labelFilters,omitempty"`
+}
+
+// AddonSelector is a single cluster filter.
+type AddonSelector struct {
+	// Key is the cluster label a label filter matches on.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Operator is how the value is matched against Values.
+	// +kubebuilder:validation:Enum=In;NotIn
+	Operator string `json:"operator"`
+
+	// Values are the names or label values matched.
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// InstanceAddonObservation reflects the observed state of an addon.
+type InstanceAddonObservation struct {
+	// ID is the Akuity-assigned addon ID.
+	ID string `json:"id,omitempty"`
+
+	// InstanceID is the resolved opaque Akuity ID of the target
+	// Instance, cached so Delete can remove the addon even if the
+	// referenced Instance MR has already been removed.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// RepoID is the ID of the addon repository the addon is defined in.
+	RepoID string `json:"repoId,omitempty"`
+
+	// Name reported by the Akuity platform.
+	Name string `json:"name,omitempty"`
+
+	// AddonType reported by the Akuity platform, for example helm or
+	// kustomize.
+	AddonType string `json:"addonType,omitempty"`
+
+	// Enabled reported by the Akuity platform.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// ClusterSelector reported by the Akuity platform.
+	ClusterSelector *AddonClusterSelector `json:"clusterSelector,omitempty"`
+
+	// ClusterCount is the number of clusters the addon is installed on.
+	ClusterCount uint32 `json:"clusterCount,omitempty"`
+
+	// LastSyncTime is when the platform last read the addon.
+	LastSyncTime string `json:"lastSyncTime,omitempty"`
+
+	// LastSyncCommit is the commit the platform last read.
+	LastSyncCommit string `json:"lastSyncCommit,omitempty"`
+
+	// ReconciliationStatus is the platform's reconciliation status for
+	// the addon.
+	ReconciliationStatus ResourceStatusCode `json:"reconciliationStatus,omitempty"`
+
+	// Errors lists the addon errors reported by the platform, as
+	// "<scope>: <type>: <message>".
+	Errors []string `json:"errors,omitempty"`
+
+	// LastRefresh is the akuity.crossplane.io/refresh annotation value
+	// last acted on.
+	LastRefresh string `json:"lastRefresh,omitempty"`
+}
+
+// An InstanceAddonSpec defines the desired state of an InstanceAddon.
+type InstanceAddonSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceAddonParameters `json:"forProvider"`
+}
+
+// An InstanceAddonStatus represents the observed state of an
+// InstanceAddon.
+type InstanceAddonStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceAddonObservation `json:"atProvider,omitempty"`
+}
+
+// ReasonAddonErrors is the Ready condition reason InstanceAddon reports
+// while the platform lists errors for the addon.
+const ReasonAddonErrors xpv1.ConditionReason = "AddonErrors"
+
+// AddonErrors returns a Ready=False condition carrying the addon errors
+// reported by the platform.
+func AddonErrors(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAddonErrors,
+		Message:            msg,
+	}
+}
+
+// +kubebuilder:object:root=true
+
+// An InstanceAddon is a managed resource that represents an addon on an
+// Argo CD instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".status.atProvider.enabled"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type InstanceAddon struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceAddonSpec   `json:"spec"`
+	Status InstanceAddonStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceAddonList contains a list of InstanceAddon.
+type InstanceAddonList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceAddon `json:"items"`
+}
+
+// InstanceAddon type metadata.
+var (
+	InstanceAddonKind             = reflect.TypeOf(InstanceAddon{}).Name()
+	InstanceAddonGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceAddonKind}.String()
+	InstanceAddonKindAPIVersion   = InstanceAddonKind + "." + SchemeGroupVersion.String()
+	InstanceAddonGroupVersionKind = SchemeGroupVersion.WithKind(InstanceAddonKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&InstanceAddon{}, &InstanceAddonList{})
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// InstanceAddonRepoParameters are the configurable fields of an addon
+// repository on an Argo CD instance. The platform has no update call
+// for addon repositories, so the repository URL and revision are
+// immutable; callers supply the instance ID directly on InstanceID or
+// point at an Instance managed resource via InstanceRef.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.instanceId) || has(self.instanceRef)",message="instanceId or instanceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.instanceId) || (has(self.instanceId) && self.instanceId == oldSelf.instanceId)) && (!has(oldSelf.instanceRef) || (has(self.instanceRef) && self.instanceRef.name == oldSelf.instanceRef.name))",message="instanceId/instanceRef are immutable"
+// +kubebuilder:validation:XValidation:rule="self.repoUrl == oldSelf.repoUrl && self.revision == oldSelf.revision",message="repoUrl and revision are immutable"
+type InstanceAddonRepoParameters struct {
+	// InstanceID references the target Argo CD Instance by its opaque
+	// Akuity ID. At least one of InstanceID or InstanceRef must be set;
+	// when both are present, InstanceID is used.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceRef references the target Argo CD Instance managed
+	// resource. The controller reads the referenced Instance's
+	// Status.AtProvider.ID. At least one of InstanceID or InstanceRef
+	// must be set.
+	// +optional
+	InstanceRef *LocalReference `json:"instanceRef,omitempty"`
+
+	// RepoURL is the Git URL of the addon repository.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoUrl"`
+
+	// Revision is the branch, tag or commit the addons are read from.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Revision string `json:"revision"`
+}
+
+// InstanceAddonRepoObservation reflects the observed state of an addon
+// repository.
+type InstanceAddonRepoObservation struct {
+	// ID is the Akuity-assigned addon repository ID.
+	ID string `json:"id,omitempty"`
+
+	// InstanceID is the resolved opaque Akuity ID of the target
+	// Instance, cached so Delete can remove the repository even if the
+	// referenced Instance MR has already been removed.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// RepoURL reported by the Akuity platform.
+	RepoURL string `json:"repoUrl,omitempty"`
+
+	// Revision reported by the Akuity platform.
+	Revision string `json:"revision,omitempty"`
+
+	// LastSyncTime is when the platform last read the repository.
+	LastSyncTime string `json:"lastSyncTime,omitempty"`
+
+	// LastSyncCommit is the commit the platform last read.
+	LastSyncCommit string `json:"lastSyncCommit,omitempty"`
+
+	// AddonCount is the number of addons discovered in the repository.
+	AddonCount uint32 `json:"addonCount,omitempty"`
+
+	// ReconciliationStatus is the platform's reconciliation status for
+	// the repository.
+	ReconciliationStatus ResourceStatusCode `json:"reconciliationStatus,omitempty"`
+
+	// LastRefresh is the akuity.crossplane.io/refresh annotation value
+	// last acted on.
+	LastRefresh string `json:"lastRefresh,omitempty"`
+}
+
+// An InstanceAddonRepoSpec defines the desired state of an
+// InstanceAddonRepo.
+type InstanceAddonRepoSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceAddonRepoParameters `json:"forProvider"`
+}
+
+// An InstanceAddonRepoStatus represents the observed state of an
+// InstanceAddonRepo.
+type InstanceAddonRepoStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceAddonRepoObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InstanceAddonRepo is a managed resource that represents an addon
+// repository on an Argo CD instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ADDONS",type="integer",JSONPath=".status.atProvider.addonCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,path=instanceaddonrepos,categories={crossplane,managed,akuity}
+type InstanceAddonRepo struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceAddonRepoSpec   `json:"spec"`
+	Status InstanceAddonRepoStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceAddonRepoList contains a list of InstanceAddonRepo.
+type InstanceAddonRepoList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceAddonRepo `json:"items"`
+}
+
+// InstanceAddonRepo type metadata.
+var (
+	InstanceAddonRepoKind             = reflect.TypeOf(InstanceAddonRepo{}).Name()
+	InstanceAddonRepoGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceAddonRepoKind}.String()
+	InstanceAddonRepoKindAPIVersion   = InstanceAddonRepoKind + "." + SchemeGroupVersion.String()
+	InstanceAddonRepoGroupVersionKind = SchemeGroupVersion.WithKind(InstanceAddonRepoKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&InstanceAddonRepo{}, &InstanceAddonRepoList{})
+}
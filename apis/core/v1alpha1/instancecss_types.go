@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// InstanceCSSParameters manage the custom stylesheet of an Argo CD
+// instance's UI. Callers can supply the ID directly on InstanceID or
+// point at an Instance managed resource in the same namespace via
+// InstanceRef.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.instanceId) || has(self.instanceRef)",message="instanceId or instanceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.instanceId) || (has(self.instanceId) && self.instanceId == oldSelf.instanceId)) && (!has(oldSelf.instanceRef) || (has(self.instanceRef) && self.instanceRef.name == oldSelf.instanceRef.name))",message="instanceId/instanceRef are immutable"
+type InstanceCSSParameters struct {
+	// InstanceID references the target Argo CD Instance by its opaque
+	// Akuity ID. At least one of InstanceID or InstanceRef must be set;
+	// when both are present, InstanceID is used.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceRef references the target Argo CD Instance by name in the
+	// same namespace as this InstanceCSS. The controller reads the
+	// referenced Instance's Status.AtProvider.ID to resolve the
+	// underlying Akuity ID. At least one of InstanceID or InstanceRef
+	// must be set.
+	// +optional
+	InstanceRef *LocalReference `json:"instanceRef,omitempty"`
+
+	// CSS is the stylesheet applied to the Argo CD UI.
+	// +optional
+	CSS string `json:"css,omitempty"`
+}
+
+// InstanceCSSObservation reflects the observed custom stylesheet on the
+// referenced Argo CD Instance.
+type InstanceCSSObservation struct {
+	// CSS is the stylesheet currently applied to the Argo CD UI.
+	CSS string `json:"css,omitempty"`
+
+	// InstanceID is the resolved opaque Akuity ID of the target
+	// Instance, cached on first successful Observe so Delete can clear
+	// the remote stylesheet even if the referenced Instance MR has
+	// already been removed.
+	InstanceID string `json:"instanceId,omitempty"`
+}
+
+// An InstanceCSSSpec defines the desired state of an InstanceCSS.
+type InstanceCSSSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceCSSParameters `json:"forProvider"`
+}
+
+// An InstanceCSSStatus represents the observed state of an InstanceCSS.
+type InstanceCSSStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceCSSObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InstanceCSS manages the custom UI stylesheet of an Argo CD
+// Instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,path=instancecsses,categories={crossplane,managed,akuity}
+type InstanceCSS struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceCSSSpec   `json:"spec"`
+	Status InstanceCSSStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceCSSList contains a list of InstanceCSS.
+type InstanceCSSList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceCSS `json:"items"`
+}
+
+// InstanceCSS type metadata.
+var (
+	InstanceCSSKind             = reflect.TypeOf(InstanceCSS{}).Name()
+	InstanceCSSGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceCSSKind}.String()
+	InstanceCSSKindAPIVersion   = InstanceCSSKind + "." + SchemeGroupVersion.String()
+	InstanceCSSGroupVersionKind = SchemeGroupVersion.WithKind(InstanceCSSKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&InstanceCSS{}, &InstanceCSSList{})
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// InstanceQuotaTarget is the type of instance a quota applies to.
+// +kubebuilder:validation:Enum=ArgoCD;Kargo
+type InstanceQuotaTarget string
+
+// InstanceQuotaTarget values.
+const (
+	InstanceQuotaTargetArgoCD InstanceQuotaTarget = "ArgoCD"
+	InstanceQuotaTargetKargo  InstanceQuotaTarget = "Kargo"
+)
+
+// InstanceQuotaParameters set the capacity quota of one Akuity
+// instance: the maximum number of applications on an Argo CD instance,
+// or the maximum number of stages on a Kargo instance. Callers supply
+// the instance ID directly on InstanceID or point at an Instance (kind
+// ArgoCD) or KargoInstance (kind Kargo) managed resource via
+// InstanceRef.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.instanceId) || has(self.instanceRef)",message="instanceId or instanceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.instanceId) || (has(self.instanceId) && self.instanceId == oldSelf.instanceId)) && (!has(oldSelf.instanceRef) || (has(self.instanceRef) && self.instanceRef.name == oldSelf.instanceRef.name))",message="instanceId/instanceRef are immutable"
+// +kubebuilder:validation:XValidation:rule="self.kind == oldSelf.kind",message="kind is immutable"
+type InstanceQuotaParameters struct {
+	// Kind is the type of the target instance: ArgoCD caps the number
+	// of applications, Kargo caps the number of stages.
+	// +kubebuilder:validation:Required
+	Kind InstanceQuotaTarget `json:"kind"`
+
+	// InstanceID references the target instance by its opaque Akuity
+	// ID. At least one of InstanceID or InstanceRef must be set; when
+	// both are present, InstanceID is used.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceRef references the target instance managed resource by
+	// name: an Instance for kind ArgoCD, a KargoInstance for kind
+	// Kargo. The controller reads the referenced resource's
+	// Status.AtProvider.ID. At least one of InstanceID or InstanceRef
+	// must be set.
+	// +optional
+	InstanceRef *LocalReference `json:"instanceRef,omitempty"`
+
+	// MaxCount is the maximum number of applications (kind ArgoCD) or
+	// stages (kind Kargo) allowed on the instance.
+	// +kubebuilder:validation:Minimum=0
+	MaxCount int32 `json:"maxCount"`
+}
+
+// InstanceQuotaObservation reflects the quota and usage reported for
+// the target instance.
+type InstanceQuotaObservation struct {
+	// InstanceID is the resolved opaque Akuity ID of the target
+	// instance.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceName is the name of the target instance.
+	InstanceName string `json:"instanceName,omitempty"`
+
+	// MaxCount is the quota currently enforced on the instance.
+	MaxCount int32 `json:"maxCount,omitempty"`
+
+	// CurrentCount is the number of applications (kind ArgoCD) or
+	// stages (kind Kargo) currently on the instance.
+	CurrentCount int32 `json:"currentCount,omitempty"`
+}
+
+// An InstanceQuotaSpec defines the desired state of an InstanceQuota.
+type InstanceQuotaSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceQuotaParameters `json:"forProvider"`
+}
+
+// An InstanceQuotaStatus represents the observed state of an
+// InstanceQuota.
+type InstanceQuotaStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceQuotaObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InstanceQuota manages the application or stage quota of an Akuity
+// Argo CD or Kargo instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".spec.forProvider.kind"
+// +kubebuilder:printcolumn:name="CURRENT",type="integer",JSONPath=".status.atProvider.currentCount"
+// +kubebuilder:printcolumn:name="MAX",type="integer",JSONPath=".status.atProvider.maxCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type InstanceQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceQuotaSpec   `json:"spec"`
+	Status InstanceQuotaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceQuotaList contains a list of InstanceQuota.
+type InstanceQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceQuota `json:"items"`
+}
+
+// InstanceQuota type metadata.
+var (
+	InstanceQuotaKind             = reflect.TypeOf(InstanceQuota{}).Name()
+	InstanceQuotaGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceQuotaKind}.String()
+	InstanceQuotaKindAPIVersion   = InstanceQuotaKind + "." + SchemeGroupVersion.String()
+	InstanceQuotaGroupVersionKind = SchemeGroupVersion.WithKind(InstanceQuotaKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&InstanceQuota{}, &InstanceQuotaList{})
+}
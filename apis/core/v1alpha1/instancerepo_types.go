@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// InstanceRepoParameters register a single repository on an Argo CD
+// instance, separately from the Instance spec, so teams can own their
+// repository registrations with their own RBAC and lifecycle. Callers
+// supply the instance ID directly on InstanceID or point at an
+// Instance managed resource via InstanceRef.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.instanceId) || has(self.instanceRef)",message="instanceId or instanceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.instanceId) || (has(self.instanceId) && self.instanceId == oldSelf.instanceId)) && (!has(oldSelf.instanceRef) || (has(self.instanceRef) && self.instanceRef.name == oldSelf.instanceRef.name))",message="instanceId/instanceRef are immutable"
+// +kubebuilder:validation:XValidation:rule="self.url == oldSelf.url",message="url is immutable"
+type InstanceRepoParameters struct {
+	// InstanceID references the target Argo CD Instance by its opaque
+	// Akuity ID. At least one of InstanceID or InstanceRef must be set;
+	// when both are present, InstanceID is used.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceRef references the target Argo CD Instance managed
+	// resource. The controller reads the referenced Instance's
+	// Status.AtProvider.ID. At least one of InstanceID or InstanceRef
+	// must be set.
+	// +optional
+	InstanceRef *LocalReference `json:"instanceRef,omitempty"`
+
+	// URL is the repository URL. It identifies the registration on the
+	// instance.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url"`
+
+	// Type is the repository type. Argo CD defaults to git when unset.
+	// +optional
+	// +kubebuilder:validation:Enum=git;helm;oci
+	Type string `json:"type,omitempty"`
+
+	// Project scopes the repository to an Argo CD project.
+	// +optional
+	Project string `json:"project,omitempty"`
+
+	// Name is an optional display name for the repository, typically
+	// used for Helm repositories.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// SecretRef references a Kubernetes Secret holding the repository
+	// credentials, using Argo CD repository secret keys such as
+	// username, password or sshPrivateKey. Every key in the Secret is
+	// sent; url, type, project and name from this spec take precedence
+	// over the same keys in the Secret.
+	// +optional
+	SecretRef *xpv1.SecretReference `json:"secretRef,omitempty"`
+}
+
+// InstanceRepoObservation reflects the observed repository
+// registration. Credentials are never part of the observation.
+type InstanceRepoObservation struct {
+	// InstanceID is the resolved opaque Akuity ID of the target
+	// Instance.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// URL is the repository URL reported by the Akuity platform.
+	URL string `json:"url,omitempty"`
+
+	// Type is the repository type reported by the Akuity platform.
+	Type string `json:"type,omitempty"`
+
+	// Project is the Argo CD project reported by the Akuity platform.
+	Project string `json:"project,omitempty"`
+
+	// SecretHash is a digest of the credential Secret data last
+	// written. It lets the controller detect rotated credentials
+	// without storing the values.
+	SecretHash string `json:"secretHash,omitempty"`
+}
+
+// An InstanceRepoSpec defines the desired state of an InstanceRepo.
+type InstanceRepoSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceRepoParameters `json:"forProvider"`
+}
+
+// An InstanceRepoStatus represents the observed state of an
+// InstanceRepo.
+type InstanceRepoStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceRepoObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InstanceRepo is a managed resource that registers a repository on
+// an Argo CD instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.forProvider.url"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,path=instancerepos,categories={crossplane,managed,akuity}
+type InstanceRepo struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceRepoSpec   `json:"spec"`
+	Status InstanceRepoStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceRepoList contains a list of InstanceRepo.
+type InstanceRepoList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceRepo `json:"items"`
+}
+
+// InstanceRepo type metadata.
+var (
+	InstanceRepoKind             = reflect.TypeOf(InstanceRepo{}).Name()
+	InstanceRepoGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceRepoKind}.String()
+	InstanceRepoKindAPIVersion   = InstanceRepoKind + "." + SchemeGroupVersion.String()
+	InstanceRepoGroupVersionKind = SchemeGroupVersion.WithKind(InstanceRepoKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&InstanceRepo{}, &InstanceRepoList{})
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ResourceCustomization customizes how Argo CD handles one resource
+// group and kind.
+type ResourceCustomization struct {
+	// Group is the API group of the customized resource. Empty selects
+	// the core group.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Kind is the kind of the customized resource.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// Health is a Lua health check script.
+	// +optional
+	Health string `json:"health,omitempty"`
+
+	// Actions is the YAML actions definition, carrying a discovery.lua
+	// script and a list of named action.lua scripts.
+	// +optional
+	Actions string `json:"actions,omitempty"`
+
+	// IgnoreDifferences is the YAML ignore-differences definition.
+	// +optional
+	IgnoreDifferences string `json:"ignoreDifferences,omitempty"`
+
+	// IgnoreResourceUpdates is the YAML ignore-resource-updates
+	// definition.
+	// +optional
+	IgnoreResourceUpdates string `json:"ignoreResourceUpdates,omitempty"`
+
+	// KnownTypeFields is the YAML known-type-fields definition.
+	// +optional
+	KnownTypeFields string `json:"knownTypeFields,omitempty"`
+
+	// UseOpenLibs lets the Lua scripts use the standard Lua libraries.
+	// +optional
+	UseOpenLibs *bool `json:"useOpenLibs,omitempty"`
+}
+
+// InstanceResourceCustomizationParameters manage the resource
+// customizations of an Argo CD instance. The customizations are
+// written as a unit; this resource owns the whole list. Callers can
+// supply the ID directly on InstanceID or point at an Instance managed
+// resource in the same namespace via InstanceRef.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.instanceId) || has(self.instanceRef)",message="instanceId or instanceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.instanceId) || (has(self.instanceId) && self.instanceId == oldSelf.instanceId)) && (!has(oldSelf.instanceRef) || (has(self.instanceRef) && self.instanceRef.name == oldSelf.instanceRef.name))",message="instanceId/instanceRef are immutable"
+type InstanceResourceCustomizationParameters struct {
+	// InstanceID references the target Argo CD Instance by its opaque
+	// Akuity ID. At least one of InstanceID or InstanceRef must be set;
+	// when both are present, InstanceID is used.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceRef references the target Argo CD Instance by name in the
+	// same namespace as this InstanceResourceCustomization. The
+	// controller reads the referenced Instance's Status.AtProvider.ID
+	// to resolve the underlying Akuity ID. At least one of InstanceID
+	// or InstanceRef must be set.
+	// +optional
+	InstanceRef *LocalReference `json:"instanceRef,omitempty"`
+
+	// Customizations is the set of resource customizations to enforce
+	// on the instance. Each group and kind may appear once.
+	// +optional
+	Customizations []ResourceCustomization `json:"customizations,omitempty"`
+
+	// IgnoreResourceUpdatesEnabled turns on Argo CD's
+	// ignoreResourceUpdates handling. Left unmanaged when unset.
+	// +optional
+	IgnoreResourceUpdatesEnabled *bool `json:"ignoreResourceUpdatesEnabled,omitempty"`
+}
+
+// InstanceResourceCustomizationObservation reflects the observed
+// resource customizations on the referenced Argo CD Instance.
+type InstanceResourceCustomizationObservation struct {
+	// Customizations is the set of resource customizations currently
+	// configured on the instance.
+	Customizations []ResourceCustomization `json:"customizations,omitempty"`
+
+	// IgnoreResourceUpdatesEnabled reports whether ignoreResourceUpdates
+	// handling is on.
+	IgnoreResourceUpdatesEnabled *bool `json:"ignoreResourceUpdatesEnabled,omitempty"`
+
+	// InstanceID is the resolved opaque Akuity ID of the target
+	// Instance, cached on first successful Observe so Delete can clear
+	// the remote customizations even if the referenced Instance MR has
+	// already been removed.
+	InstanceID string `json:"instanceId,omitempty"`
+}
+
+// An InstanceResourceCustomizationSpec defines the desired state of an
+// InstanceResourceCustomization.
+type InstanceResourceCustomizationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceResourceCustomizationParameters `json:"forProvider"`
+}
+
+// An InstanceResourceCustomizationStatus represents the observed state
+// of an InstanceResourceCustomization.
+type InstanceResourceCustomizationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceResourceCustomizationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InstanceResourceCustomization manages the resource customizations
+// of an Argo CD Instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type InstanceResourceCustomization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceResourceCustomizationSpec   `json:"spec"`
+	Status InstanceResourceCustomizationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceResourceCustomizationList contains a list of
+// InstanceResourceCustomization.
+type InstanceResourceCustomizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceResourceCustomization `json:"items"`
+}
+
+// InstanceResourceCustomization type metadata.
+var (
+	InstanceResourceCustomizationKind             = reflect.TypeOf(InstanceResourceCustomization{}).Name()
+	InstanceResourceCustomizationGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceResourceCustomizationKind}.String()
+	InstanceResourceCustomizationKindAPIVersion   = InstanceResourceCustomizationKind + "." + SchemeGroupVersion.String()
+	InstanceResourceCustomizationGroupVersionKind = SchemeGroupVersion.WithKind(InstanceResourceCustomizationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&InstanceResourceCustomization{}, &InstanceResourceCustomizationList{})
+}
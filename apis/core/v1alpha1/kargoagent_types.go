@@ -36,6 +36,7 @@ import (
 // therefore returns true even when the user never set it. Treat the
 // field as set only when the embedded secret name is non-empty.
 // +kubebuilder:validation:XValidation:rule="!(has(self.kubeconfigSecretRef) && size(self.kubeconfigSecretRef.name) > 0 && has(self.enableInClusterKubeconfig) && self.enableInClusterKubeconfig)",message="kubeConfigSecretRef and enableInClusterKubeConfig are mutually exclusive: set at most one"
+// +kubebuilder:validation:XValidation:rule="!has(self.manifestsSink) || ((!has(self.kubeconfigSecretRef) || !has(self.kubeconfigSecretRef.name) || size(self.kubeconfigSecretRef.name) == 0) && (!has(self.enableInClusterKubeconfig) || !self.enableInClusterKubeconfig))",message="manifestsSink is mutually exclusive with kubeconfigSecretRef and enableInClusterKubeconfig"
 // kargoAgentSpec.data.akuityManaged is immutable on the Akuity
 // platform: PatchKargoInstanceAgent silently drops incoming changes
 // to this field on update, so a user's edit looks successful from the
@@ -47,6 +48,7 @@ import (
 // CR submitted without kargoAgentSpec at all leaves those parents
 // absent in the apiserver's stored state.
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.kargoAgentSpec) || !has(oldSelf.kargoAgentSpec.data) || !has(oldSelf.kargoAgentSpec.data.akuityManaged) || (has(self.kargoAgentSpec) && has(self.kargoAgentSpec.data) && has(self.kargoAgentSpec.data.akuityManaged) && self.kargoAgentSpec.data.akuityManaged == oldSelf.kargoAgentSpec.data.akuityManaged)",message="akuityManaged is immutable after create: the platform ignores updates to this field"
+// +kubebuilder:validation:XValidation:rule="!has(self.maintenanceWindows) || size(self.maintenanceWindows) == 0 || !has(self.kargoAgentSpec) || !has(self.kargoAgentSpec.data) || (!has(self.kargoAgentSpec.data.maintenanceMode) && !has(self.kargoAgentSpec.data.maintenanceModeExpiry))",message="maintenanceWindows and kargoAgentSpec.data.maintenanceMode/maintenanceModeExpiry are mutually exclusive"
 type KargoAgentParameters struct {
 	// KargoInstanceID references the owning Kargo instance by ID. At
 	// least one of KargoInstanceID or KargoInstanceRef must be set;
@@ -63,10 +65,18 @@ type KargoAgentParameters struct {
 	// Workspace is the Akuity workspace used to route Kargo agent gateway calls.
 	// Prefer the workspace ID; a workspace name is also accepted and resolved by
 	// the client. When omitted with kargoInstanceRef set, the controller
-	// inherits the parent KargoInstance workspace.
+	// inherits the parent KargoInstance's observed workspace, so the agent
+	// follows the parent across workspace moves.
 	// +optional
 	Workspace string `json:"workspace,omitempty"`
 
+	// WorkspaceRef references a Workspace managed resource by name; its
+	// Status.AtProvider.ID is used to route Kargo agent gateway calls.
+	// Takes precedence over Workspace and over the workspace inherited
+	// from the parent KargoInstance.
+	// +optional
+	WorkspaceRef *LocalReference `json:"workspaceRef,omitempty"`
+
 	// Name of the agent. Required.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
@@ -108,11 +118,29 @@ type KargoAgentParameters struct {
 	// +optional
 	EnableInClusterKubeConfig bool `json:"enableInClusterKubeconfig,omitempty"`
 
+	// ManifestsSink writes the agent install manifests to a Secret or
+	// ConfigMap instead of applying them, for clusters the provider
+	// cannot reach. The objects are rewritten when the spec or the
+	// agent version changes. Mutually exclusive with
+	// KubeConfigSecretRef and EnableInClusterKubeConfig.
+	// +optional
+	ManifestsSink *ManifestsSink `json:"manifestsSink,omitempty"`
+
 	// RemoveAgentResourcesOnDestroy removes the agent manifests from
 	// the managed cluster before DeleteKargoInstanceAgent runs. Only
-	// effective when a kubeconfig source is configured.
+	// effective when a kubeconfig source or ManifestsSink is
+	// configured; with ManifestsSink the sink objects are deleted.
 	// +optional
 	RemoveAgentResourcesOnDestroy bool `json:"removeAgentResourcesOnDestroy,omitempty"`
+
+	// MaintenanceWindows schedules recurring maintenance. While a
+	// window is open the controller enables maintenance mode with an
+	// expiry at the window's end, and disables it once all windows are
+	// closed. Mutually exclusive with data.maintenanceMode and
+	// data.maintenanceModeExpiry.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
 }
 
 // KargoAgentObservation contains the observable fields of a KargoAgent.
@@ -121,7 +149,9 @@ type KargoAgentObservation struct {
 	ID string `json:"id,omitempty"`
 	// Name of the agent as reported by the Akuity platform.
 	Name string `json:"name,omitempty"`
-	// Workspace is the Akuity workspace routing value carried from spec.
+	// Workspace is the workspace inherited from the parent KargoInstance
+	// on the last apply. Set only when neither spec.forProvider.workspace
+	// nor spec.forProvider.workspaceRef is given.
 	Workspace string `json:"workspace,omitempty"`
 	// HealthStatus is the agent health.
 	HealthStatus ResourceStatusCode `json:"healthStatus,omitempty"`
@@ -132,6 +162,15 @@ type KargoAgentObservation struct {
 	// data), mirroring spec.forProvider.kargoAgentSpec on the most
 	// recent reconcile.
 	KargoAgentSpec crossplanetypes.KargoAgentSpec `json:"kargoAgentSpec,omitempty"`
+
+	CredentialRotationStatus `json:",inline"`
+	// MaintenanceWindow reports the state of spec.forProvider.maintenanceWindows.
+	// +optional
+	MaintenanceWindow *MaintenanceWindowStatus `json:"maintenanceWindow,omitempty"`
+	// ManifestsSinkRevision identifies the spec generation and agent
+	// version last written to spec.forProvider.manifestsSink.
+	// +optional
+	ManifestsSinkRevision string `json:"manifestsSinkRevision,omitempty"`
 }
 
 // A KargoAgentSpec defines the desired state of a KargoAgent.
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// KargoAgentVersionPolicyParameters describe a batched rollout of an
+// agent version across the KargoAgent managed resources of one Kargo
+// instance. Callers supply the instance ID directly on KargoInstanceID
+// or point at a KargoInstance managed resource via KargoInstanceRef.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.kargoInstanceId) || has(self.kargoInstanceRef)",message="kargoInstanceId or kargoInstanceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.kargoInstanceId) || (has(self.kargoInstanceId) && self.kargoInstanceId == oldSelf.kargoInstanceId)) && (!has(oldSelf.kargoInstanceRef) || (has(self.kargoInstanceRef) && self.kargoInstanceRef.name == oldSelf.kargoInstanceRef.name))",message="kargoInstanceId/kargoInstanceRef are immutable"
+type KargoAgentVersionPolicyParameters struct {
+	// KargoInstanceID references the Kargo instance by its opaque
+	// Akuity ID. At least one of KargoInstanceID or KargoInstanceRef
+	// must be set; when both are present, KargoInstanceID is used.
+	// +optional
+	KargoInstanceID string `json:"kargoInstanceId,omitempty"`
+
+	// KargoInstanceRef references the KargoInstance managed resource
+	// by name. The controller reads the referenced resource's
+	// Status.AtProvider.ID. At least one of KargoInstanceID or
+	// KargoInstanceRef must be set.
+	// +optional
+	KargoInstanceRef *LocalReference `json:"kargoInstanceRef,omitempty"`
+
+	// AgentSelector selects the KargoAgent managed resources, by their
+	// labels, whose agents this policy upgrades. Only KargoAgents that
+	// belong to the target instance are considered.
+	// +kubebuilder:validation:Required
+	AgentSelector metav1.LabelSelector `json:"agentSelector"`
+
+	// TargetVersion is the agent version to roll out.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	TargetVersion string `json:"targetVersion"`
+
+	// WaveSize is the number of agents upgraded per batch, either as an
+	// absolute count or as a percentage of the selected agents (for
+	// example "25%"). Percentages round up, and every batch upgrades at
+	// least one agent. Defaults to 1.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:XIntOrString
+	WaveSize intstr.IntOrString `json:"waveSize,omitempty"`
+
+	// PauseBetweenWaves is how long to wait after a batch has succeeded
+	// before the next batch is started. Defaults to no pause.
+	// +optional
+	PauseBetweenWaves *metav1.Duration `json:"pauseBetweenWaves,omitempty"`
+}
+
+// KargoAgentVersionPolicyObservation reports the progress of the
+// rollout.
+type KargoAgentVersionPolicyObservation struct {
+	// KargoInstanceID is the resolved opaque Akuity ID of the target
+	// Kargo instance.
+	KargoInstanceID string `json:"kargoInstanceId,omitempty"`
+
+	// TargetVersion is the version the rollout state below refers to.
+	// Changing spec.forProvider.targetVersion starts a new rollout.
+	TargetVersion string `json:"targetVersion,omitempty"`
+
+	// Phase is the state of the rollout.
+	Phase AgentRolloutPhase `json:"phase,omitempty"`
+
+	// Wave is the number of batches started for TargetVersion.
+	Wave int32 `json:"wave,omitempty"`
+
+	// WaveAgents are the names of the agents upgraded by the most
+	// recent batch.
+	WaveAgents []string `json:"waveAgents,omitempty"`
+
+	// WaveCompletionTime is when the most recent batch was observed to
+	// have succeeded. The next batch starts PauseBetweenWaves later.
+	WaveCompletionTime *metav1.Time `json:"waveCompletionTime,omitempty"`
+
+	// SelectedAgents is the number of agents the policy selects.
+	SelectedAgents int32 `json:"selectedAgents,omitempty"`
+
+	// UpdatedAgents is the number of selected agents that report
+	// TargetVersion, a healthy status, and a successful reconciliation.
+	UpdatedAgents int32 `json:"updatedAgents,omitempty"`
+
+	// Message explains the current phase, such as which agent halted
+	// the rollout.
+	Message string `json:"message,omitempty"`
+}
+
+// A KargoAgentVersionPolicySpec defines the desired state of a
+// KargoAgentVersionPolicy.
+type KargoAgentVersionPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KargoAgentVersionPolicyParameters `json:"forProvider"`
+}
+
+// A KargoAgentVersionPolicyStatus represents the observed state of a
+// KargoAgentVersionPolicy.
+type KargoAgentVersionPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KargoAgentVersionPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KargoAgentVersionPolicy rolls an agent version out to the
+// KargoAgents of an Akuity Kargo instance in batches.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.targetVersion"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".status.atProvider.phase"
+// +kubebuilder:printcolumn:name="UPDATED",type="integer",JSONPath=".status.atProvider.updatedAgents"
+// +kubebuilder:printcolumn:name="SELECTED",type="integer",JSONPath=".status.atProvider.selectedAgents"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type KargoAgentVersionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KargoAgentVersionPolicySpec   `json:"spec"`
+	Status KargoAgentVersionPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KargoAgentVersionPolicyList contains a list of
+// KargoAgentVersionPolicy.
+type KargoAgentVersionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KargoAgentVersionPolicy `json:"items"`
+}
+
+// KargoAgentVersionPolicy type metadata.
+var (
+	KargoAgentVersionPolicyKind             = reflect.TypeOf(KargoAgentVersionPolicy{}).Name()
+	KargoAgentVersionPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: KargoAgentVersionPolicyKind}.String()
+	KargoAgentVersionPolicyKindAPIVersion   = KargoAgentVersionPolicyKind + "." + SchemeGroupVersion.String()
+	KargoAgentVersionPolicyGroupVersionKind = SchemeGroupVersion.WithKind(KargoAgentVersionPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KargoAgentVersionPolicy{}, &KargoAgentVersionPolicyList{})
+}
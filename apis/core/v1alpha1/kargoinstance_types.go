@@ -78,12 +78,20 @@ type KargoInstanceParameters struct {
 	// preferred value is the workspace ID because workspace-scoped gateway calls
 	// are routed by ID. A workspace name is also accepted and resolved before
 	// gateway calls. When omitted, the organization default workspace is used on
-	// create. The canonical workspace ID is reported in
+	// create. Changing it on an existing instance moves the instance to the
+	// new workspace. The canonical workspace ID is reported in
 	// status.atProvider.workspace.
 	//
 	// +optional
 	Workspace string `json:"workspace,omitempty"`
 
+	// WorkspaceRef references a Workspace managed resource by name. The
+	// controller reads the referenced Workspace's Status.AtProvider.ID
+	// and routes gateway calls to that workspace. When set, WorkspaceRef
+	// takes precedence over Workspace.
+	// +optional
+	WorkspaceRef *LocalReference `json:"workspaceRef,omitempty"`
+
 	// Kargo contains the Kargo configuration sent to the Akuity platform.
 	// Required.
 	// +kubebuilder:validation:Required
@@ -103,11 +111,21 @@ type KargoInstanceParameters struct {
 	// accepted spelling onto its canonical lowerCamel form before
 	// Apply and rejects any other key with a terminal classification.
 	// Removing this ref stops applying the platform-side Secret, but
-	// does not delete it from the Akuity platform.
+	// does not delete it from the Akuity platform. When
+	// PublishAdminPassword is set, the adminAccountPasswordHash value
+	// is also published as the adminPasswordHash connection detail.
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="has(self.name) && size(self.name) > 0 && has(self.__namespace__) && size(self.__namespace__) > 0",message="kargoSecretRef.name and kargoSecretRef.namespace are required"
 	KargoSecretRef *xpv1.SecretReference `json:"kargoSecretRef,omitempty"`
 
+	// PublishAdminPassword publishes the adminAccountPasswordHash value
+	// from KargoSecretRef as the adminPasswordHash connection detail.
+	// It is off by default so the hash is not copied into the
+	// connection Secret unless asked for. Not sent to the Akuity
+	// platform.
+	// +optional
+	PublishAdminPassword bool `json:"publishAdminPassword,omitempty"`
+
 	// KargoRepoCredentialSecretRefs registers repository credentials
 	// with the Kargo gateway. Each entry's SecretRef points at a
 	// namespaced Secret; the controller synthesizes a labeled
@@ -231,6 +249,20 @@ type KargoInstanceObservation struct {
 	// spec.workspace hot-looped portal-server at roughly 350 wasted
 	// writes in 12 minutes.
 	Workspace string `json:"workspace,omitempty"`
+
+	// ResolvedWorkspace caches the canonical ID spec.forProvider.workspace
+	// last resolved to. Used to detect a pending workspace move without
+	// resolving a workspace name on every poll.
+	// +optional
+	ResolvedWorkspace *WorkspaceResolution `json:"resolvedWorkspace,omitempty"`
+
+	// PreflightFeaturesHash identifies the plan-gated fields set in
+	// spec.forProvider when the organization's plan was last found to
+	// include their features. The plan lookup is skipped while those
+	// fields are unchanged and cleared after a failed apply, so a plan
+	// downgrade is caught on the next write.
+	// +optional
+	PreflightFeaturesHash string `json:"preflightFeaturesHash,omitempty"`
 }
 
 // A KargoInstanceSpec defines the desired state of a Kargo instance.
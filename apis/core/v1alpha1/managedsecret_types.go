@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ManagedSecretParameters are the configurable fields of an
+// Akuity-managed secret on an Argo CD instance. The secret data is
+// sourced from a Kubernetes Secret; callers supply the instance ID
+// directly on InstanceID or point at an Instance managed resource via
+// InstanceRef.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.instanceId) || has(self.instanceRef)",message="instanceId or instanceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.instanceId) || (has(self.instanceId) && self.instanceId == oldSelf.instanceId)) && (!has(oldSelf.instanceRef) || (has(self.instanceRef) && self.instanceRef.name == oldSelf.instanceRef.name))",message="instanceId/instanceRef are immutable"
+// +kubebuilder:validation:XValidation:rule="self.name == oldSelf.name",message="name is immutable"
+type ManagedSecretParameters struct {
+	// InstanceID references the target Argo CD Instance by its opaque
+	// Akuity ID. At least one of InstanceID or InstanceRef must be set;
+	// when both are present, InstanceID is used.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceRef references the target Argo CD Instance managed
+	// resource. The controller reads the referenced Instance's
+	// Status.AtProvider.ID. At least one of InstanceID or InstanceRef
+	// must be set.
+	// +optional
+	InstanceRef *LocalReference `json:"instanceRef,omitempty"`
+
+	// Name is the managed secret name on the instance.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Labels applied to the managed secret. Use
+	// akuity.io/secret-sync: "true" to sync the secret to clusters.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// ClusterSelector selects the clusters that receive the secret.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// AllowedClusters names the clusters that receive the secret, and
+	// takes precedence over ClusterSelector. The reserved value ALL
+	// selects every cluster.
+	// +optional
+	AllowedClusters []string `json:"allowedClusters,omitempty"`
+
+	// SecretRef references the Kubernetes Secret whose data is written
+	// to the managed secret. Every key in the Secret is written.
+	// +kubebuilder:validation:Required
+	SecretRef xpv1.SecretReference `json:"secretRef"`
+}
+
+// ManagedSecretObservation reflects the observed state of an
+// Akuity-managed secret. Secret values are never part of the
+// observation.
+type ManagedSecretObservation struct {
+	// InstanceID is the resolved opaque Akuity ID of the target
+	// Instance, cached so Delete can remove the secret even if the
+	// referenced Instance MR has already been removed.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// Name is the managed secret name as reported by the Akuity
+	// platform.
+	Name string `json:"name,omitempty"`
+
+	// Labels reported by the Akuity platform.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// ClusterSelector reported by the Akuity platform.
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// AllowedClusters reported by the Akuity platform.
+	AllowedClusters []string `json:"allowedClusters,omitempty"`
+
+	// SecretKeys are the keys stored in the managed secret, sorted.
+	SecretKeys []string `json:"secretKeys,omitempty"`
+
+	// SecretHash is a digest of the source Secret data last written.
+	// It lets the controller detect a rotated Secret without storing
+	// the values.
+	SecretHash string `json:"secretHash,omitempty"`
+}
+
+// A ManagedSecretSpec defines the desired state of a ManagedSecret.
+type ManagedSecretSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ManagedSecretParameters `json:"forProvider"`
+}
+
+// A ManagedSecretStatus represents the observed state of a
+// ManagedSecret.
+type ManagedSecretStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ManagedSecretObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ManagedSecret is a managed resource that represents an
+// Akuity-managed secret on an Argo CD instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type ManagedSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManagedSecretSpec   `json:"spec"`
+	Status ManagedSecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedSecretList contains a list of ManagedSecret.
+type ManagedSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManagedSecret `json:"items"`
+}
+
+// ManagedSecret type metadata.
+var (
+	ManagedSecretKind             = reflect.TypeOf(ManagedSecret{}).Name()
+	ManagedSecretGroupKind        = schema.GroupKind{Group: Group, Kind: ManagedSecretKind}.String()
+	ManagedSecretKindAPIVersion   = ManagedSecretKind + "." + SchemeGroupVersion.String()
+	ManagedSecretGroupVersionKind = SchemeGroupVersion.WithKind(ManagedSecretKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ManagedSecret{}, &ManagedSecretList{})
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NotificationConfigParameters are the configurable fields of an Akuity
+// organization notification config. Exactly one delivery method must be
+// set.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.webhook) != has(self.email)",message="exactly one of webhook or email must be set"
+type NotificationConfigParameters struct {
+	// Name of the notification config.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Active enables delivery. Defaults to true.
+	// +optional
+	// +kubebuilder:default=true
+	Active *bool `json:"active,omitempty"`
+
+	// Webhook delivers events as HTTP POST requests.
+	// +optional
+	Webhook *NotificationWebhook `json:"webhook,omitempty"`
+
+	// Email delivers events by email.
+	// +optional
+	Email *NotificationEmail `json:"email,omitempty"`
+
+	// Filter narrows the events delivered. An omitted filter delivers
+	// every event for every instance and agent.
+	// +optional
+	Filter *NotificationFilter `json:"filter,omitempty"`
+
+	// PingOnWrite sends a test event after every create or update and
+	// reports the outcome on the DeliveryTest condition. A failed ping
+	// does not fail the write.
+	// +optional
+	PingOnWrite bool `json:"pingOnWrite,omitempty"`
+}
+
+// NotificationWebhook configures webhook delivery.
+type NotificationWebhook struct {
+	// URL the events are posted to.
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url"`
+
+	// SecretRef selects the key of a Secret holding the webhook signing
+	// secret. Rotating the Secret value re-applies the config.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="size(self.name) > 0 && size(self.__namespace__) > 0 && size(self.key) > 0",message="secretRef.name, secretRef.namespace and secretRef.key are required"
+	SecretRef *xpv1.SecretKeySelector `json:"secretRef,omitempty"`
+}
+
+// NotificationEmail configures email delivery.
+type NotificationEmail struct {
+	// Emails are the recipient addresses.
+	// +kubebuilder:validation:MinItems=1
+	Emails []string `json:"emails"`
+}
+
+// NotificationFilter narrows the events delivered by a notification
+// config. Each name list, when non-empty, restricts delivery to the
+// named objects; an empty list matches all of them.
+type NotificationFilter struct {
+	// Events restricts delivery to the listed event types.
+	// +optional
+	Events []string `json:"events,omitempty"`
+
+	// ArgoCDInstanceNames restricts delivery to the named Argo CD
+	// instances.
+	// +optional
+	ArgoCDInstanceNames []string `json:"argocdInstanceNames,omitempty"`
+
+	// ArgoCDInstanceRefs restricts delivery to the Argo CD instances of
+	// the named Instance managed resources. Merged with
+	// ArgoCDInstanceNames.
+	// +optional
+	ArgoCDInstanceRefs []LocalReference `json:"argocdInstanceRefs,omitempty"`
+
+	// KargoInstanceNames restricts delivery to the named Kargo
+	// instances.
+	// +optional
+	KargoInstanceNames []string `json:"kargoInstanceNames,omitempty"`
+
+	// KargoInstanceRefs restricts delivery to the Kargo instances of
+	// the named KargoInstance managed resources. Merged with
+	// KargoInstanceNames.
+	// +optional
+	KargoInstanceRefs []LocalReference `json:"kargoInstanceRefs,omitempty"`
+
+	// ArgoCDClusterNames restricts delivery to the named Argo CD
+	// clusters.
+	// +optional
+	ArgoCDClusterNames []string `json:"argocdClusterNames,omitempty"`
+
+	// KargoAgentNames restricts delivery to the named Kargo agents.
+	// +optional
+	KargoAgentNames []string `json:"kargoAgentNames,omitempty"`
+}
+
+// NotificationDeliveryFailure describes a failed notification delivery.
+type NotificationDeliveryFailure struct {
+	// ID of the delivery.
+	ID string `json:"id,omitempty"`
+	// EventType of the event that failed to deliver.
+	EventType string `json:"eventType,omitempty"`
+	// Time of the initial delivery attempt, RFC3339.
+	Time string `json:"time,omitempty"`
+	// RetryCount is the number of retries attempted.
+	RetryCount int64 `json:"retryCount,omitempty"`
+	// StatusCode is the HTTP status returned by a webhook receiver.
+	// +optional
+	StatusCode *int64 `json:"statusCode,omitempty"`
+	// Error reported for the delivery.
+	Error string `json:"error,omitempty"`
+}
+
+// NotificationConfigObservation reflects the observed state of an
+// Akuity notification config. Webhook secrets are never reported.
+type NotificationConfigObservation struct {
+	// ID is the platform-assigned notification config ID.
+	ID string `json:"id,omitempty"`
+	// Name as reported by the Akuity platform.
+	Name string `json:"name,omitempty"`
+	// DeliveryMethod is webhook or email.
+	DeliveryMethod string `json:"deliveryMethod,omitempty"`
+	// Active as reported by the Akuity platform.
+	Active bool `json:"active,omitempty"`
+	// URL of the webhook receiver.
+	URL string `json:"url,omitempty"`
+	// Emails of the email recipients.
+	Emails []string `json:"emails,omitempty"`
+	// LastDeliveryStatus is the status of the most recent delivery:
+	// Success or Failure.
+	LastDeliveryStatus string `json:"lastDeliveryStatus,omitempty"`
+	// LastDeliveryFailure is the most recent failed delivery in the
+	// config's delivery history, if any.
+	// +optional
+	LastDeliveryFailure *NotificationDeliveryFailure `json:"lastDeliveryFailure,omitempty"`
+
+	// SecretHash is the SHA256 of the resolved webhook secret on the
+	// most recent write. Used as the drift signal for Secret rotation.
+	// +optional
+	SecretHash string `json:"secretHash,omitempty"`
+}
+
+// A NotificationConfigSpec defines the desired state of a
+// NotificationConfig.
+type NotificationConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NotificationConfigParameters `json:"forProvider"`
+}
+
+// A NotificationConfigStatus represents the observed state of a
+// NotificationConfig.
+type NotificationConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NotificationConfigObservation `json:"atProvider,omitempty"`
+}
+
+// Condition type and reasons reported by NotificationConfig for the
+// test event sent when spec.forProvider.pingOnWrite is set.
+const (
+	TypeDeliveryTest xpv1.ConditionType = "DeliveryTest"
+
+	ReasonPingSucceeded xpv1.ConditionReason = "PingSucceeded"
+	ReasonPingFailed    xpv1.ConditionReason = "PingFailed"
+)
+
+// DeliveryTestSucceeded returns a condition reporting that the test
+// event was accepted.
+func DeliveryTestSucceeded() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeliveryTest,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPingSucceeded,
+	}
+}
+
+// DeliveryTestFailed returns a condition reporting that the test event
+// could not be sent.
+func DeliveryTestFailed(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeliveryTest,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPingFailed,
+		Message:            err.Error(),
+	}
+}
+
+// +kubebuilder:object:root=true
+
+// A NotificationConfig is a managed resource that represents an Akuity
+// organization notification config.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="LAST-DELIVERY",type="string",JSONPath=".status.atProvider.lastDeliveryStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type NotificationConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationConfigSpec   `json:"spec"`
+	Status NotificationConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotificationConfigList contains a list of NotificationConfig.
+type NotificationConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationConfig `json:"items"`
+}
+
+// NotificationConfig type metadata.
+var (
+	NotificationConfigKind             = reflect.TypeOf(NotificationConfig{}).Name()
+	NotificationConfigGroupKind        = schema.GroupKind{Group: Group, Kind: NotificationConfigKind}.String()
+	NotificationConfigKindAPIVersion   = NotificationConfigKind + "." + SchemeGroupVersion.String()
+	NotificationConfigGroupVersionKind = SchemeGroupVersion.WithKind(NotificationConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NotificationConfig{}, &NotificationConfigList{})
+}
@@ -1,5 +1,25 @@
 package v1alpha1
 
+// GetObservedGeneration of this AddonMarketplaceInstall.
+func (mg *AddonMarketplaceInstall) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this AddonMarketplaceInstall.
+func (mg *AddonMarketplaceInstall) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this AgentVersionPolicy.
+func (mg *AgentVersionPolicy) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this AgentVersionPolicy.
+func (mg *AgentVersionPolicy) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
 // GetObservedGeneration of this Cluster.
 func (mg *Cluster) GetObservedGeneration() int64 {
 	return mg.Status.GetObservedGeneration()
@@ -10,6 +30,16 @@ func (mg *Cluster) SetObservedGeneration(generation int64) {
 	mg.Status.SetObservedGeneration(generation)
 }
 
+// GetObservedGeneration of this CustomRole.
+func (mg *CustomRole) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this CustomRole.
+func (mg *CustomRole) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
 // GetObservedGeneration of this Instance.
 func (mg *Instance) GetObservedGeneration() int64 {
 	return mg.Status.GetObservedGeneration()
@@ -20,6 +50,46 @@ func (mg *Instance) SetObservedGeneration(generation int64) {
 	mg.Status.SetObservedGeneration(generation)
 }
 
+// GetObservedGeneration of this InstanceAccount.
+func (mg *InstanceAccount) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this InstanceAccount.
+func (mg *InstanceAccount) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this InstanceAddon.
+func (mg *InstanceAddon) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this InstanceAddon.
+func (mg *InstanceAddon) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this InstanceAddonRepo.
+func (mg *InstanceAddonRepo) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this InstanceAddonRepo.
+func (mg *InstanceAddonRepo) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this InstanceCSS.
+func (mg *InstanceCSS) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this InstanceCSS.
+func (mg *InstanceCSS) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
 // GetObservedGeneration of this InstanceIpAllowList.
 func (mg *InstanceIpAllowList) GetObservedGeneration() int64 {
 	return mg.Status.GetObservedGeneration()
@@ -30,6 +100,36 @@ func (mg *InstanceIpAllowList) SetObservedGeneration(generation int64) {
 	mg.Status.SetObservedGeneration(generation)
 }
 
+// GetObservedGeneration of this InstanceQuota.
+func (mg *InstanceQuota) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this InstanceQuota.
+func (mg *InstanceQuota) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this InstanceRepo.
+func (mg *InstanceRepo) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this InstanceRepo.
+func (mg *InstanceRepo) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this InstanceResourceCustomization.
+func (mg *InstanceResourceCustomization) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this InstanceResourceCustomization.
+func (mg *InstanceResourceCustomization) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
 // GetObservedGeneration of this KargoAgent.
 func (mg *KargoAgent) GetObservedGeneration() int64 {
 	return mg.Status.GetObservedGeneration()
@@ -40,6 +140,16 @@ func (mg *KargoAgent) SetObservedGeneration(generation int64) {
 	mg.Status.SetObservedGeneration(generation)
 }
 
+// GetObservedGeneration of this KargoAgentVersionPolicy.
+func (mg *KargoAgentVersionPolicy) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this KargoAgentVersionPolicy.
+func (mg *KargoAgentVersionPolicy) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
 // GetObservedGeneration of this KargoDefaultShardAgent.
 func (mg *KargoDefaultShardAgent) GetObservedGeneration() int64 {
 	return mg.Status.GetObservedGeneration()
@@ -59,3 +169,123 @@ func (mg *KargoInstance) GetObservedGeneration() int64 {
 func (mg *KargoInstance) SetObservedGeneration(generation int64) {
 	mg.Status.SetObservedGeneration(generation)
 }
+
+// GetObservedGeneration of this ManagedSecret.
+func (mg *ManagedSecret) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this ManagedSecret.
+func (mg *ManagedSecret) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this NotificationConfig.
+func (mg *NotificationConfig) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this NotificationConfig.
+func (mg *NotificationConfig) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this OIDCMap.
+func (mg *OIDCMap) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this OIDCMap.
+func (mg *OIDCMap) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this OrganizationAPIKey.
+func (mg *OrganizationAPIKey) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this OrganizationAPIKey.
+func (mg *OrganizationAPIKey) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this SSOConfiguration.
+func (mg *SSOConfiguration) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this SSOConfiguration.
+func (mg *SSOConfiguration) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this Team.
+func (mg *Team) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this Team.
+func (mg *Team) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this TeamMember.
+func (mg *TeamMember) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this TeamMember.
+func (mg *TeamMember) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this TeamOIDCMap.
+func (mg *TeamOIDCMap) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this TeamOIDCMap.
+func (mg *TeamOIDCMap) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this Workspace.
+func (mg *Workspace) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this Workspace.
+func (mg *Workspace) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this WorkspaceAPIKey.
+func (mg *WorkspaceAPIKey) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this WorkspaceAPIKey.
+func (mg *WorkspaceAPIKey) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this WorkspaceCustomRole.
+func (mg *WorkspaceCustomRole) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this WorkspaceCustomRole.
+func (mg *WorkspaceCustomRole) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
+
+// GetObservedGeneration of this WorkspaceMember.
+func (mg *WorkspaceMember) GetObservedGeneration() int64 {
+	return mg.Status.GetObservedGeneration()
+}
+
+// SetObservedGeneration of this WorkspaceMember.
+func (mg *WorkspaceMember) SetObservedGeneration(generation int64) {
+	mg.Status.SetObservedGeneration(generation)
+}
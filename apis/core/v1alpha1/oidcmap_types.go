@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY OIDCMap, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// OIDCMapParameters are the configurable fields of the Akuity
+// organization's SSO group to organization role mapping.
+type OIDCMapParameters struct {
+	// Entries maps an SSO group name, as found in the claims listed on
+	// SSOConfiguration groupClaims, to the organization role (member,
+	// admin or owner) granted to its members. The MR owns the whole
+	// map: entries added in the Akuity UI are removed on the next
+	// Update.
+	// +optional
+	Entries map[string]string `json:"entries,omitempty"`
+}
+
+// OIDCMapObservation reflects the observed SSO group to organization
+// role mapping.
+type OIDCMapObservation struct {
+	// Entries as reported by the Akuity platform.
+	Entries map[string]string `json:"entries,omitempty"`
+}
+
+// An OIDCMapSpec defines the desired state of a OIDCMap.
+type OIDCMapSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OIDCMapParameters `json:"forProvider"`
+}
+
+// An OIDCMapStatus represents the observed state of a OIDCMap.
+type OIDCMapStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OIDCMapObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OIDCMap is a managed resource that represents the SSO group to
+// organization role mapping of the Akuity organization. The
+// organization has a single map; create one OIDCMap per ProviderConfig.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type OIDCMap struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OIDCMapSpec   `json:"spec"`
+	Status OIDCMapStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OIDCMapList contains a list of OIDCMap.
+type OIDCMapList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OIDCMap `json:"items"`
+}
+
+// OIDCMap type metadata.
+var (
+	OIDCMapKind             = reflect.TypeOf(OIDCMap{}).Name()
+	OIDCMapGroupKind        = schema.GroupKind{Group: Group, Kind: OIDCMapKind}.String()
+	OIDCMapKindAPIVersion   = OIDCMapKind + "." + SchemeGroupVersion.String()
+	OIDCMapGroupVersionKind = SchemeGroupVersion.WithKind(OIDCMapKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OIDCMap{}, &OIDCMapList{})
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// OrganizationAPIKeyParameters are the configurable fields of an Akuity
+// organization API key. The platform offers no update route for API
+// keys, so every field is immutable after create; delete and recreate
+// the resource to change the key.
+//
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forProvider is immutable"
+type OrganizationAPIKeyParameters struct {
+	// Description of the API key.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Permissions granted to the API key.
+	// +optional
+	Permissions APIKeyPermissions `json:"permissions,omitempty"`
+
+	// ExpireInDuration bounds the lifetime of the key, expressed as a
+	// duration relative to creation (for example "720h"). The key never
+	// expires when omitted.
+	// +optional
+	ExpireInDuration string `json:"expireInDuration,omitempty"`
+}
+
+// APIKeyObservation reflects the observed state of an Akuity API key.
+// The key secret is never part of the observation; it is only published
+// to the connection Secret when the key is created.
+type APIKeyObservation struct {
+	// ID is the platform-assigned API key ID.
+	ID string `json:"id,omitempty"`
+	// Description of the key as reported by the Akuity platform.
+	Description string `json:"description,omitempty"`
+	// Permissions granted to the key as reported by the Akuity
+	// platform.
+	Permissions APIKeyPermissions `json:"permissions,omitempty"`
+	// CreateTime is the RFC 3339 time at which the key was created.
+	CreateTime string `json:"createTime,omitempty"`
+	// ExpireTime is the RFC 3339 time at which the key expires. Empty
+	// when the key does not expire.
+	ExpireTime string `json:"expireTime,omitempty"`
+}
+
+// An OrganizationAPIKeySpec defines the desired state of an
+// OrganizationAPIKey.
+type OrganizationAPIKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationAPIKeyParameters `json:"forProvider"`
+}
+
+// An OrganizationAPIKeyStatus represents the observed state of an
+// OrganizationAPIKey.
+type OrganizationAPIKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          APIKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationAPIKey is a managed resource that represents an Akuity
+// organization API key. The key ID and secret are written to the
+// writeConnectionSecretToRef Secret in the format a ProviderConfig
+// credentialsSecretRef expects.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="EXPIRES",type="string",JSONPath=".status.atProvider.expireTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type OrganizationAPIKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationAPIKeySpec   `json:"spec"`
+	Status OrganizationAPIKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationAPIKeyList contains a list of OrganizationAPIKey.
+type OrganizationAPIKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationAPIKey `json:"items"`
+}
+
+// OrganizationAPIKey type metadata.
+var (
+	OrganizationAPIKeyKind             = reflect.TypeOf(OrganizationAPIKey{}).Name()
+	OrganizationAPIKeyGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationAPIKeyKind}.String()
+	OrganizationAPIKeyKindAPIVersion   = OrganizationAPIKeyKind + "." + SchemeGroupVersion.String()
+	OrganizationAPIKeyGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationAPIKeyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrganizationAPIKey{}, &OrganizationAPIKeyList{})
+}
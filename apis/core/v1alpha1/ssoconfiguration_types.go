@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SSOConfigurationParameters are the configurable fields of an Akuity
+// organization's single sign-on configuration. Exactly one identity
+// provider block must be set.
+//
+// +kubebuilder:validation:XValidation:rule="[has(self.azureAd), has(self.okta), has(self.googleWorkspace), has(self.oidc), has(self.saml)].filter(x, x).size() == 1",message="exactly one of azureAd, okta, googleWorkspace, oidc or saml must be set"
+type SSOConfigurationParameters struct {
+	// AutoAddMember adds users who sign in through SSO to the
+	// organization as members automatically.
+	// +optional
+	AutoAddMember bool `json:"autoAddMember,omitempty"`
+
+	// EnforceSSO requires every member to sign in through SSO.
+	// +optional
+	EnforceSSO bool `json:"enforceSso,omitempty"`
+
+	// GroupClaims lists the token claims the platform reads group
+	// membership from. Consumed by OIDCMap and TeamOIDCMap.
+	// +optional
+	GroupClaims []string `json:"groupClaims,omitempty"`
+
+	// AzureAD configures Microsoft Entra ID (Azure AD) as the identity
+	// provider.
+	// +optional
+	AzureAD *SSOClientOptions `json:"azureAd,omitempty"`
+
+	// Okta configures Okta as the identity provider.
+	// +optional
+	Okta *SSOClientOptions `json:"okta,omitempty"`
+
+	// GoogleWorkspace configures Google Workspace as the identity
+	// provider.
+	// +optional
+	GoogleWorkspace *SSOClientOptions `json:"googleWorkspace,omitempty"`
+
+	// OIDC configures a generic OpenID Connect identity provider.
+	// +optional
+	OIDC *SSOOIDCOptions `json:"oidc,omitempty"`
+
+	// SAML configures a SAML identity provider.
+	// +optional
+	SAML *SSOSAMLOptions `json:"saml,omitempty"`
+}
+
+// SSOClientOptions configure an OAuth identity provider (Azure AD, Okta
+// or Google Workspace) by client credentials and tenant domain.
+type SSOClientOptions struct {
+	// ClientID of the application registered with the identity
+	// provider.
+	// +kubebuilder:validation:MinLength=1
+	ClientID string `json:"clientId"`
+
+	// ClientSecretRef selects the key of a Secret holding the client
+	// secret. Rotating the Secret value re-applies the configuration.
+	// +kubebuilder:validation:XValidation:rule="size(self.name) > 0 && size(self.__namespace__) > 0 && size(self.key) > 0",message="clientSecretRef.name, clientSecretRef.namespace and clientSecretRef.key are required"
+	ClientSecretRef xpv1.SecretKeySelector `json:"clientSecretRef"`
+
+	// Domain is the identity provider tenant domain, e.g.
+	// example.okta.com or example.onmicrosoft.com.
+	// +kubebuilder:validation:MinLength=1
+	Domain string `json:"domain"`
+
+	// DomainAliases are additional email domains routed to this
+	// identity provider.
+	// +optional
+	DomainAliases []string `json:"domainAliases,omitempty"`
+}
+
+// SSOOIDCOptions configure a generic OpenID Connect identity provider.
+// Endpoints are discovered from DiscoveryURL unless one of the channel
+// blocks overrides them.
+//
+// +kubebuilder:validation:XValidation:rule="!(has(self.backChannel) && has(self.frontChannel))",message="at most one of backChannel or frontChannel may be set"
+type SSOOIDCOptions struct {
+	// DiscoveryURL is the issuer's OpenID discovery document URL.
+	// +optional
+	DiscoveryURL string `json:"discoveryUrl,omitempty"`
+
+	// ClientID of the application registered with the identity
+	// provider.
+	// +kubebuilder:validation:MinLength=1
+	ClientID string `json:"clientId"`
+
+	// Domain is the email domain routed to this identity provider.
+	// +kubebuilder:validation:MinLength=1
+	Domain string `json:"domain"`
+
+	// DomainAliases are additional email domains routed to this
+	// identity provider.
+	// +optional
+	DomainAliases []string `json:"domainAliases,omitempty"`
+
+	// Scopes requested in addition to openid.
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// BackChannel configures the authorization code flow with a client
+	// secret.
+	// +optional
+	BackChannel *SSOOIDCBackChannel `json:"backChannel,omitempty"`
+
+	// FrontChannel configures the implicit flow, which needs no client
+	// secret.
+	// +optional
+	FrontChannel *SSOOIDCFrontChannel `json:"frontChannel,omitempty"`
+}
+
+// SSOOIDCBackChannel configures the OIDC authorization code flow.
+type SSOOIDCBackChannel struct {
+	// ClientSecretRef selects the key of a Secret holding the client
+	// secret. Rotating the Secret value re-applies the configuration.
+	// +kubebuilder:validation:XValidation:rule="size(self.name) > 0 && size(self.__namespace__) > 0 && size(self.key) > 0",message="clientSecretRef.name, clientSecretRef.namespace and clientSecretRef.key are required"
+	ClientSecretRef xpv1.SecretKeySelector `json:"clientSecretRef"`
+
+	// Issuer overrides the discovered issuer.
+	// +optional
+	Issuer string `json:"issuer,omitempty"`
+
+	// AuthorizationEndpoint overrides the discovered authorization
+	// endpoint.
+	// +optional
+	AuthorizationEndpoint string `json:"authorizationEndpoint,omitempty"`
+
+	// TokenEndpoint overrides the discovered token endpoint.
+	// +optional
+	TokenEndpoint string `json:"tokenEndpoint,omitempty"`
+
+	// JWKSURI overrides the discovered JSON Web Key Set URI.
+	// +optional
+	JWKSURI string `json:"jwksUri,omitempty"`
+}
+
+// SSOOIDCFrontChannel configures the OIDC implicit flow.
+type SSOOIDCFrontChannel struct {
+	// Issuer overrides the discovered issuer.
+	// +optional
+	Issuer string `json:"issuer,omitempty"`
+
+	// AuthorizationEndpoint overrides the discovered authorization
+	// endpoint.
+	// +optional
+	AuthorizationEndpoint string `json:"authorizationEndpoint,omitempty"`
+
+	// JWKSURI overrides the discovered JSON Web Key Set URI.
+	// +optional
+	JWKSURI string `json:"jwksUri,omitempty"`
+}
+
+// SSOSAMLOptions configure a SAML identity provider from its metadata
+// document.
+type SSOSAMLOptions struct {
+	// Domain is the email domain routed to this identity provider.
+	// +kubebuilder:validation:MinLength=1
+	Domain string `json:"domain"`
+
+	// DomainAliases are additional email domains routed to this
+	// identity provider.
+	// +optional
+	DomainAliases []string `json:"domainAliases,omitempty"`
+
+	// MetadataXML is the identity provider's SAML metadata document.
+	// +kubebuilder:validation:MinLength=1
+	MetadataXML string `json:"metadataXml"`
+}
+
+// SSOConfigurationObservation reflects the observed SSO configuration
+// of the organization. Client secrets are never reported.
+type SSOConfigurationObservation struct {
+	// Provider is the configured identity provider: azureAd, okta,
+	// googleWorkspace, oidc or saml.
+	Provider string `json:"provider,omitempty"`
+	// AutoAddMember as reported by the Akuity platform.
+	AutoAddMember bool `json:"autoAddMember,omitempty"`
+	// EnforceSSO as reported by the Akuity platform.
+	EnforceSSO bool `json:"enforceSso,omitempty"`
+	// GroupClaims as reported by the Akuity platform.
+	GroupClaims []string `json:"groupClaims,omitempty"`
+	// ClientID of the configured identity provider application.
+	ClientID string `json:"clientId,omitempty"`
+	// Domain of the configured identity provider.
+	Domain string `json:"domain,omitempty"`
+	// DomainAliases of the configured identity provider.
+	DomainAliases []string `json:"domainAliases,omitempty"`
+
+	// SecretHash is the SHA256 of the resolved client secret on the
+	// most recent Ensure. Used as the drift signal for Secret rotation.
+	// +optional
+	SecretHash string `json:"secretHash,omitempty"`
+}
+
+// An SSOConfigurationSpec defines the desired state of an
+// SSOConfiguration.
+type SSOConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SSOConfigurationParameters `json:"forProvider"`
+}
+
+// An SSOConfigurationStatus represents the observed state of an
+// SSOConfiguration.
+type SSOConfigurationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SSOConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An SSOConfiguration is a managed resource that represents the single
+// sign-on configuration of the Akuity organization. An organization
+// has at most one; create a single SSOConfiguration per ProviderConfig.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PROVIDER",type="string",JSONPath=".status.atProvider.provider"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type SSOConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SSOConfigurationSpec   `json:"spec"`
+	Status SSOConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SSOConfigurationList contains a list of SSOConfiguration.
+type SSOConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SSOConfiguration `json:"items"`
+}
+
+// SSOConfiguration type metadata.
+var (
+	SSOConfigurationKind             = reflect.TypeOf(SSOConfiguration{}).Name()
+	SSOConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: SSOConfigurationKind}.String()
+	SSOConfigurationKindAPIVersion   = SSOConfigurationKind + "." + SchemeGroupVersion.String()
+	SSOConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(SSOConfigurationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SSOConfiguration{}, &SSOConfigurationList{})
+}
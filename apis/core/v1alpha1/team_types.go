@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TeamParameters are the configurable fields of an Akuity organization
+// team. Teams are keyed by name on the Organization gateway, so the
+// name doubles as the external-name and cannot change after create.
+//
+// +kubebuilder:validation:XValidation:rule="self.name == oldSelf.name",message="name is immutable"
+type TeamParameters struct {
+	// Name of the team. Must be unique within the organization.
+	// Required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Description of the team.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// CustomRoles lists the IDs of organization custom roles granted to
+	// every member of the team. Order is not significant.
+	// +optional
+	CustomRoles []string `json:"customRoles,omitempty"`
+
+	// CustomRoleRefs references organization custom roles by the name
+	// of their CustomRole managed resource. The resolved IDs are granted
+	// in addition to CustomRoles.
+	// +optional
+	CustomRoleRefs []LocalReference `json:"customRoleRefs,omitempty"`
+}
+
+// TeamObservation reflects the observed state of an Akuity team.
+type TeamObservation struct {
+	// Name of the team as reported by the Akuity platform. TeamMember
+	// and WorkspaceMember resolve spec.forProvider.teamRef through this
+	// field.
+	Name string `json:"name,omitempty"`
+	// Description of the team as reported by the Akuity platform.
+	Description string `json:"description,omitempty"`
+	// CustomRoles lists the IDs of the custom roles granted to the team.
+	CustomRoles []string `json:"customRoles,omitempty"`
+	// MemberCount is the number of users in the team.
+	MemberCount int64 `json:"memberCount,omitempty"`
+}
+
+// A TeamSpec defines the desired state of a Team.
+type TeamSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TeamParameters `json:"forProvider"`
+}
+
+// A TeamStatus represents the observed state of a Team.
+type TeamStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TeamObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Team is a managed resource that represents an Akuity organization
+// team.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type Team struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamSpec   `json:"spec"`
+	Status TeamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TeamList contains a list of Team.
+type TeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Team `json:"items"`
+}
+
+// Team type metadata.
+var (
+	TeamKind             = reflect.TypeOf(Team{}).Name()
+	TeamGroupKind        = schema.GroupKind{Group: Group, Kind: TeamKind}.String()
+	TeamKindAPIVersion   = TeamKind + "." + SchemeGroupVersion.String()
+	TeamGroupVersionKind = SchemeGroupVersion.WithKind(TeamKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Team{}, &TeamList{})
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TeamMemberParameters add one organization user to an Akuity team.
+// The team is named directly on TeamName or through a Team managed
+// resource on TeamRef, in which case the controller reads the Team's
+// Status.AtProvider.Name. Membership has no mutable fields: a member
+// removed out-of-band is re-added on the next reconcile.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.teamName) || has(self.teamRef)",message="teamName or teamRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.teamName) || (has(self.teamName) && self.teamName == oldSelf.teamName)) && (!has(oldSelf.teamRef) || (has(self.teamRef) && self.teamRef.name == oldSelf.teamRef.name))",message="teamName/teamRef are immutable"
+// +kubebuilder:validation:XValidation:rule="self.userId == oldSelf.userId",message="userId is immutable"
+type TeamMemberParameters struct {
+	// TeamName is the name of the target team. At least one of
+	// TeamName or TeamRef must be set; when both are present, TeamName
+	// is used.
+	// +optional
+	TeamName string `json:"teamName,omitempty"`
+
+	// TeamRef references the target team by the name of its Team
+	// managed resource. At least one of TeamName or TeamRef must be
+	// set.
+	// +optional
+	TeamRef *LocalReference `json:"teamRef,omitempty"`
+
+	// UserID is the Akuity user ID to add to the team. Required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	UserID string `json:"userId"`
+}
+
+// TeamMemberObservation reflects the observed team membership.
+type TeamMemberObservation struct {
+	// ID is the platform-assigned team member ID.
+	ID string `json:"id,omitempty"`
+	// Email of the member as reported by the Akuity platform.
+	Email string `json:"email,omitempty"`
+	// TeamName is the resolved team name, cached on first successful
+	// Observe so Delete can remove the member even if the referenced
+	// Team MR has already been removed.
+	TeamName string `json:"teamName,omitempty"`
+}
+
+// A TeamMemberSpec defines the desired state of a TeamMember.
+type TeamMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TeamMemberParameters `json:"forProvider"`
+}
+
+// A TeamMemberStatus represents the observed state of a TeamMember.
+type TeamMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TeamMemberObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TeamMember is a managed resource that represents one user's
+// membership of an Akuity team.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type TeamMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamMemberSpec   `json:"spec"`
+	Status TeamMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TeamMemberList contains a list of TeamMember.
+type TeamMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TeamMember `json:"items"`
+}
+
+// TeamMember type metadata.
+var (
+	TeamMemberKind             = reflect.TypeOf(TeamMember{}).Name()
+	TeamMemberGroupKind        = schema.GroupKind{Group: Group, Kind: TeamMemberKind}.String()
+	TeamMemberKindAPIVersion   = TeamMemberKind + "." + SchemeGroupVersion.String()
+	TeamMemberGroupVersionKind = SchemeGroupVersion.WithKind(TeamMemberKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TeamMember{}, &TeamMemberList{})
+}
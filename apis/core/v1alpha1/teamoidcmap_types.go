@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY TeamOIDCMap, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TeamOIDCMapParameters are the configurable fields of the Akuity
+// organization's SSO group to team mapping.
+type TeamOIDCMapParameters struct {
+	// Entries maps an SSO group name, as found in the claims listed on
+	// SSOConfiguration groupClaims, to the name of the organization
+	// team its members join. The MR owns the whole map: entries added
+	// in the Akuity UI are removed on the next Update.
+	// +optional
+	Entries map[string]string `json:"entries,omitempty"`
+}
+
+// TeamOIDCMapObservation reflects the observed SSO group to team
+// mapping.
+type TeamOIDCMapObservation struct {
+	// Entries as reported by the Akuity platform.
+	Entries map[string]string `json:"entries,omitempty"`
+}
+
+// A TeamOIDCMapSpec defines the desired state of a TeamOIDCMap.
+type TeamOIDCMapSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TeamOIDCMapParameters `json:"forProvider"`
+}
+
+// A TeamOIDCMapStatus represents the observed state of a TeamOIDCMap.
+type TeamOIDCMapStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TeamOIDCMapObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TeamOIDCMap is a managed resource that represents the SSO group to
+// team mapping of the Akuity organization. The organization has a
+// single map; create one TeamOIDCMap per ProviderConfig.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type TeamOIDCMap struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamOIDCMapSpec   `json:"spec"`
+	Status TeamOIDCMapStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TeamOIDCMapList contains a list of TeamOIDCMap.
+type TeamOIDCMapList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TeamOIDCMap `json:"items"`
+}
+
+// TeamOIDCMap type metadata.
+var (
+	TeamOIDCMapKind             = reflect.TypeOf(TeamOIDCMap{}).Name()
+	TeamOIDCMapGroupKind        = schema.GroupKind{Group: Group, Kind: TeamOIDCMapKind}.String()
+	TeamOIDCMapKindAPIVersion   = TeamOIDCMapKind + "." + SchemeGroupVersion.String()
+	TeamOIDCMapGroupVersionKind = SchemeGroupVersion.WithKind(TeamOIDCMapKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TeamOIDCMap{}, &TeamOIDCMapList{})
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WorkspaceParameters are the configurable fields of an Akuity
+// workspace. Workspaces are organization-scoped containers for Argo CD
+// and Kargo instances; the platform assigns the canonical ID on create
+// and the controller stamps it as the external-name.
+type WorkspaceParameters struct {
+	// Name of the workspace as shown in the Akuity platform. Must be
+	// unique within the organization. Required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Description of the workspace.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// WorkspaceObservation reflects the observed state of an Akuity
+// workspace.
+type WorkspaceObservation struct {
+	// ID is the canonical Akuity workspace ID. Instance, KargoInstance,
+	// and KargoAgent resolve spec.forProvider.workspaceRef through this
+	// field.
+	ID string `json:"id,omitempty"`
+	// Name of the workspace as reported by the Akuity platform.
+	Name string `json:"name,omitempty"`
+	// Description of the workspace as reported by the Akuity platform.
+	Description string `json:"description,omitempty"`
+	// IsDefault is true for the organization's default workspace.
+	IsDefault bool `json:"isDefault,omitempty"`
+	// ArgoCDInstances lists the names of the Argo CD instances in the
+	// workspace.
+	ArgoCDInstances []string `json:"argocdInstances,omitempty"`
+	// KargoInstances lists the names of the Kargo instances in the
+	// workspace.
+	KargoInstances []string `json:"kargoInstances,omitempty"`
+	// TeamMemberCount is the number of teams that are members of the
+	// workspace.
+	TeamMemberCount int64 `json:"teamMemberCount,omitempty"`
+	// UserMemberCount is the number of users that are members of the
+	// workspace.
+	UserMemberCount int64 `json:"userMemberCount,omitempty"`
+}
+
+// A WorkspaceSpec defines the desired state of a Workspace.
+type WorkspaceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WorkspaceParameters `json:"forProvider"`
+}
+
+// A WorkspaceStatus represents the observed state of a Workspace.
+type WorkspaceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WorkspaceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Workspace is a managed resource that represents an Akuity
+// workspace.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type Workspace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkspaceSpec   `json:"spec"`
+	Status WorkspaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkspaceList contains a list of Workspace.
+type WorkspaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Workspace `json:"items"`
+}
+
+// Workspace type metadata.
+var (
+	WorkspaceKind             = reflect.TypeOf(Workspace{}).Name()
+	WorkspaceGroupKind        = schema.GroupKind{Group: Group, Kind: WorkspaceKind}.String()
+	WorkspaceKindAPIVersion   = WorkspaceKind + "." + SchemeGroupVersion.String()
+	WorkspaceGroupVersionKind = SchemeGroupVersion.WithKind(WorkspaceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Workspace{}, &WorkspaceList{})
+}
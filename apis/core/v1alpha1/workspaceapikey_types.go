@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WorkspaceAPIKeyParameters are the configurable fields of an Akuity
+// workspace API key. The workspace is addressed by ID on WorkspaceID or
+// through a Workspace managed resource on WorkspaceRef. As with
+// organization keys, every field is immutable after create.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.workspaceId) || has(self.workspaceRef)",message="workspaceId or workspaceRef must be set"
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forProvider is immutable"
+type WorkspaceAPIKeyParameters struct {
+	// WorkspaceID is the canonical Akuity ID of the workspace that owns
+	// the key. At least one of WorkspaceID or WorkspaceRef must be set;
+	// when both are present, WorkspaceID is used.
+	// +optional
+	WorkspaceID string `json:"workspaceId,omitempty"`
+
+	// WorkspaceRef references the owning workspace by the name of its
+	// Workspace managed resource. The controller reads the Workspace's
+	// Status.AtProvider.ID.
+	// +optional
+	WorkspaceRef *LocalReference `json:"workspaceRef,omitempty"`
+
+	// Description of the API key.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Permissions granted to the API key.
+	// +optional
+	Permissions APIKeyPermissions `json:"permissions,omitempty"`
+
+	// ExpireInDuration bounds the lifetime of the key, expressed as a
+	// duration relative to creation (for example "720h"). The key never
+	// expires when omitted.
+	// +optional
+	ExpireInDuration string `json:"expireInDuration,omitempty"`
+}
+
+// WorkspaceAPIKeyObservation reflects the observed state of an Akuity
+// workspace API key.
+type WorkspaceAPIKeyObservation struct {
+	APIKeyObservation `json:",inline"`
+	// WorkspaceID is the resolved workspace ID, cached on create so
+	// Delete can remove the key even if the referenced Workspace MR has
+	// already been removed.
+	WorkspaceID string `json:"workspaceId,omitempty"`
+}
+
+// A WorkspaceAPIKeySpec defines the desired state of a WorkspaceAPIKey.
+type WorkspaceAPIKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WorkspaceAPIKeyParameters `json:"forProvider"`
+}
+
+// A WorkspaceAPIKeyStatus represents the observed state of a
+// WorkspaceAPIKey.
+type WorkspaceAPIKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WorkspaceAPIKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WorkspaceAPIKey is a managed resource that represents an Akuity
+// workspace API key. The key ID and secret are written to the
+// writeConnectionSecretToRef Secret in the format a ProviderConfig
+// credentialsSecretRef expects.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="EXPIRES",type="string",JSONPath=".status.atProvider.expireTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type WorkspaceAPIKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkspaceAPIKeySpec   `json:"spec"`
+	Status WorkspaceAPIKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkspaceAPIKeyList contains a list of WorkspaceAPIKey.
+type WorkspaceAPIKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkspaceAPIKey `json:"items"`
+}
+
+// WorkspaceAPIKey type metadata.
+var (
+	WorkspaceAPIKeyKind             = reflect.TypeOf(WorkspaceAPIKey{}).Name()
+	WorkspaceAPIKeyGroupKind        = schema.GroupKind{Group: Group, Kind: WorkspaceAPIKeyKind}.String()
+	WorkspaceAPIKeyKindAPIVersion   = WorkspaceAPIKeyKind + "." + SchemeGroupVersion.String()
+	WorkspaceAPIKeyGroupVersionKind = SchemeGroupVersion.WithKind(WorkspaceAPIKeyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&WorkspaceAPIKey{}, &WorkspaceAPIKeyList{})
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WorkspaceCustomRoleParameters are the configurable fields of an
+// Akuity workspace custom role. The workspace is addressed by ID on
+// WorkspaceID or through a Workspace managed resource on WorkspaceRef.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.workspaceId) || has(self.workspaceRef)",message="workspaceId or workspaceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.workspaceId) || (has(self.workspaceId) && self.workspaceId == oldSelf.workspaceId)) && (!has(oldSelf.workspaceRef) || (has(self.workspaceRef) && self.workspaceRef.name == oldSelf.workspaceRef.name))",message="workspaceId/workspaceRef are immutable"
+type WorkspaceCustomRoleParameters struct {
+	// WorkspaceID is the canonical Akuity ID of the workspace that owns
+	// the role. At least one of WorkspaceID or WorkspaceRef must be
+	// set; when both are present, WorkspaceID is used.
+	// +optional
+	WorkspaceID string `json:"workspaceId,omitempty"`
+
+	// WorkspaceRef references the owning workspace by the name of its
+	// Workspace managed resource. The controller reads the Workspace's
+	// Status.AtProvider.ID.
+	// +optional
+	WorkspaceRef *LocalReference `json:"workspaceRef,omitempty"`
+
+	// Name of the custom role. Must be unique within the workspace.
+	// Required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Description of the custom role.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Policy lists the permissions granted by the role, one rule per
+	// line. Rule order, blank lines, and surrounding whitespace are not
+	// significant.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Policy string `json:"policy"`
+}
+
+// WorkspaceCustomRoleObservation reflects the observed state of an
+// Akuity workspace custom role.
+type WorkspaceCustomRoleObservation struct {
+	CustomRoleObservation `json:",inline"`
+	// WorkspaceID is the resolved workspace ID, cached on first
+	// successful Observe so Delete can remove the role even if the
+	// referenced Workspace MR has already been removed.
+	WorkspaceID string `json:"workspaceId,omitempty"`
+}
+
+// A WorkspaceCustomRoleSpec defines the desired state of a
+// WorkspaceCustomRole.
+type WorkspaceCustomRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WorkspaceCustomRoleParameters `json:"forProvider"`
+}
+
+// A WorkspaceCustomRoleStatus represents the observed state of a
+// WorkspaceCustomRole.
+type WorkspaceCustomRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WorkspaceCustomRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WorkspaceCustomRole is a managed resource that represents a custom
+// role scoped to an Akuity workspace.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type WorkspaceCustomRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkspaceCustomRoleSpec   `json:"spec"`
+	Status WorkspaceCustomRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkspaceCustomRoleList contains a list of WorkspaceCustomRole.
+type WorkspaceCustomRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkspaceCustomRole `json:"items"`
+}
+
+// WorkspaceCustomRole type metadata.
+var (
+	WorkspaceCustomRoleKind             = reflect.TypeOf(WorkspaceCustomRole{}).Name()
+	WorkspaceCustomRoleGroupKind        = schema.GroupKind{Group: Group, Kind: WorkspaceCustomRoleKind}.String()
+	WorkspaceCustomRoleKindAPIVersion   = WorkspaceCustomRoleKind + "." + SchemeGroupVersion.String()
+	WorkspaceCustomRoleGroupVersionKind = SchemeGroupVersion.WithKind(WorkspaceCustomRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&WorkspaceCustomRole{}, &WorkspaceCustomRoleList{})
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WorkspaceMemberRole is the role a member holds in a workspace.
+// +kubebuilder:validation:Enum=member;admin
+type WorkspaceMemberRole string
+
+// Workspace member roles.
+const (
+	WorkspaceMemberRoleMember WorkspaceMemberRole = "member"
+	WorkspaceMemberRoleAdmin  WorkspaceMemberRole = "admin"
+)
+
+// WorkspaceMemberParameters grant a user or a team access to an Akuity
+// workspace. The workspace is addressed by ID on WorkspaceID or through
+// a Workspace managed resource on WorkspaceRef. Exactly one of UserID,
+// UserEmail, TeamName, or TeamRef identifies the member.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.workspaceId) || has(self.workspaceRef)",message="workspaceId or workspaceRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.workspaceId) || (has(self.workspaceId) && self.workspaceId == oldSelf.workspaceId)) && (!has(oldSelf.workspaceRef) || (has(self.workspaceRef) && self.workspaceRef.name == oldSelf.workspaceRef.name))",message="workspaceId/workspaceRef are immutable"
+// +kubebuilder:validation:XValidation:rule="[has(self.userId), has(self.userEmail), has(self.teamName), has(self.teamRef)].filter(x, x).size() == 1",message="exactly one of userId, userEmail, teamName, or teamRef must be set"
+// +kubebuilder:validation:XValidation:rule="(!has(oldSelf.userId) || (has(self.userId) && self.userId == oldSelf.userId)) && (!has(oldSelf.userEmail) || (has(self.userEmail) && self.userEmail == oldSelf.userEmail)) && (!has(oldSelf.teamName) || (has(self.teamName) && self.teamName == oldSelf.teamName)) && (!has(oldSelf.teamRef) || (has(self.teamRef) && self.teamRef.name == oldSelf.teamRef.name))",message="the member (userId/userEmail/teamName/teamRef) is immutable"
+type WorkspaceMemberParameters struct {
+	// WorkspaceID is the canonical Akuity ID of the target workspace.
+	// At least one of WorkspaceID or WorkspaceRef must be set; when
+	// both are present, WorkspaceID is used.
+	// +optional
+	WorkspaceID string `json:"workspaceId,omitempty"`
+
+	// WorkspaceRef references the target workspace by the name of its
+	// Workspace managed resource. The controller reads the Workspace's
+	// Status.AtProvider.ID.
+	// +optional
+	WorkspaceRef *LocalReference `json:"workspaceRef,omitempty"`
+
+	// UserID is the Akuity user ID of a user member.
+	// +optional
+	UserID string `json:"userId,omitempty"`
+
+	// UserEmail is the email address of a user member.
+	// +optional
+	UserEmail string `json:"userEmail,omitempty"`
+
+	// TeamName is the name of a team member.
+	// +optional
+	TeamName string `json:"teamName,omitempty"`
+
+	// TeamRef references a team member by the name of its Team managed
+	// resource.
+	// +optional
+	TeamRef *LocalReference `json:"teamRef,omitempty"`
+
+	// Role granted to the member in the workspace.
+	// +optional
+	// +kubebuilder:default=member
+	Role WorkspaceMemberRole `json:"role,omitempty"`
+}
+
+// WorkspaceMemberObservation reflects the observed workspace
+// membership.
+type WorkspaceMemberObservation struct {
+	// ID is the platform-assigned workspace member ID.
+	ID string `json:"id,omitempty"`
+	// Role the member holds in the workspace.
+	Role WorkspaceMemberRole `json:"role,omitempty"`
+	// UserID is the member's user ID when the member is a user.
+	UserID string `json:"userId,omitempty"`
+	// UserEmail is the member's email when the member is a user.
+	UserEmail string `json:"userEmail,omitempty"`
+	// TeamName is the member's team name when the member is a team.
+	TeamName string `json:"teamName,omitempty"`
+	// WorkspaceID is the resolved workspace ID, cached on first
+	// successful Observe so Delete can remove the member even if the
+	// referenced Workspace MR has already been removed.
+	WorkspaceID string `json:"workspaceId,omitempty"`
+}
+
+// A WorkspaceMemberSpec defines the desired state of a WorkspaceMember.
+type WorkspaceMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WorkspaceMemberParameters `json:"forProvider"`
+}
+
+// A WorkspaceMemberStatus represents the observed state of a
+// WorkspaceMember.
+type WorkspaceMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WorkspaceMemberObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WorkspaceMember is a managed resource that represents a user's or
+// team's membership of an Akuity workspace.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,akuity}
+type WorkspaceMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkspaceMemberSpec   `json:"spec"`
+	Status WorkspaceMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkspaceMemberList contains a list of WorkspaceMember.
+type WorkspaceMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkspaceMember `json:"items"`
+}
+
+// WorkspaceMember type metadata.
+var (
+	WorkspaceMemberKind             = reflect.TypeOf(WorkspaceMember{}).Name()
+	WorkspaceMemberGroupKind        = schema.GroupKind{Group: Group, Kind: WorkspaceMemberKind}.String()
+	WorkspaceMemberKindAPIVersion   = WorkspaceMemberKind + "." + SchemeGroupVersion.String()
+	WorkspaceMemberGroupVersionKind = SchemeGroupVersion.WithKind(WorkspaceMemberKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&WorkspaceMember{}, &WorkspaceMemberList{})
+}
@@ -22,12 +22,142 @@ package v1alpha1
 
 import (
 	crossplanev1alpha1 "github.com/akuityio/provider-crossplane-akuity/internal/types/generated/crossplane/v1alpha1"
-	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	commonv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Cluster) DeepCopyInto(out *Cluster) {
+func (in *APIKeyObservation) DeepCopyInto(out *APIKeyObservation) {
+	*out = *in
+	in.Permissions.DeepCopyInto(&out.Permissions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyObservation.
+func (in *APIKeyObservation) DeepCopy() *APIKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyPermissions) DeepCopyInto(out *APIKeyPermissions) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomRoles != nil {
+		in, out := &in.CustomRoles, &out.CustomRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyPermissions.
+func (in *APIKeyPermissions) DeepCopy() *APIKeyPermissions {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyPermissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonChartDependency) DeepCopyInto(out *AddonChartDependency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonChartDependency.
+func (in *AddonChartDependency) DeepCopy() *AddonChartDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonChartDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonClusterSelector) DeepCopyInto(out *AddonClusterSelector) {
+	*out = *in
+	if in.NameFilters != nil {
+		in, out := &in.NameFilters, &out.NameFilters
+		*out = make([]AddonSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LabelFilters != nil {
+		in, out := &in.LabelFilters, &out.LabelFilters
+		*out = make([]AddonSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonClusterSelector.
+func (in *AddonClusterSelector) DeepCopy() *AddonClusterSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonClusterSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonMarketplaceEvent) DeepCopyInto(out *AddonMarketplaceEvent) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonMarketplaceEvent.
+func (in *AddonMarketplaceEvent) DeepCopy() *AddonMarketplaceEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonMarketplaceEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonMarketplaceHelmChart) DeepCopyInto(out *AddonMarketplaceHelmChart) {
+	*out = *in
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]AddonChartDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonMarketplaceHelmChart.
+func (in *AddonMarketplaceHelmChart) DeepCopy() *AddonMarketplaceHelmChart {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonMarketplaceHelmChart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonMarketplaceInstall) DeepCopyInto(out *AddonMarketplaceInstall) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -35,18 +165,18 @@ func (in *Cluster) DeepCopyInto(out *Cluster) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cluster.
-func (in *Cluster) DeepCopy() *Cluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonMarketplaceInstall.
+func (in *AddonMarketplaceInstall) DeepCopy() *AddonMarketplaceInstall {
 	if in == nil {
 		return nil
 	}
-	out := new(Cluster)
+	out := new(AddonMarketplaceInstall)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Cluster) DeepCopyObject() runtime.Object {
+func (in *AddonMarketplaceInstall) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -54,31 +184,31 @@ func (in *Cluster) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+func (in *AddonMarketplaceInstallList) DeepCopyInto(out *AddonMarketplaceInstallList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Cluster, len(*in))
+		*out = make([]AddonMarketplaceInstall, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
-func (in *ClusterList) DeepCopy() *ClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonMarketplaceInstallList.
+func (in *AddonMarketplaceInstallList) DeepCopy() *AddonMarketplaceInstallList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterList)
+	out := new(AddonMarketplaceInstallList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterList) DeepCopyObject() runtime.Object {
+func (in *AddonMarketplaceInstallList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -86,174 +216,141 @@ func (in *ClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterObservation) DeepCopyInto(out *ClusterObservation) {
+func (in *AddonMarketplaceInstallObservation) DeepCopyInto(out *AddonMarketplaceInstallObservation) {
 	*out = *in
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]AddonChartDependency, len(*in))
+		copy(*out, *in)
 	}
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.LastEvent != nil {
+		in, out := &in.LastEvent, &out.LastEvent
+		*out = new(AddonMarketplaceEvent)
+		(*in).DeepCopyInto(*out)
 	}
-	in.AgentState.DeepCopyInto(&out.AgentState)
-	out.HealthStatus = in.HealthStatus
-	out.ReconciliationStatus = in.ReconciliationStatus
-	in.ClusterSpec.DeepCopyInto(&out.ClusterSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservation.
-func (in *ClusterObservation) DeepCopy() *ClusterObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonMarketplaceInstallObservation.
+func (in *AddonMarketplaceInstallObservation) DeepCopy() *AddonMarketplaceInstallObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterObservation)
+	out := new(AddonMarketplaceInstallObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterObservationAgentHealthStatus) DeepCopyInto(out *ClusterObservationAgentHealthStatus) {
+func (in *AddonMarketplaceInstallOverrides) DeepCopyInto(out *AddonMarketplaceInstallOverrides) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservationAgentHealthStatus.
-func (in *ClusterObservationAgentHealthStatus) DeepCopy() *ClusterObservationAgentHealthStatus {
-	if in == nil {
-		return nil
+	if in.Envs != nil {
+		in, out := &in.Envs, &out.Envs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out := new(ClusterObservationAgentHealthStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterObservationAgentState) DeepCopyInto(out *ClusterObservationAgentState) {
-	*out = *in
-	if in.Statuses != nil {
-		in, out := &in.Statuses, &out.Statuses
-		*out = make(map[string]ClusterObservationAgentHealthStatus, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservationAgentState.
-func (in *ClusterObservationAgentState) DeepCopy() *ClusterObservationAgentState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonMarketplaceInstallOverrides.
+func (in *AddonMarketplaceInstallOverrides) DeepCopy() *AddonMarketplaceInstallOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterObservationAgentState)
+	out := new(AddonMarketplaceInstallOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
+func (in *AddonMarketplaceInstallParameters) DeepCopyInto(out *AddonMarketplaceInstallParameters) {
 	*out = *in
 	if in.InstanceRef != nil {
 		in, out := &in.InstanceRef, &out.InstanceRef
 		*out = new(LocalReference)
 		**out = **in
 	}
-	in.ClusterSpec.DeepCopyInto(&out.ClusterSpec)
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.HelmChart != nil {
+		in, out := &in.HelmChart, &out.HelmChart
+		*out = new(AddonMarketplaceHelmChart)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(AddonMarketplaceInstallOverrides)
+		(*in).DeepCopyInto(*out)
 	}
-	in.KubeConfigSecretRef.DeepCopyInto(&out.KubeConfigSecretRef)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterParameters.
-func (in *ClusterParameters) DeepCopy() *ClusterParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonMarketplaceInstallParameters.
+func (in *AddonMarketplaceInstallParameters) DeepCopy() *AddonMarketplaceInstallParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterParameters)
+	out := new(AddonMarketplaceInstallParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
+func (in *AddonMarketplaceInstallSpec) DeepCopyInto(out *AddonMarketplaceInstallSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
-func (in *ClusterSpec) DeepCopy() *ClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonMarketplaceInstallSpec.
+func (in *AddonMarketplaceInstallSpec) DeepCopy() *AddonMarketplaceInstallSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterSpec)
+	out := new(AddonMarketplaceInstallSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
+func (in *AddonMarketplaceInstallStatus) DeepCopyInto(out *AddonMarketplaceInstallStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
-func (in *ClusterStatus) DeepCopy() *ClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonMarketplaceInstallStatus.
+func (in *AddonMarketplaceInstallStatus) DeepCopy() *AddonMarketplaceInstallStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterStatus)
+	out := new(AddonMarketplaceInstallStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Instance) DeepCopyInto(out *Instance) {
+func (in *AddonSelector) DeepCopyInto(out *AddonSelector) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
-func (in *Instance) DeepCopy() *Instance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonSelector.
+func (in *AddonSelector) DeepCopy() *AddonSelector {
 	if in == nil {
 		return nil
 	}
-	out := new(Instance)
+	out := new(AddonSelector)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Instance) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceIpAllowList) DeepCopyInto(out *InstanceIpAllowList) {
+func (in *AgentVersionPolicy) DeepCopyInto(out *AgentVersionPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -261,18 +358,18 @@ func (in *InstanceIpAllowList) DeepCopyInto(out *InstanceIpAllowList) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIpAllowList.
-func (in *InstanceIpAllowList) DeepCopy() *InstanceIpAllowList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentVersionPolicy.
+func (in *AgentVersionPolicy) DeepCopy() *AgentVersionPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceIpAllowList)
+	out := new(AgentVersionPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InstanceIpAllowList) DeepCopyObject() runtime.Object {
+func (in *AgentVersionPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -280,31 +377,31 @@ func (in *InstanceIpAllowList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceIpAllowListList) DeepCopyInto(out *InstanceIpAllowListList) {
+func (in *AgentVersionPolicyList) DeepCopyInto(out *AgentVersionPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]InstanceIpAllowList, len(*in))
+		*out = make([]AgentVersionPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIpAllowListList.
-func (in *InstanceIpAllowListList) DeepCopy() *InstanceIpAllowListList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentVersionPolicyList.
+func (in *AgentVersionPolicyList) DeepCopy() *AgentVersionPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceIpAllowListList)
+	out := new(AgentVersionPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InstanceIpAllowListList) DeepCopyObject() runtime.Object {
+func (in *AgentVersionPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -312,122 +409,120 @@ func (in *InstanceIpAllowListList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceIpAllowListObservation) DeepCopyInto(out *InstanceIpAllowListObservation) {
+func (in *AgentVersionPolicyObservation) DeepCopyInto(out *AgentVersionPolicyObservation) {
 	*out = *in
-	if in.AllowList != nil {
-		in, out := &in.AllowList, &out.AllowList
-		*out = make([]*crossplanev1alpha1.IPAllowListEntry, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(crossplanev1alpha1.IPAllowListEntry)
-				**out = **in
-			}
-		}
+	if in.WaveClusters != nil {
+		in, out := &in.WaveClusters, &out.WaveClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WaveStartTime != nil {
+		in, out := &in.WaveStartTime, &out.WaveStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.WaveCompletionTime != nil {
+		in, out := &in.WaveCompletionTime, &out.WaveCompletionTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIpAllowListObservation.
-func (in *InstanceIpAllowListObservation) DeepCopy() *InstanceIpAllowListObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentVersionPolicyObservation.
+func (in *AgentVersionPolicyObservation) DeepCopy() *AgentVersionPolicyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceIpAllowListObservation)
+	out := new(AgentVersionPolicyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceIpAllowListParameters) DeepCopyInto(out *InstanceIpAllowListParameters) {
+func (in *AgentVersionPolicyParameters) DeepCopyInto(out *AgentVersionPolicyParameters) {
 	*out = *in
 	if in.InstanceRef != nil {
 		in, out := &in.InstanceRef, &out.InstanceRef
 		*out = new(LocalReference)
 		**out = **in
 	}
-	if in.AllowList != nil {
-		in, out := &in.AllowList, &out.AllowList
-		*out = make([]*crossplanev1alpha1.IPAllowListEntry, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(crossplanev1alpha1.IPAllowListEntry)
-				**out = **in
-			}
-		}
+	in.ClusterSelector.DeepCopyInto(&out.ClusterSelector)
+	out.WaveSize = in.WaveSize
+	if in.PauseBetweenWaves != nil {
+		in, out := &in.PauseBetweenWaves, &out.PauseBetweenWaves
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.WaveTimeout != nil {
+		in, out := &in.WaveTimeout, &out.WaveTimeout
+		*out = new(v1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIpAllowListParameters.
-func (in *InstanceIpAllowListParameters) DeepCopy() *InstanceIpAllowListParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentVersionPolicyParameters.
+func (in *AgentVersionPolicyParameters) DeepCopy() *AgentVersionPolicyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceIpAllowListParameters)
+	out := new(AgentVersionPolicyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceIpAllowListSpec) DeepCopyInto(out *InstanceIpAllowListSpec) {
+func (in *AgentVersionPolicySpec) DeepCopyInto(out *AgentVersionPolicySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIpAllowListSpec.
-func (in *InstanceIpAllowListSpec) DeepCopy() *InstanceIpAllowListSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentVersionPolicySpec.
+func (in *AgentVersionPolicySpec) DeepCopy() *AgentVersionPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceIpAllowListSpec)
+	out := new(AgentVersionPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceIpAllowListStatus) DeepCopyInto(out *InstanceIpAllowListStatus) {
+func (in *AgentVersionPolicyStatus) DeepCopyInto(out *AgentVersionPolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIpAllowListStatus.
-func (in *InstanceIpAllowListStatus) DeepCopy() *InstanceIpAllowListStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentVersionPolicyStatus.
+func (in *AgentVersionPolicyStatus) DeepCopy() *AgentVersionPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceIpAllowListStatus)
+	out := new(AgentVersionPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceList) DeepCopyInto(out *InstanceList) {
+func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Instance, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceList.
-func (in *InstanceList) DeepCopy() *InstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cluster.
+func (in *Cluster) DeepCopy() *Cluster {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceList)
+	out := new(Cluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InstanceList) DeepCopyObject() runtime.Object {
+func (in *Cluster) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -435,232 +530,3909 @@ func (in *InstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceObservation) DeepCopyInto(out *InstanceObservation) {
+func (in *ClusterInfo) DeepCopyInto(out *ClusterInfo) {
 	*out = *in
-	out.HealthStatus = in.HealthStatus
-	out.ReconciliationStatus = in.ReconciliationStatus
-	in.ArgoCD.DeepCopyInto(&out.ArgoCD)
-	if in.ArgoCDConfigMap != nil {
-		in, out := &in.ArgoCDConfigMap, &out.ArgoCDConfigMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.HasApplications != nil {
+		in, out := &in.HasApplications, &out.HasApplications
+		*out = new(bool)
+		**out = **in
 	}
-	if in.ArgoCDImageUpdaterConfigMap != nil {
-		in, out := &in.ArgoCDImageUpdaterConfigMap, &out.ArgoCDImageUpdaterConfigMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.LastRefreshTime != nil {
+		in, out := &in.LastRefreshTime, &out.LastRefreshTime
+		*out = (*in).DeepCopy()
 	}
-	if in.ArgoCDImageUpdaterSSHConfigMap != nil {
-		in, out := &in.ArgoCDImageUpdaterSSHConfigMap, &out.ArgoCDImageUpdaterSSHConfigMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.LastAttemptTime != nil {
+		in, out := &in.LastAttemptTime, &out.LastAttemptTime
+		*out = (*in).DeepCopy()
 	}
-	if in.ArgoCDNotificationsConfigMap != nil {
-		in, out := &in.ArgoCDNotificationsConfigMap, &out.ArgoCDNotificationsConfigMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInfo.
+func (in *ClusterInfo) DeepCopy() *ClusterInfo {
+	if in == nil {
+		return nil
 	}
-	if in.ArgoCDRBACConfigMap != nil {
-		in, out := &in.ArgoCDRBACConfigMap, &out.ArgoCDRBACConfigMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out := new(ClusterInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.ArgoCDSSHKnownHostsConfigMap != nil {
-		in, out := &in.ArgoCDSSHKnownHostsConfigMap, &out.ArgoCDSSHKnownHostsConfigMap
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
+func (in *ClusterList) DeepCopy() *ClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterObservation) DeepCopyInto(out *ClusterObservation) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.ArgoCDTLSCertsConfigMap != nil {
-		in, out := &in.ArgoCDTLSCertsConfigMap, &out.ArgoCDTLSCertsConfigMap
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.ConfigManagementPlugins != nil {
-		in, out := &in.ConfigManagementPlugins, &out.ConfigManagementPlugins
-		*out = make(map[string]crossplanev1alpha1.ConfigManagementPlugin, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
+	in.AgentState.DeepCopyInto(&out.AgentState)
+	out.HealthStatus = in.HealthStatus
+	out.ReconciliationStatus = in.ReconciliationStatus
+	in.ClusterSpec.DeepCopyInto(&out.ClusterSpec)
+	in.CredentialRotationStatus.DeepCopyInto(&out.CredentialRotationStatus)
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindowStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SupportAccessUntil != nil {
+		in, out := &in.SupportAccessUntil, &out.SupportAccessUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.ConnectionDetailsRefreshTime != nil {
+		in, out := &in.ConnectionDetailsRefreshTime, &out.ConnectionDetailsRefreshTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ClusterInfo != nil {
+		in, out := &in.ClusterInfo, &out.ClusterInfo
+		*out = new(ClusterInfo)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceObservation.
-func (in *InstanceObservation) DeepCopy() *InstanceObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservation.
+func (in *ClusterObservation) DeepCopy() *ClusterObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceObservation)
+	out := new(ClusterObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
+func (in *ClusterObservationAgentHealthStatus) DeepCopyInto(out *ClusterObservationAgentHealthStatus) {
 	*out = *in
-	if in.ArgoCD != nil {
-		in, out := &in.ArgoCD, &out.ArgoCD
-		*out = new(crossplanev1alpha1.ArgoCD)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservationAgentHealthStatus.
+func (in *ClusterObservationAgentHealthStatus) DeepCopy() *ClusterObservationAgentHealthStatus {
+	if in == nil {
+		return nil
 	}
-	if in.ArgoCDConfigMap != nil {
-		in, out := &in.ArgoCDConfigMap, &out.ArgoCDConfigMap
-		*out = make(map[string]string, len(*in))
+	out := new(ClusterObservationAgentHealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterObservationAgentState) DeepCopyInto(out *ClusterObservationAgentState) {
+	*out = *in
+	if in.Statuses != nil {
+		in, out := &in.Statuses, &out.Statuses
+		*out = make(map[string]ClusterObservationAgentHealthStatus, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.ArgoCDImageUpdaterConfigMap != nil {
-		in, out := &in.ArgoCDImageUpdaterConfigMap, &out.ArgoCDImageUpdaterConfigMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservationAgentState.
+func (in *ClusterObservationAgentState) DeepCopy() *ClusterObservationAgentState {
+	if in == nil {
+		return nil
 	}
-	if in.ArgoCDImageUpdaterSSHConfigMap != nil {
-		in, out := &in.ArgoCDImageUpdaterSSHConfigMap, &out.ArgoCDImageUpdaterSSHConfigMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	out := new(ClusterObservationAgentState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(LocalReference)
+		**out = **in
 	}
-	if in.ArgoCDNotificationsConfigMap != nil {
-		in, out := &in.ArgoCDNotificationsConfigMap, &out.ArgoCDNotificationsConfigMap
+	in.ClusterSpec.DeepCopyInto(&out.ClusterSpec)
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.ArgoCDRBACConfigMap != nil {
-		in, out := &in.ArgoCDRBACConfigMap, &out.ArgoCDRBACConfigMap
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.ArgoCDSSHKnownHostsConfigMap != nil {
-		in, out := &in.ArgoCDSSHKnownHostsConfigMap, &out.ArgoCDSSHKnownHostsConfigMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	in.KubeConfigSecretRef.DeepCopyInto(&out.KubeConfigSecretRef)
+	if in.ManifestsSink != nil {
+		in, out := &in.ManifestsSink, &out.ManifestsSink
+		*out = new(ManifestsSink)
+		**out = **in
 	}
-	if in.ArgoCDTLSCertsConfigMap != nil {
-		in, out := &in.ArgoCDTLSCertsConfigMap, &out.ArgoCDTLSCertsConfigMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
 	}
-	if in.ConfigManagementPlugins != nil {
-		in, out := &in.ConfigManagementPlugins, &out.ConfigManagementPlugins
-		*out = make(map[string]crossplanev1alpha1.ConfigManagementPlugin, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
+	if in.SupportAccess != nil {
+		in, out := &in.SupportAccess, &out.SupportAccess
+		*out = new(ClusterSupportAccess)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ArgoCDSecretRef != nil {
-		in, out := &in.ArgoCDSecretRef, &out.ArgoCDSecretRef
-		*out = new(v1.SecretReference)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterParameters.
+func (in *ClusterParameters) DeepCopy() *ClusterParameters {
+	if in == nil {
+		return nil
 	}
-	if in.ArgoCDNotificationsSecretRef != nil {
-		in, out := &in.ArgoCDNotificationsSecretRef, &out.ArgoCDNotificationsSecretRef
-		*out = new(v1.SecretReference)
-		**out = **in
+	out := new(ClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
+func (in *ClusterSpec) DeepCopy() *ClusterSpec {
+	if in == nil {
+		return nil
 	}
-	if in.ArgoCDImageUpdaterSecretRef != nil {
-		in, out := &in.ArgoCDImageUpdaterSecretRef, &out.ArgoCDImageUpdaterSecretRef
-		*out = new(v1.SecretReference)
-		**out = **in
+	out := new(ClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
+func (in *ClusterStatus) DeepCopy() *ClusterStatus {
+	if in == nil {
+		return nil
 	}
-	if in.ApplicationSetSecretRef != nil {
-		in, out := &in.ApplicationSetSecretRef, &out.ApplicationSetSecretRef
-		*out = new(v1.SecretReference)
-		**out = **in
+	out := new(ClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSupportAccess) DeepCopyInto(out *ClusterSupportAccess) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSupportAccess.
+func (in *ClusterSupportAccess) DeepCopy() *ClusterSupportAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSupportAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRotationStatus) DeepCopyInto(out *CredentialRotationStatus) {
+	*out = *in
+	if in.LastCredentialRotationTime != nil {
+		in, out := &in.LastCredentialRotationTime, &out.LastCredentialRotationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRotationStatus.
+func (in *CredentialRotationStatus) DeepCopy() *CredentialRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRole) DeepCopyInto(out *CustomRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRole.
+func (in *CustomRole) DeepCopy() *CustomRole {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRoleList) DeepCopyInto(out *CustomRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRoleList.
+func (in *CustomRoleList) DeepCopy() *CustomRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRoleObservation) DeepCopyInto(out *CustomRoleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRoleObservation.
+func (in *CustomRoleObservation) DeepCopy() *CustomRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRoleParameters) DeepCopyInto(out *CustomRoleParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRoleParameters.
+func (in *CustomRoleParameters) DeepCopy() *CustomRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRoleSpec) DeepCopyInto(out *CustomRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRoleSpec.
+func (in *CustomRoleSpec) DeepCopy() *CustomRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRoleStatus) DeepCopyInto(out *CustomRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRoleStatus.
+func (in *CustomRoleStatus) DeepCopy() *CustomRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Instance) DeepCopyInto(out *Instance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
+func (in *Instance) DeepCopy() *Instance {
+	if in == nil {
+		return nil
+	}
+	out := new(Instance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Instance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAccount) DeepCopyInto(out *InstanceAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAccount.
+func (in *InstanceAccount) DeepCopy() *InstanceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAccountCapabilities) DeepCopyInto(out *InstanceAccountCapabilities) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAccountCapabilities.
+func (in *InstanceAccountCapabilities) DeepCopy() *InstanceAccountCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAccountCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAccountList) DeepCopyInto(out *InstanceAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAccountList.
+func (in *InstanceAccountList) DeepCopy() *InstanceAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAccountObservation) DeepCopyInto(out *InstanceAccountObservation) {
+	*out = *in
+	out.Capabilities = in.Capabilities
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAccountObservation.
+func (in *InstanceAccountObservation) DeepCopy() *InstanceAccountObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAccountObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAccountParameters) DeepCopyInto(out *InstanceAccountParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+	out.Capabilities = in.Capabilities
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAccountParameters.
+func (in *InstanceAccountParameters) DeepCopy() *InstanceAccountParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAccountParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAccountSpec) DeepCopyInto(out *InstanceAccountSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAccountSpec.
+func (in *InstanceAccountSpec) DeepCopy() *InstanceAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAccountStatus) DeepCopyInto(out *InstanceAccountStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAccountStatus.
+func (in *InstanceAccountStatus) DeepCopy() *InstanceAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAddon) DeepCopyInto(out *InstanceAddon) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAddon.
+func (in *InstanceAddon) DeepCopy() *InstanceAddon {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAddon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceAddon) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAddonList) DeepCopyInto(out *InstanceAddonList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceAddon, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAddonList.
+func (in *InstanceAddonList) DeepCopy() *InstanceAddonList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAddonList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceAddonList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAddonObservation) DeepCopyInto(out *InstanceAddonObservation) {
+	*out = *in
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(AddonClusterSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	out.ReconciliationStatus = in.ReconciliationStatus
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAddonObservation.
+func (in *InstanceAddonObservation) DeepCopy() *InstanceAddonObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAddonObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAddonParameters) DeepCopyInto(out *InstanceAddonParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+	if in.RepoRef != nil {
+		in, out := &in.RepoRef, &out.RepoRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(AddonClusterSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAddonParameters.
+func (in *InstanceAddonParameters) DeepCopy() *InstanceAddonParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAddonParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAddonRepo) DeepCopyInto(out *InstanceAddonRepo) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAddonRepo.
+func (in *InstanceAddonRepo) DeepCopy() *InstanceAddonRepo {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAddonRepo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceAddonRepo) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAddonRepoList) DeepCopyInto(out *InstanceAddonRepoList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceAddonRepo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAddonRepoList.
+func (in *InstanceAddonRepoList) DeepCopy() *InstanceAddonRepoList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAddonRepoList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceAddonRepoList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAddonRepoObservation) DeepCopyInto(out *InstanceAddonRepoObservation) {
+	*out = *in
+	out.ReconciliationStatus = in.ReconciliationStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAddonRepoObservation.
+func (in *InstanceAddonRepoObservation) DeepCopy() *InstanceAddonRepoObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAddonRepoObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAddonRepoParameters) DeepCopyInto(out *InstanceAddonRepoParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAddonRepoParameters.
+func (in *InstanceAddonRepoParameters) DeepCopy() *InstanceAddonRepoParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAddonRepoParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAddonRepoSpec) DeepCopyInto(out *InstanceAddonRepoSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAddonRepoSpec.
+func (in *InstanceAddonRepoSpec) DeepCopy() *InstanceAddonRepoSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAddonRepoSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAddonRepoStatus) DeepCopyInto(out *InstanceAddonRepoStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAddonRepoStatus.
+func (in *InstanceAddonRepoStatus) DeepCopy() *InstanceAddonRepoStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAddonRepoStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAddonSpec) DeepCopyInto(out *InstanceAddonSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAddonSpec.
+func (in *InstanceAddonSpec) DeepCopy() *InstanceAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceAddonStatus) DeepCopyInto(out *InstanceAddonStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceAddonStatus.
+func (in *InstanceAddonStatus) DeepCopy() *InstanceAddonStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceAddonStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceCSS) DeepCopyInto(out *InstanceCSS) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceCSS.
+func (in *InstanceCSS) DeepCopy() *InstanceCSS {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceCSS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceCSS) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceCSSList) DeepCopyInto(out *InstanceCSSList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceCSS, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceCSSList.
+func (in *InstanceCSSList) DeepCopy() *InstanceCSSList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceCSSList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceCSSList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceCSSObservation) DeepCopyInto(out *InstanceCSSObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceCSSObservation.
+func (in *InstanceCSSObservation) DeepCopy() *InstanceCSSObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceCSSObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceCSSParameters) DeepCopyInto(out *InstanceCSSParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceCSSParameters.
+func (in *InstanceCSSParameters) DeepCopy() *InstanceCSSParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceCSSParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceCSSSpec) DeepCopyInto(out *InstanceCSSSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceCSSSpec.
+func (in *InstanceCSSSpec) DeepCopy() *InstanceCSSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceCSSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceCSSStatus) DeepCopyInto(out *InstanceCSSStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceCSSStatus.
+func (in *InstanceCSSStatus) DeepCopy() *InstanceCSSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceCSSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceIpAllowList) DeepCopyInto(out *InstanceIpAllowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIpAllowList.
+func (in *InstanceIpAllowList) DeepCopy() *InstanceIpAllowList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceIpAllowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceIpAllowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceIpAllowListList) DeepCopyInto(out *InstanceIpAllowListList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceIpAllowList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIpAllowListList.
+func (in *InstanceIpAllowListList) DeepCopy() *InstanceIpAllowListList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceIpAllowListList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceIpAllowListList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceIpAllowListObservation) DeepCopyInto(out *InstanceIpAllowListObservation) {
+	*out = *in
+	if in.AllowList != nil {
+		in, out := &in.AllowList, &out.AllowList
+		*out = make([]*crossplanev1alpha1.IPAllowListEntry, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(crossplanev1alpha1.IPAllowListEntry)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIpAllowListObservation.
+func (in *InstanceIpAllowListObservation) DeepCopy() *InstanceIpAllowListObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceIpAllowListObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceIpAllowListParameters) DeepCopyInto(out *InstanceIpAllowListParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+	if in.AllowList != nil {
+		in, out := &in.AllowList, &out.AllowList
+		*out = make([]*crossplanev1alpha1.IPAllowListEntry, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(crossplanev1alpha1.IPAllowListEntry)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIpAllowListParameters.
+func (in *InstanceIpAllowListParameters) DeepCopy() *InstanceIpAllowListParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceIpAllowListParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceIpAllowListSpec) DeepCopyInto(out *InstanceIpAllowListSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIpAllowListSpec.
+func (in *InstanceIpAllowListSpec) DeepCopy() *InstanceIpAllowListSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceIpAllowListSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceIpAllowListStatus) DeepCopyInto(out *InstanceIpAllowListStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIpAllowListStatus.
+func (in *InstanceIpAllowListStatus) DeepCopy() *InstanceIpAllowListStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceIpAllowListStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceList) DeepCopyInto(out *InstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Instance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceList.
+func (in *InstanceList) DeepCopy() *InstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceObservation) DeepCopyInto(out *InstanceObservation) {
+	*out = *in
+	out.HealthStatus = in.HealthStatus
+	out.ReconciliationStatus = in.ReconciliationStatus
+	in.ArgoCD.DeepCopyInto(&out.ArgoCD)
+	if in.ArgoCDConfigMap != nil {
+		in, out := &in.ArgoCDConfigMap, &out.ArgoCDConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArgoCDImageUpdaterConfigMap != nil {
+		in, out := &in.ArgoCDImageUpdaterConfigMap, &out.ArgoCDImageUpdaterConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArgoCDImageUpdaterSSHConfigMap != nil {
+		in, out := &in.ArgoCDImageUpdaterSSHConfigMap, &out.ArgoCDImageUpdaterSSHConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArgoCDNotificationsConfigMap != nil {
+		in, out := &in.ArgoCDNotificationsConfigMap, &out.ArgoCDNotificationsConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArgoCDRBACConfigMap != nil {
+		in, out := &in.ArgoCDRBACConfigMap, &out.ArgoCDRBACConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArgoCDSSHKnownHostsConfigMap != nil {
+		in, out := &in.ArgoCDSSHKnownHostsConfigMap, &out.ArgoCDSSHKnownHostsConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArgoCDTLSCertsConfigMap != nil {
+		in, out := &in.ArgoCDTLSCertsConfigMap, &out.ArgoCDTLSCertsConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ConfigManagementPlugins != nil {
+		in, out := &in.ConfigManagementPlugins, &out.ConfigManagementPlugins
+		*out = make(map[string]crossplanev1alpha1.ConfigManagementPlugin, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ResolvedWorkspace != nil {
+		in, out := &in.ResolvedWorkspace, &out.ResolvedWorkspace
+		*out = new(WorkspaceResolution)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceObservation.
+func (in *InstanceObservation) DeepCopy() *InstanceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
+	*out = *in
+	if in.ArgoCD != nil {
+		in, out := &in.ArgoCD, &out.ArgoCD
+		*out = new(crossplanev1alpha1.ArgoCD)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkspaceRef != nil {
+		in, out := &in.WorkspaceRef, &out.WorkspaceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+	if in.ArgoCDConfigMap != nil {
+		in, out := &in.ArgoCDConfigMap, &out.ArgoCDConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArgoCDImageUpdaterConfigMap != nil {
+		in, out := &in.ArgoCDImageUpdaterConfigMap, &out.ArgoCDImageUpdaterConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArgoCDImageUpdaterSSHConfigMap != nil {
+		in, out := &in.ArgoCDImageUpdaterSSHConfigMap, &out.ArgoCDImageUpdaterSSHConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArgoCDNotificationsConfigMap != nil {
+		in, out := &in.ArgoCDNotificationsConfigMap, &out.ArgoCDNotificationsConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArgoCDRBACConfigMap != nil {
+		in, out := &in.ArgoCDRBACConfigMap, &out.ArgoCDRBACConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArgoCDSSHKnownHostsConfigMap != nil {
+		in, out := &in.ArgoCDSSHKnownHostsConfigMap, &out.ArgoCDSSHKnownHostsConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ArgoCDTLSCertsConfigMap != nil {
+		in, out := &in.ArgoCDTLSCertsConfigMap, &out.ArgoCDTLSCertsConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ConfigManagementPlugins != nil {
+		in, out := &in.ConfigManagementPlugins, &out.ConfigManagementPlugins
+		*out = make(map[string]crossplanev1alpha1.ConfigManagementPlugin, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ArgoCDSecretRef != nil {
+		in, out := &in.ArgoCDSecretRef, &out.ArgoCDSecretRef
+		*out = new(commonv1.SecretReference)
+		**out = **in
+	}
+	if in.ArgoCDNotificationsSecretRef != nil {
+		in, out := &in.ArgoCDNotificationsSecretRef, &out.ArgoCDNotificationsSecretRef
+		*out = new(commonv1.SecretReference)
+		**out = **in
+	}
+	if in.ArgoCDImageUpdaterSecretRef != nil {
+		in, out := &in.ArgoCDImageUpdaterSecretRef, &out.ArgoCDImageUpdaterSecretRef
+		*out = new(commonv1.SecretReference)
+		**out = **in
+	}
+	if in.ApplicationSetSecretRef != nil {
+		in, out := &in.ApplicationSetSecretRef, &out.ApplicationSetSecretRef
+		*out = new(commonv1.SecretReference)
+		**out = **in
+	}
+	if in.RepoCredentialSecretRefs != nil {
+		in, out := &in.RepoCredentialSecretRefs, &out.RepoCredentialSecretRefs
+		*out = make([]NamedSecretReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RepoTemplateCredentialSecretRefs != nil {
+		in, out := &in.RepoTemplateCredentialSecretRefs, &out.RepoTemplateCredentialSecretRefs
+		*out = make([]NamedSecretReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceParameters.
+func (in *InstanceParameters) DeepCopy() *InstanceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceQuota) DeepCopyInto(out *InstanceQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceQuota.
+func (in *InstanceQuota) DeepCopy() *InstanceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceQuotaList) DeepCopyInto(out *InstanceQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceQuotaList.
+func (in *InstanceQuotaList) DeepCopy() *InstanceQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceQuotaObservation) DeepCopyInto(out *InstanceQuotaObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceQuotaObservation.
+func (in *InstanceQuotaObservation) DeepCopy() *InstanceQuotaObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceQuotaObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceQuotaParameters) DeepCopyInto(out *InstanceQuotaParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceQuotaParameters.
+func (in *InstanceQuotaParameters) DeepCopy() *InstanceQuotaParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceQuotaParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceQuotaSpec) DeepCopyInto(out *InstanceQuotaSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceQuotaSpec.
+func (in *InstanceQuotaSpec) DeepCopy() *InstanceQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceQuotaStatus) DeepCopyInto(out *InstanceQuotaStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceQuotaStatus.
+func (in *InstanceQuotaStatus) DeepCopy() *InstanceQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRepo) DeepCopyInto(out *InstanceRepo) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRepo.
+func (in *InstanceRepo) DeepCopy() *InstanceRepo {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRepo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceRepo) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRepoList) DeepCopyInto(out *InstanceRepoList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceRepo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRepoList.
+func (in *InstanceRepoList) DeepCopy() *InstanceRepoList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRepoList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceRepoList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRepoObservation) DeepCopyInto(out *InstanceRepoObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRepoObservation.
+func (in *InstanceRepoObservation) DeepCopy() *InstanceRepoObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRepoObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRepoParameters) DeepCopyInto(out *InstanceRepoParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(commonv1.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRepoParameters.
+func (in *InstanceRepoParameters) DeepCopy() *InstanceRepoParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRepoParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRepoSpec) DeepCopyInto(out *InstanceRepoSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRepoSpec.
+func (in *InstanceRepoSpec) DeepCopy() *InstanceRepoSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRepoSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRepoStatus) DeepCopyInto(out *InstanceRepoStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRepoStatus.
+func (in *InstanceRepoStatus) DeepCopy() *InstanceRepoStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRepoStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceResourceCustomization) DeepCopyInto(out *InstanceResourceCustomization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceResourceCustomization.
+func (in *InstanceResourceCustomization) DeepCopy() *InstanceResourceCustomization {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceResourceCustomization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceResourceCustomization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceResourceCustomizationList) DeepCopyInto(out *InstanceResourceCustomizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceResourceCustomization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceResourceCustomizationList.
+func (in *InstanceResourceCustomizationList) DeepCopy() *InstanceResourceCustomizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceResourceCustomizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceResourceCustomizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceResourceCustomizationObservation) DeepCopyInto(out *InstanceResourceCustomizationObservation) {
+	*out = *in
+	if in.Customizations != nil {
+		in, out := &in.Customizations, &out.Customizations
+		*out = make([]ResourceCustomization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IgnoreResourceUpdatesEnabled != nil {
+		in, out := &in.IgnoreResourceUpdatesEnabled, &out.IgnoreResourceUpdatesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceResourceCustomizationObservation.
+func (in *InstanceResourceCustomizationObservation) DeepCopy() *InstanceResourceCustomizationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceResourceCustomizationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceResourceCustomizationParameters) DeepCopyInto(out *InstanceResourceCustomizationParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+	if in.Customizations != nil {
+		in, out := &in.Customizations, &out.Customizations
+		*out = make([]ResourceCustomization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IgnoreResourceUpdatesEnabled != nil {
+		in, out := &in.IgnoreResourceUpdatesEnabled, &out.IgnoreResourceUpdatesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceResourceCustomizationParameters.
+func (in *InstanceResourceCustomizationParameters) DeepCopy() *InstanceResourceCustomizationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceResourceCustomizationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceResourceCustomizationSpec) DeepCopyInto(out *InstanceResourceCustomizationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceResourceCustomizationSpec.
+func (in *InstanceResourceCustomizationSpec) DeepCopy() *InstanceResourceCustomizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceResourceCustomizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceResourceCustomizationStatus) DeepCopyInto(out *InstanceResourceCustomizationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceResourceCustomizationStatus.
+func (in *InstanceResourceCustomizationStatus) DeepCopy() *InstanceResourceCustomizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceResourceCustomizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSpec.
+func (in *InstanceSpec) DeepCopy() *InstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatus.
+func (in *InstanceStatus) DeepCopy() *InstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoAgent) DeepCopyInto(out *KargoAgent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgent.
+func (in *KargoAgent) DeepCopy() *KargoAgent {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoAgent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KargoAgent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoAgentList) DeepCopyInto(out *KargoAgentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KargoAgent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentList.
+func (in *KargoAgentList) DeepCopy() *KargoAgentList {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoAgentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KargoAgentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoAgentObservation) DeepCopyInto(out *KargoAgentObservation) {
+	*out = *in
+	out.HealthStatus = in.HealthStatus
+	out.ReconciliationStatus = in.ReconciliationStatus
+	in.KargoAgentSpec.DeepCopyInto(&out.KargoAgentSpec)
+	in.CredentialRotationStatus.DeepCopyInto(&out.CredentialRotationStatus)
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindowStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentObservation.
+func (in *KargoAgentObservation) DeepCopy() *KargoAgentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoAgentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoAgentParameters) DeepCopyInto(out *KargoAgentParameters) {
+	*out = *in
+	if in.KargoInstanceRef != nil {
+		in, out := &in.KargoInstanceRef, &out.KargoInstanceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+	if in.WorkspaceRef != nil {
+		in, out := &in.WorkspaceRef, &out.WorkspaceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.KargoAgentSpec.DeepCopyInto(&out.KargoAgentSpec)
+	in.KubeConfigSecretRef.DeepCopyInto(&out.KubeConfigSecretRef)
+	if in.ManifestsSink != nil {
+		in, out := &in.ManifestsSink, &out.ManifestsSink
+		*out = new(ManifestsSink)
+		**out = **in
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentParameters.
+func (in *KargoAgentParameters) DeepCopy() *KargoAgentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoAgentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoAgentSpec) DeepCopyInto(out *KargoAgentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentSpec.
+func (in *KargoAgentSpec) DeepCopy() *KargoAgentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoAgentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoAgentStatus) DeepCopyInto(out *KargoAgentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentStatus.
+func (in *KargoAgentStatus) DeepCopy() *KargoAgentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoAgentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoAgentVersionPolicy) DeepCopyInto(out *KargoAgentVersionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentVersionPolicy.
+func (in *KargoAgentVersionPolicy) DeepCopy() *KargoAgentVersionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoAgentVersionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KargoAgentVersionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoAgentVersionPolicyList) DeepCopyInto(out *KargoAgentVersionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KargoAgentVersionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentVersionPolicyList.
+func (in *KargoAgentVersionPolicyList) DeepCopy() *KargoAgentVersionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoAgentVersionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KargoAgentVersionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoAgentVersionPolicyObservation) DeepCopyInto(out *KargoAgentVersionPolicyObservation) {
+	*out = *in
+	if in.WaveAgents != nil {
+		in, out := &in.WaveAgents, &out.WaveAgents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WaveCompletionTime != nil {
+		in, out := &in.WaveCompletionTime, &out.WaveCompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentVersionPolicyObservation.
+func (in *KargoAgentVersionPolicyObservation) DeepCopy() *KargoAgentVersionPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoAgentVersionPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoAgentVersionPolicyParameters) DeepCopyInto(out *KargoAgentVersionPolicyParameters) {
+	*out = *in
+	if in.KargoInstanceRef != nil {
+		in, out := &in.KargoInstanceRef, &out.KargoInstanceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+	in.AgentSelector.DeepCopyInto(&out.AgentSelector)
+	out.WaveSize = in.WaveSize
+	if in.PauseBetweenWaves != nil {
+		in, out := &in.PauseBetweenWaves, &out.PauseBetweenWaves
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentVersionPolicyParameters.
+func (in *KargoAgentVersionPolicyParameters) DeepCopy() *KargoAgentVersionPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoAgentVersionPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoAgentVersionPolicySpec) DeepCopyInto(out *KargoAgentVersionPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentVersionPolicySpec.
+func (in *KargoAgentVersionPolicySpec) DeepCopy() *KargoAgentVersionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoAgentVersionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoAgentVersionPolicyStatus) DeepCopyInto(out *KargoAgentVersionPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentVersionPolicyStatus.
+func (in *KargoAgentVersionPolicyStatus) DeepCopy() *KargoAgentVersionPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoAgentVersionPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoDefaultShardAgent) DeepCopyInto(out *KargoDefaultShardAgent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoDefaultShardAgent.
+func (in *KargoDefaultShardAgent) DeepCopy() *KargoDefaultShardAgent {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoDefaultShardAgent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KargoDefaultShardAgent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoDefaultShardAgentList) DeepCopyInto(out *KargoDefaultShardAgentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KargoDefaultShardAgent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoDefaultShardAgentList.
+func (in *KargoDefaultShardAgentList) DeepCopy() *KargoDefaultShardAgentList {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoDefaultShardAgentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KargoDefaultShardAgentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoDefaultShardAgentObservation) DeepCopyInto(out *KargoDefaultShardAgentObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoDefaultShardAgentObservation.
+func (in *KargoDefaultShardAgentObservation) DeepCopy() *KargoDefaultShardAgentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoDefaultShardAgentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoDefaultShardAgentParameters) DeepCopyInto(out *KargoDefaultShardAgentParameters) {
+	*out = *in
+	if in.KargoInstanceRef != nil {
+		in, out := &in.KargoInstanceRef, &out.KargoInstanceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoDefaultShardAgentParameters.
+func (in *KargoDefaultShardAgentParameters) DeepCopy() *KargoDefaultShardAgentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoDefaultShardAgentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoDefaultShardAgentSpec) DeepCopyInto(out *KargoDefaultShardAgentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoDefaultShardAgentSpec.
+func (in *KargoDefaultShardAgentSpec) DeepCopy() *KargoDefaultShardAgentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoDefaultShardAgentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoDefaultShardAgentStatus) DeepCopyInto(out *KargoDefaultShardAgentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoDefaultShardAgentStatus.
+func (in *KargoDefaultShardAgentStatus) DeepCopy() *KargoDefaultShardAgentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoDefaultShardAgentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoInstance) DeepCopyInto(out *KargoInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoInstance.
+func (in *KargoInstance) DeepCopy() *KargoInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KargoInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoInstanceList) DeepCopyInto(out *KargoInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KargoInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoInstanceList.
+func (in *KargoInstanceList) DeepCopy() *KargoInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KargoInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoInstanceObservation) DeepCopyInto(out *KargoInstanceObservation) {
+	*out = *in
+	out.HealthStatus = in.HealthStatus
+	out.ReconciliationStatus = in.ReconciliationStatus
+	in.Kargo.DeepCopyInto(&out.Kargo)
+	if in.ResolvedWorkspace != nil {
+		in, out := &in.ResolvedWorkspace, &out.ResolvedWorkspace
+		*out = new(WorkspaceResolution)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoInstanceObservation.
+func (in *KargoInstanceObservation) DeepCopy() *KargoInstanceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoInstanceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoInstanceParameters) DeepCopyInto(out *KargoInstanceParameters) {
+	*out = *in
+	if in.WorkspaceRef != nil {
+		in, out := &in.WorkspaceRef, &out.WorkspaceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+	in.Kargo.DeepCopyInto(&out.Kargo)
+	if in.KargoConfigMap != nil {
+		in, out := &in.KargoConfigMap, &out.KargoConfigMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.KargoSecretRef != nil {
+		in, out := &in.KargoSecretRef, &out.KargoSecretRef
+		*out = new(commonv1.SecretReference)
+		**out = **in
+	}
+	if in.KargoRepoCredentialSecretRefs != nil {
+		in, out := &in.KargoRepoCredentialSecretRefs, &out.KargoRepoCredentialSecretRefs
+		*out = make([]KargoRepoCredentialSecretRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoInstanceParameters.
+func (in *KargoInstanceParameters) DeepCopy() *KargoInstanceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoInstanceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoInstanceSpec) DeepCopyInto(out *KargoInstanceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoInstanceSpec.
+func (in *KargoInstanceSpec) DeepCopy() *KargoInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoInstanceStatus) DeepCopyInto(out *KargoInstanceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoInstanceStatus.
+func (in *KargoInstanceStatus) DeepCopy() *KargoInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KargoRepoCredentialSecretRef) DeepCopyInto(out *KargoRepoCredentialSecretRef) {
+	*out = *in
+	in.NamedSecretReference.DeepCopyInto(&out.NamedSecretReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoRepoCredentialSecretRef.
+func (in *KargoRepoCredentialSecretRef) DeepCopy() *KargoRepoCredentialSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(KargoRepoCredentialSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReference) DeepCopyInto(out *LocalReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReference.
+func (in *LocalReference) DeepCopy() *LocalReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowStatus) DeepCopyInto(out *MaintenanceWindowStatus) {
+	*out = *in
+	if in.ActiveUntil != nil {
+		in, out := &in.ActiveUntil, &out.ActiveUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.NextStart != nil {
+		in, out := &in.NextStart, &out.NextStart
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowStatus.
+func (in *MaintenanceWindowStatus) DeepCopy() *MaintenanceWindowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSecret) DeepCopyInto(out *ManagedSecret) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSecret.
+func (in *ManagedSecret) DeepCopy() *ManagedSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedSecret) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSecretList) DeepCopyInto(out *ManagedSecretList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagedSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSecretList.
+func (in *ManagedSecretList) DeepCopy() *ManagedSecretList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSecretList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedSecretList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSecretObservation) DeepCopyInto(out *ManagedSecretObservation) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedClusters != nil {
+		in, out := &in.AllowedClusters, &out.AllowedClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretKeys != nil {
+		in, out := &in.SecretKeys, &out.SecretKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSecretObservation.
+func (in *ManagedSecretObservation) DeepCopy() *ManagedSecretObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSecretObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSecretParameters) DeepCopyInto(out *ManagedSecretParameters) {
+	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedClusters != nil {
+		in, out := &in.AllowedClusters, &out.AllowedClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.SecretRef.DeepCopyInto(&out.SecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSecretParameters.
+func (in *ManagedSecretParameters) DeepCopy() *ManagedSecretParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSecretParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSecretSpec) DeepCopyInto(out *ManagedSecretSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSecretSpec.
+func (in *ManagedSecretSpec) DeepCopy() *ManagedSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSecretStatus) DeepCopyInto(out *ManagedSecretStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSecretStatus.
+func (in *ManagedSecretStatus) DeepCopy() *ManagedSecretStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSecretStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestsSink) DeepCopyInto(out *ManifestsSink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestsSink.
+func (in *ManifestsSink) DeepCopy() *ManifestsSink {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestsSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedSecretReference) DeepCopyInto(out *NamedSecretReference) {
+	*out = *in
+	in.SecretRef.DeepCopyInto(&out.SecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedSecretReference.
+func (in *NamedSecretReference) DeepCopy() *NamedSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationConfig) DeepCopyInto(out *NotificationConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfig.
+func (in *NotificationConfig) DeepCopy() *NotificationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationConfigList) DeepCopyInto(out *NotificationConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigList.
+func (in *NotificationConfigList) DeepCopy() *NotificationConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationConfigObservation) DeepCopyInto(out *NotificationConfigObservation) {
+	*out = *in
+	if in.Emails != nil {
+		in, out := &in.Emails, &out.Emails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastDeliveryFailure != nil {
+		in, out := &in.LastDeliveryFailure, &out.LastDeliveryFailure
+		*out = new(NotificationDeliveryFailure)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigObservation.
+func (in *NotificationConfigObservation) DeepCopy() *NotificationConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationConfigParameters) DeepCopyInto(out *NotificationConfigParameters) {
+	*out = *in
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(NotificationWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(NotificationEmail)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(NotificationFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigParameters.
+func (in *NotificationConfigParameters) DeepCopy() *NotificationConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationConfigSpec) DeepCopyInto(out *NotificationConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigSpec.
+func (in *NotificationConfigSpec) DeepCopy() *NotificationConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationConfigStatus) DeepCopyInto(out *NotificationConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfigStatus.
+func (in *NotificationConfigStatus) DeepCopy() *NotificationConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDeliveryFailure) DeepCopyInto(out *NotificationDeliveryFailure) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDeliveryFailure.
+func (in *NotificationDeliveryFailure) DeepCopy() *NotificationDeliveryFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDeliveryFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationEmail) DeepCopyInto(out *NotificationEmail) {
+	*out = *in
+	if in.Emails != nil {
+		in, out := &in.Emails, &out.Emails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationEmail.
+func (in *NotificationEmail) DeepCopy() *NotificationEmail {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationEmail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationFilter) DeepCopyInto(out *NotificationFilter) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArgoCDInstanceNames != nil {
+		in, out := &in.ArgoCDInstanceNames, &out.ArgoCDInstanceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArgoCDInstanceRefs != nil {
+		in, out := &in.ArgoCDInstanceRefs, &out.ArgoCDInstanceRefs
+		*out = make([]LocalReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.KargoInstanceNames != nil {
+		in, out := &in.KargoInstanceNames, &out.KargoInstanceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KargoInstanceRefs != nil {
+		in, out := &in.KargoInstanceRefs, &out.KargoInstanceRefs
+		*out = make([]LocalReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArgoCDClusterNames != nil {
+		in, out := &in.ArgoCDClusterNames, &out.ArgoCDClusterNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KargoAgentNames != nil {
+		in, out := &in.KargoAgentNames, &out.KargoAgentNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationFilter.
+func (in *NotificationFilter) DeepCopy() *NotificationFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationWebhook) DeepCopyInto(out *NotificationWebhook) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationWebhook.
+func (in *NotificationWebhook) DeepCopy() *NotificationWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCMap) DeepCopyInto(out *OIDCMap) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCMap.
+func (in *OIDCMap) DeepCopy() *OIDCMap {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OIDCMap) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCMapList) DeepCopyInto(out *OIDCMapList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OIDCMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCMapList.
+func (in *OIDCMapList) DeepCopy() *OIDCMapList {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCMapList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OIDCMapList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCMapObservation) DeepCopyInto(out *OIDCMapObservation) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCMapObservation.
+func (in *OIDCMapObservation) DeepCopy() *OIDCMapObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCMapObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCMapParameters) DeepCopyInto(out *OIDCMapParameters) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCMapParameters.
+func (in *OIDCMapParameters) DeepCopy() *OIDCMapParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCMapParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCMapSpec) DeepCopyInto(out *OIDCMapSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCMapSpec.
+func (in *OIDCMapSpec) DeepCopy() *OIDCMapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCMapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCMapStatus) DeepCopyInto(out *OIDCMapStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCMapStatus.
+func (in *OIDCMapStatus) DeepCopy() *OIDCMapStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCMapStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationAPIKey) DeepCopyInto(out *OrganizationAPIKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationAPIKey.
+func (in *OrganizationAPIKey) DeepCopy() *OrganizationAPIKey {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationAPIKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationAPIKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationAPIKeyList) DeepCopyInto(out *OrganizationAPIKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationAPIKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationAPIKeyList.
+func (in *OrganizationAPIKeyList) DeepCopy() *OrganizationAPIKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationAPIKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationAPIKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationAPIKeyParameters) DeepCopyInto(out *OrganizationAPIKeyParameters) {
+	*out = *in
+	in.Permissions.DeepCopyInto(&out.Permissions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationAPIKeyParameters.
+func (in *OrganizationAPIKeyParameters) DeepCopy() *OrganizationAPIKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationAPIKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationAPIKeySpec) DeepCopyInto(out *OrganizationAPIKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationAPIKeySpec.
+func (in *OrganizationAPIKeySpec) DeepCopy() *OrganizationAPIKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationAPIKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationAPIKeyStatus) DeepCopyInto(out *OrganizationAPIKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationAPIKeyStatus.
+func (in *OrganizationAPIKeyStatus) DeepCopy() *OrganizationAPIKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationAPIKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceCustomization) DeepCopyInto(out *ResourceCustomization) {
+	*out = *in
+	if in.UseOpenLibs != nil {
+		in, out := &in.UseOpenLibs, &out.UseOpenLibs
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceCustomization.
+func (in *ResourceCustomization) DeepCopy() *ResourceCustomization {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceCustomization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceStatusCode) DeepCopyInto(out *ResourceStatusCode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceStatusCode.
+func (in *ResourceStatusCode) DeepCopy() *ResourceStatusCode {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceStatusCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSOClientOptions) DeepCopyInto(out *SSOClientOptions) {
+	*out = *in
+	in.ClientSecretRef.DeepCopyInto(&out.ClientSecretRef)
+	if in.DomainAliases != nil {
+		in, out := &in.DomainAliases, &out.DomainAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSOClientOptions.
+func (in *SSOClientOptions) DeepCopy() *SSOClientOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOClientOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSOConfiguration) DeepCopyInto(out *SSOConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSOConfiguration.
+func (in *SSOConfiguration) DeepCopy() *SSOConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSOConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSOConfigurationList) DeepCopyInto(out *SSOConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SSOConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSOConfigurationList.
+func (in *SSOConfigurationList) DeepCopy() *SSOConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSOConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSOConfigurationObservation) DeepCopyInto(out *SSOConfigurationObservation) {
+	*out = *in
+	if in.GroupClaims != nil {
+		in, out := &in.GroupClaims, &out.GroupClaims
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DomainAliases != nil {
+		in, out := &in.DomainAliases, &out.DomainAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSOConfigurationObservation.
+func (in *SSOConfigurationObservation) DeepCopy() *SSOConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSOConfigurationParameters) DeepCopyInto(out *SSOConfigurationParameters) {
+	*out = *in
+	if in.GroupClaims != nil {
+		in, out := &in.GroupClaims, &out.GroupClaims
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AzureAD != nil {
+		in, out := &in.AzureAD, &out.AzureAD
+		*out = new(SSOClientOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Okta != nil {
+		in, out := &in.Okta, &out.Okta
+		*out = new(SSOClientOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GoogleWorkspace != nil {
+		in, out := &in.GoogleWorkspace, &out.GoogleWorkspace
+		*out = new(SSOClientOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(SSOOIDCOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SAML != nil {
+		in, out := &in.SAML, &out.SAML
+		*out = new(SSOSAMLOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSOConfigurationParameters.
+func (in *SSOConfigurationParameters) DeepCopy() *SSOConfigurationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSOConfigurationSpec) DeepCopyInto(out *SSOConfigurationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSOConfigurationSpec.
+func (in *SSOConfigurationSpec) DeepCopy() *SSOConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSOConfigurationStatus) DeepCopyInto(out *SSOConfigurationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSOConfigurationStatus.
+func (in *SSOConfigurationStatus) DeepCopy() *SSOConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSOOIDCBackChannel) DeepCopyInto(out *SSOOIDCBackChannel) {
+	*out = *in
+	in.ClientSecretRef.DeepCopyInto(&out.ClientSecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSOOIDCBackChannel.
+func (in *SSOOIDCBackChannel) DeepCopy() *SSOOIDCBackChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOOIDCBackChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSOOIDCFrontChannel) DeepCopyInto(out *SSOOIDCFrontChannel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSOOIDCFrontChannel.
+func (in *SSOOIDCFrontChannel) DeepCopy() *SSOOIDCFrontChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOOIDCFrontChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSOOIDCOptions) DeepCopyInto(out *SSOOIDCOptions) {
+	*out = *in
+	if in.DomainAliases != nil {
+		in, out := &in.DomainAliases, &out.DomainAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackChannel != nil {
+		in, out := &in.BackChannel, &out.BackChannel
+		*out = new(SSOOIDCBackChannel)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FrontChannel != nil {
+		in, out := &in.FrontChannel, &out.FrontChannel
+		*out = new(SSOOIDCFrontChannel)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSOOIDCOptions.
+func (in *SSOOIDCOptions) DeepCopy() *SSOOIDCOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOOIDCOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSOSAMLOptions) DeepCopyInto(out *SSOSAMLOptions) {
+	*out = *in
+	if in.DomainAliases != nil {
+		in, out := &in.DomainAliases, &out.DomainAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSOSAMLOptions.
+func (in *SSOSAMLOptions) DeepCopy() *SSOSAMLOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOSAMLOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Team) DeepCopyInto(out *Team) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Team.
+func (in *Team) DeepCopy() *Team {
+	if in == nil {
+		return nil
+	}
+	out := new(Team)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Team) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamList) DeepCopyInto(out *TeamList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Team, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamList.
+func (in *TeamList) DeepCopy() *TeamList {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMember) DeepCopyInto(out *TeamMember) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMember.
+func (in *TeamMember) DeepCopy() *TeamMember {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamMember) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMemberList) DeepCopyInto(out *TeamMemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TeamMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMemberList.
+func (in *TeamMemberList) DeepCopy() *TeamMemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamMemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMemberObservation) DeepCopyInto(out *TeamMemberObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMemberObservation.
+func (in *TeamMemberObservation) DeepCopy() *TeamMemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMemberParameters) DeepCopyInto(out *TeamMemberParameters) {
+	*out = *in
+	if in.TeamRef != nil {
+		in, out := &in.TeamRef, &out.TeamRef
+		*out = new(LocalReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMemberParameters.
+func (in *TeamMemberParameters) DeepCopy() *TeamMemberParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMemberParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMemberSpec) DeepCopyInto(out *TeamMemberSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMemberSpec.
+func (in *TeamMemberSpec) DeepCopy() *TeamMemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMemberStatus) DeepCopyInto(out *TeamMemberStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMemberStatus.
+func (in *TeamMemberStatus) DeepCopy() *TeamMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamOIDCMap) DeepCopyInto(out *TeamOIDCMap) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamOIDCMap.
+func (in *TeamOIDCMap) DeepCopy() *TeamOIDCMap {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamOIDCMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamOIDCMap) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamOIDCMapList) DeepCopyInto(out *TeamOIDCMapList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TeamOIDCMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamOIDCMapList.
+func (in *TeamOIDCMapList) DeepCopy() *TeamOIDCMapList {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamOIDCMapList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamOIDCMapList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamOIDCMapObservation) DeepCopyInto(out *TeamOIDCMapObservation) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamOIDCMapObservation.
+func (in *TeamOIDCMapObservation) DeepCopy() *TeamOIDCMapObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamOIDCMapObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamOIDCMapParameters) DeepCopyInto(out *TeamOIDCMapParameters) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamOIDCMapParameters.
+func (in *TeamOIDCMapParameters) DeepCopy() *TeamOIDCMapParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamOIDCMapParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamOIDCMapSpec) DeepCopyInto(out *TeamOIDCMapSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamOIDCMapSpec.
+func (in *TeamOIDCMapSpec) DeepCopy() *TeamOIDCMapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamOIDCMapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamOIDCMapStatus) DeepCopyInto(out *TeamOIDCMapStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamOIDCMapStatus.
+func (in *TeamOIDCMapStatus) DeepCopy() *TeamOIDCMapStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamOIDCMapStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamObservation) DeepCopyInto(out *TeamObservation) {
+	*out = *in
+	if in.CustomRoles != nil {
+		in, out := &in.CustomRoles, &out.CustomRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.RepoCredentialSecretRefs != nil {
-		in, out := &in.RepoCredentialSecretRefs, &out.RepoCredentialSecretRefs
-		*out = make([]NamedSecretReference, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamObservation.
+func (in *TeamObservation) DeepCopy() *TeamObservation {
+	if in == nil {
+		return nil
 	}
-	if in.RepoTemplateCredentialSecretRefs != nil {
-		in, out := &in.RepoTemplateCredentialSecretRefs, &out.RepoTemplateCredentialSecretRefs
-		*out = make([]NamedSecretReference, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out := new(TeamObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamParameters) DeepCopyInto(out *TeamParameters) {
+	*out = *in
+	if in.CustomRoles != nil {
+		in, out := &in.CustomRoles, &out.CustomRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]runtime.RawExtension, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.CustomRoleRefs != nil {
+		in, out := &in.CustomRoleRefs, &out.CustomRoleRefs
+		*out = make([]LocalReference, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceParameters.
-func (in *InstanceParameters) DeepCopy() *InstanceParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamParameters.
+func (in *TeamParameters) DeepCopy() *TeamParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceParameters)
+	out := new(TeamParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
+func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSpec.
-func (in *InstanceSpec) DeepCopy() *InstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
+func (in *TeamSpec) DeepCopy() *TeamSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceSpec)
+	out := new(TeamSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
+func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatus.
-func (in *InstanceStatus) DeepCopy() *InstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamStatus.
+func (in *TeamStatus) DeepCopy() *TeamStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceStatus)
+	out := new(TeamStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoAgent) DeepCopyInto(out *KargoAgent) {
+func (in *Workspace) DeepCopyInto(out *Workspace) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -668,18 +4440,18 @@ func (in *KargoAgent) DeepCopyInto(out *KargoAgent) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgent.
-func (in *KargoAgent) DeepCopy() *KargoAgent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Workspace.
+func (in *Workspace) DeepCopy() *Workspace {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoAgent)
+	out := new(Workspace)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KargoAgent) DeepCopyObject() runtime.Object {
+func (in *Workspace) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -687,31 +4459,58 @@ func (in *KargoAgent) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoAgentList) DeepCopyInto(out *KargoAgentList) {
+func (in *WorkspaceAPIKey) DeepCopyInto(out *WorkspaceAPIKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceAPIKey.
+func (in *WorkspaceAPIKey) DeepCopy() *WorkspaceAPIKey {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceAPIKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkspaceAPIKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceAPIKeyList) DeepCopyInto(out *WorkspaceAPIKeyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KargoAgent, len(*in))
+		*out = make([]WorkspaceAPIKey, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentList.
-func (in *KargoAgentList) DeepCopy() *KargoAgentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceAPIKeyList.
+func (in *WorkspaceAPIKeyList) DeepCopy() *WorkspaceAPIKeyList {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoAgentList)
+	out := new(WorkspaceAPIKeyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KargoAgentList) DeepCopyObject() runtime.Object {
+func (in *WorkspaceAPIKeyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -719,95 +4518,78 @@ func (in *KargoAgentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoAgentObservation) DeepCopyInto(out *KargoAgentObservation) {
+func (in *WorkspaceAPIKeyObservation) DeepCopyInto(out *WorkspaceAPIKeyObservation) {
 	*out = *in
-	out.HealthStatus = in.HealthStatus
-	out.ReconciliationStatus = in.ReconciliationStatus
-	in.KargoAgentSpec.DeepCopyInto(&out.KargoAgentSpec)
+	in.APIKeyObservation.DeepCopyInto(&out.APIKeyObservation)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentObservation.
-func (in *KargoAgentObservation) DeepCopy() *KargoAgentObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceAPIKeyObservation.
+func (in *WorkspaceAPIKeyObservation) DeepCopy() *WorkspaceAPIKeyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoAgentObservation)
+	out := new(WorkspaceAPIKeyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoAgentParameters) DeepCopyInto(out *KargoAgentParameters) {
+func (in *WorkspaceAPIKeyParameters) DeepCopyInto(out *WorkspaceAPIKeyParameters) {
 	*out = *in
-	if in.KargoInstanceRef != nil {
-		in, out := &in.KargoInstanceRef, &out.KargoInstanceRef
+	if in.WorkspaceRef != nil {
+		in, out := &in.WorkspaceRef, &out.WorkspaceRef
 		*out = new(LocalReference)
 		**out = **in
 	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	in.KargoAgentSpec.DeepCopyInto(&out.KargoAgentSpec)
-	in.KubeConfigSecretRef.DeepCopyInto(&out.KubeConfigSecretRef)
+	in.Permissions.DeepCopyInto(&out.Permissions)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentParameters.
-func (in *KargoAgentParameters) DeepCopy() *KargoAgentParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceAPIKeyParameters.
+func (in *WorkspaceAPIKeyParameters) DeepCopy() *WorkspaceAPIKeyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoAgentParameters)
+	out := new(WorkspaceAPIKeyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoAgentSpec) DeepCopyInto(out *KargoAgentSpec) {
+func (in *WorkspaceAPIKeySpec) DeepCopyInto(out *WorkspaceAPIKeySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentSpec.
-func (in *KargoAgentSpec) DeepCopy() *KargoAgentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceAPIKeySpec.
+func (in *WorkspaceAPIKeySpec) DeepCopy() *WorkspaceAPIKeySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoAgentSpec)
+	out := new(WorkspaceAPIKeySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoAgentStatus) DeepCopyInto(out *KargoAgentStatus) {
+func (in *WorkspaceAPIKeyStatus) DeepCopyInto(out *WorkspaceAPIKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoAgentStatus.
-func (in *KargoAgentStatus) DeepCopy() *KargoAgentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceAPIKeyStatus.
+func (in *WorkspaceAPIKeyStatus) DeepCopy() *WorkspaceAPIKeyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoAgentStatus)
+	out := new(WorkspaceAPIKeyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoDefaultShardAgent) DeepCopyInto(out *KargoDefaultShardAgent) {
+func (in *WorkspaceCustomRole) DeepCopyInto(out *WorkspaceCustomRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -815,18 +4597,18 @@ func (in *KargoDefaultShardAgent) DeepCopyInto(out *KargoDefaultShardAgent) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoDefaultShardAgent.
-func (in *KargoDefaultShardAgent) DeepCopy() *KargoDefaultShardAgent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceCustomRole.
+func (in *WorkspaceCustomRole) DeepCopy() *WorkspaceCustomRole {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoDefaultShardAgent)
+	out := new(WorkspaceCustomRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KargoDefaultShardAgent) DeepCopyObject() runtime.Object {
+func (in *WorkspaceCustomRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -834,31 +4616,31 @@ func (in *KargoDefaultShardAgent) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoDefaultShardAgentList) DeepCopyInto(out *KargoDefaultShardAgentList) {
+func (in *WorkspaceCustomRoleList) DeepCopyInto(out *WorkspaceCustomRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KargoDefaultShardAgent, len(*in))
+		*out = make([]WorkspaceCustomRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoDefaultShardAgentList.
-func (in *KargoDefaultShardAgentList) DeepCopy() *KargoDefaultShardAgentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceCustomRoleList.
+func (in *WorkspaceCustomRoleList) DeepCopy() *WorkspaceCustomRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoDefaultShardAgentList)
+	out := new(WorkspaceCustomRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KargoDefaultShardAgentList) DeepCopyObject() runtime.Object {
+func (in *WorkspaceCustomRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -866,76 +4648,109 @@ func (in *KargoDefaultShardAgentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoDefaultShardAgentObservation) DeepCopyInto(out *KargoDefaultShardAgentObservation) {
+func (in *WorkspaceCustomRoleObservation) DeepCopyInto(out *WorkspaceCustomRoleObservation) {
 	*out = *in
+	out.CustomRoleObservation = in.CustomRoleObservation
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoDefaultShardAgentObservation.
-func (in *KargoDefaultShardAgentObservation) DeepCopy() *KargoDefaultShardAgentObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceCustomRoleObservation.
+func (in *WorkspaceCustomRoleObservation) DeepCopy() *WorkspaceCustomRoleObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoDefaultShardAgentObservation)
+	out := new(WorkspaceCustomRoleObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoDefaultShardAgentParameters) DeepCopyInto(out *KargoDefaultShardAgentParameters) {
+func (in *WorkspaceCustomRoleParameters) DeepCopyInto(out *WorkspaceCustomRoleParameters) {
 	*out = *in
-	if in.KargoInstanceRef != nil {
-		in, out := &in.KargoInstanceRef, &out.KargoInstanceRef
+	if in.WorkspaceRef != nil {
+		in, out := &in.WorkspaceRef, &out.WorkspaceRef
 		*out = new(LocalReference)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoDefaultShardAgentParameters.
-func (in *KargoDefaultShardAgentParameters) DeepCopy() *KargoDefaultShardAgentParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceCustomRoleParameters.
+func (in *WorkspaceCustomRoleParameters) DeepCopy() *WorkspaceCustomRoleParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoDefaultShardAgentParameters)
+	out := new(WorkspaceCustomRoleParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoDefaultShardAgentSpec) DeepCopyInto(out *KargoDefaultShardAgentSpec) {
+func (in *WorkspaceCustomRoleSpec) DeepCopyInto(out *WorkspaceCustomRoleSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoDefaultShardAgentSpec.
-func (in *KargoDefaultShardAgentSpec) DeepCopy() *KargoDefaultShardAgentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceCustomRoleSpec.
+func (in *WorkspaceCustomRoleSpec) DeepCopy() *WorkspaceCustomRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoDefaultShardAgentSpec)
+	out := new(WorkspaceCustomRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoDefaultShardAgentStatus) DeepCopyInto(out *KargoDefaultShardAgentStatus) {
+func (in *WorkspaceCustomRoleStatus) DeepCopyInto(out *WorkspaceCustomRoleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoDefaultShardAgentStatus.
-func (in *KargoDefaultShardAgentStatus) DeepCopy() *KargoDefaultShardAgentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceCustomRoleStatus.
+func (in *WorkspaceCustomRoleStatus) DeepCopy() *WorkspaceCustomRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoDefaultShardAgentStatus)
+	out := new(WorkspaceCustomRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoInstance) DeepCopyInto(out *KargoInstance) {
+func (in *WorkspaceList) DeepCopyInto(out *WorkspaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Workspace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceList.
+func (in *WorkspaceList) DeepCopy() *WorkspaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkspaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceMember) DeepCopyInto(out *WorkspaceMember) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -943,18 +4758,18 @@ func (in *KargoInstance) DeepCopyInto(out *KargoInstance) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoInstance.
-func (in *KargoInstance) DeepCopy() *KargoInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceMember.
+func (in *WorkspaceMember) DeepCopy() *WorkspaceMember {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoInstance)
+	out := new(WorkspaceMember)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KargoInstance) DeepCopyObject() runtime.Object {
+func (in *WorkspaceMember) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -962,31 +4777,31 @@ func (in *KargoInstance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoInstanceList) DeepCopyInto(out *KargoInstanceList) {
+func (in *WorkspaceMemberList) DeepCopyInto(out *WorkspaceMemberList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KargoInstance, len(*in))
+		*out = make([]WorkspaceMember, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoInstanceList.
-func (in *KargoInstanceList) DeepCopy() *KargoInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceMemberList.
+func (in *WorkspaceMemberList) DeepCopy() *WorkspaceMemberList {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoInstanceList)
+	out := new(WorkspaceMemberList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KargoInstanceList) DeepCopyObject() runtime.Object {
+func (in *WorkspaceMemberList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -994,157 +4809,164 @@ func (in *KargoInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoInstanceObservation) DeepCopyInto(out *KargoInstanceObservation) {
+func (in *WorkspaceMemberObservation) DeepCopyInto(out *WorkspaceMemberObservation) {
 	*out = *in
-	out.HealthStatus = in.HealthStatus
-	out.ReconciliationStatus = in.ReconciliationStatus
-	in.Kargo.DeepCopyInto(&out.Kargo)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoInstanceObservation.
-func (in *KargoInstanceObservation) DeepCopy() *KargoInstanceObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceMemberObservation.
+func (in *WorkspaceMemberObservation) DeepCopy() *WorkspaceMemberObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoInstanceObservation)
+	out := new(WorkspaceMemberObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoInstanceParameters) DeepCopyInto(out *KargoInstanceParameters) {
+func (in *WorkspaceMemberParameters) DeepCopyInto(out *WorkspaceMemberParameters) {
 	*out = *in
-	in.Kargo.DeepCopyInto(&out.Kargo)
-	if in.KargoConfigMap != nil {
-		in, out := &in.KargoConfigMap, &out.KargoConfigMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.KargoSecretRef != nil {
-		in, out := &in.KargoSecretRef, &out.KargoSecretRef
-		*out = new(v1.SecretReference)
+	if in.WorkspaceRef != nil {
+		in, out := &in.WorkspaceRef, &out.WorkspaceRef
+		*out = new(LocalReference)
 		**out = **in
 	}
-	if in.KargoRepoCredentialSecretRefs != nil {
-		in, out := &in.KargoRepoCredentialSecretRefs, &out.KargoRepoCredentialSecretRefs
-		*out = make([]KargoRepoCredentialSecretRef, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]runtime.RawExtension, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.TeamRef != nil {
+		in, out := &in.TeamRef, &out.TeamRef
+		*out = new(LocalReference)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoInstanceParameters.
-func (in *KargoInstanceParameters) DeepCopy() *KargoInstanceParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceMemberParameters.
+func (in *WorkspaceMemberParameters) DeepCopy() *WorkspaceMemberParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoInstanceParameters)
+	out := new(WorkspaceMemberParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoInstanceSpec) DeepCopyInto(out *KargoInstanceSpec) {
+func (in *WorkspaceMemberSpec) DeepCopyInto(out *WorkspaceMemberSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoInstanceSpec.
-func (in *KargoInstanceSpec) DeepCopy() *KargoInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceMemberSpec.
+func (in *WorkspaceMemberSpec) DeepCopy() *WorkspaceMemberSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoInstanceSpec)
+	out := new(WorkspaceMemberSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoInstanceStatus) DeepCopyInto(out *KargoInstanceStatus) {
+func (in *WorkspaceMemberStatus) DeepCopyInto(out *WorkspaceMemberStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoInstanceStatus.
-func (in *KargoInstanceStatus) DeepCopy() *KargoInstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceMemberStatus.
+func (in *WorkspaceMemberStatus) DeepCopy() *WorkspaceMemberStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoInstanceStatus)
+	out := new(WorkspaceMemberStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KargoRepoCredentialSecretRef) DeepCopyInto(out *KargoRepoCredentialSecretRef) {
+func (in *WorkspaceObservation) DeepCopyInto(out *WorkspaceObservation) {
 	*out = *in
-	in.NamedSecretReference.DeepCopyInto(&out.NamedSecretReference)
+	if in.ArgoCDInstances != nil {
+		in, out := &in.ArgoCDInstances, &out.ArgoCDInstances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KargoInstances != nil {
+		in, out := &in.KargoInstances, &out.KargoInstances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KargoRepoCredentialSecretRef.
-func (in *KargoRepoCredentialSecretRef) DeepCopy() *KargoRepoCredentialSecretRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceObservation.
+func (in *WorkspaceObservation) DeepCopy() *WorkspaceObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(KargoRepoCredentialSecretRef)
+	out := new(WorkspaceObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalReference) DeepCopyInto(out *LocalReference) {
+func (in *WorkspaceParameters) DeepCopyInto(out *WorkspaceParameters) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReference.
-func (in *LocalReference) DeepCopy() *LocalReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceParameters.
+func (in *WorkspaceParameters) DeepCopy() *WorkspaceParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalReference)
+	out := new(WorkspaceParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamedSecretReference) DeepCopyInto(out *NamedSecretReference) {
+func (in *WorkspaceResolution) DeepCopyInto(out *WorkspaceResolution) {
 	*out = *in
-	in.SecretRef.DeepCopyInto(&out.SecretRef)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedSecretReference.
-func (in *NamedSecretReference) DeepCopy() *NamedSecretReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceResolution.
+func (in *WorkspaceResolution) DeepCopy() *WorkspaceResolution {
 	if in == nil {
 		return nil
 	}
-	out := new(NamedSecretReference)
+	out := new(WorkspaceResolution)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceStatusCode) DeepCopyInto(out *ResourceStatusCode) {
+func (in *WorkspaceSpec) DeepCopyInto(out *WorkspaceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSpec.
+func (in *WorkspaceSpec) DeepCopy() *WorkspaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceStatus) DeepCopyInto(out *WorkspaceStatus) {
 	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceStatusCode.
-func (in *ResourceStatusCode) DeepCopy() *ResourceStatusCode {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceStatus.
+func (in *WorkspaceStatus) DeepCopy() *WorkspaceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceStatusCode)
+	out := new(WorkspaceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,308 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// ----------------------------------------------------------------------
+// Addon methods. Addon repositories, addons and addon marketplace
+// installs live on an Argo CD instance and are keyed by server-assigned
+// IDs. Addons are discovered from a repository rather than created, so
+// the addon surface is list/get/patch/refresh/delete only. The gateway
+// has no single-install read for marketplace installs, so
+// GetAddonMarketplaceInstall lists with an ID filter.
+// ----------------------------------------------------------------------
+
+func (c client) GetInstanceAddonRepo(ctx context.Context, instanceID, id string) (*argocdv1.AddonRepo, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.gatewayClient.GetInstanceAddonRepo(ctx, &argocdv1.GetInstanceAddonRepoRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get instance %s addon repo %s: %w", instanceID, id, err))
+		}
+		return nil, fmt.Errorf("could not get instance %s addon repo %s: %w", instanceID, id, err)
+	}
+	return resp.GetAddonRepo(), nil
+}
+
+func (c client) CreateInstanceAddonRepo(ctx context.Context, instanceID string, spec *argocdv1.RepoSpec) (*argocdv1.AddonRepo, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("CreateInstanceAddonRepo", instanceID+"/"+spec.GetRepoUrl())
+	resp, err := c.gatewayClient.CreateInstanceAddonRepo(ctx, &argocdv1.CreateInstanceAddonRepoRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+		Spec:           spec,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create instance %s addon repo %s: %w", instanceID, spec.GetRepoUrl(), err)
+	}
+	return resp.GetAddonRepo(), nil
+}
+
+func (c client) RefreshInstanceAddonRepo(ctx context.Context, instanceID, id string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("RefreshInstanceAddonRepo", instanceID+"/"+id)
+	if _, err := c.gatewayClient.RefreshInstanceAddonRepo(ctx, &argocdv1.RefreshInstanceAddonRepoRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+		Id:             id,
+	}); err != nil {
+		return fmt.Errorf("could not refresh instance %s addon repo %s: %w", instanceID, id, err)
+	}
+	return nil
+}
+
+func (c client) DeleteInstanceAddonRepo(ctx context.Context, instanceID, id string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteInstanceAddonRepo", instanceID+"/"+id)
+	if _, err := c.gatewayClient.DeleteInstanceAddonRepo(ctx, &argocdv1.DeleteInstanceAddonRepoRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+		Id:             id,
+	}); err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete instance %s addon repo %s: %w", instanceID, id, err))
+		}
+		return fmt.Errorf("could not delete instance %s addon repo %s: %w", instanceID, id, err)
+	}
+	return nil
+}
+
+func (c client) GetInstanceAddon(ctx context.Context, instanceID, id string) (*argocdv1.Addon, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.gatewayClient.GetInstanceAddon(ctx, &argocdv1.GetInstanceAddonRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get instance %s addon %s: %w", instanceID, id, err))
+		}
+		return nil, fmt.Errorf("could not get instance %s addon %s: %w", instanceID, id, err)
+	}
+	return resp.GetAddon(), nil
+}
+
+func (c client) ListInstanceAddons(ctx context.Context, instanceID, name string) ([]*argocdv1.Addon, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	req := &argocdv1.ListInstanceAddonsRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+	}
+	if name != "" {
+		req.Filter = &argocdv1.AddonFilter{Name: ptr.To(name)}
+	}
+	resp, err := c.gatewayClient.ListInstanceAddons(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not list instance %s addons: %w", instanceID, err)
+	}
+	return resp.GetAddons(), nil
+}
+
+func (c client) ListInstanceAddonErrors(ctx context.Context, instanceID, id string) (map[string]*argocdv1.AddonErrorList, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.gatewayClient.ListInstanceAddonErrors(ctx, &argocdv1.ListInstanceAddonErrorsRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+		Id:             id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list instance %s addon %s errors: %w", instanceID, id, err)
+	}
+	return resp.GetErrors(), nil
+}
+
+func (c client) PatchInstanceAddon(ctx context.Context, instanceID, id string, patch *structpb.Struct) (*argocdv1.Addon, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("PatchInstanceAddon", instanceID+"/"+id)
+	resp, err := c.gatewayClient.PatchInstanceAddon(ctx, &argocdv1.PatchInstanceAddonRequest{
+		Id:             id,
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+		Patch:          patch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not patch instance %s addon %s: %w", instanceID, id, err)
+	}
+	return resp.GetAddon(), nil
+}
+
+func (c client) RefreshInstanceAddon(ctx context.Context, instanceID, id string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("RefreshInstanceAddon", instanceID+"/"+id)
+	if _, err := c.gatewayClient.RefreshInstanceAddon(ctx, &argocdv1.RefreshInstanceAddonRequest{
+		Id:             id,
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+	}); err != nil {
+		return fmt.Errorf("could not refresh instance %s addon %s: %w", instanceID, id, err)
+	}
+	return nil
+}
+
+func (c client) DeleteInstanceAddon(ctx context.Context, instanceID, id string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteInstanceAddon", instanceID+"/"+id)
+	if _, err := c.gatewayClient.DeleteInstanceAddon(ctx, &argocdv1.DeleteInstanceAddonRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+		Id:             id,
+	}); err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete instance %s addon %s: %w", instanceID, id, err))
+		}
+		return fmt.Errorf("could not delete instance %s addon %s: %w", instanceID, id, err)
+	}
+	return nil
+}
+
+func (c client) GetAddonMarketplaceInstall(ctx context.Context, instanceID, id string) (*argocdv1.AddonMarketplaceInstall, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.gatewayClient.ListAddonMarketplaceInstalls(ctx, &argocdv1.ListAddonMarketplaceInstallsRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		Filter:         &argocdv1.AddonMarketplaceInstallFilter{Id: ptr.To(id)},
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get instance %s addon marketplace install %s: %w", instanceID, id, err))
+		}
+		return nil, fmt.Errorf("could not get instance %s addon marketplace install %s: %w", instanceID, id, err)
+	}
+	for _, i := range resp.GetAddonInstalls() {
+		if i.GetId() == id {
+			return i, nil
+		}
+	}
+	return nil, reason.AsNotFound(fmt.Errorf("could not get instance %s addon marketplace install %s: install was not found", instanceID, id))
+}
+
+func (c client) AddonMarketplaceInstall(ctx context.Context, instanceID string, config *argocdv1.AddonMarketplaceInstallConfig) (*argocdv1.AddonMarketplaceInstall, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("AddonMarketplaceInstall", instanceID+"/"+config.GetAddonName())
+	resp, err := c.gatewayClient.AddonMarketplaceInstall(ctx, &argocdv1.AddonMarketplaceInstallRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		Config:         config,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not install addon %s on instance %s: %w", config.GetAddonName(), instanceID, err)
+	}
+	return resp.GetAddonInstall(), nil
+}
+
+func (c client) UpdateAddonMarketplaceInstall(ctx context.Context, instanceID, id string, dependencies []*argocdv1.ChartDependency) (*argocdv1.AddonMarketplaceInstall, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateAddonMarketplaceInstall", instanceID+"/"+id)
+	resp, err := c.gatewayClient.UpdateAddonMarketplaceInstall(ctx, &argocdv1.UpdateAddonMarketplaceInstallRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		Id:             id,
+		Dependencies:   dependencies,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not update instance %s addon marketplace install %s: %w", instanceID, id, err)
+	}
+	return resp.GetAddonInstall(), nil
+}
+
+func (c client) DeleteAddonMarketplaceInstall(ctx context.Context, instanceID, id string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteAddonMarketplaceInstall", instanceID+"/"+id)
+	if _, err := c.gatewayClient.DeleteAddonMarketplaceInstall(ctx, &argocdv1.DeleteAddonMarketplaceInstallRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		Id:             id,
+	}); err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete instance %s addon marketplace install %s: %w", instanceID, id, err))
+		}
+		return fmt.Errorf("could not delete instance %s addon marketplace install %s: %w", instanceID, id, err)
+	}
+	return nil
+}
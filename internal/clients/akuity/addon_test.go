@@ -0,0 +1,170 @@
+package akuity_test
+
+import (
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const (
+	addonRepoID = "repo-1"
+	addonID     = "addon-1"
+	installID   = "install-1"
+)
+
+func TestGetInstanceAddonRepo_NotFound(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().GetInstanceAddonRepo(authCtx, &argocdv1.GetInstanceAddonRepoRequest{
+		OrganizationId: organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+		Id:             addonRepoID,
+	}).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetInstanceAddonRepo(ctx, instanceID, addonRepoID)
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestCreateInstanceAddonRepo(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	spec := &argocdv1.RepoSpec{RepoUrl: "https://github.com/example/addons.git", Revision: "main"}
+	want := &argocdv1.AddonRepo{Id: addonRepoID, Spec: spec}
+	mockGatewayClient.EXPECT().CreateInstanceAddonRepo(authCtx, &argocdv1.CreateInstanceAddonRepoRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		Spec:           spec,
+	}).Return(&argocdv1.CreateInstanceAddonRepoResponse{AddonRepo: want}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	got, err := client.CreateInstanceAddonRepo(ctx, instanceID, spec)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestListInstanceAddons_FiltersByName(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	want := []*argocdv1.Addon{{Id: addonID}}
+	mockGatewayClient.EXPECT().ListInstanceAddons(authCtx, &argocdv1.ListInstanceAddonsRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		Filter:         &argocdv1.AddonFilter{Name: ptr.To("cert-manager")},
+	}).Return(&argocdv1.ListInstanceAddonsResponse{Addons: want}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	got, err := client.ListInstanceAddons(ctx, instanceID, "cert-manager")
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestListInstanceAddonErrors(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	want := map[string]*argocdv1.AddonErrorList{"prod": {Errors: []*argocdv1.AddonError{{Type: "helm", Error: "bad values"}}}}
+	mockGatewayClient.EXPECT().ListInstanceAddonErrors(authCtx, &argocdv1.ListInstanceAddonErrorsRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		Id:             addonID,
+	}).Return(&argocdv1.ListInstanceAddonErrorsResponse{Errors: want}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	got, err := client.ListInstanceAddonErrors(ctx, instanceID, addonID)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestPatchInstanceAddon(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	patch, err := structpb.NewStruct(map[string]any{"spec": map[string]any{"enabled": false}})
+	require.NoError(t, err)
+	mockGatewayClient.EXPECT().PatchInstanceAddon(authCtx, &argocdv1.PatchInstanceAddonRequest{
+		Id:             addonID,
+		OrganizationId: organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+		Patch:          patch,
+	}).Return(&argocdv1.PatchInstanceAddonResponse{Addon: &argocdv1.Addon{Id: addonID}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	got, err := client.PatchInstanceAddon(ctx, instanceID, addonID, patch)
+	require.NoError(t, err)
+	assert.Equal(t, addonID, got.GetId())
+}
+
+func TestGetAddonMarketplaceInstall_MissingIsNotFound(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	mockGatewayClient.EXPECT().ListAddonMarketplaceInstalls(authCtx, &argocdv1.ListAddonMarketplaceInstallsRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		Filter:         &argocdv1.AddonMarketplaceInstallFilter{Id: ptr.To(installID)},
+	}).Return(&argocdv1.ListAddonMarketplaceInstallsResponse{
+		AddonInstalls: []*argocdv1.AddonMarketplaceInstall{{Id: "other"}},
+	}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetAddonMarketplaceInstall(ctx, instanceID, installID)
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestUpdateAddonMarketplaceInstall_SendsDependencies(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	deps := []*argocdv1.ChartDependency{{Name: "cert-manager", Version: "1.16.1", Repository: "https://charts.jetstack.io"}}
+	mockGatewayClient.EXPECT().UpdateAddonMarketplaceInstall(authCtx, &argocdv1.UpdateAddonMarketplaceInstallRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		Id:             installID,
+		Dependencies:   deps,
+	}).Return(&argocdv1.UpdateAddonMarketplaceInstallResponse{AddonInstall: &argocdv1.AddonMarketplaceInstall{Id: installID}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.UpdateAddonMarketplaceInstall(ctx, instanceID, installID, deps)
+	require.NoError(t, err)
+}
+
+func TestDeleteInstanceAddon_NotFound(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	mockGatewayClient.EXPECT().DeleteInstanceAddon(authCtx, &argocdv1.DeleteInstanceAddonRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		Id:             addonID,
+	}).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = client.DeleteInstanceAddon(ctx, instanceID, addonID)
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
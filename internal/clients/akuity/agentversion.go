@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+)
+
+// ----------------------------------------------------------------------
+// Agent version rollout. The platform sets the target agent version on
+// the named agents and the agents upgrade themselves; the call returns
+// before any agent has reported the new version, so callers observe
+// the agents' state to learn whether the upgrade landed.
+// ----------------------------------------------------------------------
+
+func (c client) UpdateClustersAgentVersion(ctx context.Context, instanceID string, clusterNames []string, version string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateInstanceClustersAgentVersion", instanceID)
+	_, err = c.gatewayClient.UpdateInstanceClustersAgentVersion(ctx, &argocdv1.UpdateInstanceClustersAgentVersionRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		ClusterNames:   clusterNames,
+		NewVersion:     version,
+	})
+	if err != nil {
+		return fmt.Errorf("could not update agent version of %d cluster(s) on instance %s to %s: %w", len(clusterNames), instanceID, version, err)
+	}
+	return nil
+}
+
+func (c client) UpdateKargoAgentsVersion(ctx context.Context, kargoInstanceID string, agentNames []string, version string) error {
+	if err := c.kargoRequired("UpdateKargoAgentsVersion"); err != nil {
+		return err
+	}
+	workspaceID, err := c.kargoWorkspaceIDForInstance(ctx, kargoInstanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateInstanceAgentVersion", kargoInstanceID)
+	_, err = c.kargoGatewayClient.UpdateInstanceAgentVersion(ctx, &kargov1.UpdateInstanceAgentVersionRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     kargoInstanceID,
+		WorkspaceId:    workspaceID,
+		AgentNames:     agentNames,
+		NewVersion:     version,
+	})
+	if err != nil {
+		return fmt.Errorf("could not update agent version of %d kargo agent(s) on instance %s to %s: %w", len(agentNames), kargoInstanceID, version, err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akuity_test
+
+import (
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+)
+
+func TestUpdateClustersAgentVersion(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().UpdateInstanceClustersAgentVersion(authCtx, &argocdv1.UpdateInstanceClustersAgentVersionRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		ClusterNames:   []string{"a", "b"},
+		NewVersion:     "0.5.10",
+	}).Return(&emptypb.Empty{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.UpdateClustersAgentVersion(ctx, instanceID, []string{"a", "b"}, "0.5.10"))
+}
+
+func TestUpdateClustersAgentVersion_Error(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().UpdateInstanceClustersAgentVersion(authCtx, gomock.Any()).Return(nil, errFake).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = client.UpdateClustersAgentVersion(ctx, instanceID, []string{"a"}, "0.5.10")
+	require.ErrorIs(t, err, errFake)
+}
+
+func TestUpdateKargoAgentsVersion(t *testing.T) {
+	mockKargoClient := mock_akuity_client.NewMockKargoServiceGatewayClient(gomock.NewController(t))
+	mockKargoClient.EXPECT().ListKargoInstances(authCtx, &kargov1.ListKargoInstancesRequest{
+		OrganizationId: organizationID,
+	}).Return(&kargov1.ListKargoInstancesResponse{Instances: []*kargov1.KargoInstance{
+		{Id: "kargo-1", WorkspaceId: workspaceID},
+	}}, nil).Times(1)
+	mockKargoClient.EXPECT().UpdateInstanceAgentVersion(authCtx, &kargov1.UpdateInstanceAgentVersionRequest{
+		OrganizationId: organizationID,
+		InstanceId:     "kargo-1",
+		WorkspaceId:    workspaceID,
+		AgentNames:     []string{"agent-1"},
+		NewVersion:     "0.5.10",
+	}).Return(&kargov1.UpdateInstanceAgentVersionResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, mockKargoClient, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.UpdateKargoAgentsVersion(ctx, "kargo-1", []string{"agent-1"}, "0.5.10"))
+}
+
+func TestUpdateKargoAgentsVersion_KargoNotConfigured(t *testing.T) {
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	require.Error(t, client.UpdateKargoAgentsVersion(ctx, "kargo-1", []string{"agent-1"}, "0.5.10"))
+}
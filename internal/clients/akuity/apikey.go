@@ -0,0 +1,144 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	accesscontrolv1 "github.com/akuity/api-client-go/pkg/api/gen/accesscontrol/v1"
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// ----------------------------------------------------------------------
+// API key methods. Keys are minted through the Organization gateway
+// (the create routes hang off the organization / workspace) and read or
+// deleted through the dedicated APIKey gateway. Keys are keyed by their
+// platform-assigned ID; the secret is only ever returned on create.
+// ----------------------------------------------------------------------
+
+func (c client) GetAPIKey(ctx context.Context, id string) (*apikeyv1.APIKey, error) {
+	if err := c.apiKeyRequired("GetAPIKey"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.apiKeyGatewayClient.GetAPIKey(ctx, &apikeyv1.GetAPIKeyRequest{Id: id})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get API key %s: %w", id, err))
+		}
+		return nil, fmt.Errorf("could not get API key %s: %w", id, err)
+	}
+	if resp == nil || resp.GetApiKey() == nil {
+		return nil, fmt.Errorf("could not get API key %s: empty response", id)
+	}
+	return resp.GetApiKey(), nil
+}
+
+func (c client) CreateOrganizationAPIKey(ctx context.Context, description string, permissions *accesscontrolv1.Permissions, expireIn string) (*apikeyv1.APIKey, error) {
+	if err := c.orgRequired("CreateOrganizationAPIKey"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("CreateOrganizationAPIKey", c.organizationID)
+	resp, err := c.orgGatewayClient.CreateOrganizationAPIKey(ctx, &orgcv1.CreateOrganizationAPIKeyRequest{
+		Id:               c.organizationID,
+		Description:      description,
+		Permissions:      permissions,
+		ExpireInDuration: expireIn,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create organization API key: %w", err)
+	}
+	if resp == nil || resp.GetApiKey().GetId() == "" {
+		return nil, fmt.Errorf("could not create organization API key: empty response")
+	}
+	return resp.GetApiKey(), nil
+}
+
+func (c client) DeleteAPIKey(ctx context.Context, id string) error {
+	if err := c.apiKeyRequired("DeleteAPIKey"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteAPIKey", id)
+	_, err := c.apiKeyGatewayClient.DeleteAPIKey(ctx, &apikeyv1.DeleteAPIKeyRequest{Id: id})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete API key %s: %w", id, err))
+		}
+		return fmt.Errorf("could not delete API key %s: %w", id, err)
+	}
+	return nil
+}
+
+func (c client) GetWorkspaceAPIKey(ctx context.Context, workspaceID, id string) (*apikeyv1.APIKey, error) {
+	if err := c.apiKeyRequired("GetWorkspaceAPIKey"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.apiKeyGatewayClient.GetWorkspaceAPIKey(ctx, &apikeyv1.GetWorkspaceAPIKeyRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get workspace %s API key %s: %w", workspaceID, id, err))
+		}
+		return nil, fmt.Errorf("could not get workspace %s API key %s: %w", workspaceID, id, err)
+	}
+	if resp == nil || resp.GetApiKey() == nil {
+		return nil, fmt.Errorf("could not get workspace %s API key %s: empty response", workspaceID, id)
+	}
+	return resp.GetApiKey(), nil
+}
+
+func (c client) CreateWorkspaceAPIKey(ctx context.Context, workspaceID, description string, permissions *accesscontrolv1.Permissions, expireIn string) (*apikeyv1.APIKey, error) {
+	if err := c.orgRequired("CreateWorkspaceAPIKey"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("CreateWorkspaceAPIKey", workspaceID)
+	resp, err := c.orgGatewayClient.CreateWorkspaceAPIKey(ctx, &orgcv1.CreateWorkspaceAPIKeyRequest{
+		Id:               c.organizationID,
+		WorkspaceId:      workspaceID,
+		Description:      description,
+		Permissions:      permissions,
+		ExpireInDuration: expireIn,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not create workspace %s API key: %w", workspaceID, err))
+		}
+		return nil, fmt.Errorf("could not create workspace %s API key: %w", workspaceID, err)
+	}
+	if resp == nil || resp.GetApiKey().GetId() == "" {
+		return nil, fmt.Errorf("could not create workspace %s API key: empty response", workspaceID)
+	}
+	return resp.GetApiKey(), nil
+}
+
+func (c client) DeleteWorkspaceAPIKey(ctx context.Context, workspaceID, id string) error {
+	if err := c.apiKeyRequired("DeleteWorkspaceAPIKey"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteWorkspaceAPIKey", workspaceID+"/"+id)
+	_, err := c.apiKeyGatewayClient.DeleteWorkspaceAPIKey(ctx, &apikeyv1.DeleteWorkspaceAPIKeyRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete workspace %s API key %s: %w", workspaceID, id, err))
+		}
+		return fmt.Errorf("could not delete workspace %s API key %s: %w", workspaceID, id, err)
+	}
+	return nil
+}
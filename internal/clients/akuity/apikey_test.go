@@ -0,0 +1,108 @@
+package akuity_test
+
+import (
+	"testing"
+
+	accesscontrolv1 "github.com/akuity/api-client-go/pkg/api/gen/accesscontrol/v1"
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func TestCreateOrganizationAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	perms := &accesscontrolv1.Permissions{Roles: []string{"organization/member"}}
+	secret := "key-secret"
+	mockOrgGatewayClient.EXPECT().CreateOrganizationAPIKey(authCtx, &orgcv1.CreateOrganizationAPIKeyRequest{
+		Id:               organizationID,
+		Description:      "ci",
+		Permissions:      perms,
+		ExpireInDuration: "720h",
+	}).Return(&orgcv1.CreateOrganizationAPIKeyResponse{ApiKey: &apikeyv1.APIKey{Id: "key-1", Secret: &secret}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	key, err := client.CreateOrganizationAPIKey(ctx, "ci", perms, "720h")
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", key.GetId())
+	assert.Equal(t, secret, key.GetSecret())
+}
+
+func TestCreateOrganizationAPIKey_EmptyResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().CreateOrganizationAPIKey(authCtx, gomock.Any()).
+		Return(&orgcv1.CreateOrganizationAPIKeyResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	_, err = client.CreateOrganizationAPIKey(ctx, "ci", nil, "")
+	require.Error(t, err)
+}
+
+func TestGetAPIKey_StatusNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAPIKeyGatewayClient := mock_akuity_client.NewMockAPIKeyServiceGatewayClient(ctrl)
+	mockAPIKeyGatewayClient.EXPECT().GetAPIKey(authCtx, &apikeyv1.GetAPIKeyRequest{Id: "key-1"}).
+		Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, nil, mockAPIKeyGatewayClient)
+	require.NoError(t, err)
+
+	_, err = client.GetAPIKey(ctx, "key-1")
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestGetAPIKey_GatewayNotConfigured(t *testing.T) {
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetAPIKey(ctx, "key-1")
+	require.Error(t, err)
+	assert.False(t, reason.IsNotFound(err))
+}
+
+func TestCreateWorkspaceAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	secret := "key-secret"
+	mockOrgGatewayClient.EXPECT().CreateWorkspaceAPIKey(authCtx, &orgcv1.CreateWorkspaceAPIKeyRequest{
+		Id:          organizationID,
+		WorkspaceId: workspaceID,
+		Description: "ci",
+	}).Return(&orgcv1.CreateWorkspaceAPIKeyResponse{ApiKey: &apikeyv1.APIKey{Id: "key-1", Secret: &secret}, WorkspaceId: workspaceID}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	key, err := client.CreateWorkspaceAPIKey(ctx, workspaceID, "ci", nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", key.GetId())
+}
+
+func TestDeleteWorkspaceAPIKey_StatusNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAPIKeyGatewayClient := mock_akuity_client.NewMockAPIKeyServiceGatewayClient(ctrl)
+	mockAPIKeyGatewayClient.EXPECT().DeleteWorkspaceAPIKey(authCtx, &apikeyv1.DeleteWorkspaceAPIKeyRequest{
+		OrganizationId: organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             "key-1",
+	}).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, nil, mockAPIKeyGatewayClient)
+	require.NoError(t, err)
+
+	err = client.DeleteWorkspaceAPIKey(ctx, workspaceID, "key-1")
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
@@ -16,9 +16,12 @@ import (
 	"github.com/avast/retry-go/v4"
 
 	"github.com/akuity/api-client-go/pkg/api/gateway/accesscontrol"
+	accesscontrolv1 "github.com/akuity/api-client-go/pkg/api/gen/accesscontrol/v1"
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
 	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
 	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
 	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	featuresv1 "github.com/akuity/api-client-go/pkg/api/gen/types/features/v1"
 	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
 	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
 	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
@@ -47,6 +50,30 @@ type Client interface {
 	// fields flow through this separate RPC. Pass expiry=nil when
 	// maintenance mode has no time bound on the platform.
 	SetClusterMaintenanceMode(ctx context.Context, instanceID, clusterName string, mode bool, expiry *time.Time) error
+	// RotateClusterCredentials rotates the agent credentials of a single
+	// cluster. The previously installed manifests stop authenticating,
+	// so callers re-fetch and re-apply manifests afterwards.
+	RotateClusterCredentials(ctx context.Context, instanceID, clusterName string) error
+	// UpdateClusterSupportAccess grants or revokes Akuity support access
+	// to a cluster, addressed by cluster ID. A positive duration bounds
+	// the grant on the platform; zero leaves it open until revoked.
+	UpdateClusterSupportAccess(ctx context.Context, instanceID, clusterID string, enable bool, duration time.Duration) error
+	// GetClusterInstallCommand returns the command that installs the
+	// agent for a cluster, addressed by cluster ID.
+	GetClusterInstallCommand(ctx context.Context, instanceID, clusterID string) (string, error)
+	// GetClusterAPIServerCAData returns the API server CA data the
+	// platform holds for a cluster, addressed by cluster name.
+	GetClusterAPIServerCAData(ctx context.Context, instanceID, clusterName string) (string, error)
+	// GetClusterInfo returns the platform's summary of a cluster's
+	// usage, addressed by cluster name.
+	GetClusterInfo(ctx context.Context, instanceID, clusterName string) (*argocdv1.GetInstanceClusterInfoResponse, error)
+	// UpdateClustersAgentVersion sets the agent version of the named
+	// clusters. The agents upgrade asynchronously; callers observe each
+	// cluster's agent state to confirm the upgrade.
+	UpdateClustersAgentVersion(ctx context.Context, instanceID string, clusterNames []string, version string) error
+	// MoveInstanceWorkspace moves an Argo CD instance to workspace, given
+	// as an ID or name. It is a no-op when the instance is already there.
+	MoveInstanceWorkspace(ctx context.Context, instanceID, workspace string) error
 	GetInstance(ctx context.Context, name string) (*argocdv1.Instance, error)
 	// GetInstanceByID fetches an Instance by its canonical ID. Used by
 	// narrow-patch controllers that have the ID on their spec and want
@@ -93,6 +120,10 @@ type Client interface {
 	DeleteKargoInstance(ctx context.Context, name string) error
 	GetKargoInstanceAgent(ctx context.Context, kargoInstanceID, agentName string) (*kargov1.KargoAgent, error)
 	DeleteKargoInstanceAgent(ctx context.Context, kargoInstanceID, agentName string) error
+	// SetKargoAgentMaintenanceMode is the Kargo-plane counterpart of
+	// SetClusterMaintenanceMode. Pass expiry=nil when maintenance mode
+	// has no time bound on the platform.
+	SetKargoAgentMaintenanceMode(ctx context.Context, kargoInstanceID, agentName string, mode bool, expiry *time.Time) error
 	// GetKargoInstanceAgentManifests fetches install manifests for a
 	// Kargo agent AFTER blocking until the agent reaches
 	// SUCCESSFUL/FAILED reconciliation. Mirrors GetClusterManifests +
@@ -105,6 +136,15 @@ type Client interface {
 	// GetKargoInstanceAgentManifestsOnce fetches install manifests for
 	// a Kargo agent without waiting for reconciliation.
 	GetKargoInstanceAgentManifestsOnce(ctx context.Context, kargoInstanceID, agentID string) (string, error)
+	// RotateKargoAgentCredentials is the Kargo-plane counterpart of
+	// RotateClusterCredentials.
+	RotateKargoAgentCredentials(ctx context.Context, kargoInstanceID, agentName string) error
+	// MoveKargoInstanceWorkspace is the Kargo-plane counterpart of
+	// MoveInstanceWorkspace.
+	MoveKargoInstanceWorkspace(ctx context.Context, kargoInstanceID, workspace string) error
+	// UpdateKargoAgentsVersion is the Kargo-plane counterpart of
+	// UpdateClustersAgentVersion.
+	UpdateKargoAgentsVersion(ctx context.Context, kargoInstanceID string, agentNames []string, version string) error
 
 	// ResolveWorkspace resolves an Akuity workspace by ID or name and
 	// returns it. When name is empty the organization's default workspace is
@@ -113,15 +153,161 @@ type Client interface {
 	// (the route templates the id straight into the path so empty produces
 	// a 404).
 	ResolveWorkspace(ctx context.Context, name string) (*orgcv1.Workspace, error)
+
+	// Organization-plane methods for the Workspace controller. All
+	// routing is via the OrganizationServiceGatewayClient; workspaces
+	// are keyed by their canonical ID.
+	GetWorkspace(ctx context.Context, id string) (*orgcv1.Workspace, error)
+	CreateWorkspace(ctx context.Context, name, description string) (*orgcv1.Workspace, error)
+	// UpdateWorkspace renames the workspace and replaces its
+	// description.
+	UpdateWorkspace(ctx context.Context, id, name, description string) (*orgcv1.Workspace, error)
+	DeleteWorkspace(ctx context.Context, id string) error
+
+	// Organization-plane methods for the Team, TeamMember, and
+	// WorkspaceMember controllers. Teams are keyed by name; team and
+	// workspace members by the platform-assigned member ID.
+	GetTeam(ctx context.Context, name string) (*orgcv1.UserTeam, error)
+	CreateTeam(ctx context.Context, name, description string, customRoles []string) (*orgcv1.UserTeam, error)
+	// UpdateTeam replaces the team's description and custom role set.
+	UpdateTeam(ctx context.Context, name, description string, customRoles []string) (*orgcv1.UserTeam, error)
+	DeleteTeam(ctx context.Context, name string) error
+	GetTeamMember(ctx context.Context, teamName, id string) (*orgcv1.TeamMember, error)
+	AddTeamMember(ctx context.Context, teamName, userID string) (*orgcv1.TeamMember, error)
+	RemoveTeamMember(ctx context.Context, teamName, id string) error
+	GetWorkspaceMember(ctx context.Context, workspaceID, id string) (*orgcv1.WorkspaceMember, error)
+	AddWorkspaceMember(ctx context.Context, workspaceID string, ref *orgcv1.WorkspaceMemberRef) (*orgcv1.WorkspaceMember, error)
+	UpdateWorkspaceMember(ctx context.Context, workspaceID, id string, role orgcv1.WorkspaceMemberRole) (*orgcv1.WorkspaceMember, error)
+	RemoveWorkspaceMember(ctx context.Context, workspaceID, id string) error
+
+	// API key methods for the OrganizationAPIKey and WorkspaceAPIKey
+	// controllers. Keys are created through the Organization gateway
+	// and read/deleted through the APIKey gateway. The secret is only
+	// populated on the key returned by Create*APIKey.
+	GetAPIKey(ctx context.Context, id string) (*apikeyv1.APIKey, error)
+	CreateOrganizationAPIKey(ctx context.Context, description string, permissions *accesscontrolv1.Permissions, expireIn string) (*apikeyv1.APIKey, error)
+	DeleteAPIKey(ctx context.Context, id string) error
+	GetWorkspaceAPIKey(ctx context.Context, workspaceID, id string) (*apikeyv1.APIKey, error)
+	CreateWorkspaceAPIKey(ctx context.Context, workspaceID, description string, permissions *accesscontrolv1.Permissions, expireIn string) (*apikeyv1.APIKey, error)
+	DeleteWorkspaceAPIKey(ctx context.Context, workspaceID, id string) error
+
+	// Organization-plane methods for the CustomRole and
+	// WorkspaceCustomRole controllers. Roles are keyed by their
+	// platform-assigned ID. Update* replaces name, description, and
+	// policy wholesale.
+	GetCustomRole(ctx context.Context, id string) (*orgcv1.CustomRole, error)
+	CreateCustomRole(ctx context.Context, name, description, policy string) (*orgcv1.CustomRole, error)
+	UpdateCustomRole(ctx context.Context, id, name, description, policy string) (*orgcv1.CustomRole, error)
+	DeleteCustomRole(ctx context.Context, id string) error
+	GetWorkspaceCustomRole(ctx context.Context, workspaceID, id string) (*orgcv1.CustomRole, error)
+	CreateWorkspaceCustomRole(ctx context.Context, workspaceID, name, description, policy string) (*orgcv1.CustomRole, error)
+	UpdateWorkspaceCustomRole(ctx context.Context, workspaceID, id, name, description, policy string) (*orgcv1.CustomRole, error)
+	DeleteWorkspaceCustomRole(ctx context.Context, workspaceID, id string) error
+
+	// Organization SSO methods for the SSOConfiguration, OIDCMap and
+	// TeamOIDCMap controllers. Each is a per-organization singleton
+	// keyed by the client's organization ID. GetSSOConfiguration
+	// reports an organization without a configured provider as
+	// NotFound.
+	GetSSOConfiguration(ctx context.Context) (*orgcv1.GetSSOConfigurationResponse, error)
+	EnsureSSOConfiguration(ctx context.Context, req *orgcv1.EnsureSSOConfigurationRequest) error
+	DeleteSSOConfiguration(ctx context.Context) error
+	GetOIDCMap(ctx context.Context) (map[string]string, error)
+	UpdateOIDCMap(ctx context.Context, entries map[string]string) error
+	GetTeamOIDCMap(ctx context.Context) (map[string]string, error)
+	UpdateTeamOIDCMap(ctx context.Context, entries map[string]string) error
+
+	// Notification config methods for the NotificationConfig
+	// controller. Configs are keyed by their platform-assigned ID;
+	// Create/Update stamp the organization ID on the request.
+	GetNotificationConfig(ctx context.Context, id string) (*orgcv1.NotificationConfig, error)
+	CreateNotificationConfig(ctx context.Context, req *orgcv1.CreateNotificationConfigRequest) (*orgcv1.NotificationConfig, error)
+	UpdateNotificationConfig(ctx context.Context, req *orgcv1.UpdateNotificationConfigRequest) (*orgcv1.NotificationConfig, error)
+	DeleteNotificationConfig(ctx context.Context, id string) error
+	PingNotificationConfig(ctx context.Context, id string) error
+	ListNotificationDeliveryHistory(ctx context.Context, id string, limit int64) ([]*orgcv1.NotificationDelivery, error)
+
+	// Argo CD local account methods for the InstanceAccount controller.
+	// Accounts are keyed by instance ID and account name;
+	// GetInstanceAccount reports a missing account as NotFound.
+	GetInstanceAccount(ctx context.Context, instanceID, name string) (*argocdv1.InstanceAccount, error)
+	UpsertInstanceAccount(ctx context.Context, instanceID, name string, capabilities *argocdv1.InstanceAccountCapabilities, disabled bool) (*argocdv1.InstanceAccount, error)
+	DeleteInstanceAccount(ctx context.Context, instanceID, name string) error
+	UpdateInstanceAccountPassword(ctx context.Context, instanceID, name, password string) error
+	// RegenerateInstanceAccountPassword has the platform generate a new
+	// password and returns it. It is the only call that returns a
+	// password.
+	RegenerateInstanceAccountPassword(ctx context.Context, instanceID, name string) (string, error)
+
+	// Managed secret methods for the ManagedSecret controller. Secrets
+	// are keyed by instance ID and secret name; GetManagedSecret reports
+	// a missing secret as NotFound. UpdateManagedSecret replaces the
+	// secret metadata and data; PatchManagedSecret merges only data.
+	GetManagedSecret(ctx context.Context, instanceID, name string) (*argocdv1.ManagedSecret, error)
+	CreateManagedSecret(ctx context.Context, instanceID string, secret *argocdv1.ManagedSecret, data map[string]string) error
+	UpdateManagedSecret(ctx context.Context, instanceID string, secret *argocdv1.ManagedSecret, data map[string]string) error
+	PatchManagedSecret(ctx context.Context, instanceID, name string, data map[string]string) error
+	DeleteManagedSecret(ctx context.Context, instanceID, name string) error
+
+	// Addon methods for the InstanceAddonRepo, InstanceAddon and
+	// AddonMarketplaceInstall controllers. All three are keyed by
+	// instance ID and server-assigned ID; the Get methods report a
+	// missing object as NotFound. Addons are discovered from a
+	// repository, so ListInstanceAddons (optionally filtered by addon
+	// name) is how an addon ID is first found.
+	GetInstanceAddonRepo(ctx context.Context, instanceID, id string) (*argocdv1.AddonRepo, error)
+	CreateInstanceAddonRepo(ctx context.Context, instanceID string, spec *argocdv1.RepoSpec) (*argocdv1.AddonRepo, error)
+	RefreshInstanceAddonRepo(ctx context.Context, instanceID, id string) error
+	DeleteInstanceAddonRepo(ctx context.Context, instanceID, id string) error
+	GetInstanceAddon(ctx context.Context, instanceID, id string) (*argocdv1.Addon, error)
+	ListInstanceAddons(ctx context.Context, instanceID, name string) ([]*argocdv1.Addon, error)
+	ListInstanceAddonErrors(ctx context.Context, instanceID, id string) (map[string]*argocdv1.AddonErrorList, error)
+	PatchInstanceAddon(ctx context.Context, instanceID, id string, patch *structpb.Struct) (*argocdv1.Addon, error)
+	RefreshInstanceAddon(ctx context.Context, instanceID, id string) error
+	DeleteInstanceAddon(ctx context.Context, instanceID, id string) error
+	GetAddonMarketplaceInstall(ctx context.Context, instanceID, id string) (*argocdv1.AddonMarketplaceInstall, error)
+	AddonMarketplaceInstall(ctx context.Context, instanceID string, config *argocdv1.AddonMarketplaceInstallConfig) (*argocdv1.AddonMarketplaceInstall, error)
+	UpdateAddonMarketplaceInstall(ctx context.Context, instanceID, id string, dependencies []*argocdv1.ChartDependency) (*argocdv1.AddonMarketplaceInstall, error)
+	DeleteAddonMarketplaceInstall(ctx context.Context, instanceID, id string) error
+
+	// Instance repository methods for the InstanceRepo controller.
+	// Repositories are keyed by URL; GetInstanceRepo reports a missing
+	// repository as NotFound. The gateway has no update or delete
+	// endpoint, so CreateInstanceRepo is the only write.
+	GetInstanceRepo(ctx context.Context, instanceID, url string) (*argocdv1.Repository, error)
+	CreateInstanceRepo(ctx context.Context, instanceID string, data map[string]string) (*argocdv1.Repository, error)
+
+	// Instance settings methods for the InstanceResourceCustomization
+	// and InstanceCSS controllers. Each update replaces the whole
+	// setting on the instance.
+	GetInstanceResourceCustomizations(ctx context.Context, instanceID string) (*argocdv1.GetInstanceResourceCustomizationsResponse, error)
+	UpdateInstanceResourceCustomizations(ctx context.Context, instanceID string, resources []*argocdv1.ResourceCustomizationConfig, ignoreResourceUpdatesEnabled *bool) error
+	GetInstanceCSS(ctx context.Context, instanceID string) (string, error)
+	UpdateInstanceCSS(ctx context.Context, instanceID, css string) error
+
+	// Organization-plane instance quota methods for the InstanceQuota
+	// controller. The list methods return every instance in the
+	// organization with its current usage; the update methods change
+	// only the given instance.
+	ListArgocdInstancesQuota(ctx context.Context) ([]*orgcv1.InstanceQuota, error)
+	UpdateArgocdInstanceQuota(ctx context.Context, instanceID string, maxApps int32) error
+	ListKargoInstancesQuota(ctx context.Context) ([]*orgcv1.KargoInstanceQuota, error)
+	UpdateKargoInstanceQuota(ctx context.Context, instanceID string, maxStages int32) error
+
+	// Organization plan methods used by the Instance and KargoInstance
+	// preflight checks.
+	GetOrganizationQuota(ctx context.Context) (*featuresv1.OrganizationQuota, error)
+	GetFeatureStatuses(ctx context.Context) (*featuresv1.FeatureStatuses, error)
 }
 
 type client struct {
-	organizationID     string
-	credentials        accesscontrol.ClientCredential
-	gatewayClient      argocdv1.ArgoCDServiceGatewayClient
-	kargoGatewayClient kargov1.KargoServiceGatewayClient
-	orgGatewayClient   orgcv1.OrganizationServiceGatewayClient
-	workspaceCache     *workspaceIDCache
+	organizationID      string
+	credentials         accesscontrol.ClientCredential
+	gatewayClient       argocdv1.ArgoCDServiceGatewayClient
+	kargoGatewayClient  kargov1.KargoServiceGatewayClient
+	orgGatewayClient    orgcv1.OrganizationServiceGatewayClient
+	apiKeyGatewayClient apikeyv1.APIKeyServiceGatewayClient
+	workspaceCache      *workspaceIDCache
 }
 
 type workspaceIDCache struct {
@@ -209,7 +395,7 @@ func (c *workspaceIDCache) setKargoInstance(instanceID, workspaceID string) {
 // subset of the API surface (e.g. in legacy tests that exercise only
 // the Argo plane); the corresponding methods will then return a
 // descriptive error rather than panicking on a nil dispatch.
-func NewClient(organizationID string, apiKeyID string, apiKeySecret string, gatewayClient argocdv1.ArgoCDServiceGatewayClient, kargoGatewayClient kargov1.KargoServiceGatewayClient, orgGatewayClient orgcv1.OrganizationServiceGatewayClient) (Client, error) {
+func NewClient(organizationID string, apiKeyID string, apiKeySecret string, gatewayClient argocdv1.ArgoCDServiceGatewayClient, kargoGatewayClient kargov1.KargoServiceGatewayClient, orgGatewayClient orgcv1.OrganizationServiceGatewayClient, apiKeyGatewayClient apikeyv1.APIKeyServiceGatewayClient) (Client, error) {
 	if organizationID == "" {
 		return client{}, errors.New("organization ID must not be empty")
 	}
@@ -223,12 +409,13 @@ func NewClient(organizationID string, apiKeyID string, apiKeySecret string, gate
 	}
 
 	c := client{
-		organizationID:     organizationID,
-		credentials:        accesscontrol.NewAPIKeyCredential(apiKeyID, apiKeySecret),
-		gatewayClient:      gatewayClient,
-		kargoGatewayClient: kargoGatewayClient,
-		orgGatewayClient:   orgGatewayClient,
-		workspaceCache:     newWorkspaceIDCache(),
+		organizationID:      organizationID,
+		credentials:         accesscontrol.NewAPIKeyCredential(apiKeyID, apiKeySecret),
+		gatewayClient:       gatewayClient,
+		kargoGatewayClient:  kargoGatewayClient,
+		orgGatewayClient:    orgGatewayClient,
+		apiKeyGatewayClient: apiKeyGatewayClient,
+		workspaceCache:      newWorkspaceIDCache(),
 	}
 
 	return c, nil
@@ -1022,6 +1209,37 @@ func (c client) DeleteKargoInstanceAgent(ctx context.Context, kargoInstanceID, a
 	return nil
 }
 
+// SetKargoAgentMaintenanceMode implements
+// Client.SetKargoAgentMaintenanceMode. Like the Argo CD cluster route,
+// ApplyKargoInstance drops data.maintenanceMode and
+// data.maintenanceModeExpiry, so they flow through the dedicated
+// set-maintenance-mode RPC instead.
+func (c client) SetKargoAgentMaintenanceMode(ctx context.Context, kargoInstanceID, agentName string, mode bool, expiry *time.Time) error {
+	if err := c.kargoRequired("SetKargoAgentMaintenanceMode"); err != nil {
+		return err
+	}
+	workspaceID, err := c.kargoWorkspaceIDForInstance(ctx, kargoInstanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	req := &kargov1.SetAgentMaintenanceModeRequest{
+		OrganizationId:  c.organizationID,
+		InstanceId:      kargoInstanceID,
+		WorkspaceId:     workspaceID,
+		AgentNames:      []string{agentName},
+		MaintenanceMode: mode,
+	}
+	if expiry != nil {
+		req.Expiry = timestamppb.New(*expiry)
+	}
+	incAPIWrite("SetKargoAgentMaintenanceMode", kargoInstanceID+"/"+agentName)
+	if _, err := c.kargoGatewayClient.SetAgentMaintenanceMode(ctx, req); err != nil {
+		return fmt.Errorf("could not set maintenance mode for kargo agent %s/%s: %w", kargoInstanceID, agentName, err)
+	}
+	return nil
+}
+
 func (c client) orgRequired(op string) error {
 	if c.orgGatewayClient == nil {
 		return fmt.Errorf("%s: organization gateway client not configured on this Akuity client", op)
@@ -1029,6 +1247,13 @@ func (c client) orgRequired(op string) error {
 	return nil
 }
 
+func (c client) apiKeyRequired(op string) error {
+	if c.apiKeyGatewayClient == nil {
+		return fmt.Errorf("%s: API key gateway client not configured on this Akuity client", op)
+	}
+	return nil
+}
+
 // ResolveWorkspace implements Client.ResolveWorkspace.
 //
 // When name is empty the function selects the workspace flagged
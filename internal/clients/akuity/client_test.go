@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
 
 	"github.com/akuity/api-client-go/pkg/api/gateway/accesscontrol"
 	gwoption "github.com/akuity/api-client-go/pkg/api/gateway/option"
 	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
 	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
 	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
 	"go.uber.org/mock/gomock"
@@ -17,6 +19,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
 	"github.com/stretchr/testify/assert"
@@ -93,7 +96,7 @@ func TestNewClient_Err(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			_, err := akuity.NewClient(tc.organizationID, tc.apiKeyID, tc.apiKeySecret, gatewayClient, nil, nil)
+			_, err := akuity.NewClient(tc.organizationID, tc.apiKeyID, tc.apiKeySecret, gatewayClient, nil, nil, nil)
 			require.EqualError(t, err, tc.expectedErrStr)
 		})
 	}
@@ -101,7 +104,7 @@ func TestNewClient_Err(t *testing.T) {
 
 func TestNewClient(t *testing.T) {
 	gatewayClient := argocdv1.NewArgoCDServiceGatewayClient(gwoption.NewClient("fake", false))
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, gatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, gatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 	assert.NotNil(t, client)
 }
@@ -120,7 +123,7 @@ func TestGetCluster(t *testing.T) {
 		Id:             clusterName,
 	}).Return(mockResponse, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	cluster, err := client.GetCluster(ctx, instanceID, clusterName)
@@ -142,7 +145,7 @@ func TestGetCluster_UsesInstanceWorkspaceID(t *testing.T) {
 		Cluster: &argocdv1.Cluster{},
 	}, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	cluster, err := client.GetCluster(ctx, instanceID, clusterName)
@@ -161,7 +164,7 @@ func TestGetCluster_ClientErr(t *testing.T) {
 		Id:             clusterName,
 	}).Return(nil, errFake).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	cluster, err := client.GetCluster(ctx, instanceID, clusterName)
@@ -180,7 +183,7 @@ func TestGetCluster_StatusNotFound(t *testing.T) {
 		Id:             clusterName,
 	}).Return(nil, statusNotFound).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	cluster, err := client.GetCluster(ctx, instanceID, clusterName)
@@ -200,7 +203,7 @@ func TestGetCluster_NilResponse(t *testing.T) {
 		Id:             clusterName,
 	}).Return(nil, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	cluster, err := client.GetCluster(ctx, instanceID, clusterName)
@@ -219,7 +222,7 @@ func TestGetCluster_NilCluster(t *testing.T) {
 		Id:             clusterName,
 	}).Return(&argocdv1.GetInstanceClusterResponse{}, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	cluster, err := client.GetCluster(ctx, instanceID, clusterName)
@@ -255,7 +258,7 @@ func TestGetClusterManifests(t *testing.T) {
 		Id:             clusterID,
 	}).Return(mockResponseChan, nil, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	manifests, err := client.GetClusterManifests(ctx, instanceID, clusterName)
@@ -274,7 +277,7 @@ func TestGetClusterManifests_GetClusterErr(t *testing.T) {
 		Id:             clusterName,
 	}).Return(nil, errors.New("fake")).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	manifests, err := client.GetClusterManifests(ctx, instanceID, clusterName)
@@ -300,7 +303,7 @@ func TestGetClusterManifests_ClusterNotReconciledErr(t *testing.T) {
 		},
 	}, nil).Times(5)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	manifests, err := client.GetClusterManifests(ctx, instanceID, clusterName)
@@ -332,7 +335,7 @@ func TestGetClusterManifests_ClientErr(t *testing.T) {
 		Id:             clusterID,
 	}).Return(nil, nil, errFake).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	manifests, err := client.GetClusterManifests(ctx, instanceID, clusterName)
@@ -366,7 +369,7 @@ func TestGetClusterManifests_ResponseErrChanErr(t *testing.T) {
 		Id:             clusterID,
 	}).Return(nil, mockErrChan, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	manifests, err := client.GetClusterManifests(ctx, instanceID, clusterName)
@@ -396,7 +399,7 @@ func TestDeleteCluster(t *testing.T) {
 		Id:             mockCluster.GetId(),
 	}).Return(nil, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	err = client.DeleteCluster(ctx, instanceID, clusterName)
@@ -414,7 +417,7 @@ func TestDeleteCluster_GetClusterErr(t *testing.T) {
 		Id:             clusterName,
 	}).Return(nil, errFake).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	err = client.DeleteCluster(ctx, instanceID, clusterName)
@@ -443,7 +446,7 @@ func TestDeleteCluster_ClientErr(t *testing.T) {
 		Id:             mockCluster.GetId(),
 	}).Return(nil, errFake).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	err = client.DeleteCluster(ctx, instanceID, clusterName)
@@ -462,7 +465,7 @@ func TestGetInstance(t *testing.T) {
 		Id:             instanceName,
 	}).Return(mockResponse, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	instance, err := client.GetInstance(ctx, instanceName)
@@ -479,7 +482,7 @@ func TestGetInstance_StatusNotFound(t *testing.T) {
 		Id:             instanceName,
 	}).Return(nil, statusNotFound).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	instance, err := client.GetInstance(ctx, instanceName)
@@ -497,7 +500,7 @@ func TestGetInstance_ClientErr(t *testing.T) {
 		Id:             instanceName,
 	}).Return(nil, errFake).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	instance, err := client.GetInstance(ctx, instanceName)
@@ -514,7 +517,7 @@ func TestGetInstance_NilResponse(t *testing.T) {
 		Id:             instanceName,
 	}).Return(nil, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	instance, err := client.GetInstance(ctx, instanceName)
@@ -534,7 +537,7 @@ func TestGetInstance_NilInstance(t *testing.T) {
 		Id:             instanceName,
 	}).Return(mockResponse, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	instance, err := client.GetInstance(ctx, instanceName)
@@ -552,7 +555,7 @@ func TestExportInstance(t *testing.T) {
 		Id:             instanceName,
 	}).Return(mockResponse, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	resp, err := client.ExportInstance(ctx, instanceName)
@@ -569,7 +572,7 @@ func TestExportInstance_statusNotFound(t *testing.T) {
 		Id:             instanceName,
 	}).Return(nil, statusNotFound).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	resp, err := client.ExportInstance(ctx, instanceName)
@@ -587,7 +590,7 @@ func TestExportInstance_ClientErr(t *testing.T) {
 		Id:             instanceName,
 	}).Return(nil, errFake).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	resp, err := client.ExportInstance(ctx, instanceName)
@@ -604,7 +607,7 @@ func TestExportInstance_NilResponse(t *testing.T) {
 		Id:             instanceName,
 	}).Return(nil, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	resp, err := client.ExportInstance(ctx, instanceName)
@@ -624,7 +627,7 @@ func TestApplyInstance(t *testing.T) {
 
 	mockGatewayClient.EXPECT().ApplyInstance(authCtx, mockRequest).Return(nil, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	err = client.ApplyInstance(ctx, mockRequest)
@@ -653,7 +656,7 @@ func TestApplyInstance_IDRequestWorkspaceWins(t *testing.T) {
 	})
 	mockGatewayClient.EXPECT().ApplyInstance(authCtx, expected).Return(nil, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, mockOrgGatewayClient)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, mockOrgGatewayClient, nil)
 	require.NoError(t, err)
 
 	err = client.ApplyInstance(ctx, callerRequest)
@@ -688,7 +691,7 @@ func TestApplyInstance_NameRequestDefaultsWorkspaceOnCreate(t *testing.T) {
 	})
 	mockGatewayClient.EXPECT().ApplyInstance(authCtx, expected).Return(nil, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, mockOrgGatewayClient)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, mockOrgGatewayClient, nil)
 	require.NoError(t, err)
 
 	err = client.ApplyInstance(ctx, callerRequest)
@@ -712,7 +715,7 @@ func TestApplyInstance_WorkspaceLookupNotFoundIsTerminal(t *testing.T) {
 		IsDefault: true,
 	})
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, mockOrgGatewayClient)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, mockOrgGatewayClient, nil)
 	require.NoError(t, err)
 
 	err = client.ApplyInstance(ctx, callerRequest)
@@ -739,7 +742,7 @@ func TestApplyInstance_FillsOrganizationId(t *testing.T) {
 
 	mockGatewayClient.EXPECT().ApplyInstance(authCtx, expected).Return(nil, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	err = client.ApplyInstance(ctx, callerRequest)
@@ -766,7 +769,7 @@ func TestDeleteInstance(t *testing.T) {
 		Id:             instanceID,
 	}).Return(nil, nil).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	err = client.DeleteInstance(ctx, instanceName)
@@ -782,7 +785,7 @@ func TestDeleteInstance_GetInstanceErr(t *testing.T) {
 		Id:             instanceName,
 	}).Return(nil, errFake).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	err = client.DeleteInstance(ctx, instanceName)
@@ -808,7 +811,7 @@ func TestDeleteInstance_ClientErr(t *testing.T) {
 		Id:             instanceID,
 	}).Return(nil, errFake).Times(1)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
 	require.NoError(t, err)
 
 	err = client.DeleteInstance(ctx, instanceName)
@@ -829,7 +832,7 @@ func TestResolveWorkspace_MatchesIDBeforeName(t *testing.T) {
 	}
 	expectListWorkspaces(mockOrgGatewayClient, nameMatch, idMatch)
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, mockOrgGatewayClient)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, mockOrgGatewayClient, nil)
 	require.NoError(t, err)
 
 	workspace, err := client.ResolveWorkspace(ctx, "workspace-ref")
@@ -847,10 +850,47 @@ func TestResolveWorkspace_Default(t *testing.T) {
 		IsDefault: true,
 	})
 
-	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, mockOrgGatewayClient)
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, mockOrgGatewayClient, nil)
 	require.NoError(t, err)
 
 	workspace, err := client.ResolveWorkspace(ctx, "")
 	require.NoError(t, err)
 	assert.Equal(t, workspaceID, workspace.GetId())
 }
+
+func TestSetKargoAgentMaintenanceMode(t *testing.T) {
+	mockKargoClient := mock_akuity_client.NewMockKargoServiceGatewayClient(gomock.NewController(t))
+	mockKargoClient.EXPECT().ListKargoInstances(authCtx, gomock.Any()).
+		Return(&kargov1.ListKargoInstancesResponse{Instances: []*kargov1.KargoInstance{
+			{Id: "kargo-1", WorkspaceId: workspaceID},
+		}}, nil).Times(1)
+	expiry := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	mockKargoClient.EXPECT().SetAgentMaintenanceMode(authCtx, &kargov1.SetAgentMaintenanceModeRequest{
+		OrganizationId:  organizationID,
+		InstanceId:      "kargo-1",
+		WorkspaceId:     workspaceID,
+		AgentNames:      []string{"agent-1"},
+		MaintenanceMode: true,
+		Expiry:          timestamppb.New(expiry),
+	}).Return(&kargov1.SetAgentMaintenanceModeResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, mockKargoClient, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.SetKargoAgentMaintenanceMode(ctx, "kargo-1", "agent-1", true, &expiry))
+}
+
+func TestSetKargoAgentMaintenanceMode_Error(t *testing.T) {
+	mockKargoClient := mock_akuity_client.NewMockKargoServiceGatewayClient(gomock.NewController(t))
+	mockKargoClient.EXPECT().ListKargoInstances(authCtx, gomock.Any()).
+		Return(&kargov1.ListKargoInstancesResponse{Instances: []*kargov1.KargoInstance{
+			{Id: "kargo-1", WorkspaceId: workspaceID},
+		}}, nil).Times(1)
+	mockKargoClient.EXPECT().SetAgentMaintenanceMode(authCtx, gomock.Any()).Return(nil, errFake).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, mockKargoClient, nil, nil)
+	require.NoError(t, err)
+
+	err = client.SetKargoAgentMaintenanceMode(ctx, "kargo-1", "agent-1", false, nil)
+	require.ErrorIs(t, err, errFake)
+}
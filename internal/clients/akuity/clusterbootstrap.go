@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+)
+
+// ----------------------------------------------------------------------
+// Cluster bootstrap material. The install command and API server CA
+// data let tooling outside the provider install the agent on a cluster
+// the provider cannot reach.
+// ----------------------------------------------------------------------
+
+func (c client) GetClusterInstallCommand(ctx context.Context, instanceID, clusterID string) (string, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return "", err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.gatewayClient.GetInstanceClusterCommand(ctx, &argocdv1.GetInstanceClusterCommandRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		Id:             clusterID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not get install command for cluster %s/%s: %w", instanceID, clusterID, err)
+	}
+	return resp.GetCommand(), nil
+}
+
+func (c client) GetClusterAPIServerCAData(ctx context.Context, instanceID, clusterName string) (string, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return "", err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.gatewayClient.GetClusterAPIServerCAData(ctx, &argocdv1.GetClusterAPIServerCADataRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		ClusterName:    clusterName,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not get API server CA data for cluster %s/%s: %w", instanceID, clusterName, err)
+	}
+	return resp.GetData(), nil
+}
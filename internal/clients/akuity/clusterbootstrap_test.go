@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akuity_test
+
+import (
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+)
+
+func TestGetClusterInstallCommand(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().GetInstanceClusterCommand(authCtx, &argocdv1.GetInstanceClusterCommandRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		Id:             "cluster-id",
+	}).Return(&argocdv1.GetInstanceClusterCommandResponse{Command: "kubectl apply -f https://example/manifests"}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	command, err := client.GetClusterInstallCommand(ctx, instanceID, "cluster-id")
+	require.NoError(t, err)
+	assert.Equal(t, "kubectl apply -f https://example/manifests", command)
+}
+
+func TestGetClusterInstallCommand_Error(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().GetInstanceClusterCommand(authCtx, gomock.Any()).
+		Return(nil, errFake).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetClusterInstallCommand(ctx, instanceID, "cluster-id")
+	require.ErrorIs(t, err, errFake)
+}
+
+func TestGetClusterAPIServerCAData(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().GetClusterAPIServerCAData(authCtx, &argocdv1.GetClusterAPIServerCADataRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		ClusterName:    clusterName,
+	}).Return(&argocdv1.GetClusterAPIServerCADataResponse{Data: "LS0tLS1CRUdJTg=="}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	data, err := client.GetClusterAPIServerCAData(ctx, instanceID, clusterName)
+	require.NoError(t, err)
+	assert.Equal(t, "LS0tLS1CRUdJTg==", data)
+}
+
+func TestGetClusterAPIServerCAData_Error(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().GetClusterAPIServerCAData(authCtx, gomock.Any()).
+		Return(nil, errFake).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetClusterAPIServerCAData(ctx, instanceID, clusterName)
+	require.ErrorIs(t, err, errFake)
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+)
+
+func (c client) GetClusterInfo(ctx context.Context, instanceID, clusterName string) (*argocdv1.GetInstanceClusterInfoResponse, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.gatewayClient.GetInstanceClusterInfo(ctx, &argocdv1.GetInstanceClusterRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		IdType:         idv1.Type_NAME,
+		Id:             clusterName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get info for cluster %s/%s: %w", instanceID, clusterName, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akuity_test
+
+import (
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+)
+
+func TestGetClusterInfo(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().GetInstanceClusterInfo(authCtx, &argocdv1.GetInstanceClusterRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		IdType:         idv1.Type_NAME,
+		Id:             clusterName,
+	}).Return(&argocdv1.GetInstanceClusterInfoResponse{HasApplications: true}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	info, err := client.GetClusterInfo(ctx, instanceID, clusterName)
+	require.NoError(t, err)
+	assert.True(t, info.GetHasApplications())
+}
+
+func TestGetClusterInfo_Error(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().GetInstanceClusterInfo(authCtx, gomock.Any()).
+		Return(nil, errFake).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetClusterInfo(ctx, instanceID, clusterName)
+	require.ErrorIs(t, err, errFake)
+}
@@ -0,0 +1,65 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+)
+
+// ----------------------------------------------------------------------
+// Agent credential rotation. Both planes rotate by name and report the
+// agents they declined to rotate in a skipped list; a single-agent
+// rotation that comes back skipped is surfaced as an error so the
+// caller does not re-apply manifests carrying the old credentials.
+// ----------------------------------------------------------------------
+
+func (c client) RotateClusterCredentials(ctx context.Context, instanceID, clusterName string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("RotateInstanceClusterCredentials", instanceID+"/"+clusterName)
+	resp, err := c.gatewayClient.RotateInstanceClusterCredentials(ctx, &argocdv1.RotateInstanceClusterCredentialsRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		ClusterNames:   []string{clusterName},
+	})
+	if err != nil {
+		return fmt.Errorf("could not rotate credentials for cluster %s/%s: %w", instanceID, clusterName, err)
+	}
+	if slices.Contains(resp.GetSkippedClusters(), clusterName) {
+		return fmt.Errorf("credential rotation skipped for cluster %s/%s", instanceID, clusterName)
+	}
+	return nil
+}
+
+func (c client) RotateKargoAgentCredentials(ctx context.Context, kargoInstanceID, agentName string) error {
+	if err := c.kargoRequired("RotateKargoAgentCredentials"); err != nil {
+		return err
+	}
+	workspaceID, err := c.kargoWorkspaceIDForInstance(ctx, kargoInstanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("RotateInstanceAgentCredentials", kargoInstanceID+"/"+agentName)
+	resp, err := c.kargoGatewayClient.RotateInstanceAgentCredentials(ctx, &kargov1.RotateInstanceAgentCredentialsRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     kargoInstanceID,
+		WorkspaceId:    workspaceID,
+		AgentNames:     []string{agentName},
+	})
+	if err != nil {
+		return fmt.Errorf("could not rotate credentials for kargo agent %s/%s: %w", kargoInstanceID, agentName, err)
+	}
+	if slices.Contains(resp.GetSkippedAgents(), agentName) {
+		return fmt.Errorf("credential rotation skipped for kargo agent %s/%s", kargoInstanceID, agentName)
+	}
+	return nil
+}
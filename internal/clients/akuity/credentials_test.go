@@ -0,0 +1,79 @@
+package akuity_test
+
+import (
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+)
+
+func TestRotateClusterCredentials(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().RotateInstanceClusterCredentials(authCtx, &argocdv1.RotateInstanceClusterCredentialsRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		ClusterNames:   []string{clusterName},
+	}).Return(&argocdv1.RotateInstanceClusterCredentialsResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.RotateClusterCredentials(ctx, instanceID, clusterName))
+}
+
+func TestRotateClusterCredentials_Skipped(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().RotateInstanceClusterCredentials(authCtx, gomock.Any()).
+		Return(&argocdv1.RotateInstanceClusterCredentialsResponse{SkippedClusters: []string{clusterName}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = client.RotateClusterCredentials(ctx, instanceID, clusterName)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "skipped")
+}
+
+func TestRotateKargoAgentCredentials(t *testing.T) {
+	mockKargoClient := mock_akuity_client.NewMockKargoServiceGatewayClient(gomock.NewController(t))
+	mockKargoClient.EXPECT().ListKargoInstances(authCtx, &kargov1.ListKargoInstancesRequest{
+		OrganizationId: organizationID,
+	}).Return(&kargov1.ListKargoInstancesResponse{Instances: []*kargov1.KargoInstance{
+		{Id: "kargo-1", WorkspaceId: workspaceID},
+	}}, nil).Times(1)
+	mockKargoClient.EXPECT().RotateInstanceAgentCredentials(authCtx, &kargov1.RotateInstanceAgentCredentialsRequest{
+		OrganizationId: organizationID,
+		InstanceId:     "kargo-1",
+		WorkspaceId:    workspaceID,
+		AgentNames:     []string{"agent-1"},
+	}).Return(&kargov1.RotateInstanceAgentCredentialsResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, mockKargoClient, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.RotateKargoAgentCredentials(ctx, "kargo-1", "agent-1"))
+}
+
+func TestRotateKargoAgentCredentials_Error(t *testing.T) {
+	mockKargoClient := mock_akuity_client.NewMockKargoServiceGatewayClient(gomock.NewController(t))
+	mockKargoClient.EXPECT().ListKargoInstances(authCtx, gomock.Any()).
+		Return(&kargov1.ListKargoInstancesResponse{Instances: []*kargov1.KargoInstance{
+			{Id: "kargo-1", WorkspaceId: workspaceID},
+		}}, nil).Times(1)
+	mockKargoClient.EXPECT().RotateInstanceAgentCredentials(authCtx, gomock.Any()).Return(nil, errFake).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, mockKargoClient, nil, nil)
+	require.NoError(t, err)
+
+	err = client.RotateKargoAgentCredentials(ctx, "kargo-1", "agent-1")
+	require.ErrorIs(t, err, errFake)
+}
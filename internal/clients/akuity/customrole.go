@@ -0,0 +1,204 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// ----------------------------------------------------------------------
+// Organization-plane custom role methods. Organization and workspace
+// custom roles share the CustomRole message and are keyed by their
+// platform-assigned ID; workspace roles additionally route through the
+// owning workspace ID.
+// ----------------------------------------------------------------------
+
+func (c client) GetCustomRole(ctx context.Context, id string) (*orgcv1.CustomRole, error) {
+	if err := c.orgRequired("GetCustomRole"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.GetCustomRole(ctx, &orgcv1.GetCustomRoleRequest{
+		OrganizationId: c.organizationID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get custom role %s: %w", id, err))
+		}
+		return nil, fmt.Errorf("could not get custom role %s: %w", id, err)
+	}
+	if resp == nil || resp.GetCustomRole() == nil {
+		return nil, fmt.Errorf("could not get custom role %s: empty response", id)
+	}
+	return resp.GetCustomRole(), nil
+}
+
+func (c client) CreateCustomRole(ctx context.Context, name, description, policy string) (*orgcv1.CustomRole, error) {
+	if err := c.orgRequired("CreateCustomRole"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("CreateCustomRole", name)
+	resp, err := c.orgGatewayClient.CreateCustomRole(ctx, &orgcv1.CreateCustomRoleRequest{
+		OrganizationId: c.organizationID,
+		Name:           name,
+		Description:    description,
+		Policy:         policy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create custom role %s: %w", name, err)
+	}
+	if resp == nil || resp.GetCustomRole().GetId() == "" {
+		return nil, fmt.Errorf("could not create custom role %s: empty response", name)
+	}
+	return resp.GetCustomRole(), nil
+}
+
+// UpdateCustomRole implements Client.UpdateCustomRole. Name,
+// description, and policy are always sent, so an empty value clears the
+// field on the platform.
+func (c client) UpdateCustomRole(ctx context.Context, id, name, description, policy string) (*orgcv1.CustomRole, error) {
+	if err := c.orgRequired("UpdateCustomRole"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateCustomRole", id)
+	resp, err := c.orgGatewayClient.UpdateCustomRole(ctx, &orgcv1.UpdateCustomRoleRequest{
+		OrganizationId: c.organizationID,
+		Id:             id,
+		Name:           name,
+		Description:    description,
+		Policy:         policy,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not update custom role %s: %w", id, err))
+		}
+		return nil, fmt.Errorf("could not update custom role %s: %w", id, err)
+	}
+	if resp == nil || resp.GetCustomRole() == nil {
+		return nil, fmt.Errorf("could not update custom role %s: empty response", id)
+	}
+	return resp.GetCustomRole(), nil
+}
+
+func (c client) DeleteCustomRole(ctx context.Context, id string) error {
+	if err := c.orgRequired("DeleteCustomRole"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteCustomRole", id)
+	_, err := c.orgGatewayClient.DeleteCustomRole(ctx, &orgcv1.DeleteCustomRoleRequest{
+		OrganizationId: c.organizationID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete custom role %s: %w", id, err))
+		}
+		return fmt.Errorf("could not delete custom role %s: %w", id, err)
+	}
+	return nil
+}
+
+func (c client) GetWorkspaceCustomRole(ctx context.Context, workspaceID, id string) (*orgcv1.CustomRole, error) {
+	if err := c.orgRequired("GetWorkspaceCustomRole"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.GetWorkspaceCustomRole(ctx, &orgcv1.GetWorkspaceCustomRoleRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get workspace %s custom role %s: %w", workspaceID, id, err))
+		}
+		return nil, fmt.Errorf("could not get workspace %s custom role %s: %w", workspaceID, id, err)
+	}
+	if resp == nil || resp.GetCustomRole() == nil {
+		return nil, fmt.Errorf("could not get workspace %s custom role %s: empty response", workspaceID, id)
+	}
+	return resp.GetCustomRole(), nil
+}
+
+func (c client) CreateWorkspaceCustomRole(ctx context.Context, workspaceID, name, description, policy string) (*orgcv1.CustomRole, error) {
+	if err := c.orgRequired("CreateWorkspaceCustomRole"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("CreateWorkspaceCustomRole", workspaceID+"/"+name)
+	resp, err := c.orgGatewayClient.CreateWorkspaceCustomRole(ctx, &orgcv1.CreateWorkspaceCustomRoleRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		Name:           name,
+		Description:    description,
+		Policy:         policy,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not create workspace %s custom role %s: %w", workspaceID, name, err))
+		}
+		return nil, fmt.Errorf("could not create workspace %s custom role %s: %w", workspaceID, name, err)
+	}
+	if resp == nil || resp.GetCustomRole().GetId() == "" {
+		return nil, fmt.Errorf("could not create workspace %s custom role %s: empty response", workspaceID, name)
+	}
+	return resp.GetCustomRole(), nil
+}
+
+// UpdateWorkspaceCustomRole implements Client.UpdateWorkspaceCustomRole
+// with the same full-replace semantics as UpdateCustomRole.
+func (c client) UpdateWorkspaceCustomRole(ctx context.Context, workspaceID, id, name, description, policy string) (*orgcv1.CustomRole, error) {
+	if err := c.orgRequired("UpdateWorkspaceCustomRole"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateWorkspaceCustomRole", workspaceID+"/"+id)
+	resp, err := c.orgGatewayClient.UpdateWorkspaceCustomRole(ctx, &orgcv1.UpdateWorkspaceCustomRoleRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             id,
+		Name:           name,
+		Description:    description,
+		Policy:         policy,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not update workspace %s custom role %s: %w", workspaceID, id, err))
+		}
+		return nil, fmt.Errorf("could not update workspace %s custom role %s: %w", workspaceID, id, err)
+	}
+	if resp == nil || resp.GetCustomRole() == nil {
+		return nil, fmt.Errorf("could not update workspace %s custom role %s: empty response", workspaceID, id)
+	}
+	return resp.GetCustomRole(), nil
+}
+
+func (c client) DeleteWorkspaceCustomRole(ctx context.Context, workspaceID, id string) error {
+	if err := c.orgRequired("DeleteWorkspaceCustomRole"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteWorkspaceCustomRole", workspaceID+"/"+id)
+	_, err := c.orgGatewayClient.DeleteWorkspaceCustomRole(ctx, &orgcv1.DeleteWorkspaceCustomRoleRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete workspace %s custom role %s: %w", workspaceID, id, err))
+		}
+		return fmt.Errorf("could not delete workspace %s custom role %s: %w", workspaceID, id, err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package akuity_test
+
+import (
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func TestCreateCustomRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().CreateCustomRole(authCtx, &orgcv1.CreateCustomRoleRequest{
+		OrganizationId: organizationID,
+		Name:           "deployer",
+		Description:    "deploys apps",
+		Policy:         "instances/get",
+	}).Return(&orgcv1.CreateCustomRoleResponse{CustomRole: &orgcv1.CustomRole{Id: "role-1"}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	role, err := client.CreateCustomRole(ctx, "deployer", "deploys apps", "instances/get")
+	require.NoError(t, err)
+	assert.Equal(t, "role-1", role.GetId())
+}
+
+func TestGetCustomRole_StatusNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().GetCustomRole(authCtx, &orgcv1.GetCustomRoleRequest{
+		OrganizationId: organizationID,
+		Id:             "role-1",
+	}).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetCustomRole(ctx, "role-1")
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestUpdateWorkspaceCustomRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().UpdateWorkspaceCustomRole(authCtx, &orgcv1.UpdateWorkspaceCustomRoleRequest{
+		OrganizationId: organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             "role-1",
+		Name:           "deployer",
+		Policy:         "instances/get",
+	}).Return(&orgcv1.UpdateWorkspaceCustomRoleResponse{CustomRole: &orgcv1.CustomRole{Id: "role-1"}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	_, err = client.UpdateWorkspaceCustomRole(ctx, workspaceID, "role-1", "deployer", "", "instances/get")
+	require.NoError(t, err)
+}
+
+func TestDeleteWorkspaceCustomRole_StatusNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().DeleteWorkspaceCustomRole(authCtx, gomock.Any()).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	err = client.DeleteWorkspaceCustomRole(ctx, workspaceID, "role-1")
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
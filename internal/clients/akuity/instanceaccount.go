@@ -0,0 +1,133 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// ----------------------------------------------------------------------
+// Instance account methods. Argo CD local accounts live on an instance
+// and are keyed by name. The gateway has no single-account read, so
+// GetInstanceAccount lists and filters. Passwords are write-only: the
+// platform returns one only from RegenerateInstanceAccountPassword.
+// ----------------------------------------------------------------------
+
+func (c client) GetInstanceAccount(ctx context.Context, instanceID, name string) (*argocdv1.InstanceAccount, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.gatewayClient.ListInstanceAccounts(ctx, &argocdv1.ListInstanceAccountsRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get instance %s account %s: %w", instanceID, name, err))
+		}
+		return nil, fmt.Errorf("could not get instance %s account %s: %w", instanceID, name, err)
+	}
+	for _, a := range resp.GetAccounts() {
+		if a.GetName() == name {
+			return a, nil
+		}
+	}
+	return nil, reason.AsNotFound(fmt.Errorf("could not get instance %s account %s: account was not found", instanceID, name))
+}
+
+func (c client) UpsertInstanceAccount(ctx context.Context, instanceID, name string, capabilities *argocdv1.InstanceAccountCapabilities, disabled bool) (*argocdv1.InstanceAccount, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpsertInstanceAccount", instanceID+"/"+name)
+	resp, err := c.gatewayClient.UpsertInstanceAccount(ctx, &argocdv1.UpsertInstanceAccountRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		Name:           name,
+		Capabilities:   capabilities,
+		Disabled:       &disabled,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not upsert instance %s account %s: %w", instanceID, name, err)
+	}
+	if resp == nil || resp.GetAccount() == nil {
+		return nil, fmt.Errorf("could not upsert instance %s account %s: empty response", instanceID, name)
+	}
+	return resp.GetAccount(), nil
+}
+
+func (c client) DeleteInstanceAccount(ctx context.Context, instanceID, name string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteInstanceAccount", instanceID+"/"+name)
+	_, err = c.gatewayClient.DeleteInstanceAccount(ctx, &argocdv1.DeleteInstanceAccountRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		Name:           name,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete instance %s account %s: %w", instanceID, name, err))
+		}
+		return fmt.Errorf("could not delete instance %s account %s: %w", instanceID, name, err)
+	}
+	return nil
+}
+
+func (c client) UpdateInstanceAccountPassword(ctx context.Context, instanceID, name, password string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateInstanceAccountPassword", instanceID+"/"+name)
+	_, err = c.gatewayClient.UpdateInstanceAccountPassword(ctx, &argocdv1.UpdateInstanceAccountPasswordRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		Name:           name,
+		Password:       password,
+	})
+	if err != nil {
+		return fmt.Errorf("could not update instance %s account %s password: %w", instanceID, name, err)
+	}
+	return nil
+}
+
+func (c client) RegenerateInstanceAccountPassword(ctx context.Context, instanceID, name string) (string, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return "", err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("RegenerateInstanceAccountPassword", instanceID+"/"+name)
+	resp, err := c.gatewayClient.RegenerateInstanceAccountPassword(ctx, &argocdv1.RegenerateInstanceAccountPasswordRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		Name:           name,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not regenerate instance %s account %s password: %w", instanceID, name, err)
+	}
+	if resp.GetPassword() == "" {
+		return "", fmt.Errorf("could not regenerate instance %s account %s password: empty response", instanceID, name)
+	}
+	return resp.GetPassword(), nil
+}
@@ -0,0 +1,117 @@
+package akuity_test
+
+import (
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const accountName = "ci-bot"
+
+func TestGetInstanceAccount(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	want := &argocdv1.InstanceAccount{Name: accountName, Capabilities: &argocdv1.InstanceAccountCapabilities{ApiKey: true}}
+	mockGatewayClient.EXPECT().ListInstanceAccounts(authCtx, &argocdv1.ListInstanceAccountsRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+	}).Return(&argocdv1.ListInstanceAccountsResponse{Accounts: []*argocdv1.InstanceAccount{{Name: "other"}, want}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	got, err := client.GetInstanceAccount(ctx, instanceID, accountName)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestGetInstanceAccount_MissingIsNotFound(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	mockGatewayClient.EXPECT().ListInstanceAccounts(authCtx, gomock.Any()).
+		Return(&argocdv1.ListInstanceAccountsResponse{Accounts: []*argocdv1.InstanceAccount{{Name: "other"}}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetInstanceAccount(ctx, instanceID, accountName)
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestUpsertInstanceAccount(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	caps := &argocdv1.InstanceAccountCapabilities{Login: true}
+	disabled := false
+	mockGatewayClient.EXPECT().UpsertInstanceAccount(authCtx, &argocdv1.UpsertInstanceAccountRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		Name:           accountName,
+		Capabilities:   caps,
+		Disabled:       &disabled,
+	}).Return(&argocdv1.UpsertInstanceAccountResponse{Account: &argocdv1.InstanceAccount{Name: accountName, Capabilities: caps}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	got, err := client.UpsertInstanceAccount(ctx, instanceID, accountName, caps, false)
+	require.NoError(t, err)
+	assert.Equal(t, accountName, got.GetName())
+}
+
+func TestDeleteInstanceAccount_NotFound(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	mockGatewayClient.EXPECT().DeleteInstanceAccount(authCtx, &argocdv1.DeleteInstanceAccountRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		Name:           accountName,
+	}).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = client.DeleteInstanceAccount(ctx, instanceID, accountName)
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestRegenerateInstanceAccountPassword(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	mockGatewayClient.EXPECT().RegenerateInstanceAccountPassword(authCtx, &argocdv1.RegenerateInstanceAccountPasswordRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		Name:           accountName,
+	}).Return(&argocdv1.RegenerateInstanceAccountPasswordResponse{Password: "generated"}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	password, err := client.RegenerateInstanceAccountPassword(ctx, instanceID, accountName)
+	require.NoError(t, err)
+	assert.Equal(t, "generated", password)
+}
+
+func TestRegenerateInstanceAccountPassword_EmptyResponse(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	mockGatewayClient.EXPECT().RegenerateInstanceAccountPassword(authCtx, gomock.Any()).
+		Return(&argocdv1.RegenerateInstanceAccountPasswordResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.RegenerateInstanceAccountPassword(ctx, instanceID, accountName)
+	require.Error(t, err)
+}
@@ -0,0 +1,59 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// ----------------------------------------------------------------------
+// Instance repository methods. Repository registrations live on an Argo
+// CD instance and are keyed by repository URL. The gateway only exposes
+// create and list, so GetInstanceRepo lists and filters by URL, and
+// there is no update or delete surface.
+// ----------------------------------------------------------------------
+
+func (c client) GetInstanceRepo(ctx context.Context, instanceID, url string) (*argocdv1.Repository, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.gatewayClient.ListInstanceRepos(ctx, &argocdv1.ListInstanceReposRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list instance %s repos: %w", instanceID, err)
+	}
+	for _, r := range resp.GetRepos() {
+		if r.GetRepo() == url {
+			return r, nil
+		}
+	}
+	return nil, reason.AsNotFound(fmt.Errorf("instance %s repo %s not found", instanceID, url))
+}
+
+func (c client) CreateInstanceRepo(ctx context.Context, instanceID string, data map[string]string) (*argocdv1.Repository, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("CreateInstanceRepo", instanceID+"/"+data["url"])
+	resp, err := c.gatewayClient.CreateInstanceRepo(ctx, &argocdv1.CreateInstanceRepoRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		InstanceId:     instanceID,
+		Data:           data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create instance %s repo %s: %w", instanceID, data["url"], err)
+	}
+	return resp.GetRepo(), nil
+}
@@ -0,0 +1,72 @@
+package akuity_test
+
+import (
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const instanceRepoURL = "https://github.com/example/apps.git"
+
+func TestGetInstanceRepo_FiltersByURL(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	want := &argocdv1.Repository{Repo: ptr.To(instanceRepoURL), Type: ptr.To("git")}
+	mockGatewayClient.EXPECT().ListInstanceRepos(authCtx, &argocdv1.ListInstanceReposRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+	}).Return(&argocdv1.ListInstanceReposResponse{Repos: []*argocdv1.Repository{
+		{Repo: ptr.To("https://github.com/example/other.git")},
+		want,
+	}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	got, err := client.GetInstanceRepo(ctx, instanceID, instanceRepoURL)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestGetInstanceRepo_NotFound(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	mockGatewayClient.EXPECT().ListInstanceRepos(authCtx, &argocdv1.ListInstanceReposRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+	}).Return(&argocdv1.ListInstanceReposResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetInstanceRepo(ctx, instanceID, instanceRepoURL)
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestCreateInstanceRepo(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	data := map[string]string{"url": instanceRepoURL, "type": "git"}
+	want := &argocdv1.Repository{Repo: ptr.To(instanceRepoURL), Type: ptr.To("git")}
+	mockGatewayClient.EXPECT().CreateInstanceRepo(authCtx, &argocdv1.CreateInstanceRepoRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		Data:           data,
+	}).Return(&argocdv1.CreateInstanceRepoResponse{Repo: want}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	got, err := client.CreateInstanceRepo(ctx, instanceID, data)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
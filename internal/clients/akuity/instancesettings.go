@@ -0,0 +1,88 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+)
+
+// ----------------------------------------------------------------------
+// Instance settings methods. Resource customizations and custom CSS are
+// instance-wide settings with dedicated get/update endpoints outside
+// ApplyInstance. Both updates replace the whole setting: the
+// customization list is written as a unit, and an empty CSS string
+// clears the stylesheet.
+// ----------------------------------------------------------------------
+
+func (c client) GetInstanceResourceCustomizations(ctx context.Context, instanceID string) (*argocdv1.GetInstanceResourceCustomizationsResponse, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.gatewayClient.GetInstanceResourceCustomizations(ctx, &argocdv1.GetInstanceResourceCustomizationsRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             instanceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get instance %s resource customizations: %w", instanceID, err)
+	}
+	return resp, nil
+}
+
+func (c client) UpdateInstanceResourceCustomizations(ctx context.Context, instanceID string, resources []*argocdv1.ResourceCustomizationConfig, ignoreResourceUpdatesEnabled *bool) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateInstanceResourceCustomizations", instanceID)
+	if _, err := c.gatewayClient.UpdateInstanceResourceCustomizations(ctx, &argocdv1.UpdateInstanceResourceCustomizationsRequest{
+		OrganizationId:               c.organizationID,
+		WorkspaceId:                  workspaceID,
+		Id:                           instanceID,
+		Resources:                    resources,
+		IgnoreResourceUpdatesEnabled: ignoreResourceUpdatesEnabled,
+	}); err != nil {
+		return fmt.Errorf("could not update instance %s resource customizations: %w", instanceID, err)
+	}
+	return nil
+}
+
+func (c client) GetInstanceCSS(ctx context.Context, instanceID string) (string, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return "", err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.gatewayClient.GetInstanceCSS(ctx, &argocdv1.GetInstanceCSSRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             instanceID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not get instance %s css: %w", instanceID, err)
+	}
+	return resp.GetCss(), nil
+}
+
+func (c client) UpdateInstanceCSS(ctx context.Context, instanceID, css string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateInstanceCSS", instanceID)
+	if _, err := c.gatewayClient.UpdateInstanceCSS(ctx, &argocdv1.UpdateInstanceCSSRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             instanceID,
+		Css:            css,
+	}); err != nil {
+		return fmt.Errorf("could not update instance %s css: %w", instanceID, err)
+	}
+	return nil
+}
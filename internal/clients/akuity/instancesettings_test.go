@@ -0,0 +1,64 @@
+package akuity_test
+
+import (
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+)
+
+func TestUpdateInstanceResourceCustomizations(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	resources := []*argocdv1.ResourceCustomizationConfig{{Group: "apps", Kind: "Deployment", Health: "return {}"}}
+	mockGatewayClient.EXPECT().UpdateInstanceResourceCustomizations(authCtx, &argocdv1.UpdateInstanceResourceCustomizationsRequest{
+		OrganizationId:               organizationID,
+		WorkspaceId:                  workspaceID,
+		Id:                           instanceID,
+		Resources:                    resources,
+		IgnoreResourceUpdatesEnabled: ptr.To(true),
+	}).Return(&argocdv1.UpdateInstanceResourceCustomizationsResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.UpdateInstanceResourceCustomizations(ctx, instanceID, resources, ptr.To(true)))
+}
+
+func TestGetInstanceCSS(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	mockGatewayClient.EXPECT().GetInstanceCSS(authCtx, &argocdv1.GetInstanceCSSRequest{
+		OrganizationId: organizationID,
+		Id:             instanceID,
+	}).Return(&argocdv1.GetInstanceCSSResponse{Css: "body {}"}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	got, err := client.GetInstanceCSS(ctx, instanceID)
+	require.NoError(t, err)
+	assert.Equal(t, "body {}", got)
+}
+
+func TestUpdateInstanceCSS_Error(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	mockGatewayClient.EXPECT().UpdateInstanceCSS(authCtx, &argocdv1.UpdateInstanceCSSRequest{
+		OrganizationId: organizationID,
+		Id:             instanceID,
+		Css:            "body {}",
+	}).Return(nil, errFake).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = client.UpdateInstanceCSS(ctx, instanceID, "body {}")
+	require.ErrorIs(t, err, errFake)
+}
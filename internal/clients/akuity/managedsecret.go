@@ -0,0 +1,124 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// ----------------------------------------------------------------------
+// Managed secret methods. Akuity-managed secrets live on an Argo CD
+// instance and are keyed by name. The gateway has no single-secret
+// read, so GetManagedSecret lists and filters. Secret values are
+// write-only: reads return only the key names.
+// ----------------------------------------------------------------------
+
+func (c client) GetManagedSecret(ctx context.Context, instanceID, name string) (*argocdv1.ManagedSecret, error) {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.gatewayClient.ListInstanceManagedSecrets(ctx, &argocdv1.ListInstanceManagedSecretsRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get instance %s managed secret %s: %w", instanceID, name, err))
+		}
+		return nil, fmt.Errorf("could not get instance %s managed secret %s: %w", instanceID, name, err)
+	}
+	for _, s := range resp.GetManagedSecrets() {
+		if s.GetName() == name {
+			return s, nil
+		}
+	}
+	return nil, reason.AsNotFound(fmt.Errorf("could not get instance %s managed secret %s: secret was not found", instanceID, name))
+}
+
+func (c client) CreateManagedSecret(ctx context.Context, instanceID string, secret *argocdv1.ManagedSecret, data map[string]string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("CreateManagedSecret", instanceID+"/"+secret.GetName())
+	if _, err := c.gatewayClient.CreateManagedSecret(ctx, &argocdv1.CreateManagedSecretRequest{
+		OrganizationId:    c.organizationID,
+		InstanceId:        instanceID,
+		WorkspaceId:       workspaceID,
+		ManagedSecret:     secret,
+		ManagedSecretData: data,
+	}); err != nil {
+		return fmt.Errorf("could not create instance %s managed secret %s: %w", instanceID, secret.GetName(), err)
+	}
+	return nil
+}
+
+func (c client) UpdateManagedSecret(ctx context.Context, instanceID string, secret *argocdv1.ManagedSecret, data map[string]string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateManagedSecret", instanceID+"/"+secret.GetName())
+	if _, err := c.gatewayClient.UpdateManagedSecret(ctx, &argocdv1.UpdateManagedSecretRequest{
+		OrganizationId:    c.organizationID,
+		InstanceId:        instanceID,
+		WorkspaceId:       workspaceID,
+		Name:              secret.GetName(),
+		ManagedSecret:     secret,
+		ManagedSecretData: data,
+	}); err != nil {
+		return fmt.Errorf("could not update instance %s managed secret %s: %w", instanceID, secret.GetName(), err)
+	}
+	return nil
+}
+
+func (c client) PatchManagedSecret(ctx context.Context, instanceID, name string, data map[string]string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("PatchManagedSecret", instanceID+"/"+name)
+	if _, err := c.gatewayClient.PatchManagedSecret(ctx, &argocdv1.PatchManagedSecretRequest{
+		OrganizationId:    c.organizationID,
+		InstanceId:        instanceID,
+		WorkspaceId:       workspaceID,
+		Name:              name,
+		ManagedSecretData: data,
+	}); err != nil {
+		return fmt.Errorf("could not patch instance %s managed secret %s: %w", instanceID, name, err)
+	}
+	return nil
+}
+
+func (c client) DeleteManagedSecret(ctx context.Context, instanceID, name string) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteManagedSecret", instanceID+"/"+name)
+	if _, err := c.gatewayClient.DeleteManagedSecret(ctx, &argocdv1.DeleteManagedSecretRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+		Name:           name,
+	}); err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete instance %s managed secret %s: %w", instanceID, name, err))
+		}
+		return fmt.Errorf("could not delete instance %s managed secret %s: %w", instanceID, name, err)
+	}
+	return nil
+}
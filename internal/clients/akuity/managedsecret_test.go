@@ -0,0 +1,97 @@
+package akuity_test
+
+import (
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const managedSecretName = "registry-creds"
+
+func TestGetManagedSecret(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	want := &argocdv1.ManagedSecret{Name: managedSecretName, SecretKeys: []string{"password", "username"}}
+	mockGatewayClient.EXPECT().ListInstanceManagedSecrets(authCtx, &argocdv1.ListInstanceManagedSecretsRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		WorkspaceId:    workspaceID,
+	}).Return(&argocdv1.ListInstanceManagedSecretsResponse{ManagedSecrets: []*argocdv1.ManagedSecret{{Name: "other"}, want}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	got, err := client.GetManagedSecret(ctx, instanceID, managedSecretName)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestGetManagedSecret_MissingIsNotFound(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	mockGatewayClient.EXPECT().ListInstanceManagedSecrets(authCtx, gomock.Any()).
+		Return(&argocdv1.ListInstanceManagedSecretsResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetManagedSecret(ctx, instanceID, managedSecretName)
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestCreateManagedSecret(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	secret := &argocdv1.ManagedSecret{Name: managedSecretName, AllowedClusters: []string{"ALL"}}
+	data := map[string]string{"password": "s3cr3t"}
+	mockGatewayClient.EXPECT().CreateManagedSecret(authCtx, &argocdv1.CreateManagedSecretRequest{
+		OrganizationId:    organizationID,
+		InstanceId:        instanceID,
+		WorkspaceId:       workspaceID,
+		ManagedSecret:     secret,
+		ManagedSecretData: data,
+	}).Return(&argocdv1.CreateManagedSecretResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.CreateManagedSecret(ctx, instanceID, secret, data))
+}
+
+func TestPatchManagedSecret_SendsOnlyData(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	data := map[string]string{"password": "rotated"}
+	mockGatewayClient.EXPECT().PatchManagedSecret(authCtx, &argocdv1.PatchManagedSecretRequest{
+		OrganizationId:    organizationID,
+		InstanceId:        instanceID,
+		Name:              managedSecretName,
+		ManagedSecretData: data,
+	}).Return(&argocdv1.PatchManagedSecretResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.PatchManagedSecret(ctx, instanceID, managedSecretName, data))
+}
+
+func TestDeleteManagedSecret_NotFound(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByID(mockGatewayClient, instanceID)
+	mockGatewayClient.EXPECT().DeleteManagedSecret(authCtx, gomock.Any()).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = client.DeleteManagedSecret(ctx, instanceID, managedSecretName)
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
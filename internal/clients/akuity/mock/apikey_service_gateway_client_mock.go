@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/akuity/api-client-go/pkg/api/gen/apikey/v1 (interfaces: APIKeyServiceGatewayClient)
+//
+// Generated by this command:
+//
+//	mockgen -package mock_akuity_client -destination internal/clients/akuity/mock/apikey_service_gateway_client_mock.go github.com/akuity/api-client-go/pkg/api/gen/apikey/v1 APIKeyServiceGatewayClient
+//
+
+// Package mock_akuity_client is a generated GoMock package.
+package mock_akuity_client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAPIKeyServiceGatewayClient is a mock of APIKeyServiceGatewayClient interface.
+type MockAPIKeyServiceGatewayClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyServiceGatewayClientMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyServiceGatewayClientMockRecorder is the mock recorder for MockAPIKeyServiceGatewayClient.
+type MockAPIKeyServiceGatewayClientMockRecorder struct {
+	mock *MockAPIKeyServiceGatewayClient
+}
+
+// NewMockAPIKeyServiceGatewayClient creates a new mock instance.
+func NewMockAPIKeyServiceGatewayClient(ctrl *gomock.Controller) *MockAPIKeyServiceGatewayClient {
+	mock := &MockAPIKeyServiceGatewayClient{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyServiceGatewayClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyServiceGatewayClient) EXPECT() *MockAPIKeyServiceGatewayClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteAPIKey mocks base method.
+func (m *MockAPIKeyServiceGatewayClient) DeleteAPIKey(arg0 context.Context, arg1 *apikeyv1.DeleteAPIKeyRequest) (*apikeyv1.DeleteAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*apikeyv1.DeleteAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAPIKey indicates an expected call of DeleteAPIKey.
+func (mr *MockAPIKeyServiceGatewayClientMockRecorder) DeleteAPIKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIKey", reflect.TypeOf((*MockAPIKeyServiceGatewayClient)(nil).DeleteAPIKey), arg0, arg1)
+}
+
+// DeleteWorkspaceAPIKey mocks base method.
+func (m *MockAPIKeyServiceGatewayClient) DeleteWorkspaceAPIKey(arg0 context.Context, arg1 *apikeyv1.DeleteWorkspaceAPIKeyRequest) (*apikeyv1.DeleteWorkspaceAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspaceAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*apikeyv1.DeleteWorkspaceAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkspaceAPIKey indicates an expected call of DeleteWorkspaceAPIKey.
+func (mr *MockAPIKeyServiceGatewayClientMockRecorder) DeleteWorkspaceAPIKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceAPIKey", reflect.TypeOf((*MockAPIKeyServiceGatewayClient)(nil).DeleteWorkspaceAPIKey), arg0, arg1)
+}
+
+// GetAPIKey mocks base method.
+func (m *MockAPIKeyServiceGatewayClient) GetAPIKey(arg0 context.Context, arg1 *apikeyv1.GetAPIKeyRequest) (*apikeyv1.GetAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*apikeyv1.GetAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKey indicates an expected call of GetAPIKey.
+func (mr *MockAPIKeyServiceGatewayClientMockRecorder) GetAPIKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKey", reflect.TypeOf((*MockAPIKeyServiceGatewayClient)(nil).GetAPIKey), arg0, arg1)
+}
+
+// GetWorkspaceAPIKey mocks base method.
+func (m *MockAPIKeyServiceGatewayClient) GetWorkspaceAPIKey(arg0 context.Context, arg1 *apikeyv1.GetWorkspaceAPIKeyRequest) (*apikeyv1.GetWorkspaceAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*apikeyv1.GetWorkspaceAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceAPIKey indicates an expected call of GetWorkspaceAPIKey.
+func (mr *MockAPIKeyServiceGatewayClientMockRecorder) GetWorkspaceAPIKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAPIKey", reflect.TypeOf((*MockAPIKeyServiceGatewayClient)(nil).GetWorkspaceAPIKey), arg0, arg1)
+}
+
+// RegenerateAPIKeySecret mocks base method.
+func (m *MockAPIKeyServiceGatewayClient) RegenerateAPIKeySecret(arg0 context.Context, arg1 *apikeyv1.RegenerateAPIKeySecretRequest) (*apikeyv1.RegenerateAPIKeySecretResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateAPIKeySecret", arg0, arg1)
+	ret0, _ := ret[0].(*apikeyv1.RegenerateAPIKeySecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateAPIKeySecret indicates an expected call of RegenerateAPIKeySecret.
+func (mr *MockAPIKeyServiceGatewayClientMockRecorder) RegenerateAPIKeySecret(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateAPIKeySecret", reflect.TypeOf((*MockAPIKeyServiceGatewayClient)(nil).RegenerateAPIKeySecret), arg0, arg1)
+}
+
+// RegenerateWorkspaceAPIKeySecret mocks base method.
+func (m *MockAPIKeyServiceGatewayClient) RegenerateWorkspaceAPIKeySecret(arg0 context.Context, arg1 *apikeyv1.RegenerateWorkspaceAPIKeySecretRequest) (*apikeyv1.RegenerateWorkspaceAPIKeySecretResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateWorkspaceAPIKeySecret", arg0, arg1)
+	ret0, _ := ret[0].(*apikeyv1.RegenerateWorkspaceAPIKeySecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateWorkspaceAPIKeySecret indicates an expected call of RegenerateWorkspaceAPIKeySecret.
+func (mr *MockAPIKeyServiceGatewayClientMockRecorder) RegenerateWorkspaceAPIKeySecret(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateWorkspaceAPIKeySecret", reflect.TypeOf((*MockAPIKeyServiceGatewayClient)(nil).RegenerateWorkspaceAPIKeySecret), arg0, arg1)
+}
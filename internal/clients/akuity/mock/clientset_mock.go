@@ -14,9 +14,12 @@ import (
 	reflect "reflect"
 	time "time"
 
+	accesscontrolv1 "github.com/akuity/api-client-go/pkg/api/gen/accesscontrol/v1"
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
 	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
 	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
 	organizationv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	featuresv1 "github.com/akuity/api-client-go/pkg/api/gen/types/features/v1"
 	gomock "go.uber.org/mock/gomock"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 )
@@ -45,6 +48,51 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// AddTeamMember mocks base method.
+func (m *MockClient) AddTeamMember(ctx context.Context, teamName, userID string) (*organizationv1.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTeamMember", ctx, teamName, userID)
+	ret0, _ := ret[0].(*organizationv1.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTeamMember indicates an expected call of AddTeamMember.
+func (mr *MockClientMockRecorder) AddTeamMember(ctx, teamName, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamMember", reflect.TypeOf((*MockClient)(nil).AddTeamMember), ctx, teamName, userID)
+}
+
+// AddWorkspaceMember mocks base method.
+func (m *MockClient) AddWorkspaceMember(ctx context.Context, workspaceID string, ref *organizationv1.WorkspaceMemberRef) (*organizationv1.WorkspaceMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkspaceMember", ctx, workspaceID, ref)
+	ret0, _ := ret[0].(*organizationv1.WorkspaceMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkspaceMember indicates an expected call of AddWorkspaceMember.
+func (mr *MockClientMockRecorder) AddWorkspaceMember(ctx, workspaceID, ref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkspaceMember", reflect.TypeOf((*MockClient)(nil).AddWorkspaceMember), ctx, workspaceID, ref)
+}
+
+// AddonMarketplaceInstall mocks base method.
+func (m *MockClient) AddonMarketplaceInstall(ctx context.Context, instanceID string, config *argocdv1.AddonMarketplaceInstallConfig) (*argocdv1.AddonMarketplaceInstall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonMarketplaceInstall", ctx, instanceID, config)
+	ret0, _ := ret[0].(*argocdv1.AddonMarketplaceInstall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddonMarketplaceInstall indicates an expected call of AddonMarketplaceInstall.
+func (mr *MockClientMockRecorder) AddonMarketplaceInstall(ctx, instanceID, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonMarketplaceInstall", reflect.TypeOf((*MockClient)(nil).AddonMarketplaceInstall), ctx, instanceID, config)
+}
+
 // ApplyInstance mocks base method.
 func (m *MockClient) ApplyInstance(ctx context.Context, request *argocdv1.ApplyInstanceRequest) error {
 	m.ctrl.T.Helper()
@@ -73,310 +121,1563 @@ func (mr *MockClientMockRecorder) ApplyKargoInstance(ctx, request any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyKargoInstance", reflect.TypeOf((*MockClient)(nil).ApplyKargoInstance), ctx, request)
 }
 
-// DeleteCluster mocks base method.
-func (m *MockClient) DeleteCluster(ctx context.Context, instanceID, name string) error {
+// CreateCustomRole mocks base method.
+func (m *MockClient) CreateCustomRole(ctx context.Context, name, description, policy string) (*organizationv1.CustomRole, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCluster", ctx, instanceID, name)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateCustomRole", ctx, name, description, policy)
+	ret0, _ := ret[0].(*organizationv1.CustomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteCluster indicates an expected call of DeleteCluster.
-func (mr *MockClientMockRecorder) DeleteCluster(ctx, instanceID, name any) *gomock.Call {
+// CreateCustomRole indicates an expected call of CreateCustomRole.
+func (mr *MockClientMockRecorder) CreateCustomRole(ctx, name, description, policy any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCluster", reflect.TypeOf((*MockClient)(nil).DeleteCluster), ctx, instanceID, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRole", reflect.TypeOf((*MockClient)(nil).CreateCustomRole), ctx, name, description, policy)
 }
 
-// DeleteInstance mocks base method.
-func (m *MockClient) DeleteInstance(ctx context.Context, name string) error {
+// CreateInstanceAddonRepo mocks base method.
+func (m *MockClient) CreateInstanceAddonRepo(ctx context.Context, instanceID string, spec *argocdv1.RepoSpec) (*argocdv1.AddonRepo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteInstance", ctx, name)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateInstanceAddonRepo", ctx, instanceID, spec)
+	ret0, _ := ret[0].(*argocdv1.AddonRepo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteInstance indicates an expected call of DeleteInstance.
-func (mr *MockClientMockRecorder) DeleteInstance(ctx, name any) *gomock.Call {
+// CreateInstanceAddonRepo indicates an expected call of CreateInstanceAddonRepo.
+func (mr *MockClientMockRecorder) CreateInstanceAddonRepo(ctx, instanceID, spec any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstance", reflect.TypeOf((*MockClient)(nil).DeleteInstance), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceAddonRepo", reflect.TypeOf((*MockClient)(nil).CreateInstanceAddonRepo), ctx, instanceID, spec)
 }
 
-// DeleteKargoInstance mocks base method.
-func (m *MockClient) DeleteKargoInstance(ctx context.Context, name string) error {
+// CreateInstanceRepo mocks base method.
+func (m *MockClient) CreateInstanceRepo(ctx context.Context, instanceID string, data map[string]string) (*argocdv1.Repository, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteKargoInstance", ctx, name)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateInstanceRepo", ctx, instanceID, data)
+	ret0, _ := ret[0].(*argocdv1.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteKargoInstance indicates an expected call of DeleteKargoInstance.
-func (mr *MockClientMockRecorder) DeleteKargoInstance(ctx, name any) *gomock.Call {
+// CreateInstanceRepo indicates an expected call of CreateInstanceRepo.
+func (mr *MockClientMockRecorder) CreateInstanceRepo(ctx, instanceID, data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKargoInstance", reflect.TypeOf((*MockClient)(nil).DeleteKargoInstance), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceRepo", reflect.TypeOf((*MockClient)(nil).CreateInstanceRepo), ctx, instanceID, data)
 }
 
-// DeleteKargoInstanceAgent mocks base method.
-func (m *MockClient) DeleteKargoInstanceAgent(ctx context.Context, kargoInstanceID, agentName string) error {
+// CreateManagedSecret mocks base method.
+func (m *MockClient) CreateManagedSecret(ctx context.Context, instanceID string, secret *argocdv1.ManagedSecret, data map[string]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteKargoInstanceAgent", ctx, kargoInstanceID, agentName)
+	ret := m.ctrl.Call(m, "CreateManagedSecret", ctx, instanceID, secret, data)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteKargoInstanceAgent indicates an expected call of DeleteKargoInstanceAgent.
-func (mr *MockClientMockRecorder) DeleteKargoInstanceAgent(ctx, kargoInstanceID, agentName any) *gomock.Call {
+// CreateManagedSecret indicates an expected call of CreateManagedSecret.
+func (mr *MockClientMockRecorder) CreateManagedSecret(ctx, instanceID, secret, data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKargoInstanceAgent", reflect.TypeOf((*MockClient)(nil).DeleteKargoInstanceAgent), ctx, kargoInstanceID, agentName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManagedSecret", reflect.TypeOf((*MockClient)(nil).CreateManagedSecret), ctx, instanceID, secret, data)
 }
 
-// ExportInstance mocks base method.
-func (m *MockClient) ExportInstance(ctx context.Context, name string) (*argocdv1.ExportInstanceResponse, error) {
+// CreateNotificationConfig mocks base method.
+func (m *MockClient) CreateNotificationConfig(ctx context.Context, req *organizationv1.CreateNotificationConfigRequest) (*organizationv1.NotificationConfig, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExportInstance", ctx, name)
-	ret0, _ := ret[0].(*argocdv1.ExportInstanceResponse)
+	ret := m.ctrl.Call(m, "CreateNotificationConfig", ctx, req)
+	ret0, _ := ret[0].(*organizationv1.NotificationConfig)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ExportInstance indicates an expected call of ExportInstance.
-func (mr *MockClientMockRecorder) ExportInstance(ctx, name any) *gomock.Call {
+// CreateNotificationConfig indicates an expected call of CreateNotificationConfig.
+func (mr *MockClientMockRecorder) CreateNotificationConfig(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportInstance", reflect.TypeOf((*MockClient)(nil).ExportInstance), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationConfig", reflect.TypeOf((*MockClient)(nil).CreateNotificationConfig), ctx, req)
 }
 
-// ExportInstanceByID mocks base method.
-func (m *MockClient) ExportInstanceByID(ctx context.Context, id string) (*argocdv1.ExportInstanceResponse, error) {
+// CreateOrganizationAPIKey mocks base method.
+func (m *MockClient) CreateOrganizationAPIKey(ctx context.Context, description string, permissions *accesscontrolv1.Permissions, expireIn string) (*apikeyv1.APIKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExportInstanceByID", ctx, id)
-	ret0, _ := ret[0].(*argocdv1.ExportInstanceResponse)
+	ret := m.ctrl.Call(m, "CreateOrganizationAPIKey", ctx, description, permissions, expireIn)
+	ret0, _ := ret[0].(*apikeyv1.APIKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ExportInstanceByID indicates an expected call of ExportInstanceByID.
-func (mr *MockClientMockRecorder) ExportInstanceByID(ctx, id any) *gomock.Call {
+// CreateOrganizationAPIKey indicates an expected call of CreateOrganizationAPIKey.
+func (mr *MockClientMockRecorder) CreateOrganizationAPIKey(ctx, description, permissions, expireIn any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportInstanceByID", reflect.TypeOf((*MockClient)(nil).ExportInstanceByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationAPIKey", reflect.TypeOf((*MockClient)(nil).CreateOrganizationAPIKey), ctx, description, permissions, expireIn)
 }
 
-// ExportKargoInstance mocks base method.
-func (m *MockClient) ExportKargoInstance(ctx context.Context, name, workspaceID string) (*kargov1.ExportKargoInstanceResponse, error) {
+// CreateTeam mocks base method.
+func (m *MockClient) CreateTeam(ctx context.Context, name, description string, customRoles []string) (*organizationv1.UserTeam, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExportKargoInstance", ctx, name, workspaceID)
-	ret0, _ := ret[0].(*kargov1.ExportKargoInstanceResponse)
+	ret := m.ctrl.Call(m, "CreateTeam", ctx, name, description, customRoles)
+	ret0, _ := ret[0].(*organizationv1.UserTeam)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ExportKargoInstance indicates an expected call of ExportKargoInstance.
-func (mr *MockClientMockRecorder) ExportKargoInstance(ctx, name, workspaceID any) *gomock.Call {
+// CreateTeam indicates an expected call of CreateTeam.
+func (mr *MockClientMockRecorder) CreateTeam(ctx, name, description, customRoles any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportKargoInstance", reflect.TypeOf((*MockClient)(nil).ExportKargoInstance), ctx, name, workspaceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockClient)(nil).CreateTeam), ctx, name, description, customRoles)
 }
 
-// GetCluster mocks base method.
-func (m *MockClient) GetCluster(ctx context.Context, instanceID, name string) (*argocdv1.Cluster, error) {
+// CreateWorkspace mocks base method.
+func (m *MockClient) CreateWorkspace(ctx context.Context, name, description string) (*organizationv1.Workspace, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCluster", ctx, instanceID, name)
-	ret0, _ := ret[0].(*argocdv1.Cluster)
+	ret := m.ctrl.Call(m, "CreateWorkspace", ctx, name, description)
+	ret0, _ := ret[0].(*organizationv1.Workspace)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCluster indicates an expected call of GetCluster.
-func (mr *MockClientMockRecorder) GetCluster(ctx, instanceID, name any) *gomock.Call {
+// CreateWorkspace indicates an expected call of CreateWorkspace.
+func (mr *MockClientMockRecorder) CreateWorkspace(ctx, name, description any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCluster", reflect.TypeOf((*MockClient)(nil).GetCluster), ctx, instanceID, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspace", reflect.TypeOf((*MockClient)(nil).CreateWorkspace), ctx, name, description)
 }
 
-// GetClusterManifests mocks base method.
-func (m *MockClient) GetClusterManifests(ctx context.Context, instanceID, clusterName string) (string, error) {
+// CreateWorkspaceAPIKey mocks base method.
+func (m *MockClient) CreateWorkspaceAPIKey(ctx context.Context, workspaceID, description string, permissions *accesscontrolv1.Permissions, expireIn string) (*apikeyv1.APIKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetClusterManifests", ctx, instanceID, clusterName)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "CreateWorkspaceAPIKey", ctx, workspaceID, description, permissions, expireIn)
+	ret0, _ := ret[0].(*apikeyv1.APIKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetClusterManifests indicates an expected call of GetClusterManifests.
-func (mr *MockClientMockRecorder) GetClusterManifests(ctx, instanceID, clusterName any) *gomock.Call {
+// CreateWorkspaceAPIKey indicates an expected call of CreateWorkspaceAPIKey.
+func (mr *MockClientMockRecorder) CreateWorkspaceAPIKey(ctx, workspaceID, description, permissions, expireIn any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterManifests", reflect.TypeOf((*MockClient)(nil).GetClusterManifests), ctx, instanceID, clusterName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspaceAPIKey", reflect.TypeOf((*MockClient)(nil).CreateWorkspaceAPIKey), ctx, workspaceID, description, permissions, expireIn)
 }
 
-// GetClusterManifestsOnce mocks base method.
-func (m *MockClient) GetClusterManifestsOnce(ctx context.Context, instanceID, clusterID string) (string, error) {
+// CreateWorkspaceCustomRole mocks base method.
+func (m *MockClient) CreateWorkspaceCustomRole(ctx context.Context, workspaceID, name, description, policy string) (*organizationv1.CustomRole, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetClusterManifestsOnce", ctx, instanceID, clusterID)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "CreateWorkspaceCustomRole", ctx, workspaceID, name, description, policy)
+	ret0, _ := ret[0].(*organizationv1.CustomRole)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetClusterManifestsOnce indicates an expected call of GetClusterManifestsOnce.
-func (mr *MockClientMockRecorder) GetClusterManifestsOnce(ctx, instanceID, clusterID any) *gomock.Call {
+// CreateWorkspaceCustomRole indicates an expected call of CreateWorkspaceCustomRole.
+func (mr *MockClientMockRecorder) CreateWorkspaceCustomRole(ctx, workspaceID, name, description, policy any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterManifestsOnce", reflect.TypeOf((*MockClient)(nil).GetClusterManifestsOnce), ctx, instanceID, clusterID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspaceCustomRole", reflect.TypeOf((*MockClient)(nil).CreateWorkspaceCustomRole), ctx, workspaceID, name, description, policy)
 }
 
-// GetInstance mocks base method.
-func (m *MockClient) GetInstance(ctx context.Context, name string) (*argocdv1.Instance, error) {
+// DeleteAPIKey mocks base method.
+func (m *MockClient) DeleteAPIKey(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetInstance", ctx, name)
-	ret0, _ := ret[0].(*argocdv1.Instance)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteAPIKey", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetInstance indicates an expected call of GetInstance.
-func (mr *MockClientMockRecorder) GetInstance(ctx, name any) *gomock.Call {
+// DeleteAPIKey indicates an expected call of DeleteAPIKey.
+func (mr *MockClientMockRecorder) DeleteAPIKey(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstance", reflect.TypeOf((*MockClient)(nil).GetInstance), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIKey", reflect.TypeOf((*MockClient)(nil).DeleteAPIKey), ctx, id)
 }
 
-// GetInstanceByID mocks base method.
-func (m *MockClient) GetInstanceByID(ctx context.Context, id string) (*argocdv1.Instance, error) {
+// DeleteAddonMarketplaceInstall mocks base method.
+func (m *MockClient) DeleteAddonMarketplaceInstall(ctx context.Context, instanceID, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetInstanceByID", ctx, id)
-	ret0, _ := ret[0].(*argocdv1.Instance)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteAddonMarketplaceInstall", ctx, instanceID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetInstanceByID indicates an expected call of GetInstanceByID.
-func (mr *MockClientMockRecorder) GetInstanceByID(ctx, id any) *gomock.Call {
+// DeleteAddonMarketplaceInstall indicates an expected call of DeleteAddonMarketplaceInstall.
+func (mr *MockClientMockRecorder) DeleteAddonMarketplaceInstall(ctx, instanceID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceByID", reflect.TypeOf((*MockClient)(nil).GetInstanceByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAddonMarketplaceInstall", reflect.TypeOf((*MockClient)(nil).DeleteAddonMarketplaceInstall), ctx, instanceID, id)
 }
 
-// GetKargoInstance mocks base method.
-func (m *MockClient) GetKargoInstance(ctx context.Context, name string) (*kargov1.KargoInstance, error) {
+// DeleteCluster mocks base method.
+func (m *MockClient) DeleteCluster(ctx context.Context, instanceID, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKargoInstance", ctx, name)
-	ret0, _ := ret[0].(*kargov1.KargoInstance)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteCluster", ctx, instanceID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetKargoInstance indicates an expected call of GetKargoInstance.
-func (mr *MockClientMockRecorder) GetKargoInstance(ctx, name any) *gomock.Call {
+// DeleteCluster indicates an expected call of DeleteCluster.
+func (mr *MockClientMockRecorder) DeleteCluster(ctx, instanceID, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKargoInstance", reflect.TypeOf((*MockClient)(nil).GetKargoInstance), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCluster", reflect.TypeOf((*MockClient)(nil).DeleteCluster), ctx, instanceID, name)
 }
 
-// GetKargoInstanceAgent mocks base method.
-func (m *MockClient) GetKargoInstanceAgent(ctx context.Context, kargoInstanceID, agentName string) (*kargov1.KargoAgent, error) {
+// DeleteCustomRole mocks base method.
+func (m *MockClient) DeleteCustomRole(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKargoInstanceAgent", ctx, kargoInstanceID, agentName)
-	ret0, _ := ret[0].(*kargov1.KargoAgent)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteCustomRole", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetKargoInstanceAgent indicates an expected call of GetKargoInstanceAgent.
-func (mr *MockClientMockRecorder) GetKargoInstanceAgent(ctx, kargoInstanceID, agentName any) *gomock.Call {
+// DeleteCustomRole indicates an expected call of DeleteCustomRole.
+func (mr *MockClientMockRecorder) DeleteCustomRole(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKargoInstanceAgent", reflect.TypeOf((*MockClient)(nil).GetKargoInstanceAgent), ctx, kargoInstanceID, agentName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRole", reflect.TypeOf((*MockClient)(nil).DeleteCustomRole), ctx, id)
 }
 
-// GetKargoInstanceAgentManifests mocks base method.
-func (m *MockClient) GetKargoInstanceAgentManifests(ctx context.Context, kargoInstanceID, agentName string) (string, error) {
+// DeleteInstance mocks base method.
+func (m *MockClient) DeleteInstance(ctx context.Context, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKargoInstanceAgentManifests", ctx, kargoInstanceID, agentName)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteInstance", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetKargoInstanceAgentManifests indicates an expected call of GetKargoInstanceAgentManifests.
-func (mr *MockClientMockRecorder) GetKargoInstanceAgentManifests(ctx, kargoInstanceID, agentName any) *gomock.Call {
+// DeleteInstance indicates an expected call of DeleteInstance.
+func (mr *MockClientMockRecorder) DeleteInstance(ctx, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKargoInstanceAgentManifests", reflect.TypeOf((*MockClient)(nil).GetKargoInstanceAgentManifests), ctx, kargoInstanceID, agentName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstance", reflect.TypeOf((*MockClient)(nil).DeleteInstance), ctx, name)
 }
 
-// GetKargoInstanceAgentManifestsOnce mocks base method.
-func (m *MockClient) GetKargoInstanceAgentManifestsOnce(ctx context.Context, kargoInstanceID, agentID string) (string, error) {
+// DeleteInstanceAccount mocks base method.
+func (m *MockClient) DeleteInstanceAccount(ctx context.Context, instanceID, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKargoInstanceAgentManifestsOnce", ctx, kargoInstanceID, agentID)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteInstanceAccount", ctx, instanceID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetKargoInstanceAgentManifestsOnce indicates an expected call of GetKargoInstanceAgentManifestsOnce.
-func (mr *MockClientMockRecorder) GetKargoInstanceAgentManifestsOnce(ctx, kargoInstanceID, agentID any) *gomock.Call {
+// DeleteInstanceAccount indicates an expected call of DeleteInstanceAccount.
+func (mr *MockClientMockRecorder) DeleteInstanceAccount(ctx, instanceID, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKargoInstanceAgentManifestsOnce", reflect.TypeOf((*MockClient)(nil).GetKargoInstanceAgentManifestsOnce), ctx, kargoInstanceID, agentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceAccount", reflect.TypeOf((*MockClient)(nil).DeleteInstanceAccount), ctx, instanceID, name)
 }
 
-// GetKargoInstanceByID mocks base method.
-func (m *MockClient) GetKargoInstanceByID(ctx context.Context, id string) (*kargov1.KargoInstance, error) {
+// DeleteInstanceAddon mocks base method.
+func (m *MockClient) DeleteInstanceAddon(ctx context.Context, instanceID, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKargoInstanceByID", ctx, id)
-	ret0, _ := ret[0].(*kargov1.KargoInstance)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteInstanceAddon", ctx, instanceID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetKargoInstanceByID indicates an expected call of GetKargoInstanceByID.
-func (mr *MockClientMockRecorder) GetKargoInstanceByID(ctx, id any) *gomock.Call {
+// DeleteInstanceAddon indicates an expected call of DeleteInstanceAddon.
+func (mr *MockClientMockRecorder) DeleteInstanceAddon(ctx, instanceID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKargoInstanceByID", reflect.TypeOf((*MockClient)(nil).GetKargoInstanceByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceAddon", reflect.TypeOf((*MockClient)(nil).DeleteInstanceAddon), ctx, instanceID, id)
 }
 
-// PatchInstance mocks base method.
-func (m *MockClient) PatchInstance(ctx context.Context, id string, patch *structpb.Struct) error {
+// DeleteInstanceAddonRepo mocks base method.
+func (m *MockClient) DeleteInstanceAddonRepo(ctx context.Context, instanceID, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PatchInstance", ctx, id, patch)
+	ret := m.ctrl.Call(m, "DeleteInstanceAddonRepo", ctx, instanceID, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PatchInstance indicates an expected call of PatchInstance.
-func (mr *MockClientMockRecorder) PatchInstance(ctx, id, patch any) *gomock.Call {
+// DeleteInstanceAddonRepo indicates an expected call of DeleteInstanceAddonRepo.
+func (mr *MockClientMockRecorder) DeleteInstanceAddonRepo(ctx, instanceID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchInstance", reflect.TypeOf((*MockClient)(nil).PatchInstance), ctx, id, patch)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceAddonRepo", reflect.TypeOf((*MockClient)(nil).DeleteInstanceAddonRepo), ctx, instanceID, id)
 }
 
-// PatchKargoInstance mocks base method.
-func (m *MockClient) PatchKargoInstance(ctx context.Context, id string, patch *structpb.Struct) error {
+// DeleteKargoInstance mocks base method.
+func (m *MockClient) DeleteKargoInstance(ctx context.Context, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PatchKargoInstance", ctx, id, patch)
+	ret := m.ctrl.Call(m, "DeleteKargoInstance", ctx, name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PatchKargoInstance indicates an expected call of PatchKargoInstance.
-func (mr *MockClientMockRecorder) PatchKargoInstance(ctx, id, patch any) *gomock.Call {
+// DeleteKargoInstance indicates an expected call of DeleteKargoInstance.
+func (mr *MockClientMockRecorder) DeleteKargoInstance(ctx, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchKargoInstance", reflect.TypeOf((*MockClient)(nil).PatchKargoInstance), ctx, id, patch)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKargoInstance", reflect.TypeOf((*MockClient)(nil).DeleteKargoInstance), ctx, name)
 }
 
-// ResolveWorkspace mocks base method.
-func (m *MockClient) ResolveWorkspace(ctx context.Context, name string) (*organizationv1.Workspace, error) {
+// DeleteKargoInstanceAgent mocks base method.
+func (m *MockClient) DeleteKargoInstanceAgent(ctx context.Context, kargoInstanceID, agentName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResolveWorkspace", ctx, name)
-	ret0, _ := ret[0].(*organizationv1.Workspace)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteKargoInstanceAgent", ctx, kargoInstanceID, agentName)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ResolveWorkspace indicates an expected call of ResolveWorkspace.
-func (mr *MockClientMockRecorder) ResolveWorkspace(ctx, name any) *gomock.Call {
+// DeleteKargoInstanceAgent indicates an expected call of DeleteKargoInstanceAgent.
+func (mr *MockClientMockRecorder) DeleteKargoInstanceAgent(ctx, kargoInstanceID, agentName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveWorkspace", reflect.TypeOf((*MockClient)(nil).ResolveWorkspace), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKargoInstanceAgent", reflect.TypeOf((*MockClient)(nil).DeleteKargoInstanceAgent), ctx, kargoInstanceID, agentName)
 }
 
-// SetClusterMaintenanceMode mocks base method.
-func (m *MockClient) SetClusterMaintenanceMode(ctx context.Context, instanceID, clusterName string, mode bool, expiry *time.Time) error {
+// DeleteManagedSecret mocks base method.
+func (m *MockClient) DeleteManagedSecret(ctx context.Context, instanceID, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetClusterMaintenanceMode", ctx, instanceID, clusterName, mode, expiry)
+	ret := m.ctrl.Call(m, "DeleteManagedSecret", ctx, instanceID, name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SetClusterMaintenanceMode indicates an expected call of SetClusterMaintenanceMode.
-func (mr *MockClientMockRecorder) SetClusterMaintenanceMode(ctx, instanceID, clusterName, mode, expiry any) *gomock.Call {
+// DeleteManagedSecret indicates an expected call of DeleteManagedSecret.
+func (mr *MockClientMockRecorder) DeleteManagedSecret(ctx, instanceID, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClusterMaintenanceMode", reflect.TypeOf((*MockClient)(nil).SetClusterMaintenanceMode), ctx, instanceID, clusterName, mode, expiry)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManagedSecret", reflect.TypeOf((*MockClient)(nil).DeleteManagedSecret), ctx, instanceID, name)
+}
+
+// DeleteNotificationConfig mocks base method.
+func (m *MockClient) DeleteNotificationConfig(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationConfig", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationConfig indicates an expected call of DeleteNotificationConfig.
+func (mr *MockClientMockRecorder) DeleteNotificationConfig(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationConfig", reflect.TypeOf((*MockClient)(nil).DeleteNotificationConfig), ctx, id)
+}
+
+// DeleteSSOConfiguration mocks base method.
+func (m *MockClient) DeleteSSOConfiguration(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSSOConfiguration", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSSOConfiguration indicates an expected call of DeleteSSOConfiguration.
+func (mr *MockClientMockRecorder) DeleteSSOConfiguration(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSSOConfiguration", reflect.TypeOf((*MockClient)(nil).DeleteSSOConfiguration), ctx)
+}
+
+// DeleteTeam mocks base method.
+func (m *MockClient) DeleteTeam(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeam", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTeam indicates an expected call of DeleteTeam.
+func (mr *MockClientMockRecorder) DeleteTeam(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeam", reflect.TypeOf((*MockClient)(nil).DeleteTeam), ctx, name)
+}
+
+// DeleteWorkspace mocks base method.
+func (m *MockClient) DeleteWorkspace(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspace", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkspace indicates an expected call of DeleteWorkspace.
+func (mr *MockClientMockRecorder) DeleteWorkspace(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspace", reflect.TypeOf((*MockClient)(nil).DeleteWorkspace), ctx, id)
+}
+
+// DeleteWorkspaceAPIKey mocks base method.
+func (m *MockClient) DeleteWorkspaceAPIKey(ctx context.Context, workspaceID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspaceAPIKey", ctx, workspaceID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkspaceAPIKey indicates an expected call of DeleteWorkspaceAPIKey.
+func (mr *MockClientMockRecorder) DeleteWorkspaceAPIKey(ctx, workspaceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceAPIKey", reflect.TypeOf((*MockClient)(nil).DeleteWorkspaceAPIKey), ctx, workspaceID, id)
+}
+
+// DeleteWorkspaceCustomRole mocks base method.
+func (m *MockClient) DeleteWorkspaceCustomRole(ctx context.Context, workspaceID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspaceCustomRole", ctx, workspaceID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkspaceCustomRole indicates an expected call of DeleteWorkspaceCustomRole.
+func (mr *MockClientMockRecorder) DeleteWorkspaceCustomRole(ctx, workspaceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceCustomRole", reflect.TypeOf((*MockClient)(nil).DeleteWorkspaceCustomRole), ctx, workspaceID, id)
+}
+
+// EnsureSSOConfiguration mocks base method.
+func (m *MockClient) EnsureSSOConfiguration(ctx context.Context, req *organizationv1.EnsureSSOConfigurationRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureSSOConfiguration", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureSSOConfiguration indicates an expected call of EnsureSSOConfiguration.
+func (mr *MockClientMockRecorder) EnsureSSOConfiguration(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureSSOConfiguration", reflect.TypeOf((*MockClient)(nil).EnsureSSOConfiguration), ctx, req)
+}
+
+// ExportInstance mocks base method.
+func (m *MockClient) ExportInstance(ctx context.Context, name string) (*argocdv1.ExportInstanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportInstance", ctx, name)
+	ret0, _ := ret[0].(*argocdv1.ExportInstanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportInstance indicates an expected call of ExportInstance.
+func (mr *MockClientMockRecorder) ExportInstance(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportInstance", reflect.TypeOf((*MockClient)(nil).ExportInstance), ctx, name)
+}
+
+// ExportInstanceByID mocks base method.
+func (m *MockClient) ExportInstanceByID(ctx context.Context, id string) (*argocdv1.ExportInstanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportInstanceByID", ctx, id)
+	ret0, _ := ret[0].(*argocdv1.ExportInstanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportInstanceByID indicates an expected call of ExportInstanceByID.
+func (mr *MockClientMockRecorder) ExportInstanceByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportInstanceByID", reflect.TypeOf((*MockClient)(nil).ExportInstanceByID), ctx, id)
+}
+
+// ExportKargoInstance mocks base method.
+func (m *MockClient) ExportKargoInstance(ctx context.Context, name, workspaceID string) (*kargov1.ExportKargoInstanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportKargoInstance", ctx, name, workspaceID)
+	ret0, _ := ret[0].(*kargov1.ExportKargoInstanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportKargoInstance indicates an expected call of ExportKargoInstance.
+func (mr *MockClientMockRecorder) ExportKargoInstance(ctx, name, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportKargoInstance", reflect.TypeOf((*MockClient)(nil).ExportKargoInstance), ctx, name, workspaceID)
+}
+
+// GetAPIKey mocks base method.
+func (m *MockClient) GetAPIKey(ctx context.Context, id string) (*apikeyv1.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKey", ctx, id)
+	ret0, _ := ret[0].(*apikeyv1.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKey indicates an expected call of GetAPIKey.
+func (mr *MockClientMockRecorder) GetAPIKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKey", reflect.TypeOf((*MockClient)(nil).GetAPIKey), ctx, id)
+}
+
+// GetAddonMarketplaceInstall mocks base method.
+func (m *MockClient) GetAddonMarketplaceInstall(ctx context.Context, instanceID, id string) (*argocdv1.AddonMarketplaceInstall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddonMarketplaceInstall", ctx, instanceID, id)
+	ret0, _ := ret[0].(*argocdv1.AddonMarketplaceInstall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddonMarketplaceInstall indicates an expected call of GetAddonMarketplaceInstall.
+func (mr *MockClientMockRecorder) GetAddonMarketplaceInstall(ctx, instanceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddonMarketplaceInstall", reflect.TypeOf((*MockClient)(nil).GetAddonMarketplaceInstall), ctx, instanceID, id)
+}
+
+// GetCluster mocks base method.
+func (m *MockClient) GetCluster(ctx context.Context, instanceID, name string) (*argocdv1.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCluster", ctx, instanceID, name)
+	ret0, _ := ret[0].(*argocdv1.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCluster indicates an expected call of GetCluster.
+func (mr *MockClientMockRecorder) GetCluster(ctx, instanceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCluster", reflect.TypeOf((*MockClient)(nil).GetCluster), ctx, instanceID, name)
+}
+
+// GetClusterAPIServerCAData mocks base method.
+func (m *MockClient) GetClusterAPIServerCAData(ctx context.Context, instanceID, clusterName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterAPIServerCAData", ctx, instanceID, clusterName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterAPIServerCAData indicates an expected call of GetClusterAPIServerCAData.
+func (mr *MockClientMockRecorder) GetClusterAPIServerCAData(ctx, instanceID, clusterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterAPIServerCAData", reflect.TypeOf((*MockClient)(nil).GetClusterAPIServerCAData), ctx, instanceID, clusterName)
+}
+
+// GetClusterInfo mocks base method.
+func (m *MockClient) GetClusterInfo(ctx context.Context, instanceID, clusterName string) (*argocdv1.GetInstanceClusterInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterInfo", ctx, instanceID, clusterName)
+	ret0, _ := ret[0].(*argocdv1.GetInstanceClusterInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterInfo indicates an expected call of GetClusterInfo.
+func (mr *MockClientMockRecorder) GetClusterInfo(ctx, instanceID, clusterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterInfo", reflect.TypeOf((*MockClient)(nil).GetClusterInfo), ctx, instanceID, clusterName)
+}
+
+// GetClusterInstallCommand mocks base method.
+func (m *MockClient) GetClusterInstallCommand(ctx context.Context, instanceID, clusterID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterInstallCommand", ctx, instanceID, clusterID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterInstallCommand indicates an expected call of GetClusterInstallCommand.
+func (mr *MockClientMockRecorder) GetClusterInstallCommand(ctx, instanceID, clusterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterInstallCommand", reflect.TypeOf((*MockClient)(nil).GetClusterInstallCommand), ctx, instanceID, clusterID)
+}
+
+// GetClusterManifests mocks base method.
+func (m *MockClient) GetClusterManifests(ctx context.Context, instanceID, clusterName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterManifests", ctx, instanceID, clusterName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterManifests indicates an expected call of GetClusterManifests.
+func (mr *MockClientMockRecorder) GetClusterManifests(ctx, instanceID, clusterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterManifests", reflect.TypeOf((*MockClient)(nil).GetClusterManifests), ctx, instanceID, clusterName)
+}
+
+// GetClusterManifestsOnce mocks base method.
+func (m *MockClient) GetClusterManifestsOnce(ctx context.Context, instanceID, clusterID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterManifestsOnce", ctx, instanceID, clusterID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterManifestsOnce indicates an expected call of GetClusterManifestsOnce.
+func (mr *MockClientMockRecorder) GetClusterManifestsOnce(ctx, instanceID, clusterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterManifestsOnce", reflect.TypeOf((*MockClient)(nil).GetClusterManifestsOnce), ctx, instanceID, clusterID)
+}
+
+// GetCustomRole mocks base method.
+func (m *MockClient) GetCustomRole(ctx context.Context, id string) (*organizationv1.CustomRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomRole", ctx, id)
+	ret0, _ := ret[0].(*organizationv1.CustomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomRole indicates an expected call of GetCustomRole.
+func (mr *MockClientMockRecorder) GetCustomRole(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRole", reflect.TypeOf((*MockClient)(nil).GetCustomRole), ctx, id)
+}
+
+// GetFeatureStatuses mocks base method.
+func (m *MockClient) GetFeatureStatuses(ctx context.Context) (*featuresv1.FeatureStatuses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeatureStatuses", ctx)
+	ret0, _ := ret[0].(*featuresv1.FeatureStatuses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeatureStatuses indicates an expected call of GetFeatureStatuses.
+func (mr *MockClientMockRecorder) GetFeatureStatuses(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeatureStatuses", reflect.TypeOf((*MockClient)(nil).GetFeatureStatuses), ctx)
+}
+
+// GetInstance mocks base method.
+func (m *MockClient) GetInstance(ctx context.Context, name string) (*argocdv1.Instance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstance", ctx, name)
+	ret0, _ := ret[0].(*argocdv1.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstance indicates an expected call of GetInstance.
+func (mr *MockClientMockRecorder) GetInstance(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstance", reflect.TypeOf((*MockClient)(nil).GetInstance), ctx, name)
+}
+
+// GetInstanceAccount mocks base method.
+func (m *MockClient) GetInstanceAccount(ctx context.Context, instanceID, name string) (*argocdv1.InstanceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceAccount", ctx, instanceID, name)
+	ret0, _ := ret[0].(*argocdv1.InstanceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceAccount indicates an expected call of GetInstanceAccount.
+func (mr *MockClientMockRecorder) GetInstanceAccount(ctx, instanceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceAccount", reflect.TypeOf((*MockClient)(nil).GetInstanceAccount), ctx, instanceID, name)
+}
+
+// GetInstanceAddon mocks base method.
+func (m *MockClient) GetInstanceAddon(ctx context.Context, instanceID, id string) (*argocdv1.Addon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceAddon", ctx, instanceID, id)
+	ret0, _ := ret[0].(*argocdv1.Addon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceAddon indicates an expected call of GetInstanceAddon.
+func (mr *MockClientMockRecorder) GetInstanceAddon(ctx, instanceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceAddon", reflect.TypeOf((*MockClient)(nil).GetInstanceAddon), ctx, instanceID, id)
+}
+
+// GetInstanceAddonRepo mocks base method.
+func (m *MockClient) GetInstanceAddonRepo(ctx context.Context, instanceID, id string) (*argocdv1.AddonRepo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceAddonRepo", ctx, instanceID, id)
+	ret0, _ := ret[0].(*argocdv1.AddonRepo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceAddonRepo indicates an expected call of GetInstanceAddonRepo.
+func (mr *MockClientMockRecorder) GetInstanceAddonRepo(ctx, instanceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceAddonRepo", reflect.TypeOf((*MockClient)(nil).GetInstanceAddonRepo), ctx, instanceID, id)
+}
+
+// GetInstanceByID mocks base method.
+func (m *MockClient) GetInstanceByID(ctx context.Context, id string) (*argocdv1.Instance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceByID", ctx, id)
+	ret0, _ := ret[0].(*argocdv1.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceByID indicates an expected call of GetInstanceByID.
+func (mr *MockClientMockRecorder) GetInstanceByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceByID", reflect.TypeOf((*MockClient)(nil).GetInstanceByID), ctx, id)
+}
+
+// GetInstanceCSS mocks base method.
+func (m *MockClient) GetInstanceCSS(ctx context.Context, instanceID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceCSS", ctx, instanceID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceCSS indicates an expected call of GetInstanceCSS.
+func (mr *MockClientMockRecorder) GetInstanceCSS(ctx, instanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceCSS", reflect.TypeOf((*MockClient)(nil).GetInstanceCSS), ctx, instanceID)
+}
+
+// GetInstanceRepo mocks base method.
+func (m *MockClient) GetInstanceRepo(ctx context.Context, instanceID, url string) (*argocdv1.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceRepo", ctx, instanceID, url)
+	ret0, _ := ret[0].(*argocdv1.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceRepo indicates an expected call of GetInstanceRepo.
+func (mr *MockClientMockRecorder) GetInstanceRepo(ctx, instanceID, url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceRepo", reflect.TypeOf((*MockClient)(nil).GetInstanceRepo), ctx, instanceID, url)
+}
+
+// GetInstanceResourceCustomizations mocks base method.
+func (m *MockClient) GetInstanceResourceCustomizations(ctx context.Context, instanceID string) (*argocdv1.GetInstanceResourceCustomizationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceResourceCustomizations", ctx, instanceID)
+	ret0, _ := ret[0].(*argocdv1.GetInstanceResourceCustomizationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceResourceCustomizations indicates an expected call of GetInstanceResourceCustomizations.
+func (mr *MockClientMockRecorder) GetInstanceResourceCustomizations(ctx, instanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceResourceCustomizations", reflect.TypeOf((*MockClient)(nil).GetInstanceResourceCustomizations), ctx, instanceID)
+}
+
+// GetKargoInstance mocks base method.
+func (m *MockClient) GetKargoInstance(ctx context.Context, name string) (*kargov1.KargoInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKargoInstance", ctx, name)
+	ret0, _ := ret[0].(*kargov1.KargoInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKargoInstance indicates an expected call of GetKargoInstance.
+func (mr *MockClientMockRecorder) GetKargoInstance(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKargoInstance", reflect.TypeOf((*MockClient)(nil).GetKargoInstance), ctx, name)
+}
+
+// GetKargoInstanceAgent mocks base method.
+func (m *MockClient) GetKargoInstanceAgent(ctx context.Context, kargoInstanceID, agentName string) (*kargov1.KargoAgent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKargoInstanceAgent", ctx, kargoInstanceID, agentName)
+	ret0, _ := ret[0].(*kargov1.KargoAgent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKargoInstanceAgent indicates an expected call of GetKargoInstanceAgent.
+func (mr *MockClientMockRecorder) GetKargoInstanceAgent(ctx, kargoInstanceID, agentName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKargoInstanceAgent", reflect.TypeOf((*MockClient)(nil).GetKargoInstanceAgent), ctx, kargoInstanceID, agentName)
+}
+
+// GetKargoInstanceAgentManifests mocks base method.
+func (m *MockClient) GetKargoInstanceAgentManifests(ctx context.Context, kargoInstanceID, agentName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKargoInstanceAgentManifests", ctx, kargoInstanceID, agentName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKargoInstanceAgentManifests indicates an expected call of GetKargoInstanceAgentManifests.
+func (mr *MockClientMockRecorder) GetKargoInstanceAgentManifests(ctx, kargoInstanceID, agentName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKargoInstanceAgentManifests", reflect.TypeOf((*MockClient)(nil).GetKargoInstanceAgentManifests), ctx, kargoInstanceID, agentName)
+}
+
+// GetKargoInstanceAgentManifestsOnce mocks base method.
+func (m *MockClient) GetKargoInstanceAgentManifestsOnce(ctx context.Context, kargoInstanceID, agentID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKargoInstanceAgentManifestsOnce", ctx, kargoInstanceID, agentID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKargoInstanceAgentManifestsOnce indicates an expected call of GetKargoInstanceAgentManifestsOnce.
+func (mr *MockClientMockRecorder) GetKargoInstanceAgentManifestsOnce(ctx, kargoInstanceID, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKargoInstanceAgentManifestsOnce", reflect.TypeOf((*MockClient)(nil).GetKargoInstanceAgentManifestsOnce), ctx, kargoInstanceID, agentID)
+}
+
+// GetKargoInstanceByID mocks base method.
+func (m *MockClient) GetKargoInstanceByID(ctx context.Context, id string) (*kargov1.KargoInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKargoInstanceByID", ctx, id)
+	ret0, _ := ret[0].(*kargov1.KargoInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKargoInstanceByID indicates an expected call of GetKargoInstanceByID.
+func (mr *MockClientMockRecorder) GetKargoInstanceByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKargoInstanceByID", reflect.TypeOf((*MockClient)(nil).GetKargoInstanceByID), ctx, id)
+}
+
+// GetManagedSecret mocks base method.
+func (m *MockClient) GetManagedSecret(ctx context.Context, instanceID, name string) (*argocdv1.ManagedSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManagedSecret", ctx, instanceID, name)
+	ret0, _ := ret[0].(*argocdv1.ManagedSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManagedSecret indicates an expected call of GetManagedSecret.
+func (mr *MockClientMockRecorder) GetManagedSecret(ctx, instanceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagedSecret", reflect.TypeOf((*MockClient)(nil).GetManagedSecret), ctx, instanceID, name)
+}
+
+// GetNotificationConfig mocks base method.
+func (m *MockClient) GetNotificationConfig(ctx context.Context, id string) (*organizationv1.NotificationConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationConfig", ctx, id)
+	ret0, _ := ret[0].(*organizationv1.NotificationConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationConfig indicates an expected call of GetNotificationConfig.
+func (mr *MockClientMockRecorder) GetNotificationConfig(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationConfig", reflect.TypeOf((*MockClient)(nil).GetNotificationConfig), ctx, id)
+}
+
+// GetOIDCMap mocks base method.
+func (m *MockClient) GetOIDCMap(ctx context.Context) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOIDCMap", ctx)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOIDCMap indicates an expected call of GetOIDCMap.
+func (mr *MockClientMockRecorder) GetOIDCMap(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOIDCMap", reflect.TypeOf((*MockClient)(nil).GetOIDCMap), ctx)
+}
+
+// GetOrganizationQuota mocks base method.
+func (m *MockClient) GetOrganizationQuota(ctx context.Context) (*featuresv1.OrganizationQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationQuota", ctx)
+	ret0, _ := ret[0].(*featuresv1.OrganizationQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationQuota indicates an expected call of GetOrganizationQuota.
+func (mr *MockClientMockRecorder) GetOrganizationQuota(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationQuota", reflect.TypeOf((*MockClient)(nil).GetOrganizationQuota), ctx)
+}
+
+// GetSSOConfiguration mocks base method.
+func (m *MockClient) GetSSOConfiguration(ctx context.Context) (*organizationv1.GetSSOConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSSOConfiguration", ctx)
+	ret0, _ := ret[0].(*organizationv1.GetSSOConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSSOConfiguration indicates an expected call of GetSSOConfiguration.
+func (mr *MockClientMockRecorder) GetSSOConfiguration(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSOConfiguration", reflect.TypeOf((*MockClient)(nil).GetSSOConfiguration), ctx)
+}
+
+// GetTeam mocks base method.
+func (m *MockClient) GetTeam(ctx context.Context, name string) (*organizationv1.UserTeam, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeam", ctx, name)
+	ret0, _ := ret[0].(*organizationv1.UserTeam)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeam indicates an expected call of GetTeam.
+func (mr *MockClientMockRecorder) GetTeam(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockClient)(nil).GetTeam), ctx, name)
+}
+
+// GetTeamMember mocks base method.
+func (m *MockClient) GetTeamMember(ctx context.Context, teamName, id string) (*organizationv1.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamMember", ctx, teamName, id)
+	ret0, _ := ret[0].(*organizationv1.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamMember indicates an expected call of GetTeamMember.
+func (mr *MockClientMockRecorder) GetTeamMember(ctx, teamName, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamMember", reflect.TypeOf((*MockClient)(nil).GetTeamMember), ctx, teamName, id)
+}
+
+// GetTeamOIDCMap mocks base method.
+func (m *MockClient) GetTeamOIDCMap(ctx context.Context) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamOIDCMap", ctx)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamOIDCMap indicates an expected call of GetTeamOIDCMap.
+func (mr *MockClientMockRecorder) GetTeamOIDCMap(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamOIDCMap", reflect.TypeOf((*MockClient)(nil).GetTeamOIDCMap), ctx)
+}
+
+// GetWorkspace mocks base method.
+func (m *MockClient) GetWorkspace(ctx context.Context, id string) (*organizationv1.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspace", ctx, id)
+	ret0, _ := ret[0].(*organizationv1.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspace indicates an expected call of GetWorkspace.
+func (mr *MockClientMockRecorder) GetWorkspace(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspace", reflect.TypeOf((*MockClient)(nil).GetWorkspace), ctx, id)
+}
+
+// GetWorkspaceAPIKey mocks base method.
+func (m *MockClient) GetWorkspaceAPIKey(ctx context.Context, workspaceID, id string) (*apikeyv1.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceAPIKey", ctx, workspaceID, id)
+	ret0, _ := ret[0].(*apikeyv1.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceAPIKey indicates an expected call of GetWorkspaceAPIKey.
+func (mr *MockClientMockRecorder) GetWorkspaceAPIKey(ctx, workspaceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceAPIKey", reflect.TypeOf((*MockClient)(nil).GetWorkspaceAPIKey), ctx, workspaceID, id)
+}
+
+// GetWorkspaceCustomRole mocks base method.
+func (m *MockClient) GetWorkspaceCustomRole(ctx context.Context, workspaceID, id string) (*organizationv1.CustomRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceCustomRole", ctx, workspaceID, id)
+	ret0, _ := ret[0].(*organizationv1.CustomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceCustomRole indicates an expected call of GetWorkspaceCustomRole.
+func (mr *MockClientMockRecorder) GetWorkspaceCustomRole(ctx, workspaceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceCustomRole", reflect.TypeOf((*MockClient)(nil).GetWorkspaceCustomRole), ctx, workspaceID, id)
+}
+
+// GetWorkspaceMember mocks base method.
+func (m *MockClient) GetWorkspaceMember(ctx context.Context, workspaceID, id string) (*organizationv1.WorkspaceMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceMember", ctx, workspaceID, id)
+	ret0, _ := ret[0].(*organizationv1.WorkspaceMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceMember indicates an expected call of GetWorkspaceMember.
+func (mr *MockClientMockRecorder) GetWorkspaceMember(ctx, workspaceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceMember", reflect.TypeOf((*MockClient)(nil).GetWorkspaceMember), ctx, workspaceID, id)
+}
+
+// ListArgocdInstancesQuota mocks base method.
+func (m *MockClient) ListArgocdInstancesQuota(ctx context.Context) ([]*organizationv1.InstanceQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArgocdInstancesQuota", ctx)
+	ret0, _ := ret[0].([]*organizationv1.InstanceQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArgocdInstancesQuota indicates an expected call of ListArgocdInstancesQuota.
+func (mr *MockClientMockRecorder) ListArgocdInstancesQuota(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArgocdInstancesQuota", reflect.TypeOf((*MockClient)(nil).ListArgocdInstancesQuota), ctx)
+}
+
+// ListInstanceAddonErrors mocks base method.
+func (m *MockClient) ListInstanceAddonErrors(ctx context.Context, instanceID, id string) (map[string]*argocdv1.AddonErrorList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceAddonErrors", ctx, instanceID, id)
+	ret0, _ := ret[0].(map[string]*argocdv1.AddonErrorList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstanceAddonErrors indicates an expected call of ListInstanceAddonErrors.
+func (mr *MockClientMockRecorder) ListInstanceAddonErrors(ctx, instanceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceAddonErrors", reflect.TypeOf((*MockClient)(nil).ListInstanceAddonErrors), ctx, instanceID, id)
+}
+
+// ListInstanceAddons mocks base method.
+func (m *MockClient) ListInstanceAddons(ctx context.Context, instanceID, name string) ([]*argocdv1.Addon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceAddons", ctx, instanceID, name)
+	ret0, _ := ret[0].([]*argocdv1.Addon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstanceAddons indicates an expected call of ListInstanceAddons.
+func (mr *MockClientMockRecorder) ListInstanceAddons(ctx, instanceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceAddons", reflect.TypeOf((*MockClient)(nil).ListInstanceAddons), ctx, instanceID, name)
+}
+
+// ListKargoInstancesQuota mocks base method.
+func (m *MockClient) ListKargoInstancesQuota(ctx context.Context) ([]*organizationv1.KargoInstanceQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKargoInstancesQuota", ctx)
+	ret0, _ := ret[0].([]*organizationv1.KargoInstanceQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKargoInstancesQuota indicates an expected call of ListKargoInstancesQuota.
+func (mr *MockClientMockRecorder) ListKargoInstancesQuota(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKargoInstancesQuota", reflect.TypeOf((*MockClient)(nil).ListKargoInstancesQuota), ctx)
+}
+
+// ListNotificationDeliveryHistory mocks base method.
+func (m *MockClient) ListNotificationDeliveryHistory(ctx context.Context, id string, limit int64) ([]*organizationv1.NotificationDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationDeliveryHistory", ctx, id, limit)
+	ret0, _ := ret[0].([]*organizationv1.NotificationDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationDeliveryHistory indicates an expected call of ListNotificationDeliveryHistory.
+func (mr *MockClientMockRecorder) ListNotificationDeliveryHistory(ctx, id, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationDeliveryHistory", reflect.TypeOf((*MockClient)(nil).ListNotificationDeliveryHistory), ctx, id, limit)
+}
+
+// MoveInstanceWorkspace mocks base method.
+func (m *MockClient) MoveInstanceWorkspace(ctx context.Context, instanceID, workspace string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveInstanceWorkspace", ctx, instanceID, workspace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveInstanceWorkspace indicates an expected call of MoveInstanceWorkspace.
+func (mr *MockClientMockRecorder) MoveInstanceWorkspace(ctx, instanceID, workspace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveInstanceWorkspace", reflect.TypeOf((*MockClient)(nil).MoveInstanceWorkspace), ctx, instanceID, workspace)
+}
+
+// MoveKargoInstanceWorkspace mocks base method.
+func (m *MockClient) MoveKargoInstanceWorkspace(ctx context.Context, kargoInstanceID, workspace string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveKargoInstanceWorkspace", ctx, kargoInstanceID, workspace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveKargoInstanceWorkspace indicates an expected call of MoveKargoInstanceWorkspace.
+func (mr *MockClientMockRecorder) MoveKargoInstanceWorkspace(ctx, kargoInstanceID, workspace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveKargoInstanceWorkspace", reflect.TypeOf((*MockClient)(nil).MoveKargoInstanceWorkspace), ctx, kargoInstanceID, workspace)
+}
+
+// PatchInstance mocks base method.
+func (m *MockClient) PatchInstance(ctx context.Context, id string, patch *structpb.Struct) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchInstance", ctx, id, patch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchInstance indicates an expected call of PatchInstance.
+func (mr *MockClientMockRecorder) PatchInstance(ctx, id, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchInstance", reflect.TypeOf((*MockClient)(nil).PatchInstance), ctx, id, patch)
+}
+
+// PatchInstanceAddon mocks base method.
+func (m *MockClient) PatchInstanceAddon(ctx context.Context, instanceID, id string, patch *structpb.Struct) (*argocdv1.Addon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchInstanceAddon", ctx, instanceID, id, patch)
+	ret0, _ := ret[0].(*argocdv1.Addon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchInstanceAddon indicates an expected call of PatchInstanceAddon.
+func (mr *MockClientMockRecorder) PatchInstanceAddon(ctx, instanceID, id, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchInstanceAddon", reflect.TypeOf((*MockClient)(nil).PatchInstanceAddon), ctx, instanceID, id, patch)
+}
+
+// PatchKargoInstance mocks base method.
+func (m *MockClient) PatchKargoInstance(ctx context.Context, id string, patch *structpb.Struct) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchKargoInstance", ctx, id, patch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchKargoInstance indicates an expected call of PatchKargoInstance.
+func (mr *MockClientMockRecorder) PatchKargoInstance(ctx, id, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchKargoInstance", reflect.TypeOf((*MockClient)(nil).PatchKargoInstance), ctx, id, patch)
+}
+
+// PatchManagedSecret mocks base method.
+func (m *MockClient) PatchManagedSecret(ctx context.Context, instanceID, name string, data map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchManagedSecret", ctx, instanceID, name, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchManagedSecret indicates an expected call of PatchManagedSecret.
+func (mr *MockClientMockRecorder) PatchManagedSecret(ctx, instanceID, name, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchManagedSecret", reflect.TypeOf((*MockClient)(nil).PatchManagedSecret), ctx, instanceID, name, data)
+}
+
+// PingNotificationConfig mocks base method.
+func (m *MockClient) PingNotificationConfig(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PingNotificationConfig", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PingNotificationConfig indicates an expected call of PingNotificationConfig.
+func (mr *MockClientMockRecorder) PingNotificationConfig(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PingNotificationConfig", reflect.TypeOf((*MockClient)(nil).PingNotificationConfig), ctx, id)
+}
+
+// RefreshInstanceAddon mocks base method.
+func (m *MockClient) RefreshInstanceAddon(ctx context.Context, instanceID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshInstanceAddon", ctx, instanceID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshInstanceAddon indicates an expected call of RefreshInstanceAddon.
+func (mr *MockClientMockRecorder) RefreshInstanceAddon(ctx, instanceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshInstanceAddon", reflect.TypeOf((*MockClient)(nil).RefreshInstanceAddon), ctx, instanceID, id)
+}
+
+// RefreshInstanceAddonRepo mocks base method.
+func (m *MockClient) RefreshInstanceAddonRepo(ctx context.Context, instanceID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshInstanceAddonRepo", ctx, instanceID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshInstanceAddonRepo indicates an expected call of RefreshInstanceAddonRepo.
+func (mr *MockClientMockRecorder) RefreshInstanceAddonRepo(ctx, instanceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshInstanceAddonRepo", reflect.TypeOf((*MockClient)(nil).RefreshInstanceAddonRepo), ctx, instanceID, id)
+}
+
+// RegenerateInstanceAccountPassword mocks base method.
+func (m *MockClient) RegenerateInstanceAccountPassword(ctx context.Context, instanceID, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateInstanceAccountPassword", ctx, instanceID, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateInstanceAccountPassword indicates an expected call of RegenerateInstanceAccountPassword.
+func (mr *MockClientMockRecorder) RegenerateInstanceAccountPassword(ctx, instanceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateInstanceAccountPassword", reflect.TypeOf((*MockClient)(nil).RegenerateInstanceAccountPassword), ctx, instanceID, name)
+}
+
+// RemoveTeamMember mocks base method.
+func (m *MockClient) RemoveTeamMember(ctx context.Context, teamName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTeamMember", ctx, teamName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTeamMember indicates an expected call of RemoveTeamMember.
+func (mr *MockClientMockRecorder) RemoveTeamMember(ctx, teamName, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTeamMember", reflect.TypeOf((*MockClient)(nil).RemoveTeamMember), ctx, teamName, id)
+}
+
+// RemoveWorkspaceMember mocks base method.
+func (m *MockClient) RemoveWorkspaceMember(ctx context.Context, workspaceID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWorkspaceMember", ctx, workspaceID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWorkspaceMember indicates an expected call of RemoveWorkspaceMember.
+func (mr *MockClientMockRecorder) RemoveWorkspaceMember(ctx, workspaceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWorkspaceMember", reflect.TypeOf((*MockClient)(nil).RemoveWorkspaceMember), ctx, workspaceID, id)
+}
+
+// ResolveWorkspace mocks base method.
+func (m *MockClient) ResolveWorkspace(ctx context.Context, name string) (*organizationv1.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveWorkspace", ctx, name)
+	ret0, _ := ret[0].(*organizationv1.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveWorkspace indicates an expected call of ResolveWorkspace.
+func (mr *MockClientMockRecorder) ResolveWorkspace(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveWorkspace", reflect.TypeOf((*MockClient)(nil).ResolveWorkspace), ctx, name)
+}
+
+// RotateClusterCredentials mocks base method.
+func (m *MockClient) RotateClusterCredentials(ctx context.Context, instanceID, clusterName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateClusterCredentials", ctx, instanceID, clusterName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateClusterCredentials indicates an expected call of RotateClusterCredentials.
+func (mr *MockClientMockRecorder) RotateClusterCredentials(ctx, instanceID, clusterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateClusterCredentials", reflect.TypeOf((*MockClient)(nil).RotateClusterCredentials), ctx, instanceID, clusterName)
+}
+
+// RotateKargoAgentCredentials mocks base method.
+func (m *MockClient) RotateKargoAgentCredentials(ctx context.Context, kargoInstanceID, agentName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateKargoAgentCredentials", ctx, kargoInstanceID, agentName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateKargoAgentCredentials indicates an expected call of RotateKargoAgentCredentials.
+func (mr *MockClientMockRecorder) RotateKargoAgentCredentials(ctx, kargoInstanceID, agentName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKargoAgentCredentials", reflect.TypeOf((*MockClient)(nil).RotateKargoAgentCredentials), ctx, kargoInstanceID, agentName)
+}
+
+// SetClusterMaintenanceMode mocks base method.
+func (m *MockClient) SetClusterMaintenanceMode(ctx context.Context, instanceID, clusterName string, mode bool, expiry *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetClusterMaintenanceMode", ctx, instanceID, clusterName, mode, expiry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetClusterMaintenanceMode indicates an expected call of SetClusterMaintenanceMode.
+func (mr *MockClientMockRecorder) SetClusterMaintenanceMode(ctx, instanceID, clusterName, mode, expiry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClusterMaintenanceMode", reflect.TypeOf((*MockClient)(nil).SetClusterMaintenanceMode), ctx, instanceID, clusterName, mode, expiry)
+}
+
+// SetKargoAgentMaintenanceMode mocks base method.
+func (m *MockClient) SetKargoAgentMaintenanceMode(ctx context.Context, kargoInstanceID, agentName string, mode bool, expiry *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKargoAgentMaintenanceMode", ctx, kargoInstanceID, agentName, mode, expiry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetKargoAgentMaintenanceMode indicates an expected call of SetKargoAgentMaintenanceMode.
+func (mr *MockClientMockRecorder) SetKargoAgentMaintenanceMode(ctx, kargoInstanceID, agentName, mode, expiry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKargoAgentMaintenanceMode", reflect.TypeOf((*MockClient)(nil).SetKargoAgentMaintenanceMode), ctx, kargoInstanceID, agentName, mode, expiry)
+}
+
+// UpdateAddonMarketplaceInstall mocks base method.
+func (m *MockClient) UpdateAddonMarketplaceInstall(ctx context.Context, instanceID, id string, dependencies []*argocdv1.ChartDependency) (*argocdv1.AddonMarketplaceInstall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAddonMarketplaceInstall", ctx, instanceID, id, dependencies)
+	ret0, _ := ret[0].(*argocdv1.AddonMarketplaceInstall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAddonMarketplaceInstall indicates an expected call of UpdateAddonMarketplaceInstall.
+func (mr *MockClientMockRecorder) UpdateAddonMarketplaceInstall(ctx, instanceID, id, dependencies any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAddonMarketplaceInstall", reflect.TypeOf((*MockClient)(nil).UpdateAddonMarketplaceInstall), ctx, instanceID, id, dependencies)
+}
+
+// UpdateArgocdInstanceQuota mocks base method.
+func (m *MockClient) UpdateArgocdInstanceQuota(ctx context.Context, instanceID string, maxApps int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArgocdInstanceQuota", ctx, instanceID, maxApps)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateArgocdInstanceQuota indicates an expected call of UpdateArgocdInstanceQuota.
+func (mr *MockClientMockRecorder) UpdateArgocdInstanceQuota(ctx, instanceID, maxApps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArgocdInstanceQuota", reflect.TypeOf((*MockClient)(nil).UpdateArgocdInstanceQuota), ctx, instanceID, maxApps)
+}
+
+// UpdateClusterSupportAccess mocks base method.
+func (m *MockClient) UpdateClusterSupportAccess(ctx context.Context, instanceID, clusterID string, enable bool, duration time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterSupportAccess", ctx, instanceID, clusterID, enable, duration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClusterSupportAccess indicates an expected call of UpdateClusterSupportAccess.
+func (mr *MockClientMockRecorder) UpdateClusterSupportAccess(ctx, instanceID, clusterID, enable, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterSupportAccess", reflect.TypeOf((*MockClient)(nil).UpdateClusterSupportAccess), ctx, instanceID, clusterID, enable, duration)
+}
+
+// UpdateClustersAgentVersion mocks base method.
+func (m *MockClient) UpdateClustersAgentVersion(ctx context.Context, instanceID string, clusterNames []string, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClustersAgentVersion", ctx, instanceID, clusterNames, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClustersAgentVersion indicates an expected call of UpdateClustersAgentVersion.
+func (mr *MockClientMockRecorder) UpdateClustersAgentVersion(ctx, instanceID, clusterNames, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClustersAgentVersion", reflect.TypeOf((*MockClient)(nil).UpdateClustersAgentVersion), ctx, instanceID, clusterNames, version)
+}
+
+// UpdateCustomRole mocks base method.
+func (m *MockClient) UpdateCustomRole(ctx context.Context, id, name, description, policy string) (*organizationv1.CustomRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomRole", ctx, id, name, description, policy)
+	ret0, _ := ret[0].(*organizationv1.CustomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomRole indicates an expected call of UpdateCustomRole.
+func (mr *MockClientMockRecorder) UpdateCustomRole(ctx, id, name, description, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRole", reflect.TypeOf((*MockClient)(nil).UpdateCustomRole), ctx, id, name, description, policy)
+}
+
+// UpdateInstanceAccountPassword mocks base method.
+func (m *MockClient) UpdateInstanceAccountPassword(ctx context.Context, instanceID, name, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceAccountPassword", ctx, instanceID, name, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInstanceAccountPassword indicates an expected call of UpdateInstanceAccountPassword.
+func (mr *MockClientMockRecorder) UpdateInstanceAccountPassword(ctx, instanceID, name, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceAccountPassword", reflect.TypeOf((*MockClient)(nil).UpdateInstanceAccountPassword), ctx, instanceID, name, password)
+}
+
+// UpdateInstanceCSS mocks base method.
+func (m *MockClient) UpdateInstanceCSS(ctx context.Context, instanceID, css string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceCSS", ctx, instanceID, css)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInstanceCSS indicates an expected call of UpdateInstanceCSS.
+func (mr *MockClientMockRecorder) UpdateInstanceCSS(ctx, instanceID, css any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceCSS", reflect.TypeOf((*MockClient)(nil).UpdateInstanceCSS), ctx, instanceID, css)
+}
+
+// UpdateInstanceResourceCustomizations mocks base method.
+func (m *MockClient) UpdateInstanceResourceCustomizations(ctx context.Context, instanceID string, resources []*argocdv1.ResourceCustomizationConfig, ignoreResourceUpdatesEnabled *bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceResourceCustomizations", ctx, instanceID, resources, ignoreResourceUpdatesEnabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInstanceResourceCustomizations indicates an expected call of UpdateInstanceResourceCustomizations.
+func (mr *MockClientMockRecorder) UpdateInstanceResourceCustomizations(ctx, instanceID, resources, ignoreResourceUpdatesEnabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceResourceCustomizations", reflect.TypeOf((*MockClient)(nil).UpdateInstanceResourceCustomizations), ctx, instanceID, resources, ignoreResourceUpdatesEnabled)
+}
+
+// UpdateKargoAgentsVersion mocks base method.
+func (m *MockClient) UpdateKargoAgentsVersion(ctx context.Context, kargoInstanceID string, agentNames []string, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKargoAgentsVersion", ctx, kargoInstanceID, agentNames, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateKargoAgentsVersion indicates an expected call of UpdateKargoAgentsVersion.
+func (mr *MockClientMockRecorder) UpdateKargoAgentsVersion(ctx, kargoInstanceID, agentNames, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKargoAgentsVersion", reflect.TypeOf((*MockClient)(nil).UpdateKargoAgentsVersion), ctx, kargoInstanceID, agentNames, version)
+}
+
+// UpdateKargoInstanceQuota mocks base method.
+func (m *MockClient) UpdateKargoInstanceQuota(ctx context.Context, instanceID string, maxStages int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKargoInstanceQuota", ctx, instanceID, maxStages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateKargoInstanceQuota indicates an expected call of UpdateKargoInstanceQuota.
+func (mr *MockClientMockRecorder) UpdateKargoInstanceQuota(ctx, instanceID, maxStages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKargoInstanceQuota", reflect.TypeOf((*MockClient)(nil).UpdateKargoInstanceQuota), ctx, instanceID, maxStages)
+}
+
+// UpdateManagedSecret mocks base method.
+func (m *MockClient) UpdateManagedSecret(ctx context.Context, instanceID string, secret *argocdv1.ManagedSecret, data map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManagedSecret", ctx, instanceID, secret, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateManagedSecret indicates an expected call of UpdateManagedSecret.
+func (mr *MockClientMockRecorder) UpdateManagedSecret(ctx, instanceID, secret, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManagedSecret", reflect.TypeOf((*MockClient)(nil).UpdateManagedSecret), ctx, instanceID, secret, data)
+}
+
+// UpdateNotificationConfig mocks base method.
+func (m *MockClient) UpdateNotificationConfig(ctx context.Context, req *organizationv1.UpdateNotificationConfigRequest) (*organizationv1.NotificationConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationConfig", ctx, req)
+	ret0, _ := ret[0].(*organizationv1.NotificationConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotificationConfig indicates an expected call of UpdateNotificationConfig.
+func (mr *MockClientMockRecorder) UpdateNotificationConfig(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationConfig", reflect.TypeOf((*MockClient)(nil).UpdateNotificationConfig), ctx, req)
+}
+
+// UpdateOIDCMap mocks base method.
+func (m *MockClient) UpdateOIDCMap(ctx context.Context, entries map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOIDCMap", ctx, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOIDCMap indicates an expected call of UpdateOIDCMap.
+func (mr *MockClientMockRecorder) UpdateOIDCMap(ctx, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOIDCMap", reflect.TypeOf((*MockClient)(nil).UpdateOIDCMap), ctx, entries)
+}
+
+// UpdateTeam mocks base method.
+func (m *MockClient) UpdateTeam(ctx context.Context, name, description string, customRoles []string) (*organizationv1.UserTeam, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeam", ctx, name, description, customRoles)
+	ret0, _ := ret[0].(*organizationv1.UserTeam)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeam indicates an expected call of UpdateTeam.
+func (mr *MockClientMockRecorder) UpdateTeam(ctx, name, description, customRoles any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeam", reflect.TypeOf((*MockClient)(nil).UpdateTeam), ctx, name, description, customRoles)
+}
+
+// UpdateTeamOIDCMap mocks base method.
+func (m *MockClient) UpdateTeamOIDCMap(ctx context.Context, entries map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamOIDCMap", ctx, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeamOIDCMap indicates an expected call of UpdateTeamOIDCMap.
+func (mr *MockClientMockRecorder) UpdateTeamOIDCMap(ctx, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamOIDCMap", reflect.TypeOf((*MockClient)(nil).UpdateTeamOIDCMap), ctx, entries)
+}
+
+// UpdateWorkspace mocks base method.
+func (m *MockClient) UpdateWorkspace(ctx context.Context, id, name, description string) (*organizationv1.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspace", ctx, id, name, description)
+	ret0, _ := ret[0].(*organizationv1.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkspace indicates an expected call of UpdateWorkspace.
+func (mr *MockClientMockRecorder) UpdateWorkspace(ctx, id, name, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspace", reflect.TypeOf((*MockClient)(nil).UpdateWorkspace), ctx, id, name, description)
+}
+
+// UpdateWorkspaceCustomRole mocks base method.
+func (m *MockClient) UpdateWorkspaceCustomRole(ctx context.Context, workspaceID, id, name, description, policy string) (*organizationv1.CustomRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceCustomRole", ctx, workspaceID, id, name, description, policy)
+	ret0, _ := ret[0].(*organizationv1.CustomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkspaceCustomRole indicates an expected call of UpdateWorkspaceCustomRole.
+func (mr *MockClientMockRecorder) UpdateWorkspaceCustomRole(ctx, workspaceID, id, name, description, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceCustomRole", reflect.TypeOf((*MockClient)(nil).UpdateWorkspaceCustomRole), ctx, workspaceID, id, name, description, policy)
+}
+
+// UpdateWorkspaceMember mocks base method.
+func (m *MockClient) UpdateWorkspaceMember(ctx context.Context, workspaceID, id string, role organizationv1.WorkspaceMemberRole) (*organizationv1.WorkspaceMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceMember", ctx, workspaceID, id, role)
+	ret0, _ := ret[0].(*organizationv1.WorkspaceMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkspaceMember indicates an expected call of UpdateWorkspaceMember.
+func (mr *MockClientMockRecorder) UpdateWorkspaceMember(ctx, workspaceID, id, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceMember", reflect.TypeOf((*MockClient)(nil).UpdateWorkspaceMember), ctx, workspaceID, id, role)
+}
+
+// UpsertInstanceAccount mocks base method.
+func (m *MockClient) UpsertInstanceAccount(ctx context.Context, instanceID, name string, capabilities *argocdv1.InstanceAccountCapabilities, disabled bool) (*argocdv1.InstanceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertInstanceAccount", ctx, instanceID, name, capabilities, disabled)
+	ret0, _ := ret[0].(*argocdv1.InstanceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertInstanceAccount indicates an expected call of UpsertInstanceAccount.
+func (mr *MockClientMockRecorder) UpsertInstanceAccount(ctx, instanceID, name, capabilities, disabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertInstanceAccount", reflect.TypeOf((*MockClient)(nil).UpsertInstanceAccount), ctx, instanceID, name, capabilities, disabled)
 }
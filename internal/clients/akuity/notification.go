@@ -0,0 +1,137 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// ----------------------------------------------------------------------
+// Notification config methods. Configs are keyed by their
+// platform-assigned ID within the client's organization. Create and
+// Update take the gateway request so the caller owns the payload oneof;
+// the organization ID is stamped here.
+// ----------------------------------------------------------------------
+
+func (c client) GetNotificationConfig(ctx context.Context, id string) (*orgcv1.NotificationConfig, error) {
+	if err := c.orgRequired("GetNotificationConfig"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.GetNotificationConfig(ctx, &orgcv1.GetNotificationConfigRequest{
+		OrganizationId: c.organizationID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get notification config %s: %w", id, err))
+		}
+		return nil, fmt.Errorf("could not get notification config %s: %w", id, err)
+	}
+	if resp == nil || resp.GetNotificationConfig() == nil {
+		return nil, fmt.Errorf("could not get notification config %s: empty response", id)
+	}
+	return resp.GetNotificationConfig(), nil
+}
+
+func (c client) CreateNotificationConfig(ctx context.Context, req *orgcv1.CreateNotificationConfigRequest) (*orgcv1.NotificationConfig, error) {
+	if err := c.orgRequired("CreateNotificationConfig"); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, fmt.Errorf("could not create notification config: nil request")
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("CreateNotificationConfig", req.GetName())
+	req.OrganizationId = c.organizationID
+	resp, err := c.orgGatewayClient.CreateNotificationConfig(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not create notification config %s: %w", req.GetName(), err)
+	}
+	if resp == nil || resp.GetNotificationConfig().GetId() == "" {
+		return nil, fmt.Errorf("could not create notification config %s: empty response", req.GetName())
+	}
+	return resp.GetNotificationConfig(), nil
+}
+
+func (c client) UpdateNotificationConfig(ctx context.Context, req *orgcv1.UpdateNotificationConfigRequest) (*orgcv1.NotificationConfig, error) {
+	if err := c.orgRequired("UpdateNotificationConfig"); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, fmt.Errorf("could not update notification config: nil request")
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateNotificationConfig", req.GetId())
+	req.OrganizationId = c.organizationID
+	resp, err := c.orgGatewayClient.UpdateNotificationConfig(ctx, req)
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not update notification config %s: %w", req.GetId(), err))
+		}
+		return nil, fmt.Errorf("could not update notification config %s: %w", req.GetId(), err)
+	}
+	if resp == nil || resp.GetNotificationConfig() == nil {
+		return nil, fmt.Errorf("could not update notification config %s: empty response", req.GetId())
+	}
+	return resp.GetNotificationConfig(), nil
+}
+
+func (c client) DeleteNotificationConfig(ctx context.Context, id string) error {
+	if err := c.orgRequired("DeleteNotificationConfig"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteNotificationConfig", id)
+	_, err := c.orgGatewayClient.DeleteNotificationConfig(ctx, &orgcv1.DeleteNotificationConfigRequest{
+		OrganizationId: c.organizationID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete notification config %s: %w", id, err))
+		}
+		return fmt.Errorf("could not delete notification config %s: %w", id, err)
+	}
+	return nil
+}
+
+func (c client) PingNotificationConfig(ctx context.Context, id string) error {
+	if err := c.orgRequired("PingNotificationConfig"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	_, err := c.orgGatewayClient.PingNotificationConfig(ctx, &orgcv1.PingNotificationConfigRequest{
+		OrganizationId: c.organizationID,
+		Id:             id,
+	})
+	if err != nil {
+		return fmt.Errorf("could not ping notification config %s: %w", id, err)
+	}
+	return nil
+}
+
+func (c client) ListNotificationDeliveryHistory(ctx context.Context, id string, limit int64) ([]*orgcv1.NotificationDelivery, error) {
+	if err := c.orgRequired("ListNotificationDeliveryHistory"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.ListNotificationDeliveryHistory(ctx, &orgcv1.ListNotificationDeliveryHistoryRequest{
+		OrganizationId: c.organizationID,
+		Id:             id,
+		Limit:          &limit,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not list notification config %s delivery history: %w", id, err))
+		}
+		return nil, fmt.Errorf("could not list notification config %s delivery history: %w", id, err)
+	}
+	return resp.GetHistory(), nil
+}
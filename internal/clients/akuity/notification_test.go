@@ -0,0 +1,78 @@
+package akuity_test
+
+import (
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func TestCreateNotificationConfig_StampsOrganizationID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().CreateNotificationConfig(authCtx, &orgcv1.CreateNotificationConfigRequest{
+		OrganizationId: organizationID,
+		Name:           "ops-webhook",
+	}).Return(&orgcv1.CreateNotificationConfigResponse{NotificationConfig: &orgcv1.NotificationConfig{Id: "nc-1"}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	nc, err := client.CreateNotificationConfig(ctx, &orgcv1.CreateNotificationConfigRequest{Name: "ops-webhook"})
+	require.NoError(t, err)
+	assert.Equal(t, "nc-1", nc.GetId())
+}
+
+func TestGetNotificationConfig_StatusNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().GetNotificationConfig(authCtx, &orgcv1.GetNotificationConfigRequest{
+		OrganizationId: organizationID,
+		Id:             "nc-1",
+	}).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetNotificationConfig(ctx, "nc-1")
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestListNotificationDeliveryHistory_SendsLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	limit := int64(20)
+	mockOrgGatewayClient.EXPECT().ListNotificationDeliveryHistory(authCtx, &orgcv1.ListNotificationDeliveryHistoryRequest{
+		OrganizationId: organizationID,
+		Id:             "nc-1",
+		Limit:          &limit,
+	}).Return(&orgcv1.ListNotificationDeliveryHistoryResponse{History: []*orgcv1.NotificationDelivery{{Id: "d-1"}}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	history, err := client.ListNotificationDeliveryHistory(ctx, "nc-1", 20)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, "d-1", history[0].GetId())
+}
+
+func TestDeleteNotificationConfig_StatusNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().DeleteNotificationConfig(authCtx, gomock.Any()).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	err = client.DeleteNotificationConfig(ctx, "nc-1")
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
@@ -0,0 +1,113 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	featuresv1 "github.com/akuity/api-client-go/pkg/api/gen/types/features/v1"
+	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+)
+
+// ----------------------------------------------------------------------
+// Organization-plane instance quota methods. Quotas cap the number of
+// applications on an Argo CD instance and the number of stages on a
+// Kargo instance. The list routes return every instance in the
+// organization with its current usage; the update routes take a map
+// keyed by instance ID, and only the supplied instances are changed.
+// ----------------------------------------------------------------------
+
+func (c client) ListArgocdInstancesQuota(ctx context.Context) ([]*orgcv1.InstanceQuota, error) {
+	if err := c.orgRequired("ListArgocdInstancesQuota"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.ListArgocdInstancesQuota(ctx, &orgcv1.ListArgocdInstancesQuotaRequest{
+		OrganizationId: c.organizationID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list argocd instances quota: %w", err)
+	}
+	return resp.GetInstances(), nil
+}
+
+func (c client) UpdateArgocdInstanceQuota(ctx context.Context, instanceID string, maxApps int32) error {
+	if err := c.orgRequired("UpdateArgocdInstancesQuota"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateArgocdInstancesQuota", instanceID)
+	if _, err := c.orgGatewayClient.UpdateArgocdInstancesQuota(ctx, &orgcv1.UpdateArgocdInstancesQuotaRequest{
+		OrganizationId: c.organizationID,
+		InstanceQuota:  map[string]int32{instanceID: maxApps},
+	}); err != nil {
+		return fmt.Errorf("could not update argocd instance %s quota: %w", instanceID, err)
+	}
+	return nil
+}
+
+func (c client) ListKargoInstancesQuota(ctx context.Context) ([]*orgcv1.KargoInstanceQuota, error) {
+	if err := c.orgRequired("ListKargoInstancesQuota"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.ListKargoInstancesQuota(ctx, &orgcv1.ListKargoInstancesQuotaRequest{
+		OrganizationId: c.organizationID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list kargo instances quota: %w", err)
+	}
+	return resp.GetInstances(), nil
+}
+
+func (c client) UpdateKargoInstanceQuota(ctx context.Context, instanceID string, maxStages int32) error {
+	if err := c.orgRequired("UpdateKargoInstancesQuota"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateKargoInstancesQuota", instanceID)
+	if _, err := c.orgGatewayClient.UpdateKargoInstancesQuota(ctx, &orgcv1.UpdateKargoInstancesQuotaRequest{
+		OrganizationId: c.organizationID,
+		InstanceQuota:  map[string]int32{instanceID: maxStages},
+	}); err != nil {
+		return fmt.Errorf("could not update kargo instance %s quota: %w", instanceID, err)
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------
+// Organization plan methods. The Instance and KargoInstance controllers
+// read the organization's quota and feature entitlements before a write
+// so that a request the plan cannot satisfy is reported as such instead
+// of as an opaque apply failure.
+// ----------------------------------------------------------------------
+
+func (c client) GetOrganizationQuota(ctx context.Context) (*featuresv1.OrganizationQuota, error) {
+	if err := c.orgRequired("GetOrganization"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.GetOrganization(ctx, &orgcv1.GetOrganizationRequest{
+		IdType: idv1.Type_ID,
+		Id:     c.organizationID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get organization %s: %w", c.organizationID, err)
+	}
+	return resp.GetOrganization().GetQuota(), nil
+}
+
+func (c client) GetFeatureStatuses(ctx context.Context) (*featuresv1.FeatureStatuses, error) {
+	if err := c.orgRequired("GetFeatureStatuses"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.GetFeatureStatuses(ctx, &orgcv1.GetFeatureStatusesRequest{
+		Id: c.organizationID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get organization %s feature statuses: %w", c.organizationID, err)
+	}
+	return resp.GetFeatureStatuses(), nil
+}
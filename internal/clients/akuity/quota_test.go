@@ -0,0 +1,105 @@
+package akuity_test
+
+import (
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	featuresv1 "github.com/akuity/api-client-go/pkg/api/gen/types/features/v1"
+	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+)
+
+func TestListArgocdInstancesQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	want := []*orgcv1.InstanceQuota{{
+		Instance:         &orgcv1.InstanceQuotaSummary{Id: instanceID, Name: "prod"},
+		CurrentAppsCount: 12,
+		MaxAppsCount:     50,
+	}}
+	mockOrgGatewayClient.EXPECT().ListArgocdInstancesQuota(authCtx, &orgcv1.ListArgocdInstancesQuotaRequest{
+		OrganizationId: organizationID,
+	}).Return(&orgcv1.ListArgocdInstancesQuotaResponse{Instances: want}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	got, err := client.ListArgocdInstancesQuota(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestUpdateArgocdInstanceQuota_SendsOnlyTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().UpdateArgocdInstancesQuota(authCtx, &orgcv1.UpdateArgocdInstancesQuotaRequest{
+		OrganizationId: organizationID,
+		InstanceQuota:  map[string]int32{instanceID: 50},
+	}).Return(&orgcv1.UpdateArgocdInstancesQuotaResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.UpdateArgocdInstanceQuota(ctx, instanceID, 50))
+}
+
+func TestUpdateKargoInstanceQuota_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().UpdateKargoInstancesQuota(authCtx, &orgcv1.UpdateKargoInstancesQuotaRequest{
+		OrganizationId: organizationID,
+		InstanceQuota:  map[string]int32{"kargo-1": 20},
+	}).Return(nil, errFake).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	err = client.UpdateKargoInstanceQuota(ctx, "kargo-1", 20)
+	require.ErrorIs(t, err, errFake)
+}
+
+func TestListKargoInstancesQuota_RequiresOrgClient(t *testing.T) {
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.ListKargoInstancesQuota(ctx)
+	require.Error(t, err)
+}
+
+func TestGetOrganizationQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	want := &featuresv1.OrganizationQuota{MaxInstances: 3, MaxKargoInstances: 1}
+	mockOrgGatewayClient.EXPECT().GetOrganization(authCtx, &orgcv1.GetOrganizationRequest{
+		IdType: idv1.Type_ID,
+		Id:     organizationID,
+	}).Return(&orgcv1.GetOrganizationResponse{Organization: &orgcv1.Organization{Quota: want}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	got, err := client.GetOrganizationQuota(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestGetFeatureStatuses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	want := &featuresv1.FeatureStatuses{Kargo: featuresv1.FeatureStatus_FEATURE_STATUS_ENABLED}
+	mockOrgGatewayClient.EXPECT().GetFeatureStatuses(authCtx, &orgcv1.GetFeatureStatusesRequest{
+		Id: organizationID,
+	}).Return(&orgcv1.GetFeatureStatusesResponse{FeatureStatuses: want}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	got, err := client.GetFeatureStatuses(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
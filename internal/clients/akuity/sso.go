@@ -0,0 +1,137 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// ----------------------------------------------------------------------
+// Organization SSO methods. The SSO configuration and the two OIDC
+// group maps are per-organization singletons keyed by the client's
+// organization ID, so none of these calls take an identifier.
+// ----------------------------------------------------------------------
+
+func (c client) GetSSOConfiguration(ctx context.Context) (*orgcv1.GetSSOConfigurationResponse, error) {
+	if err := c.orgRequired("GetSSOConfiguration"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.GetSSOConfiguration(ctx, &orgcv1.GetSSOConfigurationRequest{Id: c.organizationID})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get SSO configuration: %w", err))
+		}
+		return nil, fmt.Errorf("could not get SSO configuration: %w", err)
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("could not get SSO configuration: empty response")
+	}
+	// An organization that has never configured SSO answers with an
+	// empty body rather than NotFound; treat a missing provider as
+	// absent so the controller issues Ensure.
+	if resp.GetOptions() == nil {
+		return nil, reason.AsNotFound(fmt.Errorf("could not get SSO configuration: no provider configured"))
+	}
+	return resp, nil
+}
+
+func (c client) EnsureSSOConfiguration(ctx context.Context, req *orgcv1.EnsureSSOConfigurationRequest) error {
+	if err := c.orgRequired("EnsureSSOConfiguration"); err != nil {
+		return err
+	}
+	if req == nil {
+		return fmt.Errorf("could not ensure SSO configuration: nil request")
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("EnsureSSOConfiguration", c.organizationID)
+	req.Id = c.organizationID
+	if _, err := c.orgGatewayClient.EnsureSSOConfiguration(ctx, req); err != nil {
+		return fmt.Errorf("could not ensure SSO configuration: %w", err)
+	}
+	return nil
+}
+
+func (c client) DeleteSSOConfiguration(ctx context.Context) error {
+	if err := c.orgRequired("DeleteSSOConfiguration"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteSSOConfiguration", c.organizationID)
+	_, err := c.orgGatewayClient.DeleteSSOConfiguration(ctx, &orgcv1.DeleteSSOConfigurationRequest{Id: c.organizationID})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete SSO configuration: %w", err))
+		}
+		return fmt.Errorf("could not delete SSO configuration: %w", err)
+	}
+	return nil
+}
+
+func (c client) GetOIDCMap(ctx context.Context) (map[string]string, error) {
+	if err := c.orgRequired("GetOIDCMap"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.GetOIDCMap(ctx, &orgcv1.GetOIDCMapRequest{Id: c.organizationID})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get OIDC map: %w", err))
+		}
+		return nil, fmt.Errorf("could not get OIDC map: %w", err)
+	}
+	return resp.GetEntries(), nil
+}
+
+func (c client) UpdateOIDCMap(ctx context.Context, entries map[string]string) error {
+	if err := c.orgRequired("UpdateOIDCMap"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateOIDCMap", c.organizationID)
+	_, err := c.orgGatewayClient.UpdateOIDCMap(ctx, &orgcv1.UpdateOIDCMapRequest{
+		Id:      c.organizationID,
+		Entries: entries,
+	})
+	if err != nil {
+		return fmt.Errorf("could not update OIDC map: %w", err)
+	}
+	return nil
+}
+
+func (c client) GetTeamOIDCMap(ctx context.Context) (map[string]string, error) {
+	if err := c.orgRequired("GetTeamOIDCMap"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.GetTeamOIDCMap(ctx, &orgcv1.GetTeamOIDCMapRequest{OrganizationId: c.organizationID})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get team OIDC map: %w", err))
+		}
+		return nil, fmt.Errorf("could not get team OIDC map: %w", err)
+	}
+	return resp.GetEntries(), nil
+}
+
+func (c client) UpdateTeamOIDCMap(ctx context.Context, entries map[string]string) error {
+	if err := c.orgRequired("UpdateTeamOIDCMap"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateTeamOIDCMap", c.organizationID)
+	_, err := c.orgGatewayClient.UpdateTeamOIDCMap(ctx, &orgcv1.UpdateTeamOIDCMapRequest{
+		OrganizationId: c.organizationID,
+		Entries:        entries,
+	})
+	if err != nil {
+		return fmt.Errorf("could not update team OIDC map: %w", err)
+	}
+	return nil
+}
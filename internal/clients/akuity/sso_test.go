@@ -0,0 +1,87 @@
+package akuity_test
+
+import (
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func TestGetSSOConfiguration_NoProviderIsNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().GetSSOConfiguration(authCtx, &orgcv1.GetSSOConfigurationRequest{
+		Id: organizationID,
+	}).Return(&orgcv1.GetSSOConfigurationResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetSSOConfiguration(ctx)
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestEnsureSSOConfiguration_StampsOrganizationID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().EnsureSSOConfiguration(authCtx, &orgcv1.EnsureSSOConfigurationRequest{
+		Id:         organizationID,
+		EnforceSso: true,
+	}).Return(&orgcv1.EnsureSSOConfigurationResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.EnsureSSOConfiguration(ctx, &orgcv1.EnsureSSOConfigurationRequest{EnforceSso: true}))
+}
+
+func TestDeleteSSOConfiguration_StatusNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().DeleteSSOConfiguration(authCtx, &orgcv1.DeleteSSOConfigurationRequest{
+		Id: organizationID,
+	}).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	err = client.DeleteSSOConfiguration(ctx)
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestUpdateTeamOIDCMap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().UpdateTeamOIDCMap(authCtx, &orgcv1.UpdateTeamOIDCMapRequest{
+		OrganizationId: organizationID,
+		Entries:        map[string]string{"platform-admins": "platform"},
+	}).Return(&orgcv1.UpdateTeamOIDCMapResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.UpdateTeamOIDCMap(ctx, map[string]string{"platform-admins": "platform"}))
+}
+
+func TestGetOIDCMap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().GetOIDCMap(authCtx, &orgcv1.GetOIDCMapRequest{
+		Id: organizationID,
+	}).Return(&orgcv1.GetOIDCMapResponse{Entries: map[string]string{"admins": "owner"}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	entries, err := client.GetOIDCMap(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"admins": "owner"}, entries)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akuity
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+)
+
+// ----------------------------------------------------------------------
+// Cluster support access. The platform addresses the cluster by ID and
+// bounds a grant by a duration in whole seconds; a zero duration leaves
+// the grant open until it is revoked.
+// ----------------------------------------------------------------------
+
+func (c client) UpdateClusterSupportAccess(ctx context.Context, instanceID, clusterID string, enable bool, duration time.Duration) error {
+	workspaceID, err := c.argoWorkspaceIDForInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	req := &argocdv1.UpdateInstanceClusterSupportAccessRequest{
+		OrganizationId: c.organizationID,
+		InstanceId:     instanceID,
+		Id:             clusterID,
+		WorkspaceId:    workspaceID,
+		Enable:         enable,
+	}
+	if enable && duration > 0 {
+		req.DurationSeconds = uint32(min(math.Ceil(duration.Seconds()), math.MaxUint32))
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateInstanceClusterSupportAccess", instanceID+"/"+clusterID)
+	if _, err := c.gatewayClient.UpdateInstanceClusterSupportAccess(ctx, req); err != nil {
+		return fmt.Errorf("could not update support access for cluster %s/%s: %w", instanceID, clusterID, err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akuity_test
+
+import (
+	"testing"
+	"time"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+)
+
+func TestUpdateClusterSupportAccess_GrantRoundsDurationUp(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().UpdateInstanceClusterSupportAccess(authCtx, &argocdv1.UpdateInstanceClusterSupportAccessRequest{
+		OrganizationId:  organizationID,
+		InstanceId:      instanceID,
+		Id:              "cluster-id",
+		WorkspaceId:     workspaceID,
+		Enable:          true,
+		DurationSeconds: 3601,
+	}).Return(&argocdv1.UpdateInstanceClusterSupportAccessResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.UpdateClusterSupportAccess(ctx, instanceID, "cluster-id", true, time.Hour+500*time.Millisecond))
+}
+
+func TestUpdateClusterSupportAccess_RevokeDropsDuration(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().UpdateInstanceClusterSupportAccess(authCtx, &argocdv1.UpdateInstanceClusterSupportAccessRequest{
+		OrganizationId: organizationID,
+		InstanceId:     instanceID,
+		Id:             "cluster-id",
+		WorkspaceId:    workspaceID,
+	}).Return(&argocdv1.UpdateInstanceClusterSupportAccessResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.UpdateClusterSupportAccess(ctx, instanceID, "cluster-id", false, time.Hour))
+}
+
+func TestUpdateClusterSupportAccess_Error(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	expectGetInstanceByIDWithWorkspace(mockGatewayClient, instanceID, workspaceID)
+	mockGatewayClient.EXPECT().UpdateInstanceClusterSupportAccess(authCtx, gomock.Any()).
+		Return(nil, errFake).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = client.UpdateClusterSupportAccess(ctx, instanceID, "cluster-id", true, 0)
+	require.ErrorIs(t, err, errFake)
+}
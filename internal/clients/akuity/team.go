@@ -0,0 +1,172 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// ----------------------------------------------------------------------
+// Organization-plane team methods. Teams are keyed by name on every
+// route; team members by the platform-assigned member ID within the
+// team.
+// ----------------------------------------------------------------------
+
+func (c client) GetTeam(ctx context.Context, name string) (*orgcv1.UserTeam, error) {
+	if err := c.orgRequired("GetTeam"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.GetTeam(ctx, &orgcv1.GetTeamRequest{
+		OrganizationId: c.organizationID,
+		Name:           name,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get team %s: %w", name, err))
+		}
+		return nil, fmt.Errorf("could not get team %s: %w", name, err)
+	}
+	if resp == nil || resp.GetUserTeam().GetTeam() == nil {
+		return nil, fmt.Errorf("could not get team %s: empty response", name)
+	}
+	return resp.GetUserTeam(), nil
+}
+
+func (c client) CreateTeam(ctx context.Context, name, description string, customRoles []string) (*orgcv1.UserTeam, error) {
+	if err := c.orgRequired("CreateTeam"); err != nil {
+		return nil, err
+	}
+	req := &orgcv1.CreateTeamRequest{
+		OrganizationId: c.organizationID,
+		Name:           name,
+		CustomRoles:    customRoles,
+	}
+	if description != "" {
+		req.Description = &description
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("CreateTeam", name)
+	resp, err := c.orgGatewayClient.CreateTeam(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not create team %s: %w", name, err)
+	}
+	if resp == nil || resp.GetUserTeam().GetTeam() == nil {
+		return nil, fmt.Errorf("could not create team %s: empty response", name)
+	}
+	return resp.GetUserTeam(), nil
+}
+
+// UpdateTeam implements Client.UpdateTeam. Both description and custom
+// roles are always sent, so an empty value clears the field on the
+// platform.
+func (c client) UpdateTeam(ctx context.Context, name, description string, customRoles []string) (*orgcv1.UserTeam, error) {
+	if err := c.orgRequired("UpdateTeam"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateTeam", name)
+	resp, err := c.orgGatewayClient.UpdateTeam(ctx, &orgcv1.UpdateTeamRequest{
+		OrganizationId: c.organizationID,
+		Name:           name,
+		Description:    description,
+		CustomRoles:    customRoles,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not update team %s: %w", name, err))
+		}
+		return nil, fmt.Errorf("could not update team %s: %w", name, err)
+	}
+	if resp == nil || resp.GetUserTeam().GetTeam() == nil {
+		return nil, fmt.Errorf("could not update team %s: empty response", name)
+	}
+	return resp.GetUserTeam(), nil
+}
+
+func (c client) DeleteTeam(ctx context.Context, name string) error {
+	if err := c.orgRequired("DeleteTeam"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteTeam", name)
+	_, err := c.orgGatewayClient.DeleteTeam(ctx, &orgcv1.DeleteTeamRequest{
+		OrganizationId: c.organizationID,
+		Name:           name,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete team %s: %w", name, err))
+		}
+		return fmt.Errorf("could not delete team %s: %w", name, err)
+	}
+	return nil
+}
+
+func (c client) GetTeamMember(ctx context.Context, teamName, id string) (*orgcv1.TeamMember, error) {
+	if err := c.orgRequired("GetTeamMember"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.GetTeamMember(ctx, &orgcv1.GetTeamMemberRequest{
+		OrganizationId: c.organizationID,
+		TeamName:       teamName,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get team %s member %s: %w", teamName, id, err))
+		}
+		return nil, fmt.Errorf("could not get team %s member %s: %w", teamName, id, err)
+	}
+	if resp == nil || resp.GetTeamMember() == nil {
+		return nil, fmt.Errorf("could not get team %s member %s: empty response", teamName, id)
+	}
+	return resp.GetTeamMember(), nil
+}
+
+func (c client) AddTeamMember(ctx context.Context, teamName, userID string) (*orgcv1.TeamMember, error) {
+	if err := c.orgRequired("AddTeamMember"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("AddTeamMember", teamName)
+	resp, err := c.orgGatewayClient.AddTeamMember(ctx, &orgcv1.AddTeamMemberRequest{
+		OrganizationId: c.organizationID,
+		TeamName:       teamName,
+		UserId:         userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not add user %s to team %s: %w", userID, teamName, err)
+	}
+	if resp == nil || resp.GetTeamMember() == nil {
+		return nil, fmt.Errorf("could not add user %s to team %s: empty response", userID, teamName)
+	}
+	return resp.GetTeamMember(), nil
+}
+
+func (c client) RemoveTeamMember(ctx context.Context, teamName, id string) error {
+	if err := c.orgRequired("RemoveTeamMember"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("RemoveTeamMember", teamName)
+	_, err := c.orgGatewayClient.RemoveTeamMember(ctx, &orgcv1.RemoveTeamMemberRequest{
+		OrganizationId: c.organizationID,
+		TeamName:       teamName,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not remove team %s member %s: %w", teamName, id, err))
+		}
+		return fmt.Errorf("could not remove team %s member %s: %w", teamName, id, err)
+	}
+	return nil
+}
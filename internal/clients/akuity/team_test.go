@@ -0,0 +1,82 @@
+package akuity_test
+
+import (
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func TestGetTeam_StatusNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().GetTeam(authCtx, &orgcv1.GetTeamRequest{
+		OrganizationId: organizationID,
+		Name:           "platform",
+	}).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetTeam(ctx, "platform")
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestCreateTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	description := "platform engineers"
+	mockOrgGatewayClient.EXPECT().CreateTeam(authCtx, &orgcv1.CreateTeamRequest{
+		OrganizationId: organizationID,
+		Name:           "platform",
+		Description:    &description,
+		CustomRoles:    []string{"role-a"},
+	}).Return(&orgcv1.CreateTeamResponse{UserTeam: &orgcv1.UserTeam{
+		Team:        &orgcv1.Team{Name: "platform", Description: description},
+		CustomRoles: []string{"role-a"},
+	}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	ut, err := client.CreateTeam(ctx, "platform", description, []string{"role-a"})
+	require.NoError(t, err)
+	assert.Equal(t, "platform", ut.GetTeam().GetName())
+}
+
+func TestAddTeamMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().AddTeamMember(authCtx, &orgcv1.AddTeamMemberRequest{
+		OrganizationId: organizationID,
+		TeamName:       "platform",
+		UserId:         "user-1",
+	}).Return(&orgcv1.AddTeamMemberResponse{TeamMember: &orgcv1.TeamMember{Id: "member-1"}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	member, err := client.AddTeamMember(ctx, "platform", "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, "member-1", member.GetId())
+}
+
+func TestRemoveTeamMember_StatusNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().RemoveTeamMember(authCtx, gomock.Any()).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	err = client.RemoveTeamMember(ctx, "platform", "member-1")
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
@@ -0,0 +1,202 @@
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// ----------------------------------------------------------------------
+// Organization-plane workspace methods. Workspaces are keyed by their
+// canonical ID on every route except create; the Workspace controller
+// stamps that ID as the managed resource's external-name. Workspace
+// members are keyed by the platform-assigned member ID within the
+// workspace.
+// ----------------------------------------------------------------------
+
+func (c client) GetWorkspace(ctx context.Context, id string) (*orgcv1.Workspace, error) {
+	if err := c.orgRequired("GetWorkspace"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.GetWorkspace(ctx, &orgcv1.GetWorkspaceRequest{
+		OrganizationId: c.organizationID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get workspace %s: %w", id, err))
+		}
+		return nil, fmt.Errorf("could not get workspace %s: %w", id, err)
+	}
+	if resp == nil || resp.GetWorkspace() == nil {
+		return nil, fmt.Errorf("could not get workspace %s: empty response", id)
+	}
+	return resp.GetWorkspace(), nil
+}
+
+func (c client) CreateWorkspace(ctx context.Context, name, description string) (*orgcv1.Workspace, error) {
+	if err := c.orgRequired("CreateWorkspace"); err != nil {
+		return nil, err
+	}
+	req := &orgcv1.CreateWorkspaceRequest{
+		OrganizationId: c.organizationID,
+		Name:           name,
+	}
+	if description != "" {
+		req.Description = &description
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("CreateWorkspace", name)
+	resp, err := c.orgGatewayClient.CreateWorkspace(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not create workspace %s: %w", name, err)
+	}
+	if resp == nil || resp.GetWorkspace() == nil {
+		return nil, fmt.Errorf("could not create workspace %s: empty response", name)
+	}
+	return resp.GetWorkspace(), nil
+}
+
+// UpdateWorkspace implements Client.UpdateWorkspace. The description is
+// always sent so clearing it on the spec clears it on the platform.
+func (c client) UpdateWorkspace(ctx context.Context, id, name, description string) (*orgcv1.Workspace, error) {
+	if err := c.orgRequired("UpdateWorkspace"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateWorkspace", id)
+	resp, err := c.orgGatewayClient.UpdateWorkspace(ctx, &orgcv1.UpdateWorkspaceRequest{
+		OrganizationId: c.organizationID,
+		Id:             id,
+		Name:           name,
+		Description:    &description,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not update workspace %s: %w", id, err))
+		}
+		return nil, fmt.Errorf("could not update workspace %s: %w", id, err)
+	}
+	if resp == nil || resp.GetWorkspace() == nil {
+		return nil, fmt.Errorf("could not update workspace %s: empty response", id)
+	}
+	return resp.GetWorkspace(), nil
+}
+
+// DeleteWorkspace implements Client.DeleteWorkspace. A workspace that
+// is already gone surfaces as reason.NotFound so callers can treat the
+// delete as complete.
+func (c client) DeleteWorkspace(ctx context.Context, id string) error {
+	if err := c.orgRequired("DeleteWorkspace"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("DeleteWorkspace", id)
+	_, err := c.orgGatewayClient.DeleteWorkspace(ctx, &orgcv1.DeleteWorkspaceRequest{
+		OrganizationId: c.organizationID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not delete workspace %s: %w", id, err))
+		}
+		return fmt.Errorf("could not delete workspace %s: %w", id, err)
+	}
+	return nil
+}
+
+func (c client) GetWorkspaceMember(ctx context.Context, workspaceID, id string) (*orgcv1.WorkspaceMember, error) {
+	if err := c.orgRequired("GetWorkspaceMember"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	resp, err := c.orgGatewayClient.GetWorkspaceMember(ctx, &orgcv1.GetWorkspaceMemberRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not get workspace %s member %s: %w", workspaceID, id, err))
+		}
+		return nil, fmt.Errorf("could not get workspace %s member %s: %w", workspaceID, id, err)
+	}
+	if resp == nil || resp.GetWorkspaceMember() == nil {
+		return nil, fmt.Errorf("could not get workspace %s member %s: empty response", workspaceID, id)
+	}
+	return resp.GetWorkspaceMember(), nil
+}
+
+func (c client) AddWorkspaceMember(ctx context.Context, workspaceID string, ref *orgcv1.WorkspaceMemberRef) (*orgcv1.WorkspaceMember, error) {
+	if err := c.orgRequired("AddWorkspaceMember"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("AddWorkspaceMember", workspaceID)
+	resp, err := c.orgGatewayClient.AddWorkspaceMember(ctx, &orgcv1.AddWorkspaceMemberRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		MemberRef:      ref,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not add member to workspace %s: %w", workspaceID, err))
+		}
+		return nil, fmt.Errorf("could not add member to workspace %s: %w", workspaceID, err)
+	}
+	if resp == nil || resp.GetWorkspaceMember() == nil {
+		return nil, fmt.Errorf("could not add member to workspace %s: empty response", workspaceID)
+	}
+	return resp.GetWorkspaceMember(), nil
+}
+
+func (c client) UpdateWorkspaceMember(ctx context.Context, workspaceID, id string, role orgcv1.WorkspaceMemberRole) (*orgcv1.WorkspaceMember, error) {
+	if err := c.orgRequired("UpdateWorkspaceMember"); err != nil {
+		return nil, err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateWorkspaceMember", workspaceID)
+	resp, err := c.orgGatewayClient.UpdateWorkspaceMember(ctx, &orgcv1.UpdateWorkspaceMemberRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             id,
+		Role:           role,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return nil, reason.AsNotFound(fmt.Errorf("could not update workspace %s member %s: %w", workspaceID, id, err))
+		}
+		return nil, fmt.Errorf("could not update workspace %s member %s: %w", workspaceID, id, err)
+	}
+	if resp == nil || resp.GetWorkspaceMember() == nil {
+		return nil, fmt.Errorf("could not update workspace %s member %s: empty response", workspaceID, id)
+	}
+	return resp.GetWorkspaceMember(), nil
+}
+
+func (c client) RemoveWorkspaceMember(ctx context.Context, workspaceID, id string) error {
+	if err := c.orgRequired("RemoveWorkspaceMember"); err != nil {
+		return err
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("RemoveWorkspaceMember", workspaceID)
+	_, err := c.orgGatewayClient.RemoveWorkspaceMember(ctx, &orgcv1.RemoveWorkspaceMemberRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             id,
+	})
+	if err != nil {
+		if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+			return reason.AsNotFound(fmt.Errorf("could not remove workspace %s member %s: %w", workspaceID, id, err))
+		}
+		return fmt.Errorf("could not remove workspace %s member %s: %w", workspaceID, id, err)
+	}
+	return nil
+}
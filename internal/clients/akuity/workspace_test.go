@@ -0,0 +1,134 @@
+package akuity_test
+
+import (
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func TestGetWorkspace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().GetWorkspace(authCtx, &orgcv1.GetWorkspaceRequest{
+		OrganizationId: organizationID,
+		Id:             workspaceID,
+	}).Return(&orgcv1.GetWorkspaceResponse{Workspace: &orgcv1.Workspace{Id: workspaceID, Name: "platform"}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	ws, err := client.GetWorkspace(ctx, workspaceID)
+	require.NoError(t, err)
+	assert.Equal(t, "platform", ws.GetName())
+}
+
+func TestGetWorkspace_StatusNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().GetWorkspace(authCtx, gomock.Any()).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetWorkspace(ctx, workspaceID)
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestCreateWorkspace_OmitsEmptyDescription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().CreateWorkspace(authCtx, &orgcv1.CreateWorkspaceRequest{
+		OrganizationId: organizationID,
+		Name:           "platform",
+	}).Return(&orgcv1.CreateWorkspaceResponse{Workspace: &orgcv1.Workspace{Id: workspaceID, Name: "platform"}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	ws, err := client.CreateWorkspace(ctx, "platform", "")
+	require.NoError(t, err)
+	assert.Equal(t, workspaceID, ws.GetId())
+}
+
+func TestCreateWorkspace_NoOrgGateway(t *testing.T) {
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.CreateWorkspace(ctx, "platform", "")
+	require.Error(t, err)
+}
+
+func TestDeleteWorkspace_StatusNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().DeleteWorkspace(authCtx, &orgcv1.DeleteWorkspaceRequest{
+		OrganizationId: organizationID,
+		Id:             workspaceID,
+	}).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	err = client.DeleteWorkspace(ctx, workspaceID)
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
+
+func TestAddWorkspaceMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	ref := &orgcv1.WorkspaceMemberRef{
+		Role:   orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_ADMIN,
+		Member: &orgcv1.WorkspaceMemberRef_UserEmail{UserEmail: "alice@example.com"},
+	}
+	mockOrgGatewayClient.EXPECT().AddWorkspaceMember(authCtx, &orgcv1.AddWorkspaceMemberRequest{
+		OrganizationId: organizationID,
+		WorkspaceId:    workspaceID,
+		MemberRef:      ref,
+	}).Return(&orgcv1.AddWorkspaceMemberResponse{WorkspaceMember: &orgcv1.WorkspaceMember{Id: "wm-1"}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	member, err := client.AddWorkspaceMember(ctx, workspaceID, ref)
+	require.NoError(t, err)
+	assert.Equal(t, "wm-1", member.GetId())
+}
+
+func TestUpdateWorkspaceMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().UpdateWorkspaceMember(authCtx, &orgcv1.UpdateWorkspaceMemberRequest{
+		OrganizationId: organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             "wm-1",
+		Role:           orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_MEMBER,
+	}).Return(&orgcv1.UpdateWorkspaceMemberResponse{WorkspaceMember: &orgcv1.WorkspaceMember{Id: "wm-1"}}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	_, err = client.UpdateWorkspaceMember(ctx, workspaceID, "wm-1", orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_MEMBER)
+	require.NoError(t, err)
+}
+
+func TestRemoveWorkspaceMember_StatusNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrgGatewayClient := mock_akuity_client.NewMockOrganizationServiceGatewayClient(ctrl)
+	mockOrgGatewayClient.EXPECT().RemoveWorkspaceMember(authCtx, gomock.Any()).Return(nil, statusNotFound).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, nil, mockOrgGatewayClient, nil)
+	require.NoError(t, err)
+
+	err = client.RemoveWorkspaceMember(ctx, workspaceID, "wm-1")
+	require.Error(t, err)
+	assert.True(t, reason.IsNotFound(err))
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akuity
+
+import (
+	"context"
+	"fmt"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
+	httpctx "github.com/akuity/grpc-gateway-client/pkg/http/context"
+)
+
+// ----------------------------------------------------------------------
+// Workspace moves. The current workspace is read from the platform
+// rather than the workspace cache, which only records what earlier
+// calls on this client saw.
+// ----------------------------------------------------------------------
+
+func (c client) MoveInstanceWorkspace(ctx context.Context, instanceID, workspace string) error {
+	inst, err := c.GetInstanceByID(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	target, err := c.resolveWorkspaceID(ctx, workspace)
+	if err != nil {
+		return err
+	}
+	current := inst.GetWorkspaceId()
+	if target == "" || target == current {
+		return nil
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateInstanceWorkspace", instanceID)
+	if _, err := c.gatewayClient.UpdateInstanceWorkspace(ctx, &argocdv1.UpdateInstanceWorkspaceRequest{
+		OrganizationId: c.organizationID,
+		WorkspaceId:    current,
+		Id:             instanceID,
+		NewWorkspaceId: target,
+	}); err != nil {
+		return fmt.Errorf("could not move instance %s from workspace %s to %s: %w", instanceID, current, target, err)
+	}
+	return nil
+}
+
+func (c client) MoveKargoInstanceWorkspace(ctx context.Context, kargoInstanceID, workspace string) error {
+	if err := c.kargoRequired("MoveKargoInstanceWorkspace"); err != nil {
+		return err
+	}
+	inst, err := c.GetKargoInstanceByID(ctx, kargoInstanceID)
+	if err != nil {
+		return err
+	}
+	target, err := c.resolveWorkspaceID(ctx, workspace)
+	if err != nil {
+		return err
+	}
+	current := inst.GetWorkspaceId()
+	if target == "" || target == current {
+		return nil
+	}
+	ctx = httpctx.SetAuthorizationHeader(ctx, c.credentials.Scheme(), c.credentials.Credential())
+	incAPIWrite("UpdateKargoInstanceWorkspace", kargoInstanceID)
+	if _, err := c.kargoGatewayClient.UpdateKargoInstanceWorkspace(ctx, &kargov1.UpdateKargoInstanceWorkspaceRequest{
+		OrganizationId: c.organizationID,
+		Id:             kargoInstanceID,
+		WorkspaceId:    current,
+		NewWorkspaceId: target,
+	}); err != nil {
+		return fmt.Errorf("could not move kargo instance %s from workspace %s to %s: %w", kargoInstanceID, current, target, err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akuity_test
+
+import (
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
+	idv1 "github.com/akuity/api-client-go/pkg/api/gen/types/id/v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+)
+
+const newWorkspaceID = "new-workspace-id"
+
+func getInstanceInWorkspace(mockGatewayClient *mock_akuity_client.MockArgoCDServiceGatewayClient, workspace string) *gomock.Call {
+	return mockGatewayClient.EXPECT().GetInstance(authCtx, &argocdv1.GetInstanceRequest{
+		OrganizationId: organizationID,
+		IdType:         idv1.Type_ID,
+		Id:             instanceID,
+	}).Return(&argocdv1.GetInstanceResponse{
+		Instance: &argocdv1.Instance{Id: instanceID, WorkspaceId: workspace},
+	}, nil)
+}
+
+func TestMoveInstanceWorkspace(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	getInstanceInWorkspace(mockGatewayClient, workspaceID).Times(1)
+	mockGatewayClient.EXPECT().UpdateInstanceWorkspace(authCtx, &argocdv1.UpdateInstanceWorkspaceRequest{
+		OrganizationId: organizationID,
+		WorkspaceId:    workspaceID,
+		Id:             instanceID,
+		NewWorkspaceId: newWorkspaceID,
+	}).Return(&argocdv1.UpdateInstanceWorkspaceResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.MoveInstanceWorkspace(ctx, instanceID, newWorkspaceID))
+}
+
+func TestMoveInstanceWorkspace_AlreadyThere(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	getInstanceInWorkspace(mockGatewayClient, workspaceID).Times(1)
+	mockGatewayClient.EXPECT().UpdateInstanceWorkspace(gomock.Any(), gomock.Any()).Times(0)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.MoveInstanceWorkspace(ctx, instanceID, workspaceID))
+}
+
+func TestMoveInstanceWorkspace_Error(t *testing.T) {
+	mockGatewayClient := mock_akuity_client.NewMockArgoCDServiceGatewayClient(gomock.NewController(t))
+	getInstanceInWorkspace(mockGatewayClient, workspaceID).Times(1)
+	mockGatewayClient.EXPECT().UpdateInstanceWorkspace(authCtx, gomock.Any()).Return(nil, errFake).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, mockGatewayClient, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = client.MoveInstanceWorkspace(ctx, instanceID, newWorkspaceID)
+	require.ErrorIs(t, err, errFake)
+}
+
+func TestMoveKargoInstanceWorkspace(t *testing.T) {
+	mockKargoClient := mock_akuity_client.NewMockKargoServiceGatewayClient(gomock.NewController(t))
+	mockKargoClient.EXPECT().ListKargoInstances(authCtx, &kargov1.ListKargoInstancesRequest{
+		OrganizationId: organizationID,
+	}).Return(&kargov1.ListKargoInstancesResponse{Instances: []*kargov1.KargoInstance{
+		{Id: "kargo-1", Name: "kargo", WorkspaceId: workspaceID},
+	}}, nil).Times(1)
+	mockKargoClient.EXPECT().UpdateKargoInstanceWorkspace(authCtx, &kargov1.UpdateKargoInstanceWorkspaceRequest{
+		OrganizationId: organizationID,
+		Id:             "kargo-1",
+		WorkspaceId:    workspaceID,
+		NewWorkspaceId: newWorkspaceID,
+	}).Return(&kargov1.UpdateKargoInstanceWorkspaceResponse{}, nil).Times(1)
+
+	client, err := akuity.NewClient(organizationID, apiKeyID, apiKeySecret, nil, mockKargoClient, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, client.MoveKargoInstanceWorkspace(ctx, "kargo-1", newWorkspaceID))
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// Manifest sink object kinds.
+const (
+	SinkKindSecret    = "Secret"
+	SinkKindConfigMap = "ConfigMap"
+)
+
+const (
+	// ManifestsSinkKey is the data key holding each chunk's manifests.
+	ManifestsSinkKey = "manifests.yaml"
+	// AnnotationManifestsSinkChunks records, on the first chunk object,
+	// how many chunk objects the sink currently spans.
+	AnnotationManifestsSinkChunks = "akuity.crossplane.io/manifests-chunks"
+	// LabelManifestsSinkOwner records, on every sink object, the UID of
+	// the managed resource that wrote it. Objects without the matching
+	// label are never overwritten or deleted.
+	LabelManifestsSinkOwner = "akuity.crossplane.io/manifests-sink-owner"
+
+	// maxManifestsSinkChunkBytes bounds a single chunk, leaving room for
+	// object metadata under the API server's 1MiB object size limit.
+	maxManifestsSinkChunkBytes = 900 * 1024
+)
+
+// yamlDocumentSeparator matches a "---" line between YAML documents.
+var yamlDocumentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// ManifestsSink names the Secret or ConfigMap that receives agent
+// manifests when the target is a sink rather than a cluster. Manifests
+// too large for one object are split at YAML document boundaries into
+// Name, Name-1, Name-2, and so on, so each object holds complete
+// documents that can be applied on their own.
+//
+// Owner is the UID of the managed resource the sink belongs to. It is
+// stamped on each object the sink creates, and existing objects that
+// carry a different owner, or none, are left untouched so a sink can
+// never clobber a Secret or ConfigMap it did not create.
+type ManifestsSink struct {
+	Kind      string
+	Name      string
+	Namespace string
+	Owner     string
+}
+
+// chunkName returns the object name of chunk i.
+func (s ManifestsSink) chunkName(i int) string {
+	if i == 0 {
+		return s.Name
+	}
+	return fmt.Sprintf("%s-%d", s.Name, i)
+}
+
+// WriteManifestsSink writes manifests into the sink, or deletes every
+// sink object when del is true. Chunk objects left over from an earlier,
+// larger write are deleted. Objects not owned by s.Owner are never
+// written, and are skipped rather than deleted.
+func WriteManifestsSink(ctx context.Context, c client.Client, s ManifestsSink, manifests string, del bool) error {
+	if s.Owner == "" {
+		return fmt.Errorf("manifests sink %s %s/%s has no owner", s.Kind, s.Namespace, s.Name)
+	}
+	previous, err := manifestsSinkChunkCount(ctx, c, s)
+	if err != nil {
+		return err
+	}
+	var chunks []string
+	if !del {
+		if chunks, err = chunkManifests(manifests, maxManifestsSinkChunkBytes); err != nil {
+			return fmt.Errorf("could not split manifests for %s %s/%s: %w", s.Kind, s.Namespace, s.Name, err)
+		}
+		for i, chunk := range chunks {
+			if err := writeManifestsSinkChunk(ctx, c, s, i, len(chunks), chunk); err != nil {
+				return err
+			}
+		}
+	}
+	for i := len(chunks); i < max(previous, 1); i++ {
+		if err := deleteManifestsSinkChunk(ctx, c, s, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// manifestsSinkChunkCount reads the chunk count recorded on the first
+// chunk object. A missing object or annotation counts as one chunk so
+// an object written before the annotation existed is still replaced.
+// An object not owned by s.Owner contributes no chunks.
+func manifestsSinkChunkCount(ctx context.Context, c client.Client, s ManifestsSink) (int, error) {
+	obj, err := newManifestsSinkObject(s, 0)
+	if err != nil {
+		return 0, err
+	}
+	if err := c.Get(ctx, k8stypes.NamespacedName{Namespace: s.Namespace, Name: s.Name}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("could not get %s %s/%s: %w", s.Kind, s.Namespace, s.Name, err)
+	}
+	if !ownsManifestsSinkObject(s, obj) {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(obj.GetAnnotations()[AnnotationManifestsSinkChunks])
+	if err != nil || n < 1 {
+		return 1, nil
+	}
+	return n, nil
+}
+
+func writeManifestsSinkChunk(ctx context.Context, c client.Client, s ManifestsSink, i, total int, chunk string) error {
+	obj, err := newManifestsSinkObject(s, i)
+	if err != nil {
+		return err
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, c, obj, func() error {
+		if obj.GetResourceVersion() != "" && !ownsManifestsSinkObject(s, obj) {
+			return fmt.Errorf("refusing to overwrite an object not created for this resource: it lacks the %s=%s label", LabelManifestsSinkOwner, s.Owner)
+		}
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[LabelManifestsSinkOwner] = s.Owner
+		obj.SetLabels(labels)
+		if i == 0 {
+			annotations := obj.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[AnnotationManifestsSinkChunks] = strconv.Itoa(total)
+			obj.SetAnnotations(annotations)
+		}
+		switch o := obj.(type) {
+		case *corev1.Secret:
+			o.Data = map[string][]byte{ManifestsSinkKey: []byte(chunk)}
+		case *corev1.ConfigMap:
+			o.Data = map[string]string{ManifestsSinkKey: chunk}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not write %s %s/%s: %w", s.Kind, s.Namespace, s.chunkName(i), err)
+	}
+	return nil
+}
+
+// deleteManifestsSinkChunk deletes chunk i when it exists and is owned
+// by s.Owner. The delete is conditioned on the UID and resource version
+// that were checked, so an object replaced in between is not removed.
+func deleteManifestsSinkChunk(ctx context.Context, c client.Client, s ManifestsSink, i int) error {
+	obj, err := newManifestsSinkObject(s, i)
+	if err != nil {
+		return err
+	}
+	if err := c.Get(ctx, k8stypes.NamespacedName{Namespace: s.Namespace, Name: s.chunkName(i)}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("could not get %s %s/%s: %w", s.Kind, s.Namespace, s.chunkName(i), err)
+	}
+	if !ownsManifestsSinkObject(s, obj) {
+		return nil
+	}
+	uid, rv := obj.GetUID(), obj.GetResourceVersion()
+	if err := c.Delete(ctx, obj, client.Preconditions{UID: &uid, ResourceVersion: &rv}); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("could not delete %s %s/%s: %w", s.Kind, s.Namespace, s.chunkName(i), err)
+	}
+	return nil
+}
+
+// ownsManifestsSinkObject reports whether obj carries s.Owner's
+// ownership label.
+func ownsManifestsSinkObject(s ManifestsSink, obj client.Object) bool {
+	return obj.GetLabels()[LabelManifestsSinkOwner] == s.Owner
+}
+
+func newManifestsSinkObject(s ManifestsSink, i int) (client.Object, error) {
+	meta := metav1.ObjectMeta{Namespace: s.Namespace, Name: s.chunkName(i)}
+	switch s.Kind {
+	case SinkKindSecret, "":
+		return &corev1.Secret{ObjectMeta: meta, Type: corev1.SecretTypeOpaque}, nil
+	case SinkKindConfigMap:
+		return &corev1.ConfigMap{ObjectMeta: meta}, nil
+	default:
+		return nil, fmt.Errorf("unsupported manifests sink kind %q: must be %s or %s", s.Kind, SinkKindSecret, SinkKindConfigMap)
+	}
+}
+
+// chunkManifests packs the YAML documents in manifests into chunks of
+// at most limit bytes. Documents are never split, so a single document
+// larger than limit is an error. Empty manifests yield one empty chunk
+// so the sink still exists.
+func chunkManifests(manifests string, limit int) ([]string, error) {
+	var chunks []string
+	var current strings.Builder
+	for _, doc := range yamlDocumentSeparator.Split(manifests, -1) {
+		doc = strings.TrimSpace(doc)
+		if doc == "" {
+			continue
+		}
+		doc += "\n"
+		if len(doc) > limit {
+			return nil, fmt.Errorf("a manifest document of %d bytes exceeds the %d byte chunk limit", len(doc), limit)
+		}
+		if current.Len() > 0 && current.Len()+len("---\n")+len(doc) > limit {
+			chunks = append(chunks, current.String())
+			current.Reset()
+		}
+		if current.Len() > 0 {
+			current.WriteString("---\n")
+		}
+		current.WriteString(doc)
+	}
+	if current.Len() > 0 || len(chunks) == 0 {
+		chunks = append(chunks, current.String())
+	}
+	return chunks, nil
+}
@@ -0,0 +1,194 @@
+package kube_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/kube"
+)
+
+func newSinkClient(t *testing.T) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).Build()
+}
+
+// largeManifest returns a ConfigMap document padded to roughly size bytes.
+func largeManifest(name string, size int) string {
+	return fmt.Sprintf("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: %s\ndata:\n  pad: %s\n", name, strings.Repeat("x", size))
+}
+
+func TestWriteManifestsSink_Secret(t *testing.T) {
+	c := newSinkClient(t)
+	sink := kube.ManifestsSink{Kind: kube.SinkKindSecret, Name: "agent", Namespace: "ns", Owner: "uid-1"}
+
+	require.NoError(t, kube.WriteManifestsSink(ctx, c, sink, configMapManifest(), false))
+
+	got := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: "agent"}, got))
+	require.Equal(t, configMapManifest(), string(got.Data[kube.ManifestsSinkKey]))
+	require.Equal(t, "1", got.Annotations[kube.AnnotationManifestsSinkChunks])
+}
+
+func TestWriteManifestsSink_ConfigMapViaTarget(t *testing.T) {
+	c := newSinkClient(t)
+	target := kube.TargetKubeConfig{Sink: &kube.ManifestsSink{Kind: kube.SinkKindConfigMap, Name: "agent", Namespace: "ns", Owner: "uid-1"}}
+	require.True(t, target.HasTarget())
+	require.False(t, target.HasKubeConfig())
+	require.Equal(t, "sink:ConfigMap:ns/agent", kube.TargetFingerprint(ctx, c, target))
+
+	require.NoError(t, kube.ApplyManifestsToTarget(ctx, c, logging.NewNopLogger(), target, configMapManifest(), false))
+
+	got := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: "agent"}, got))
+	require.Equal(t, configMapManifest(), got.Data[kube.ManifestsSinkKey])
+}
+
+func TestWriteManifestsSink_ChunksAndPrunes(t *testing.T) {
+	c := newSinkClient(t)
+	sink := kube.ManifestsSink{Kind: kube.SinkKindSecret, Name: "agent", Namespace: "ns", Owner: "uid-1"}
+	manifests := strings.Join([]string{
+		largeManifest("a", 500*1024),
+		largeManifest("b", 500*1024),
+		largeManifest("c", 500*1024),
+	}, "---\n")
+
+	require.NoError(t, kube.WriteManifestsSink(ctx, c, sink, manifests, false))
+
+	first := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: "agent"}, first))
+	require.Equal(t, "3", first.Annotations[kube.AnnotationManifestsSinkChunks])
+	for _, name := range []string{"agent-1", "agent-2"} {
+		chunk := &corev1.Secret{}
+		require.NoError(t, c.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: name}, chunk))
+		require.Contains(t, string(chunk.Data[kube.ManifestsSinkKey]), "kind: ConfigMap")
+	}
+
+	// A smaller write prunes the chunks it no longer needs.
+	require.NoError(t, kube.WriteManifestsSink(ctx, c, sink, configMapManifest(), false))
+	require.NoError(t, c.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: "agent"}, first))
+	require.Equal(t, "1", first.Annotations[kube.AnnotationManifestsSinkChunks])
+	for _, name := range []string{"agent-1", "agent-2"} {
+		err := c.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: name}, &corev1.Secret{})
+		require.True(t, apierrors.IsNotFound(err), name)
+	}
+}
+
+func TestWriteManifestsSink_DocumentTooLargeErr(t *testing.T) {
+	c := newSinkClient(t)
+	sink := kube.ManifestsSink{Kind: kube.SinkKindSecret, Name: "agent", Namespace: "ns", Owner: "uid-1"}
+
+	err := kube.WriteManifestsSink(ctx, c, sink, largeManifest("a", 1024*1024), false)
+	require.ErrorContains(t, err, "exceeds")
+}
+
+func TestWriteManifestsSink_Delete(t *testing.T) {
+	c := newSinkClient(t)
+	sink := kube.ManifestsSink{Kind: kube.SinkKindSecret, Name: "agent", Namespace: "ns", Owner: "uid-1"}
+	manifests := largeManifest("a", 500*1024) + "---\n" + largeManifest("b", 500*1024)
+	require.NoError(t, kube.WriteManifestsSink(ctx, c, sink, manifests, false))
+
+	require.NoError(t, kube.WriteManifestsSink(ctx, c, sink, "", true))
+	for _, name := range []string{"agent", "agent-1"} {
+		err := c.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: name}, &corev1.Secret{})
+		require.True(t, apierrors.IsNotFound(err), name)
+	}
+
+	// Deleting an absent sink is a no-op.
+	require.NoError(t, kube.WriteManifestsSink(ctx, c, sink, "", true))
+}
+
+func TestWriteManifestsSink_UnsupportedKindErr(t *testing.T) {
+	c := newSinkClient(t)
+	sink := kube.ManifestsSink{Kind: "Pod", Name: "agent", Namespace: "ns", Owner: "uid-1"}
+
+	err := kube.WriteManifestsSink(ctx, c, sink, configMapManifest(), false)
+	require.ErrorContains(t, err, "unsupported manifests sink kind")
+}
+
+func TestWriteManifestsSink_LabelsOwner(t *testing.T) {
+	c := newSinkClient(t)
+	sink := kube.ManifestsSink{Kind: kube.SinkKindSecret, Name: "agent", Namespace: "ns", Owner: "uid-1"}
+
+	require.NoError(t, kube.WriteManifestsSink(ctx, c, sink, configMapManifest(), false))
+
+	got := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: "agent"}, got))
+	require.Equal(t, "uid-1", got.Labels[kube.LabelManifestsSinkOwner])
+}
+
+func TestWriteManifestsSink_NoOwnerErr(t *testing.T) {
+	c := newSinkClient(t)
+	sink := kube.ManifestsSink{Kind: kube.SinkKindSecret, Name: "agent", Namespace: "ns"}
+
+	err := kube.WriteManifestsSink(ctx, c, sink, configMapManifest(), false)
+	require.ErrorContains(t, err, "has no owner")
+}
+
+func TestWriteManifestsSink_RefusesUnownedObject(t *testing.T) {
+	for name, labels := range map[string]map[string]string{
+		"unlabeled":     nil,
+		"another owner": {kube.LabelManifestsSinkOwner: "uid-2"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			existing := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "agent", Labels: labels},
+				Data:       map[string][]byte{"password": []byte("keep")},
+			}
+			c := newSinkClient(t)
+			require.NoError(t, c.Create(ctx, existing))
+			sink := kube.ManifestsSink{Kind: kube.SinkKindSecret, Name: "agent", Namespace: "ns", Owner: "uid-1"}
+
+			err := kube.WriteManifestsSink(ctx, c, sink, configMapManifest(), false)
+			require.ErrorContains(t, err, "refusing to overwrite")
+
+			got := &corev1.Secret{}
+			require.NoError(t, c.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: "agent"}, got))
+			require.Equal(t, map[string][]byte{"password": []byte("keep")}, got.Data)
+		})
+	}
+}
+
+func TestWriteManifestsSink_DeleteSkipsUnownedObjects(t *testing.T) {
+	c := newSinkClient(t)
+	require.NoError(t, c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "agent"}}))
+	require.NoError(t, c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "ns",
+		Name:      "other",
+		Labels:    map[string]string{kube.LabelManifestsSinkOwner: "uid-2"},
+	}}))
+
+	for _, name := range []string{"agent", "other"} {
+		sink := kube.ManifestsSink{Kind: kube.SinkKindSecret, Name: name, Namespace: "ns", Owner: "uid-1"}
+		require.NoError(t, kube.WriteManifestsSink(ctx, c, sink, "", true))
+		require.NoError(t, c.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: name}, &corev1.Secret{}), name)
+	}
+}
+
+func TestWriteManifestsSink_PruneSkipsUnownedChunk(t *testing.T) {
+	c := newSinkClient(t)
+	sink := kube.ManifestsSink{Kind: kube.SinkKindSecret, Name: "agent", Namespace: "ns", Owner: "uid-1"}
+	manifests := largeManifest("a", 500*1024) + "---\n" + largeManifest("b", 500*1024)
+	require.NoError(t, kube.WriteManifestsSink(ctx, c, sink, manifests, false))
+
+	// Someone else takes over the second chunk's name.
+	chunk := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: "agent-1"}, chunk))
+	delete(chunk.Labels, kube.LabelManifestsSinkOwner)
+	require.NoError(t, c.Update(ctx, chunk))
+
+	require.NoError(t, kube.WriteManifestsSink(ctx, c, sink, configMapManifest(), false))
+	require.NoError(t, c.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: "agent-1"}, &corev1.Secret{}))
+}
@@ -43,6 +43,9 @@ type TargetKubeConfig struct {
 	EnableInCluster bool
 	SecretName      string
 	SecretNamespace string
+	// Sink, when set, replaces the apply with a write of the manifests
+	// into a Secret or ConfigMap on the control-plane cluster.
+	Sink *ManifestsSink
 }
 
 // HasKubeConfig reports whether either kubeconfig source is configured.
@@ -51,6 +54,12 @@ func (t TargetKubeConfig) HasKubeConfig() bool {
 	return t.EnableInCluster || t.SecretName != ""
 }
 
+// HasTarget reports whether manifests have anywhere to go, either a
+// kubeconfig-reachable cluster or a manifests sink.
+func (t TargetKubeConfig) HasTarget() bool {
+	return t.Sink != nil || t.HasKubeConfig()
+}
+
 // TargetFingerprint returns a stable, non-secret fingerprint for the
 // kubeconfig source used by terminal-write guards. It includes Secret
 // contents when available so rotating a bad kubeconfig lets a suppressed
@@ -58,6 +67,9 @@ func (t TargetKubeConfig) HasKubeConfig() bool {
 // included as markers so missing or malformed Secret refs can still be
 // suppressed until the Secret changes or the spec changes.
 func TargetFingerprint(ctx context.Context, c client.Client, t TargetKubeConfig) string {
+	if t.Sink != nil {
+		return fmt.Sprintf("sink:%s:%s/%s", t.Sink.Kind, t.Sink.Namespace, t.Sink.Name)
+	}
 	if !t.HasKubeConfig() {
 		return ""
 	}
@@ -122,8 +134,12 @@ func RestConfig(ctx context.Context, c client.Client, t TargetKubeConfig) (*rest
 // ApplyClient against it, and applies (or deletes when del is true)
 // the supplied manifests string. All three steps carry their own
 // error-wrap so the caller can distinguish config, client, and apply
-// failures in logs.
+// failures in logs. When t has a Sink the manifests are written to it
+// through c instead.
 func ApplyManifestsToTarget(ctx context.Context, c client.Client, logger logging.Logger, t TargetKubeConfig, manifests string, del bool) error {
+	if t.Sink != nil {
+		return WriteManifestsSink(ctx, c, *t.Sink, manifests, del)
+	}
 	cfg, err := RestConfig(ctx, c, t)
 	if err != nil {
 		return err
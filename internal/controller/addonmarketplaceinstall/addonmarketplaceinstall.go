@@ -0,0 +1,305 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package addonmarketplaceinstall is the AddonMarketplaceInstall
+// controller. It installs an addon from the Akuity addon marketplace
+// into an addon repository of an Argo CD instance through the Argo CD
+// gateway's AddonMarketplaceInstall endpoint. The Akuity-assigned
+// install ID is the external-name.
+//
+// Only the Helm chart dependencies of an install can change after it is
+// created; they are written with UpdateAddonMarketplaceInstall. Every
+// other field is used at install time only and is not compared for
+// drift.
+package addonmarketplaceinstall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+//
+// The external-name is the server-assigned install ID stamped on
+// Create, so the default NameAsExternalName initializer is disabled.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AddonMarketplaceInstallGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.AddonMarketplaceInstall]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.AddonMarketplaceInstall] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AddonMarketplaceInstallGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.AddonMarketplaceInstall](conn),
+		managed.WithInitializers(),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.AddonMarketplaceInstall{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.AddonMarketplaceInstall) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if meta.GetExternalName(mg) == "" {
+		// An AddonMarketplaceInstall rejected on bad input never stamps
+		// the external-name; suppress the retry loop until the spec
+		// changes.
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	install, err := e.Client.GetAddonMarketplaceInstall(ctx, instanceID, meta.GetExternalName(mg))
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+	if meta.WasDeleted(mg) && install.GetDeleteTime() != nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	mg.Status.AtProvider = installObservation(instanceID, install)
+	// The install is done once the platform has written the addon and
+	// found it in the repository.
+	base.SetHealthCondition(mg, install.GetAddonFound() && !install.GetStatusInfo().GetProcessing())
+
+	desired := mg.Spec.ForProvider
+	observed := installParameters(install)
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "AddonMarketplaceInstall")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg, instanceID)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.AddonMarketplaceInstall,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.AddonMarketplaceInstall) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	key, err := addonMarketplaceInstallTerminalWriteKey(mg, instanceID)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	install, err := e.Client.AddonMarketplaceInstall(ctx, instanceID, installConfigToProto(mg.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider.InstanceID = instanceID
+	meta.SetExternalName(mg, install.GetId())
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.AddonMarketplaceInstall) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	key, err := addonMarketplaceInstallTerminalWriteKey(mg, instanceID)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	var deps []v1alpha1.AddonChartDependency
+	if mg.Spec.ForProvider.HelmChart != nil {
+		deps = mg.Spec.ForProvider.HelmChart.Dependencies
+	}
+	if _, err := e.Client.UpdateAddonMarketplaceInstall(ctx, instanceID, meta.GetExternalName(mg), dependenciesToProto(deps)); err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.AddonMarketplaceInstall) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.AddonMarketplaceInstallGroupVersionKind)
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	if err := e.Client.DeleteAddonMarketplaceInstall(ctx, instanceID, id); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// resolveInstanceID returns the opaque Akuity ID of the target Instance.
+// ForProvider.InstanceID takes precedence; if absent, InstanceRef is
+// resolved against an Instance MR and its Status.AtProvider.ID is used.
+// The cached Status.AtProvider.InstanceID is only consulted during
+// deletion once the referenced Instance MR is gone.
+func (e *external) resolveInstanceID(ctx context.Context, mg *v1alpha1.AddonMarketplaceInstall) (string, error) {
+	if id := mg.Spec.ForProvider.InstanceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.InstanceRef == nil || mg.Spec.ForProvider.InstanceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.instanceId or spec.forProvider.instanceRef must be set")
+	}
+
+	inst := &v1alpha1.Instance{}
+	key := k8stypes.NamespacedName{Name: mg.Spec.ForProvider.InstanceRef.Name, Namespace: mg.GetNamespace()}
+	if err := e.Kube.Get(ctx, key, inst); err != nil {
+		if apierrors.IsNotFound(err) && meta.WasDeleted(mg) {
+			if cached := mg.Status.AtProvider.InstanceID; cached != "" {
+				return cached, nil
+			}
+		}
+		return "", fmt.Errorf("could not resolve InstanceRef %s: %w", key.Name, err)
+	}
+	if inst.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced Instance %s has not yet reported an ID; waiting for its controller to observe", key.Name)
+	}
+	return inst.Status.AtProvider.ID, nil
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.AddonMarketplaceInstall, instanceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := addonMarketplaceInstallTerminalWriteKey(mg, instanceID)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.AddonMarketplaceInstall, instanceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.AddonMarketplaceInstallGroupVersionKind) {
+		return
+	}
+	key, err := addonMarketplaceInstallTerminalWriteKey(mg, instanceID)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func addonMarketplaceInstallTerminalWriteKey(mg *v1alpha1.AddonMarketplaceInstall, instanceID string) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.AddonMarketplaceInstallGroupVersionKind, instanceID, mg.Spec.ForProvider)
+}
+
+// driftSpec is the resource's drift-detection recipe. Only the Helm
+// chart dependencies can be updated in place, so everything else is
+// ignored; dependencies are compared as a set keyed by chart name, and
+// only when the spec sets helmChart.
+func driftSpec() base.DriftSpec[v1alpha1.AddonMarketplaceInstallParameters] {
+	return base.DriftSpec[v1alpha1.AddonMarketplaceInstallParameters]{
+		Ignore: []cmp.Option{
+			cmpopts.IgnoreFields(v1alpha1.AddonMarketplaceInstallParameters{},
+				"InstanceID", "InstanceRef", "RepoURL", "Revision", "AddonName", "Type", "Overrides"),
+			cmpopts.IgnoreFields(v1alpha1.AddonMarketplaceHelmChart{}, "Name", "Version", "Description"),
+			cmpopts.SortSlices(func(a, b v1alpha1.AddonChartDependency) bool { return a.Name < b.Name }),
+		},
+		Normalize: func(desired, observed *v1alpha1.AddonMarketplaceInstallParameters) {
+			if desired.HelmChart == nil {
+				observed.HelmChart = nil
+			} else if observed.HelmChart == nil {
+				observed.HelmChart = &v1alpha1.AddonMarketplaceHelmChart{}
+			}
+		},
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addonmarketplaceinstall
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const (
+	instanceID = "inst-1"
+	installID  = "install-1"
+	repoURL    = "https://github.com/example/addons.git"
+)
+
+var certManager = v1alpha1.AddonChartDependency{
+	Name:       "cert-manager",
+	Version:    "1.16.1",
+	Repository: "https://charts.jetstack.io",
+}
+
+func newInstall() *v1alpha1.AddonMarketplaceInstall {
+	mg := &v1alpha1.AddonMarketplaceInstall{
+		ObjectMeta: metav1.ObjectMeta{Name: "cert-manager", UID: "cert-manager-uid"},
+		Spec: v1alpha1.AddonMarketplaceInstallSpec{
+			ForProvider: v1alpha1.AddonMarketplaceInstallParameters{
+				InstanceRef: &v1alpha1.LocalReference{Name: "prod"},
+				RepoURL:     repoURL,
+				Revision:    "main",
+				AddonName:   "cert-manager",
+				HelmChart: &v1alpha1.AddonMarketplaceHelmChart{
+					Name:         "cert-manager",
+					Version:      "0.1.0",
+					Dependencies: []v1alpha1.AddonChartDependency{certManager},
+				},
+				Overrides: &v1alpha1.AddonMarketplaceInstallOverrides{Envs: []string{"prod"}},
+			},
+		},
+	}
+	meta.SetExternalName(mg, installID)
+	return mg
+}
+
+func prodInstance() *v1alpha1.Instance {
+	inst := &v1alpha1.Instance{ObjectMeta: metav1.ObjectMeta{Name: "prod"}}
+	inst.Status.AtProvider.ID = instanceID
+	return inst
+}
+
+func platformInstall(version string) *argocdv1.AddonMarketplaceInstall {
+	return &argocdv1.AddonMarketplaceInstall{
+		Id: installID,
+		Config: &argocdv1.AddonMarketplaceInstallConfig{
+			RepoUrl:   repoURL,
+			Revision:  "main",
+			AddonName: "cert-manager",
+			HelmChartConfig: &argocdv1.HelmChartInstallConfig{
+				Name:    "cert-manager",
+				Version: "0.1.0",
+				Dependencies: []*argocdv1.ChartDependency{{
+					Name:       certManager.Name,
+					Version:    version,
+					Repository: certManager.Repository,
+				}},
+			},
+		},
+		AddonFound:      true,
+		ChecksumMatched: true,
+		StatusInfo: &argocdv1.AddonMarketplaceStatus{
+			EventList: []*argocdv1.AddonEvent{
+				{Type: "Installed", Message: "addon written", Time: timestamppb.New(time.Unix(200, 0))},
+				{Type: "Requested", Message: "install requested", Time: timestamppb.New(time.Unix(100, 0))},
+			},
+		},
+	}
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_NotFound(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mc.EXPECT().GetAddonMarketplaceInstall(gomock.Any(), instanceID, installID).Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), newInstall())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_UpToDate(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newInstall()
+	mc.EXPECT().GetAddonMarketplaceInstall(gomock.Any(), instanceID, installID).Return(platformInstall(certManager.Version), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.True(t, mg.Status.AtProvider.AddonFound)
+	require.NotNil(t, mg.Status.AtProvider.LastEvent)
+	assert.Equal(t, "Installed", mg.Status.AtProvider.LastEvent.Type)
+	assert.Equal(t, corev1.ConditionTrue, mg.GetCondition(xpv1.TypeReady).Status)
+}
+
+func TestObserve_ProcessingIsUnavailable(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newInstall()
+	install := platformInstall(certManager.Version)
+	install.StatusInfo.Processing = true
+	mc.EXPECT().GetAddonMarketplaceInstall(gomock.Any(), instanceID, installID).Return(install, nil).Times(1)
+
+	_, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, corev1.ConditionFalse, mg.GetCondition(xpv1.TypeReady).Status)
+}
+
+func TestObserve_DependencyVersionDrift(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mc.EXPECT().GetAddonMarketplaceInstall(gomock.Any(), instanceID, installID).Return(platformInstall("1.15.0"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), newInstall())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_InstallTimeFieldsDoNotDrift(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newInstall()
+	mg.Spec.ForProvider.HelmChart.Description = "changed"
+	mg.Spec.ForProvider.Overrides.Clusters = []string{"prod-east"}
+	mc.EXPECT().GetAddonMarketplaceInstall(gomock.Any(), instanceID, installID).Return(platformInstall(certManager.Version), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+}
+
+func TestCreate_StampsID(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newInstall()
+	meta.SetExternalName(mg, "")
+	mc.EXPECT().AddonMarketplaceInstall(gomock.Any(), instanceID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, cfg *argocdv1.AddonMarketplaceInstallConfig) (*argocdv1.AddonMarketplaceInstall, error) {
+			assert.Equal(t, repoURL, cfg.GetRepoUrl())
+			assert.Equal(t, "cert-manager", cfg.GetAddonName())
+			assert.Equal(t, []string{"prod"}, cfg.GetOverrides().GetEnvs())
+			assert.Equal(t, certManager.Version, cfg.GetHelmChartConfig().GetDependencies()[0].GetVersion())
+			return &argocdv1.AddonMarketplaceInstall{Id: installID}, nil
+		}).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, installID, meta.GetExternalName(mg))
+	assert.Equal(t, instanceID, mg.Status.AtProvider.InstanceID)
+}
+
+func TestCreate_RejectedIsSuppressed(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newInstall()
+	meta.SetExternalName(mg, "")
+	mc.EXPECT().AddonMarketplaceInstall(gomock.Any(), instanceID, gomock.Any()).
+		Return(nil, reason.AsTerminal(errors.New("unknown addon"))).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.Error(t, err)
+
+	// The next Observe suppresses the retry until the spec changes.
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestUpdate_SendsDependencies(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newInstall()
+	mc.EXPECT().UpdateAddonMarketplaceInstall(gomock.Any(), instanceID, installID, []*argocdv1.ChartDependency{{
+		Name:       certManager.Name,
+		Version:    certManager.Version,
+		Repository: certManager.Repository,
+	}}).Return(platformInstall(certManager.Version), nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+}
+
+func TestDelete_UsesCachedInstanceID(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newInstall()
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+	mg.Status.AtProvider.InstanceID = instanceID
+	mc.EXPECT().DeleteAddonMarketplaceInstall(gomock.Any(), instanceID, installID).Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addonmarketplaceinstall
+
+import (
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+)
+
+func installConfigToProto(fp v1alpha1.AddonMarketplaceInstallParameters) *argocdv1.AddonMarketplaceInstallConfig {
+	cfg := &argocdv1.AddonMarketplaceInstallConfig{
+		RepoUrl:   fp.RepoURL,
+		Revision:  fp.Revision,
+		AddonName: fp.AddonName,
+		Type:      fp.Type,
+	}
+	if hc := fp.HelmChart; hc != nil {
+		cfg.HelmChartConfig = &argocdv1.HelmChartInstallConfig{
+			Name:         hc.Name,
+			Version:      hc.Version,
+			Description:  hc.Description,
+			Dependencies: dependenciesToProto(hc.Dependencies),
+		}
+	}
+	if o := fp.Overrides; o != nil {
+		cfg.Overrides = &argocdv1.AddonMarketplaceInstallOverrides{
+			Envs:     o.Envs,
+			Clusters: o.Clusters,
+		}
+	}
+	return cfg
+}
+
+func dependenciesToProto(in []v1alpha1.AddonChartDependency) []*argocdv1.ChartDependency {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*argocdv1.ChartDependency, 0, len(in))
+	for _, d := range in {
+		dep := &argocdv1.ChartDependency{
+			Name:       d.Name,
+			Version:    d.Version,
+			Repository: d.Repository,
+		}
+		if d.RepositoryName != "" {
+			dep.RepositoryName = ptr.To(d.RepositoryName)
+		}
+		out = append(out, dep)
+	}
+	return out
+}
+
+func dependenciesFromProto(in []*argocdv1.ChartDependency) []v1alpha1.AddonChartDependency {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]v1alpha1.AddonChartDependency, 0, len(in))
+	for _, d := range in {
+		out = append(out, v1alpha1.AddonChartDependency{
+			Name:           d.GetName(),
+			Version:        d.GetVersion(),
+			Repository:     d.GetRepository(),
+			RepositoryName: d.GetRepositoryName(),
+		})
+	}
+	return out
+}
+
+// installParameters projects the platform install onto the spec shape
+// for drift comparison.
+func installParameters(i *argocdv1.AddonMarketplaceInstall) v1alpha1.AddonMarketplaceInstallParameters {
+	cfg := i.GetConfig()
+	p := v1alpha1.AddonMarketplaceInstallParameters{
+		RepoURL:   cfg.GetRepoUrl(),
+		Revision:  cfg.GetRevision(),
+		AddonName: cfg.GetAddonName(),
+		Type:      cfg.GetType(),
+	}
+	if hc := cfg.GetHelmChartConfig(); hc != nil {
+		p.HelmChart = &v1alpha1.AddonMarketplaceHelmChart{
+			Name:         hc.GetName(),
+			Version:      hc.GetVersion(),
+			Description:  hc.GetDescription(),
+			Dependencies: dependenciesFromProto(hc.GetDependencies()),
+		}
+	}
+	return p
+}
+
+func installObservation(instanceID string, i *argocdv1.AddonMarketplaceInstall) v1alpha1.AddonMarketplaceInstallObservation {
+	cfg := i.GetConfig()
+	obs := v1alpha1.AddonMarketplaceInstallObservation{
+		ID:              i.GetId(),
+		InstanceID:      instanceID,
+		RepoURL:         cfg.GetRepoUrl(),
+		Revision:        cfg.GetRevision(),
+		AddonName:       cfg.GetAddonName(),
+		Dependencies:    dependenciesFromProto(cfg.GetHelmChartConfig().GetDependencies()),
+		AddonFound:      i.GetAddonFound(),
+		ChecksumMatched: i.GetChecksumMatched(),
+		Processing:      i.GetStatusInfo().GetProcessing(),
+	}
+	if last := latestEvent(i.GetStatusInfo().GetEventList()); last != nil {
+		obs.LastEvent = &v1alpha1.AddonMarketplaceEvent{
+			Type:    last.GetType(),
+			Message: last.GetMessage(),
+		}
+		if last.GetTime() != nil {
+			obs.LastEvent.Time = ptr.To(metav1.NewTime(last.GetTime().AsTime()))
+		}
+	}
+	return obs
+}
+
+// latestEvent returns the most recent event. The platform does not
+// document the list order, so events are compared by time.
+func latestEvent(events []*argocdv1.AddonEvent) *argocdv1.AddonEvent {
+	var latest *argocdv1.AddonEvent
+	for _, ev := range events {
+		if latest == nil || ev.GetTime().AsTime().After(latest.GetTime().AsTime()) {
+			latest = ev
+		}
+	}
+	return latest
+}
@@ -0,0 +1,470 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package agentversionpolicy is the AgentVersionPolicy controller. It
+// rolls an agent version out to the Cluster managed resources of one
+// Argo CD instance in waves through UpdateInstanceClustersAgentVersion.
+//
+// The controller never reads agent state from the platform itself: it
+// gates every wave on the agent version and health that the Cluster
+// controller already reports in each Cluster's status. A wave succeeds
+// once all of its clusters report the target version and a healthy
+// status; the next wave starts after PauseBetweenWaves. An agent that
+// runs the target version and reports Degraded health or a failed
+// reconciliation halts the rollout until the target version changes,
+// and so does a wave that has not succeeded within WaveTimeout, such as
+// one whose agents stay Unknown or never report the target version.
+//
+// The policy has no platform-side object. Deleting it stops the
+// rollout and leaves every agent at the version it was last set to.
+package agentversionpolicy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Agent health and reconciliation codes reported in
+// ClusterObservation.HealthStatus and ReconciliationStatus.
+const (
+	healthHealthy        int32 = 1
+	healthDegraded       int32 = 3
+	reconciliationFailed int32 = 3
+)
+
+// defaultWaveTimeout is how long a wave may take to succeed when
+// WaveTimeout is unset.
+const defaultWaveTimeout = 30 * time.Minute
+
+// Setup registers the controller with the manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AgentVersionPolicyGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.AgentVersionPolicy]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.AgentVersionPolicy] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r), now: time.Now}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AgentVersionPolicyGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.AgentVersionPolicy](conn),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(func(mg resource.Managed, pollInterval time.Duration) time.Duration {
+			p, ok := mg.(*v1alpha1.AgentVersionPolicy)
+			if !ok {
+				return pollInterval
+			}
+			st := p.Status.AtProvider
+			return base.RolloutPollInterval(st.Phase == v1alpha1.AgentRolloutPaused, p.Spec.ForProvider.PauseBetweenWaves, st.WaveCompletionTime, time.Now(), pollInterval)
+		}),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.AgentVersionPolicy{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+	now func() time.Time
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.AgentVersionPolicy) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+
+	// The policy has no platform-side object, so there is nothing for
+	// Delete to wait on.
+	if meta.WasDeleted(mg) {
+		e.ClearTerminalWriteResource(mg, v1alpha1.AgentVersionPolicyGroupVersionKind)
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if e.HasTerminalWriteResource(mg, v1alpha1.AgentVersionPolicyGroupVersionKind) {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	}
+	if meta.GetExternalName(mg) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	clusters, err := e.selectClusters(ctx, mg, instanceID)
+	if err != nil {
+		mg.SetConditions(xpv1.ReconcileError(err))
+		return managed.ExternalObservation{}, err
+	}
+
+	upToDate := evaluateRollout(mg, instanceID, clusters, e.now())
+	base.SetHealthCondition(mg, mg.Status.AtProvider.Phase != v1alpha1.AgentRolloutHalted)
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg, instanceID)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+// Create only marks the policy as existing. The first wave is started
+// by the Update that follows the next Observe.
+func (e *external) Create(ctx context.Context, mg *v1alpha1.AgentVersionPolicy) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	mg.Status.AtProvider.InstanceID = instanceID
+	meta.SetExternalName(mg, mg.GetName())
+	return managed.ExternalCreation{}, nil
+}
+
+// Update starts the next wave: it sets the target version on the next
+// batch of clusters that do not yet run it.
+func (e *external) Update(ctx context.Context, mg *v1alpha1.AgentVersionPolicy) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	clusters, err := e.selectClusters(ctx, mg, instanceID)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	key, err := terminalWriteKey(mg, instanceID)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	target := mg.Spec.ForProvider.TargetVersion
+	var pending []*v1alpha1.Cluster
+	for _, c := range clusters {
+		if !base.AgentVersionMatches(c.Status.AtProvider.AgentState.Version, target) {
+			pending = append(pending, c)
+		}
+	}
+	if len(pending) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	size, err := base.RolloutWaveSize(mg.Spec.ForProvider.WaveSize, len(clusters))
+	if err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, err)
+	}
+	wave := pending[:min(size, len(pending))]
+	names := make([]string, 0, len(wave))
+	for _, c := range wave {
+		names = append(names, clusterName(c))
+	}
+
+	if err := e.Client.UpdateClustersAgentVersion(ctx, instanceID, names, target); err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+
+	st := &mg.Status.AtProvider
+	st.Wave++
+	st.WaveClusters = names
+	st.WaveStartTime = &metav1.Time{Time: e.now()}
+	st.WaveCompletionTime = nil
+	st.Phase = v1alpha1.AgentRolloutProgressing
+	st.Message = fmt.Sprintf("started wave %d: %s", st.Wave, strings.Join(names, ", "))
+
+	// A Cluster late-initializes its target version from the platform,
+	// so its spec still pins the previous version. Pin the new version
+	// there too, otherwise the Cluster controller reverts the upgrade
+	// on its next poll.
+	for _, c := range wave {
+		if err := e.pinTargetVersion(ctx, c, target); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete stops the rollout. Agents keep the version they were last set
+// to.
+func (e *external) Delete(_ context.Context, mg *v1alpha1.AgentVersionPolicy) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.AgentVersionPolicyGroupVersionKind)
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// evaluateRollout refreshes the rollout status from the selected
+// clusters and reports whether the policy is up to date, i.e. whether
+// no wave should be started right now.
+func evaluateRollout(mg *v1alpha1.AgentVersionPolicy, instanceID string, clusters []*v1alpha1.Cluster, now time.Time) bool {
+	target := mg.Spec.ForProvider.TargetVersion
+	st := &mg.Status.AtProvider
+	if st.TargetVersion != target {
+		*st = v1alpha1.AgentVersionPolicyObservation{TargetVersion: target}
+	}
+	st.InstanceID = instanceID
+	st.SelectedClusters = int32(len(clusters)) //nolint:gosec // bounded by the number of Cluster MRs
+
+	var (
+		updated, remaining int32
+		byName             = make(map[string]*v1alpha1.Cluster, len(clusters))
+	)
+	for _, c := range clusters {
+		byName[clusterName(c)] = c
+		if !base.AgentVersionMatches(c.Status.AtProvider.AgentState.Version, target) {
+			remaining++
+			continue
+		}
+		if msg := clusterFailure(c, target); msg != "" {
+			if st.Phase != v1alpha1.AgentRolloutHalted {
+				st.Phase = v1alpha1.AgentRolloutHalted
+				st.Message = msg
+			}
+			continue
+		}
+		if c.Status.AtProvider.HealthStatus.Code == healthHealthy {
+			updated++
+		}
+	}
+	st.UpdatedClusters = updated
+	if st.Phase == v1alpha1.AgentRolloutHalted {
+		return true
+	}
+
+	// Clusters dropped from the selection since the wave started no
+	// longer gate it.
+	var waiting []string
+	for _, name := range st.WaveClusters {
+		c, ok := byName[name]
+		if !ok {
+			continue
+		}
+		if !base.AgentVersionMatches(c.Status.AtProvider.AgentState.Version, target) || c.Status.AtProvider.HealthStatus.Code != healthHealthy {
+			waiting = append(waiting, name)
+		}
+	}
+	if len(waiting) > 0 {
+		// Waves started before WaveStartTime was recorded are timed
+		// from now.
+		if st.WaveStartTime == nil {
+			st.WaveStartTime = &metav1.Time{Time: now}
+		}
+		if timeout := waveTimeout(mg); !now.Before(st.WaveStartTime.Add(timeout)) {
+			st.Phase = v1alpha1.AgentRolloutHalted
+			st.Message = fmt.Sprintf("wave %d did not succeed within %s: %s", st.Wave, timeout, strings.Join(waiting, ", "))
+			return true
+		}
+		st.Phase = v1alpha1.AgentRolloutProgressing
+		st.Message = fmt.Sprintf("waiting for wave %d: %s", st.Wave, strings.Join(waiting, ", "))
+		return true
+	}
+	if st.Wave > 0 && st.WaveCompletionTime == nil {
+		st.WaveCompletionTime = &metav1.Time{Time: now}
+	}
+
+	if remaining == 0 {
+		if updated == st.SelectedClusters {
+			st.Phase = v1alpha1.AgentRolloutCompleted
+			st.Message = ""
+		} else {
+			st.Phase = v1alpha1.AgentRolloutProgressing
+			st.Message = fmt.Sprintf("waiting for %d cluster(s) to report healthy", st.SelectedClusters-updated)
+		}
+		return true
+	}
+
+	if next, ok := base.NextRolloutWave(mg.Spec.ForProvider.PauseBetweenWaves, st.WaveCompletionTime); ok && now.Before(next) {
+		st.Phase = v1alpha1.AgentRolloutPaused
+		st.Message = fmt.Sprintf("wave %d succeeded; next wave at %s", st.Wave, next.UTC().Format(time.RFC3339))
+		return true
+	}
+	return false
+}
+
+// clusterFailure describes why a cluster that runs target halts the
+// rollout, or returns "" if it does not.
+func clusterFailure(c *v1alpha1.Cluster, target string) string {
+	obs := c.Status.AtProvider
+	switch {
+	case obs.HealthStatus.Code == healthDegraded:
+		return fmt.Sprintf("cluster %s is degraded after upgrading to %s: %s", clusterName(c), target, obs.HealthStatus.Message)
+	case obs.ReconciliationStatus.Code == reconciliationFailed:
+		return fmt.Sprintf("cluster %s failed to reconcile after upgrading to %s: %s", clusterName(c), target, obs.ReconciliationStatus.Message)
+	}
+	return ""
+}
+
+// waveTimeout returns how long a wave may take to succeed.
+func waveTimeout(mg *v1alpha1.AgentVersionPolicy) time.Duration {
+	if t := mg.Spec.ForProvider.WaveTimeout; t != nil && t.Duration > 0 {
+		return t.Duration
+	}
+	return defaultWaveTimeout
+}
+
+// clusterName returns the Akuity cluster name of a Cluster MR.
+func clusterName(c *v1alpha1.Cluster) string {
+	if name := meta.GetExternalName(c); name != "" {
+		return name
+	}
+	return c.Spec.ForProvider.Name
+}
+
+// selectClusters lists the Cluster MRs matched by ClusterSelector that
+// belong to instanceID, sorted by cluster name so waves are picked in a
+// stable order. Clusters being deleted are skipped.
+func (e *external) selectClusters(ctx context.Context, mg *v1alpha1.AgentVersionPolicy, instanceID string) ([]*v1alpha1.Cluster, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&mg.Spec.ForProvider.ClusterSelector)
+	if err != nil {
+		return nil, reason.AsTerminal(fmt.Errorf("spec.forProvider.clusterSelector: %w", err))
+	}
+	list := &v1alpha1.ClusterList{}
+	if err := e.Kube.List(ctx, list, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("could not list clusters: %w", err)
+	}
+
+	ref := mg.Spec.ForProvider.InstanceRef
+	clusters := make([]*v1alpha1.Cluster, 0, len(list.Items))
+	for i := range list.Items {
+		c := &list.Items[i]
+		if meta.WasDeleted(c) {
+			continue
+		}
+		p := c.Spec.ForProvider
+		sameInstance := p.InstanceID == instanceID ||
+			(p.InstanceID == "" && ref != nil && p.InstanceRef != nil && p.InstanceRef.Name == ref.Name)
+		if sameInstance {
+			clusters = append(clusters, c)
+		}
+	}
+	slices.SortFunc(clusters, func(a, b *v1alpha1.Cluster) int {
+		return strings.Compare(clusterName(a), clusterName(b))
+	})
+	return clusters, nil
+}
+
+// pinTargetVersion writes version to the Cluster's
+// spec.forProvider.clusterSpec.data.targetVersion.
+func (e *external) pinTargetVersion(ctx context.Context, c *v1alpha1.Cluster, version string) error {
+	if c.Spec.ForProvider.ClusterSpec.Data.TargetVersion == version {
+		return nil
+	}
+	patch := client.MergeFrom(c.DeepCopy())
+	c.Spec.ForProvider.ClusterSpec.Data.TargetVersion = version
+	if err := e.Kube.Patch(ctx, c, patch); err != nil {
+		return fmt.Errorf("could not pin target version on Cluster %s: %w", c.GetName(), err)
+	}
+	return nil
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.AgentVersionPolicy, instanceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := terminalWriteKey(mg, instanceID)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.AgentVersionPolicy, instanceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.AgentVersionPolicyGroupVersionKind) {
+		return
+	}
+	key, err := terminalWriteKey(mg, instanceID)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func terminalWriteKey(mg *v1alpha1.AgentVersionPolicy, instanceID string) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.AgentVersionPolicyGroupVersionKind, map[string]any{
+		"instanceID":    instanceID,
+		"targetVersion": mg.Spec.ForProvider.TargetVersion,
+		"waveSize":      mg.Spec.ForProvider.WaveSize.String(),
+	})
+}
+
+// resolveInstanceID returns the opaque Akuity ID of the target
+// instance. ForProvider.InstanceID takes precedence; if absent,
+// InstanceRef is resolved against an Instance MR and its
+// Status.AtProvider.ID is used.
+func (e *external) resolveInstanceID(ctx context.Context, mg *v1alpha1.AgentVersionPolicy) (string, error) {
+	if id := mg.Spec.ForProvider.InstanceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.InstanceRef == nil || mg.Spec.ForProvider.InstanceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.instanceId or spec.forProvider.instanceRef must be set")
+	}
+	key := k8stypes.NamespacedName{Name: mg.Spec.ForProvider.InstanceRef.Name, Namespace: mg.GetNamespace()}
+	inst := &v1alpha1.Instance{}
+	if err := e.Kube.Get(ctx, key, inst); err != nil {
+		return "", fmt.Errorf("could not resolve InstanceRef %s: %w", key.Name, err)
+	}
+	if inst.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced Instance %s has not yet reported an ID; waiting for its controller to observe", key.Name)
+	}
+	return inst.Status.AtProvider.ID, nil
+}
@@ -0,0 +1,345 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentversionpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const (
+	instanceID = "inst-1"
+	oldVersion = "0.5.9"
+	newVersion = "0.5.10"
+)
+
+var fixedNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newPolicy(waveSize intstr.IntOrString, pause time.Duration) *v1alpha1.AgentVersionPolicy {
+	mg := &v1alpha1.AgentVersionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "rollout", UID: "rollout-uid"},
+		Spec: v1alpha1.AgentVersionPolicySpec{
+			ForProvider: v1alpha1.AgentVersionPolicyParameters{
+				InstanceID: instanceID,
+				ClusterSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"tier": "prod"},
+				},
+				TargetVersion: newVersion,
+				WaveSize:      waveSize,
+			},
+		},
+	}
+	if pause > 0 {
+		mg.Spec.ForProvider.PauseBetweenWaves = &metav1.Duration{Duration: pause}
+	}
+	meta.SetExternalName(mg, "rollout")
+	return mg
+}
+
+// newCluster returns a Cluster MR of instanceID labelled tier=prod
+// whose agent reports version and health code.
+func newCluster(name, version string, health int32) *v1alpha1.Cluster {
+	c := &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"tier": "prod"}},
+		Spec: v1alpha1.ClusterSpec{
+			ForProvider: v1alpha1.ClusterParameters{InstanceID: instanceID, Name: name},
+		},
+	}
+	c.Spec.ForProvider.ClusterSpec.Data.TargetVersion = oldVersion
+	c.Status.AtProvider.AgentState.Version = version
+	c.Status.AtProvider.HealthStatus.Code = health
+	return c
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{
+		ExternalClient: base.ExternalClient{
+			Client:         mc,
+			Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+			Logger:         logging.NewNopLogger(),
+			TerminalWrites: base.NewTerminalWriteGuard(),
+		},
+		now: func() time.Time { return fixedNow },
+	}, mc
+}
+
+func TestObserve_FirstWavePending(t *testing.T) {
+	e, _ := newExt(t,
+		newCluster("a", oldVersion, healthHealthy),
+		newCluster("b", oldVersion, healthHealthy),
+	)
+	mg := newPolicy(intstr.FromInt32(1), 0)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.False(t, obs.ResourceUpToDate)
+	assert.Equal(t, int32(2), mg.Status.AtProvider.SelectedClusters)
+	assert.Equal(t, int32(0), mg.Status.AtProvider.UpdatedClusters)
+}
+
+func TestObserve_SelectsByLabelAndInstance(t *testing.T) {
+	other := newCluster("other-instance", oldVersion, healthHealthy)
+	other.Spec.ForProvider.InstanceID = "inst-2"
+	unlabelled := newCluster("unlabelled", oldVersion, healthHealthy)
+	unlabelled.Labels = nil
+	e, _ := newExt(t, newCluster("a", newVersion, healthHealthy), other, unlabelled)
+	mg := newPolicy(intstr.FromInt32(1), 0)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.AgentRolloutCompleted, mg.Status.AtProvider.Phase)
+	assert.Equal(t, int32(1), mg.Status.AtProvider.SelectedClusters)
+	assert.Equal(t, int32(1), mg.Status.AtProvider.UpdatedClusters)
+}
+
+func TestObserve_WaitsForWave(t *testing.T) {
+	e, _ := newExt(t,
+		newCluster("a", newVersion, 2),
+		newCluster("b", oldVersion, healthHealthy),
+	)
+	mg := newPolicy(intstr.FromInt32(1), 0)
+	mg.Status.AtProvider = v1alpha1.AgentVersionPolicyObservation{
+		TargetVersion: newVersion,
+		Wave:          1,
+		WaveClusters:  []string{"a"},
+	}
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.AgentRolloutProgressing, mg.Status.AtProvider.Phase)
+	assert.Contains(t, mg.Status.AtProvider.Message, "waiting for wave 1: a")
+}
+
+func TestObserve_PausesBetweenWaves(t *testing.T) {
+	e, _ := newExt(t,
+		newCluster("a", newVersion, healthHealthy),
+		newCluster("b", oldVersion, healthHealthy),
+	)
+	mg := newPolicy(intstr.FromInt32(1), 10*time.Minute)
+	mg.Status.AtProvider = v1alpha1.AgentVersionPolicyObservation{
+		TargetVersion: newVersion,
+		Wave:          1,
+		WaveClusters:  []string{"a"},
+	}
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.AgentRolloutPaused, mg.Status.AtProvider.Phase)
+	require.NotNil(t, mg.Status.AtProvider.WaveCompletionTime)
+	assert.True(t, mg.Status.AtProvider.WaveCompletionTime.Time.Equal(fixedNow))
+
+	// Once the pause has elapsed the next wave is due.
+	e.now = func() time.Time { return fixedNow.Add(10 * time.Minute) }
+	obs, err = e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_HaltsOnDegradedAgent(t *testing.T) {
+	b := newCluster("b", newVersion, healthDegraded)
+	b.Status.AtProvider.HealthStatus.Message = "agent crashlooping"
+	e, _ := newExt(t,
+		newCluster("a", newVersion, healthHealthy),
+		b,
+		newCluster("c", oldVersion, healthHealthy),
+	)
+	mg := newPolicy(intstr.FromInt32(1), 0)
+	mg.Status.AtProvider = v1alpha1.AgentVersionPolicyObservation{
+		TargetVersion: newVersion,
+		Wave:          2,
+		WaveClusters:  []string{"b"},
+	}
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.AgentRolloutHalted, mg.Status.AtProvider.Phase)
+	assert.Contains(t, mg.Status.AtProvider.Message, "cluster b is degraded")
+	assert.Equal(t, corev1.ConditionFalse, mg.GetCondition(xpv1.TypeReady).Status)
+
+	// The halt sticks after the agent recovers.
+	recovered := &v1alpha1.Cluster{}
+	require.NoError(t, e.Kube.Get(context.Background(), k8stypes.NamespacedName{Name: "b"}, recovered))
+	recovered.Status.AtProvider.HealthStatus.Code = healthHealthy
+	require.NoError(t, e.Kube.Update(context.Background(), recovered))
+	obs, err = e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.AgentRolloutHalted, mg.Status.AtProvider.Phase)
+}
+
+func TestObserve_HaltsOnFailedReconciliation(t *testing.T) {
+	b := newCluster("b", newVersion, healthHealthy)
+	b.Status.AtProvider.ReconciliationStatus = v1alpha1.ResourceStatusCode{Code: reconciliationFailed, Message: "apply failed"}
+	e, _ := newExt(t, newCluster("a", newVersion, healthHealthy), b)
+	mg := newPolicy(intstr.FromInt32(1), 0)
+	mg.Status.AtProvider = v1alpha1.AgentVersionPolicyObservation{
+		TargetVersion: newVersion,
+		Wave:          2,
+		WaveClusters:  []string{"b"},
+	}
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.AgentRolloutHalted, mg.Status.AtProvider.Phase)
+	assert.Contains(t, mg.Status.AtProvider.Message, "cluster b failed to reconcile after upgrading to 0.5.10: apply failed")
+	assert.Equal(t, int32(1), mg.Status.AtProvider.UpdatedClusters)
+}
+
+func TestObserve_HaltsWhenWaveTimesOut(t *testing.T) {
+	const healthUnknown int32 = 4
+	e, _ := newExt(t,
+		newCluster("a", newVersion, healthUnknown),
+		newCluster("b", oldVersion, healthHealthy),
+	)
+	mg := newPolicy(intstr.FromInt32(1), 0)
+	mg.Spec.ForProvider.WaveTimeout = &metav1.Duration{Duration: 15 * time.Minute}
+	mg.Status.AtProvider = v1alpha1.AgentVersionPolicyObservation{
+		TargetVersion: newVersion,
+		Wave:          1,
+		WaveClusters:  []string{"a"},
+		WaveStartTime: &metav1.Time{Time: fixedNow},
+	}
+
+	e.now = func() time.Time { return fixedNow.Add(14 * time.Minute) }
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.AgentRolloutProgressing, mg.Status.AtProvider.Phase)
+
+	e.now = func() time.Time { return fixedNow.Add(15 * time.Minute) }
+	obs, err = e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.AgentRolloutHalted, mg.Status.AtProvider.Phase)
+	assert.Equal(t, "wave 1 did not succeed within 15m0s: a", mg.Status.AtProvider.Message)
+	assert.Equal(t, corev1.ConditionFalse, mg.GetCondition(xpv1.TypeReady).Status)
+}
+
+func TestObserve_WaveWithoutStartTimeIsTimedFromNow(t *testing.T) {
+	e, _ := newExt(t, newCluster("a", oldVersion, healthHealthy))
+	mg := newPolicy(intstr.FromInt32(1), 0)
+	mg.Status.AtProvider = v1alpha1.AgentVersionPolicyObservation{
+		TargetVersion: newVersion,
+		Wave:          1,
+		WaveClusters:  []string{"a"},
+	}
+
+	_, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	require.NotNil(t, mg.Status.AtProvider.WaveStartTime)
+	assert.True(t, mg.Status.AtProvider.WaveStartTime.Time.Equal(fixedNow))
+
+	e.now = func() time.Time { return fixedNow.Add(defaultWaveTimeout) }
+	_, err = e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.AgentRolloutHalted, mg.Status.AtProvider.Phase)
+}
+
+func TestObserve_TargetChangeResetsRollout(t *testing.T) {
+	e, _ := newExt(t, newCluster("a", newVersion, healthDegraded))
+	mg := newPolicy(intstr.FromInt32(1), 0)
+	mg.Spec.ForProvider.TargetVersion = oldVersion
+	mg.Status.AtProvider = v1alpha1.AgentVersionPolicyObservation{
+		TargetVersion: newVersion,
+		Phase:         v1alpha1.AgentRolloutHalted,
+		Wave:          1,
+		WaveClusters:  []string{"a"},
+	}
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+	assert.Equal(t, oldVersion, mg.Status.AtProvider.TargetVersion)
+	assert.Equal(t, int32(0), mg.Status.AtProvider.Wave)
+	assert.Empty(t, mg.Status.AtProvider.Phase)
+}
+
+func TestUpdate_StartsWaveAndPinsClusters(t *testing.T) {
+	e, mc := newExt(t,
+		newCluster("c", oldVersion, healthHealthy),
+		newCluster("a", newVersion, healthHealthy),
+		newCluster("b", oldVersion, healthHealthy),
+		newCluster("d", oldVersion, healthHealthy),
+	)
+	mg := newPolicy(intstr.FromString("50%"), 0)
+	mg.Status.AtProvider.TargetVersion = newVersion
+	mc.EXPECT().UpdateClustersAgentVersion(gomock.Any(), instanceID, []string{"b", "c"}, newVersion).Return(nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), mg.Status.AtProvider.Wave)
+	assert.Equal(t, []string{"b", "c"}, mg.Status.AtProvider.WaveClusters)
+	require.NotNil(t, mg.Status.AtProvider.WaveStartTime)
+	assert.True(t, mg.Status.AtProvider.WaveStartTime.Time.Equal(fixedNow))
+	assert.Equal(t, v1alpha1.AgentRolloutProgressing, mg.Status.AtProvider.Phase)
+
+	for name, want := range map[string]string{"b": newVersion, "c": newVersion, "d": oldVersion} {
+		got := &v1alpha1.Cluster{}
+		require.NoError(t, e.Kube.Get(context.Background(), k8stypes.NamespacedName{Name: name}, got))
+		assert.Equal(t, want, got.Spec.ForProvider.ClusterSpec.Data.TargetVersion, name)
+	}
+}
+
+func TestUpdate_RejectedIsTerminal(t *testing.T) {
+	e, mc := newExt(t, newCluster("a", oldVersion, healthHealthy))
+	mg := newPolicy(intstr.FromInt32(1), 0)
+	mc.EXPECT().UpdateClustersAgentVersion(gomock.Any(), instanceID, []string{"a"}, newVersion).
+		Return(reason.AsTerminal(assert.AnError)).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.Error(t, err)
+
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestDelete_LeavesAgents(t *testing.T) {
+	e, _ := newExt(t)
+
+	_, err := e.Delete(context.Background(), newPolicy(intstr.FromInt32(1), 0))
+	require.NoError(t, err)
+}
@@ -20,13 +20,36 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/addonmarketplaceinstall"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/agentversionpolicy"
 	"github.com/akuityio/provider-crossplane-akuity/internal/controller/cluster"
 	"github.com/akuityio/provider-crossplane-akuity/internal/controller/config"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/customrole"
 	"github.com/akuityio/provider-crossplane-akuity/internal/controller/instance"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/instanceaccount"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/instanceaddon"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/instanceaddonrepo"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/instancecss"
 	"github.com/akuityio/provider-crossplane-akuity/internal/controller/instanceipallowlist"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/instancequota"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/instancerepo"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/instanceresourcecustomization"
 	"github.com/akuityio/provider-crossplane-akuity/internal/controller/kargoagent"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/kargoagentversionpolicy"
 	"github.com/akuityio/provider-crossplane-akuity/internal/controller/kargodefaultshardagent"
 	"github.com/akuityio/provider-crossplane-akuity/internal/controller/kargoinstance"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/managedsecret"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/notificationconfig"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/oidcmap"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/organizationapikey"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/ssoconfiguration"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/team"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/teammember"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/teamoidcmap"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/workspace"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/workspaceapikey"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/workspacecustomrole"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/workspacemember"
 )
 
 // Setup creates all akuity controllers with the supplied logger and adds them to
@@ -36,10 +59,33 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		config.Setup,
 		instance.Setup,
 		cluster.Setup,
+		agentversionpolicy.Setup,
 		instanceipallowlist.Setup,
+		instancerepo.Setup,
+		instanceresourcecustomization.Setup,
+		instancecss.Setup,
+		instancequota.Setup,
+		instanceaccount.Setup,
+		managedsecret.Setup,
+		instanceaddonrepo.Setup,
+		instanceaddon.Setup,
+		addonmarketplaceinstall.Setup,
 		kargoinstance.Setup,
 		kargoagent.Setup,
+		kargoagentversionpolicy.Setup,
 		kargodefaultshardagent.Setup,
+		workspace.Setup,
+		team.Setup,
+		teammember.Setup,
+		workspacemember.Setup,
+		organizationapikey.Setup,
+		workspaceapikey.Setup,
+		customrole.Setup,
+		workspacecustomrole.Setup,
+		ssoconfiguration.Setup,
+		oidcmap.Setup,
+		teamoidcmap.Setup,
+		notificationconfig.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
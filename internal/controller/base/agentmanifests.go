@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/kube"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// AgentManifests describes where an agent's install manifests come from
+// and where they are delivered. Cluster and KargoAgent fill it in with
+// their own manifest fetch.
+type AgentManifests struct {
+	// Kind names the agent in error messages, such as "cluster".
+	Kind string
+	// Target is the cluster or manifests sink the manifests go to.
+	Target kube.TargetKubeConfig
+	// Fetch returns the agent's current install manifests.
+	Fetch func(ctx context.Context) (string, error)
+}
+
+// deliver fetches the agent's manifests and applies them to the target.
+// purpose completes the error messages, for example "after credential
+// rotation".
+func (e ExternalClient) deliver(ctx context.Context, m AgentManifests, purpose string) error {
+	manifests, err := m.Fetch(ctx)
+	if err != nil {
+		return reason.ClassifyManifestInstallError(fmt.Errorf("could not get %s manifests %s: %w", m.Kind, purpose, err))
+	}
+	if err := kube.ApplyManifestsToTarget(ctx, e.Kube, e.Logger, m.Target, manifests, false); err != nil {
+		return reason.ClassifyManifestInstallError(fmt.Errorf("could not apply %s manifests %s: %w", m.Kind, purpose, err))
+	}
+	return nil
+}
+
+// CredentialRotationPending reports whether mg's rotate-credentials
+// annotation carries a value the controller has not yet acted on, or
+// whether the manifests of a rotation are still to be delivered.
+func CredentialRotationPending(mg resource.Managed, st v1alpha1.CredentialRotationStatus) bool {
+	v := mg.GetAnnotations()[v1alpha1.AnnotationRotateCredentials]
+	return st.CredentialRotationManifestsPending || (v != "" && v != st.LastCredentialRotation)
+}
+
+// RotateAgentCredentials rotates an agent's credentials through rotate
+// and re-delivers its install manifests so the running agent picks them
+// up. Rotation revokes the credentials baked into the installed
+// manifests, so it is refused when m has no kubeconfig target or
+// manifests sink: the replacements could not be delivered and the
+// agent would be left disconnected.
+//
+// The annotation value is recorded in st as soon as rotate succeeds.
+// If delivery then fails, st keeps CredentialRotationManifestsPending
+// set and the next call only fetches and applies the manifests again,
+// instead of revoking the credentials once more.
+func (e ExternalClient) RotateAgentCredentials(ctx context.Context, mg resource.Managed, st *v1alpha1.CredentialRotationStatus, m AgentManifests, rotate func(ctx context.Context) error) error {
+	if !m.Target.HasTarget() {
+		return reason.AsTerminal(errors.New("credential rotation requires enableInClusterKubeConfig, kubeConfigSecretRef or manifestsSink so the new agent manifests can be delivered"))
+	}
+	if v := mg.GetAnnotations()[v1alpha1.AnnotationRotateCredentials]; v != "" && v != st.LastCredentialRotation {
+		if err := rotate(ctx); err != nil {
+			return reason.ClassifyApplyError(err)
+		}
+		now := metav1.Now()
+		st.LastCredentialRotation = v
+		st.LastCredentialRotationTime = &now
+		st.CredentialRotationManifestsPending = true
+	}
+	if err := e.deliver(ctx, m, "after credential rotation"); err != nil {
+		return err
+	}
+	st.CredentialRotationManifestsPending = false
+	return nil
+}
+
+// ManifestsSink converts the API sink reference into the kube package's
+// form, owned by the managed resource with the given UID. Returns nil
+// when no sink is configured.
+func ManifestsSink(s *v1alpha1.ManifestsSink, owner k8stypes.UID) *kube.ManifestsSink {
+	if s == nil {
+		return nil
+	}
+	return &kube.ManifestsSink{Kind: s.Kind, Name: s.Name, Namespace: s.Namespace, Owner: string(owner)}
+}
+
+// ManifestsSinkState is what a controller has observed about an agent
+// whose manifests go to a sink.
+type ManifestsSinkState struct {
+	// Sink is spec.forProvider.manifestsSink.
+	Sink *v1alpha1.ManifestsSink
+	// Generation is the managed resource's generation.
+	Generation int64
+	// TargetVersion is the agent version the platform targets.
+	TargetVersion string
+	// ID is the agent's platform ID.
+	ID string
+	// Reconciled is set once the platform has reconciled the agent.
+	Reconciled bool
+	// Written is the revision last written to the sink.
+	Written string
+	// Created is set when Create wrote the sink under Generation.
+	Created bool
+}
+
+// Revision identifies the manifests the sink should hold. It pairs the
+// spec generation with the agent version the platform targets, so both
+// a spec edit and a server-pushed agent upgrade trigger a rewrite.
+func (s ManifestsSinkState) Revision() string {
+	return fmt.Sprintf("%d:%s", s.Generation, s.TargetVersion)
+}
+
+// Pending reports whether the sink holds manifests older than the
+// current revision. It waits for the platform to reconcile the agent,
+// because manifests are not served before then.
+func (s ManifestsSinkState) Pending() bool {
+	return s.Sink != nil && s.ID != "" && s.Reconciled && s.Written != s.Revision()
+}
+
+// Settled returns the revision to record as written. Create writes the
+// sink before the platform reports a target version and cannot record
+// a revision, so the first reconciled observation under the same
+// generation adopts the current revision instead of writing the sink
+// again.
+func (s ManifestsSinkState) Settled() string {
+	if s.Written == "" && s.Created && s.Reconciled {
+		return s.Revision()
+	}
+	return s.Written
+}
+
+// MarkManifestsSinkCreated records on mg that Create wrote its manifests
+// sink under the current generation.
+func MarkManifestsSinkCreated(mg resource.Managed) {
+	meta.AddAnnotations(mg, map[string]string{
+		v1alpha1.AnnotationManifestsSinkCreated: strconv.FormatInt(mg.GetGeneration(), 10),
+	})
+}
+
+// ManifestsSinkCreated reports whether Create wrote mg's manifests sink
+// under its current generation.
+func ManifestsSinkCreated(mg resource.Managed) bool {
+	return mg.GetAnnotations()[v1alpha1.AnnotationManifestsSinkCreated] == strconv.FormatInt(mg.GetGeneration(), 10)
+}
+
+// SyncManifestsSink rewrites the sink with the agent's current install
+// manifests and returns the revision written.
+func (e ExternalClient) SyncManifestsSink(ctx context.Context, m AgentManifests, s ManifestsSinkState) (string, error) {
+	if err := e.deliver(ctx, m, "for manifests sink"); err != nil {
+		return "", err
+	}
+	return s.Revision(), nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func TestCredentialRotationPending(t *testing.T) {
+	annotated := &v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{v1alpha1.AnnotationRotateCredentials: "nonce-1"},
+	}}
+	cases := map[string]struct {
+		mg   *v1alpha1.Cluster
+		st   v1alpha1.CredentialRotationStatus
+		want bool
+	}{
+		"noAnnotation":     {mg: &v1alpha1.Cluster{}, want: false},
+		"newValue":         {mg: annotated, want: true},
+		"handled":          {mg: annotated, st: v1alpha1.CredentialRotationStatus{LastCredentialRotation: "nonce-1"}, want: false},
+		"deliveryPending":  {mg: annotated, st: v1alpha1.CredentialRotationStatus{LastCredentialRotation: "nonce-1", CredentialRotationManifestsPending: true}, want: true},
+		"annotationPruned": {mg: &v1alpha1.Cluster{}, st: v1alpha1.CredentialRotationStatus{CredentialRotationManifestsPending: true}, want: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, CredentialRotationPending(tc.mg, tc.st))
+		})
+	}
+}
+
+func TestRotateAgentCredentials_NoTargetIsTerminal(t *testing.T) {
+	mg := &v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{v1alpha1.AnnotationRotateCredentials: "nonce-1"},
+	}}
+	st := &v1alpha1.CredentialRotationStatus{}
+	rotated := false
+
+	err := ExternalClient{}.RotateAgentCredentials(context.Background(), mg, st, AgentManifests{Kind: "cluster"}, func(context.Context) error {
+		rotated = true
+		return nil
+	})
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+	assert.False(t, rotated)
+	assert.Empty(t, st.LastCredentialRotation)
+}
+
+func TestManifestsSinkStatePending(t *testing.T) {
+	sink := &v1alpha1.ManifestsSink{Name: "agent-manifests"}
+	current := ManifestsSinkState{Sink: sink, Generation: 3, TargetVersion: "v1.2.4", ID: "id", Reconciled: true}
+	assert.Equal(t, "3:v1.2.4", current.Revision())
+
+	cases := map[string]struct {
+		edit func(*ManifestsSinkState)
+		want bool
+	}{
+		"stale":         {edit: func(s *ManifestsSinkState) { s.Written = "3:v1.2.3" }, want: true},
+		"current":       {edit: func(s *ManifestsSinkState) { s.Written = "3:v1.2.4" }, want: false},
+		"noSink":        {edit: func(s *ManifestsSinkState) { s.Sink = nil }, want: false},
+		"notObserved":   {edit: func(s *ManifestsSinkState) { s.ID = "" }, want: false},
+		"notReconciled": {edit: func(s *ManifestsSinkState) { s.Reconciled = false }, want: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := current
+			tc.edit(&s)
+			assert.Equal(t, tc.want, s.Pending())
+		})
+	}
+}
+
+func TestManifestsSinkStateSettled(t *testing.T) {
+	current := ManifestsSinkState{Generation: 3, TargetVersion: "v1.2.4", Reconciled: true}
+
+	cases := map[string]struct {
+		edit func(*ManifestsSinkState)
+		want string
+	}{
+		"created":        {edit: func(s *ManifestsSinkState) { s.Created = true }, want: "3:v1.2.4"},
+		"createdPending": {edit: func(s *ManifestsSinkState) { s.Created, s.Reconciled = true, false }, want: ""},
+		"recorded":       {edit: func(s *ManifestsSinkState) { s.Created, s.Written = true, "3:v1.2.3" }, want: "3:v1.2.3"},
+		"neverWritten":   {edit: func(*ManifestsSinkState) {}, want: ""},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := current
+			tc.edit(&s)
+			assert.Equal(t, tc.want, s.Settled())
+		})
+	}
+}
+
+func TestManifestsSinkCreated(t *testing.T) {
+	mg := &v1alpha1.Cluster{}
+	mg.SetGeneration(3)
+	assert.False(t, ManifestsSinkCreated(mg))
+
+	MarkManifestsSinkCreated(mg)
+	assert.True(t, ManifestsSinkCreated(mg))
+
+	mg.SetGeneration(4)
+	assert.False(t, ManifestsSinkCreated(mg))
+}
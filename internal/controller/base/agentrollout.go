@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// RolloutWaveSize resolves a per-wave agent count, given as a count or
+// a percentage, against the number of selected agents. Percentages
+// round up and every wave upgrades at least one agent. A malformed
+// value is terminal.
+func RolloutWaveSize(size intstr.IntOrString, total int) (int, error) {
+	n, err := intstr.GetScaledValueFromIntOrPercent(&size, total, true)
+	if err != nil {
+		return 0, reason.AsTerminal(fmt.Errorf("spec.forProvider.waveSize: %w", err))
+	}
+	return max(n, 1), nil
+}
+
+// AgentVersionMatches compares an agent's reported version with a
+// target version, ignoring a leading "v". An empty observed version
+// never matches.
+func AgentVersionMatches(observed, target string) bool {
+	return observed != "" && strings.TrimPrefix(observed, "v") == strings.TrimPrefix(target, "v")
+}
+
+// NextRolloutWave returns when the next wave of a rollout may start:
+// pause after the previous wave completed. ok is false when no wave has
+// completed yet or no pause is configured.
+func NextRolloutWave(pause *metav1.Duration, completed *metav1.Time) (next time.Time, ok bool) {
+	if pause == nil || pause.Duration <= 0 || completed == nil {
+		return time.Time{}, false
+	}
+	return completed.Add(pause.Duration), true
+}
+
+// RolloutPollInterval shortens pollInterval while a rollout is paused so
+// the next wave starts on time rather than at the next regular poll.
+// The result is never below one second.
+func RolloutPollInterval(paused bool, pause *metav1.Duration, completed *metav1.Time, now time.Time, pollInterval time.Duration) time.Duration {
+	if !paused {
+		return pollInterval
+	}
+	next, ok := NextRolloutWave(pause, completed)
+	if !ok {
+		return pollInterval
+	}
+	return max(min(pollInterval, next.Sub(now)+time.Second), time.Second)
+}
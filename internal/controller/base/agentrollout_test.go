@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func TestRolloutWaveSize(t *testing.T) {
+	cases := map[string]struct {
+		size  intstr.IntOrString
+		total int
+		want  int
+	}{
+		"count":              {size: intstr.FromInt32(3), total: 10, want: 3},
+		"percentRoundsUp":    {size: intstr.FromString("25%"), total: 10, want: 3},
+		"percentAtLeastOne":  {size: intstr.FromString("1%"), total: 10, want: 1},
+		"zeroCountIsOne":     {size: intstr.FromInt32(0), total: 10, want: 1},
+		"fullPercentIsTotal": {size: intstr.FromString("100%"), total: 4, want: 4},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := RolloutWaveSize(tc.size, tc.total)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	_, err := RolloutWaveSize(intstr.FromString("half"), 10)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestAgentVersionMatches(t *testing.T) {
+	assert.True(t, AgentVersionMatches("0.5.10", "0.5.10"))
+	assert.True(t, AgentVersionMatches("v0.5.10", "0.5.10"))
+	assert.True(t, AgentVersionMatches("0.5.10", "v0.5.10"))
+	assert.False(t, AgentVersionMatches("0.5.9", "0.5.10"))
+	assert.False(t, AgentVersionMatches("", "0.5.10"))
+}
+
+func TestRolloutPollInterval(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	completed := &metav1.Time{Time: now}
+	pause := &metav1.Duration{Duration: 10 * time.Minute}
+
+	assert.Equal(t, 10*time.Minute+time.Second, RolloutPollInterval(true, pause, completed, now, time.Hour))
+	assert.Equal(t, time.Minute, RolloutPollInterval(true, pause, completed, now, time.Minute))
+	assert.Equal(t, time.Second, RolloutPollInterval(true, pause, completed, now.Add(time.Hour), time.Hour))
+	assert.Equal(t, time.Hour, RolloutPollInterval(false, pause, completed, now, time.Hour))
+	assert.Equal(t, time.Hour, RolloutPollInterval(true, nil, completed, now, time.Hour))
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"time"
+
+	accesscontrolv1 "github.com/akuity/api-client-go/pkg/api/gen/accesscontrol/v1"
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/config"
+)
+
+// APIKeyConnectionDetails returns the connection details published for
+// a freshly created API key. The ID and secret are exposed both as
+// individual keys and as a JSON document under config.CredentialsKey,
+// so the connection Secret can be referenced directly from a second
+// ProviderConfig's credentialsSecretRef.
+func APIKeyConnectionDetails(id, secret string) (managed.ConnectionDetails, error) {
+	creds, err := config.MarshalCredentials(id, secret)
+	if err != nil {
+		return nil, err
+	}
+	return managed.ConnectionDetails{
+		config.CredentialsAPIKeyID:     []byte(id),
+		config.CredentialsAPIKeySecret: []byte(secret),
+		config.CredentialsKey:          creds,
+	}, nil
+}
+
+// APIKeyPermissionsToProto converts the spec permissions into the
+// gateway's access-control shape.
+func APIKeyPermissionsToProto(p v1alpha1.APIKeyPermissions) *accesscontrolv1.Permissions {
+	return &accesscontrolv1.Permissions{
+		Actions:     p.Actions,
+		Roles:       p.Roles,
+		CustomRoles: p.CustomRoles,
+	}
+}
+
+// APIKeyObservation projects a gateway API key onto the shared status
+// shape. The secret is deliberately dropped.
+func APIKeyObservation(k *apikeyv1.APIKey) v1alpha1.APIKeyObservation {
+	obs := v1alpha1.APIKeyObservation{
+		ID:          k.GetId(),
+		Description: k.GetDescription(),
+		Permissions: v1alpha1.APIKeyPermissions{
+			Actions:     k.GetPermissions().GetActions(),
+			Roles:       k.GetPermissions().GetRoles(),
+			CustomRoles: k.GetPermissions().GetCustomRoles(),
+		},
+	}
+	if t := k.GetCreateTime(); t != nil {
+		obs.CreateTime = t.AsTime().UTC().Format(time.RFC3339)
+	}
+	if t := k.GetExpireTime(); t != nil {
+		obs.ExpireTime = t.AsTime().UTC().Format(time.RFC3339)
+	}
+	return obs
+}
+
+// APIKeyExpired reports whether k carries an expiry at or before now.
+func APIKeyExpired(k *apikeyv1.APIKey, now time.Time) bool {
+	t := k.GetExpireTime()
+	return t != nil && !t.AsTime().After(now)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+)
+
+// ResolveCustomRoleRef returns the Akuity custom role ID of the
+// CustomRole managed resource named by ref, read from its
+// Status.AtProvider.ID. An unobserved role blocks the caller rather than
+// granting an empty ID.
+func ResolveCustomRoleRef(ctx context.Context, kube client.Reader, namespace string, ref *v1alpha1.LocalReference) (string, error) {
+	if ref == nil || ref.Name == "" {
+		return "", nil
+	}
+	role := &v1alpha1.CustomRole{}
+	key := k8stypes.NamespacedName{Name: ref.Name, Namespace: namespace}
+	if err := kube.Get(ctx, key, role); err != nil {
+		return "", fmt.Errorf("could not resolve CustomRoleRef %s: %w", ref.Name, err)
+	}
+	if role.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced CustomRole %s has not yet been observed; waiting for its controller to create it", ref.Name)
+	}
+	return role.Status.AtProvider.ID, nil
+}
+
+// CanonicalCustomRolePolicy returns policy with each rule trimmed,
+// blank lines dropped, and rules sorted, so two policies granting the
+// same permission list compare equal regardless of formatting.
+func CanonicalCustomRolePolicy(policy string) string {
+	var rules []string
+	for _, line := range strings.Split(policy, "\n") {
+		if rule := strings.TrimSpace(line); rule != "" {
+			rules = append(rules, rule)
+		}
+	}
+	slices.Sort(rules)
+	return strings.Join(rules, "\n")
+}
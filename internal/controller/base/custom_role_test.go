@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+)
+
+func TestResolveCustomRoleRef(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	observed := &v1alpha1.CustomRole{ObjectMeta: metav1.ObjectMeta{Name: "observed"}}
+	observed.Status.AtProvider.ID = "role-1"
+	pending := &v1alpha1.CustomRole{ObjectMeta: metav1.ObjectMeta{Name: "pending"}}
+	kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(observed, pending).Build()
+
+	got, err := base.ResolveCustomRoleRef(context.Background(), kube, "", &v1alpha1.LocalReference{Name: "observed"})
+	require.NoError(t, err)
+	assert.Equal(t, "role-1", got)
+
+	_, err = base.ResolveCustomRoleRef(context.Background(), kube, "", &v1alpha1.LocalReference{Name: "pending"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has not yet been observed")
+
+	_, err = base.ResolveCustomRoleRef(context.Background(), kube, "", &v1alpha1.LocalReference{Name: "missing"})
+	require.Error(t, err)
+}
+
+func TestCanonicalCustomRolePolicy(t *testing.T) {
+	a := "  instances/get\n\ninstances/update  \n"
+	b := "instances/update\ninstances/get"
+	assert.Equal(t, base.CanonicalCustomRolePolicy(a), base.CanonicalCustomRolePolicy(b))
+	assert.NotEqual(t, base.CanonicalCustomRolePolicy(a), base.CanonicalCustomRolePolicy("instances/get"))
+	assert.Empty(t, base.CanonicalCustomRolePolicy("\n \n"))
+}
@@ -111,3 +111,10 @@ func (e ExternalClient) LogTerminalWriteGuardSkipped(err error) {
 		e.Logger.Debug("terminal write guard skipped", "err", err)
 	}
 }
+
+// Event records ev against mg when a recorder is wired.
+func (e ExternalClient) Event(mg resource.Managed, ev event.Event) {
+	if e.Recorder != nil {
+		e.Recorder.Event(mg, ev)
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+)
+
+// maxMaintenanceWindowSteps bounds the walk over window starts so a
+// schedule that fires far more often than its duration, or a chain of
+// back-to-back windows, cannot stall a reconcile. When the bound is hit
+// the merged window simply ends early and the next evaluation extends
+// it.
+const maxMaintenanceWindowSteps = 1000
+
+// maintenanceCronParser accepts standard five-field cron expressions
+// and descriptors such as @weekly.
+var maintenanceCronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// MaintenanceWindowState is the evaluation of a set of maintenance
+// windows at one instant.
+type MaintenanceWindowState struct {
+	// Active is true when the instant falls inside at least one window.
+	Active bool
+	// End is when the open period closes, with overlapping and
+	// back-to-back windows merged. Zero when inactive.
+	End time.Time
+	// NextStart is the first window start after End when active, or
+	// after the instant when inactive. Zero when no schedule fires
+	// again.
+	NextStart time.Time
+}
+
+// NextTransition returns when the state next changes, or zero when it
+// never does.
+func (s MaintenanceWindowState) NextTransition() time.Time {
+	if s.Active {
+		return s.End
+	}
+	return s.NextStart
+}
+
+// Status converts s into its status.atProvider shape.
+func (s MaintenanceWindowState) Status() *v1alpha1.MaintenanceWindowStatus {
+	out := &v1alpha1.MaintenanceWindowStatus{Active: s.Active}
+	if s.Active {
+		t := metav1.NewTime(s.End)
+		out.ActiveUntil = &t
+	}
+	if !s.NextStart.IsZero() {
+		t := metav1.NewTime(s.NextStart)
+		out.NextStart = &t
+	}
+	return out
+}
+
+// MaintenanceFields returns the data.maintenanceMode and
+// data.maintenanceModeExpiry values s implies, for drift comparison
+// against the observed pair. Outside a window only the mode is
+// compared and an unreported mode counts as off, so a stale expiry the
+// platform keeps after maintenance ends is not drift. Inside one, an
+// observed expiry naming the same instant as the window end is adopted
+// verbatim.
+func (s MaintenanceWindowState) MaintenanceFields(observedMode *bool, observedExpiry *string) (*bool, *string) {
+	if !s.Active {
+		if observedMode == nil {
+			return nil, nil
+		}
+		return ptr.To(false), nil
+	}
+	if observedExpiry != nil {
+		if t, err := time.Parse(time.RFC3339, *observedExpiry); err == nil && t.Equal(s.End) {
+			return ptr.To(true), observedExpiry
+		}
+	}
+	return ptr.To(true), ptr.To(s.End.Format(time.RFC3339))
+}
+
+type maintenanceWindow struct {
+	schedule cron.Schedule
+	duration time.Duration
+}
+
+// EvaluateMaintenanceWindows reports whether now falls inside any of
+// windows, when that period ends and when the next one starts. It is
+// a pure function of its arguments so callers can test it with a fixed
+// clock.
+func EvaluateMaintenanceWindows(windows []v1alpha1.MaintenanceWindow, now time.Time) (MaintenanceWindowState, error) {
+	parsed, err := parseMaintenanceWindows(windows)
+	if err != nil {
+		return MaintenanceWindowState{}, err
+	}
+
+	var state MaintenanceWindowState
+	steps := 0
+	// Grow the open period until no window starting inside it reaches
+	// further. A window starting at or before the current end (or at or
+	// before now, while nothing is open yet) opens or extends it.
+	for changed := true; changed && steps < maxMaintenanceWindowSteps; {
+		changed = false
+		horizon := now
+		if state.Active {
+			horizon = state.End
+		}
+		for _, w := range parsed {
+			for start := w.schedule.Next(now.Add(-w.duration)); !start.IsZero() && !start.After(horizon) && steps < maxMaintenanceWindowSteps; start = w.schedule.Next(start) {
+				steps++
+				end := start.Add(w.duration)
+				if !end.After(now) || (state.Active && !end.After(state.End)) {
+					continue
+				}
+				state.Active = true
+				state.End = end
+				changed = true
+			}
+		}
+	}
+
+	from := now
+	if state.Active {
+		from = state.End
+	}
+	for _, w := range parsed {
+		next := w.schedule.Next(from)
+		if next.IsZero() {
+			continue
+		}
+		if state.NextStart.IsZero() || next.Before(state.NextStart) {
+			state.NextStart = next
+		}
+	}
+	if state.Active {
+		state.End = state.End.UTC()
+	}
+	if !state.NextStart.IsZero() {
+		state.NextStart = state.NextStart.UTC()
+	}
+	return state, nil
+}
+
+func parseMaintenanceWindows(windows []v1alpha1.MaintenanceWindow) ([]maintenanceWindow, error) {
+	out := make([]maintenanceWindow, 0, len(windows))
+	for i, w := range windows {
+		if w.Duration.Duration <= 0 {
+			return nil, fmt.Errorf("maintenanceWindows[%d]: duration must be positive", i)
+		}
+		loc := time.UTC
+		if w.Timezone != "" {
+			l, err := time.LoadLocation(w.Timezone)
+			if err != nil {
+				return nil, fmt.Errorf("maintenanceWindows[%d]: invalid timezone %q: %w", i, w.Timezone, err)
+			}
+			loc = l
+		}
+		expr := strings.TrimSpace(w.Schedule)
+		if strings.HasPrefix(expr, "TZ=") || strings.HasPrefix(expr, "CRON_TZ=") {
+			return nil, fmt.Errorf("maintenanceWindows[%d]: set the time zone through timezone, not the schedule", i)
+		}
+		schedule, err := maintenanceCronParser.Parse(expr)
+		if err != nil {
+			return nil, fmt.Errorf("maintenanceWindows[%d]: invalid schedule %q: %w", i, w.Schedule, err)
+		}
+		spec, ok := schedule.(*cron.SpecSchedule)
+		if !ok {
+			return nil, fmt.Errorf("maintenanceWindows[%d]: schedule must name calendar times; @every is not supported", i)
+		}
+		spec.Location = loc
+		out = append(out, maintenanceWindow{schedule: spec, duration: w.Duration.Duration})
+	}
+	return out, nil
+}
+
+// MaintenanceWindowPollInterval shortens pollInterval so the next
+// reconcile lands just after the next window boundary reported in
+// status. A nil status or a boundary beyond the poll interval leaves
+// pollInterval unchanged.
+func MaintenanceWindowPollInterval(status *v1alpha1.MaintenanceWindowStatus, now time.Time, pollInterval time.Duration) time.Duration {
+	if status == nil {
+		return pollInterval
+	}
+	next := status.NextStart
+	if status.Active {
+		next = status.ActiveUntil
+	}
+	if next == nil {
+		return pollInterval
+	}
+	// Land a second past the boundary so the evaluation sees the new
+	// state rather than the instant before it.
+	wait := next.Sub(now) + time.Second
+	if wait < time.Second {
+		wait = time.Second
+	}
+	if wait < pollInterval {
+		return wait
+	}
+	return pollInterval
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+)
+
+// sundayWindow is Sunday 02:00-04:00 UTC.
+var sundayWindow = v1alpha1.MaintenanceWindow{
+	Schedule: "0 2 * * 0",
+	Duration: metav1.Duration{Duration: 2 * time.Hour},
+}
+
+func utc(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t.UTC()
+}
+
+func TestEvaluateMaintenanceWindows_Inactive(t *testing.T) {
+	// Saturday 2026-10-17 12:00 UTC.
+	state, err := EvaluateMaintenanceWindows([]v1alpha1.MaintenanceWindow{sundayWindow}, utc("2026-10-17T12:00:00Z"))
+	require.NoError(t, err)
+	assert.False(t, state.Active)
+	assert.True(t, state.End.IsZero())
+	assert.Equal(t, utc("2026-10-18T02:00:00Z"), state.NextStart)
+	assert.Equal(t, state.NextStart, state.NextTransition())
+}
+
+func TestEvaluateMaintenanceWindows_Active(t *testing.T) {
+	state, err := EvaluateMaintenanceWindows([]v1alpha1.MaintenanceWindow{sundayWindow}, utc("2026-10-18T03:15:00Z"))
+	require.NoError(t, err)
+	assert.True(t, state.Active)
+	assert.Equal(t, utc("2026-10-18T04:00:00Z"), state.End)
+	assert.Equal(t, utc("2026-10-25T02:00:00Z"), state.NextStart)
+	assert.Equal(t, state.End, state.NextTransition())
+}
+
+func TestEvaluateMaintenanceWindows_Boundaries(t *testing.T) {
+	windows := []v1alpha1.MaintenanceWindow{sundayWindow}
+
+	state, err := EvaluateMaintenanceWindows(windows, utc("2026-10-18T02:00:00Z"))
+	require.NoError(t, err)
+	assert.True(t, state.Active, "window start is inclusive")
+
+	state, err = EvaluateMaintenanceWindows(windows, utc("2026-10-18T04:00:00Z"))
+	require.NoError(t, err)
+	assert.False(t, state.Active, "window end is exclusive")
+	assert.Equal(t, utc("2026-10-25T02:00:00Z"), state.NextStart)
+}
+
+func TestEvaluateMaintenanceWindows_Timezone(t *testing.T) {
+	w := sundayWindow
+	w.Timezone = "America/New_York"
+	// Sunday 02:00 EDT is 06:00 UTC.
+	state, err := EvaluateMaintenanceWindows([]v1alpha1.MaintenanceWindow{w}, utc("2026-10-18T07:00:00Z"))
+	require.NoError(t, err)
+	assert.True(t, state.Active)
+	assert.Equal(t, utc("2026-10-18T08:00:00Z"), state.End)
+}
+
+func TestEvaluateMaintenanceWindows_MergesOverlappingAndBackToBack(t *testing.T) {
+	windows := []v1alpha1.MaintenanceWindow{
+		sundayWindow,
+		// Sunday 03:00-05:00 overlaps the first window.
+		{Schedule: "0 3 * * 0", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+		// Sunday 05:00-06:00 starts exactly when the second one ends.
+		{Schedule: "0 5 * * 0", Duration: metav1.Duration{Duration: time.Hour}},
+	}
+	state, err := EvaluateMaintenanceWindows(windows, utc("2026-10-18T02:30:00Z"))
+	require.NoError(t, err)
+	assert.True(t, state.Active)
+	assert.Equal(t, utc("2026-10-18T06:00:00Z"), state.End)
+	assert.Equal(t, utc("2026-10-25T02:00:00Z"), state.NextStart)
+}
+
+func TestEvaluateMaintenanceWindows_Invalid(t *testing.T) {
+	cases := map[string]v1alpha1.MaintenanceWindow{
+		"bad-schedule":  {Schedule: "every sunday", Duration: metav1.Duration{Duration: time.Hour}},
+		"bad-timezone":  {Schedule: "0 2 * * 0", Duration: metav1.Duration{Duration: time.Hour}, Timezone: "Mars/Olympus"},
+		"zero-duration": {Schedule: "0 2 * * 0"},
+		"every":         {Schedule: "@every 1h", Duration: metav1.Duration{Duration: time.Hour}},
+		"inline-tz":     {Schedule: "CRON_TZ=UTC 0 2 * * 0", Duration: metav1.Duration{Duration: time.Hour}},
+	}
+	for name, w := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := EvaluateMaintenanceWindows([]v1alpha1.MaintenanceWindow{w}, utc("2026-10-18T00:00:00Z"))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "maintenanceWindows[0]")
+		})
+	}
+}
+
+func TestMaintenanceWindowState_Status(t *testing.T) {
+	state := MaintenanceWindowState{
+		Active:    true,
+		End:       utc("2026-10-18T04:00:00Z"),
+		NextStart: utc("2026-10-25T02:00:00Z"),
+	}
+	st := state.Status()
+	assert.True(t, st.Active)
+	require.NotNil(t, st.ActiveUntil)
+	assert.True(t, st.ActiveUntil.Time.Equal(state.End))
+	require.NotNil(t, st.NextStart)
+
+	st = MaintenanceWindowState{}.Status()
+	assert.False(t, st.Active)
+	assert.Nil(t, st.ActiveUntil)
+	assert.Nil(t, st.NextStart)
+}
+
+func TestMaintenanceWindowPollInterval(t *testing.T) {
+	now := utc("2026-10-18T03:00:00Z")
+	end := metav1.NewTime(utc("2026-10-18T04:00:00Z"))
+	next := metav1.NewTime(utc("2026-10-25T02:00:00Z"))
+
+	assert.Equal(t, 10*time.Minute, MaintenanceWindowPollInterval(nil, now, 10*time.Minute))
+	assert.Equal(t, time.Hour+time.Second,
+		MaintenanceWindowPollInterval(&v1alpha1.MaintenanceWindowStatus{Active: true, ActiveUntil: &end, NextStart: &next}, now, 2*time.Hour))
+	assert.Equal(t, 10*time.Minute,
+		MaintenanceWindowPollInterval(&v1alpha1.MaintenanceWindowStatus{Active: true, ActiveUntil: &end}, now, 10*time.Minute))
+	assert.Equal(t, time.Second,
+		MaintenanceWindowPollInterval(&v1alpha1.MaintenanceWindowStatus{NextStart: &end}, end.Add(time.Minute), 10*time.Minute),
+		"a boundary already in the past requeues promptly")
+}
+
+func TestMaintenanceWindowState_MaintenanceFields(t *testing.T) {
+	open := MaintenanceWindowState{Active: true, End: utc("2026-10-18T04:00:00Z")}
+	sameInstant := "2026-10-18T06:00:00+02:00"
+
+	mode, expiry := open.MaintenanceFields(nil, nil)
+	assert.Equal(t, ptr.To(true), mode)
+	assert.Equal(t, ptr.To("2026-10-18T04:00:00Z"), expiry)
+
+	_, expiry = open.MaintenanceFields(ptr.To(true), &sameInstant)
+	assert.Same(t, &sameInstant, expiry, "an equivalent observed expiry is adopted verbatim")
+
+	closed := MaintenanceWindowState{}
+	mode, expiry = closed.MaintenanceFields(nil, nil)
+	assert.Nil(t, mode, "an unreported mode counts as off")
+	assert.Nil(t, expiry)
+
+	mode, expiry = closed.MaintenanceFields(ptr.To(true), &sameInstant)
+	assert.Equal(t, ptr.To(false), mode)
+	assert.Nil(t, expiry)
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	featuresv1 "github.com/akuity/api-client-go/pkg/api/gen/types/features/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// PreflightError is a write the organization's quota or plan is known
+// to reject. Reason is reported on the Preflight condition.
+type PreflightError struct {
+	Reason xpv1.ConditionReason
+	Err    error
+}
+
+func (p *PreflightError) Error() string { return p.Err.Error() }
+
+func (p *PreflightError) Unwrap() error { return p.Err }
+
+// RequiredFeature ties a field set in spec.forProvider to the plan
+// feature it depends on. Status reads the feature from the
+// organization's FeatureStatuses, typically through a generated getter
+// such as (*featuresv1.FeatureStatuses).GetKargo.
+type RequiredFeature struct {
+	Field   string
+	Feature string
+	Status  func(*featuresv1.FeatureStatuses) featuresv1.FeatureStatus
+}
+
+// InstanceQuota describes the organization-wide instance count a write
+// is checked against. Instances lists the names of the instances that
+// already exist; Max reads the limit from the organization's quota.
+type InstanceQuota struct {
+	Kind      string
+	Name      string
+	Instances func(ctx context.Context) ([]string, error)
+	Max       func(*featuresv1.OrganizationQuota) int64
+}
+
+// ArgoCDInstanceQuota is the Argo CD instance count quota for an
+// instance named name.
+func (e ExternalClient) ArgoCDInstanceQuota(name string) *InstanceQuota {
+	return &InstanceQuota{
+		Kind: "Argo CD",
+		Name: name,
+		Instances: func(ctx context.Context) ([]string, error) {
+			quotas, err := e.Client.ListArgocdInstancesQuota(ctx)
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(quotas))
+			for _, q := range quotas {
+				names = append(names, q.GetInstance().GetName())
+			}
+			return names, nil
+		},
+		Max: (*featuresv1.OrganizationQuota).GetMaxInstances,
+	}
+}
+
+// KargoInstanceQuota is the Kargo instance count quota for an instance
+// named name.
+func (e ExternalClient) KargoInstanceQuota(name string) *InstanceQuota {
+	return &InstanceQuota{
+		Kind: "Kargo",
+		Name: name,
+		Instances: func(ctx context.Context) ([]string, error) {
+			quotas, err := e.Client.ListKargoInstancesQuota(ctx)
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(quotas))
+			for _, q := range quotas {
+				names = append(names, q.GetInstance().GetName())
+			}
+			return names, nil
+		},
+		Max: (*featuresv1.OrganizationQuota).GetMaxKargoInstances,
+	}
+}
+
+// Preflight checks a pending instance write against the organization's
+// instance quota and plan features, and reports the outcome on mg's
+// Preflight condition.
+//
+// quota is nil when the instance already exists: an existing instance
+// never counts against the quota, so there is nothing to look up.
+// checked is the RequiredFeaturesHash recorded after the last feature
+// check that passed; while required still hashes to it, the feature
+// lookup is skipped. On success Preflight returns the hash to record
+// for the next write, or "" if the features were not verified.
+//
+// A known rejection is returned as a terminal PreflightError so the
+// terminal write guard holds the write until the spec changes.
+//
+// The checks exist to turn a rejection the platform would make anyway
+// into a clear condition; they never block a write on their own. A
+// lookup that fails (for example because the API key cannot read the
+// organization) is logged and that check is skipped.
+func (e ExternalClient) Preflight(ctx context.Context, mg resource.Managed, quota *InstanceQuota, required []RequiredFeature, checked string) (string, error) {
+	var quotaErr error
+	if quota != nil {
+		quotaErr = e.checkInstanceQuota(ctx, *quota)
+	}
+	verified, featureErr := e.checkFeatures(ctx, required, checked)
+	err := errors.Join(quotaErr, featureErr)
+	if err == nil {
+		mg.SetConditions(v1alpha1.PreflightPassed())
+		return verified, nil
+	}
+	var pf *PreflightError
+	if errors.As(err, &pf) {
+		mg.SetConditions(v1alpha1.PreflightFailed(pf.Reason, err))
+	}
+	return "", reason.AsTerminal(err)
+}
+
+// RequiredFeaturesHash identifies the set of fields in required and
+// the features they depend on, independent of order.
+func RequiredFeaturesHash(required []RequiredFeature) string {
+	parts := make([]string, 0, len(required))
+	for _, r := range required {
+		parts = append(parts, r.Field+"\x00"+r.Feature)
+	}
+	slices.Sort(parts)
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// checkInstanceQuota rejects creating an instance once the organization
+// holds as many as its quota allows. An instance that already exists is
+// never counted against the quota, and a non-positive limit is treated
+// as unlimited.
+func (e ExternalClient) checkInstanceQuota(ctx context.Context, q InstanceQuota) error {
+	names, err := q.Instances(ctx)
+	if err != nil {
+		e.logPreflightSkipped("instance quota", err)
+		return nil
+	}
+	if slices.Contains(names, q.Name) {
+		return nil
+	}
+	oq, err := e.Client.GetOrganizationQuota(ctx)
+	if err != nil {
+		e.logPreflightSkipped("instance quota", err)
+		return nil
+	}
+	limit := q.Max(oq)
+	if limit <= 0 || int64(len(names)) < limit {
+		return nil
+	}
+	return &PreflightError{
+		Reason: v1alpha1.ReasonQuotaExceeded,
+		Err:    fmt.Errorf("organization quota allows %d %s instances and %d already exist; cannot create %s", limit, q.Kind, len(names), q.Name),
+	}
+}
+
+// checkFeatures rejects a spec that sets fields whose plan feature the
+// organization reports as not available. Features that are available
+// but disabled are left to the platform. It returns the
+// RequiredFeaturesHash of required once the features are verified, or
+// "" if they were not.
+func (e ExternalClient) checkFeatures(ctx context.Context, required []RequiredFeature, checked string) (string, error) {
+	if len(required) == 0 {
+		return "", nil
+	}
+	hash := RequiredFeaturesHash(required)
+	if hash == checked {
+		return hash, nil
+	}
+	fs, err := e.Client.GetFeatureStatuses(ctx)
+	if err != nil {
+		e.logPreflightSkipped("plan features", err)
+		return "", nil
+	}
+	var missing []string
+	for _, r := range required {
+		if r.Status(fs) == featuresv1.FeatureStatus_FEATURE_STATUS_NOT_AVAILABLE {
+			missing = append(missing, fmt.Sprintf("%s requires %s", r.Field, r.Feature))
+		}
+	}
+	if len(missing) == 0 {
+		return hash, nil
+	}
+	return "", &PreflightError{
+		Reason: v1alpha1.ReasonFeatureNotEntitled,
+		Err:    fmt.Errorf("organization plan does not include the requested features: %s", strings.Join(missing, "; ")),
+	}
+}
+
+func (e ExternalClient) logPreflightSkipped(check string, err error) {
+	if e.Logger != nil {
+		e.Logger.Debug("preflight check skipped", "check", check, "err", err)
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	featuresv1 "github.com/akuity/api-client-go/pkg/api/gen/types/features/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+var kubeVision = []base.RequiredFeature{{
+	Field:   "spec.forProvider.argocd.spec.instanceSpec.kubeVisionConfig",
+	Feature: "KubeVision",
+	Status:  (*featuresv1.FeatureStatuses).GetMultiClusterK8SDashboard,
+}}
+
+func preflightClient(t *testing.T) (base.ExternalClient, *mockclient.MockClient) {
+	t.Helper()
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return base.ExternalClient{Client: mc, Logger: logging.NewNopLogger()}, mc
+}
+
+func argoInstances(names ...string) []*orgcv1.InstanceQuota {
+	out := make([]*orgcv1.InstanceQuota, 0, len(names))
+	for _, n := range names {
+		out = append(out, &orgcv1.InstanceQuota{Instance: &orgcv1.InstanceQuotaSummary{Name: n}})
+	}
+	return out
+}
+
+func TestPreflight_QuotaExceeded(t *testing.T) {
+	e, mc := preflightClient(t)
+	mc.EXPECT().ListArgocdInstancesQuota(gomock.Any()).Return(argoInstances("a", "b"), nil).Times(1)
+	mc.EXPECT().GetOrganizationQuota(gomock.Any()).Return(&featuresv1.OrganizationQuota{MaxInstances: 2}, nil).Times(1)
+
+	mg := &v1alpha1.Instance{}
+	_, err := e.Preflight(context.Background(), mg, e.ArgoCDInstanceQuota("c"), nil, "")
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+	cond := mg.GetCondition(v1alpha1.TypePreflight)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1alpha1.ReasonQuotaExceeded, cond.Reason)
+	assert.Contains(t, cond.Message, "allows 2 Argo CD instances")
+}
+
+func TestPreflight_ExistingInstanceNotCounted(t *testing.T) {
+	e, mc := preflightClient(t)
+	mc.EXPECT().ListKargoInstancesQuota(gomock.Any()).Return([]*orgcv1.KargoInstanceQuota{
+		{Instance: &orgcv1.InstanceQuotaSummary{Name: "kargo"}},
+	}, nil).Times(1)
+	mc.EXPECT().GetOrganizationQuota(gomock.Any()).Times(0)
+
+	mg := &v1alpha1.KargoInstance{}
+	_, err := e.Preflight(context.Background(), mg, e.KargoInstanceQuota("kargo"), nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.ReasonPreflightPassed, mg.GetCondition(v1alpha1.TypePreflight).Reason)
+}
+
+func TestPreflight_UnsetQuotaIsUnlimited(t *testing.T) {
+	e, mc := preflightClient(t)
+	mc.EXPECT().ListArgocdInstancesQuota(gomock.Any()).Return(argoInstances("a", "b"), nil).Times(1)
+	mc.EXPECT().GetOrganizationQuota(gomock.Any()).Return(&featuresv1.OrganizationQuota{}, nil).Times(1)
+
+	_, err := e.Preflight(context.Background(), &v1alpha1.Instance{}, e.ArgoCDInstanceQuota("c"), nil, "")
+	require.NoError(t, err)
+}
+
+func TestPreflight_FeatureNotEntitled(t *testing.T) {
+	e, mc := preflightClient(t)
+	mc.EXPECT().ListArgocdInstancesQuota(gomock.Any()).Return(nil, nil).Times(1)
+	mc.EXPECT().GetOrganizationQuota(gomock.Any()).Return(&featuresv1.OrganizationQuota{}, nil).Times(1)
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(&featuresv1.FeatureStatuses{
+		MultiClusterK8SDashboard: featuresv1.FeatureStatus_FEATURE_STATUS_NOT_AVAILABLE,
+	}, nil).Times(1)
+
+	mg := &v1alpha1.Instance{}
+	_, err := e.Preflight(context.Background(), mg, e.ArgoCDInstanceQuota("a"), kubeVision, "")
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+	var pf *base.PreflightError
+	require.ErrorAs(t, err, &pf)
+	assert.Equal(t, v1alpha1.ReasonFeatureNotEntitled, pf.Reason)
+	cond := mg.GetCondition(v1alpha1.TypePreflight)
+	assert.Equal(t, v1alpha1.ReasonFeatureNotEntitled, cond.Reason)
+	assert.Contains(t, cond.Message, "kubeVisionConfig requires KubeVision")
+}
+
+func TestPreflight_DisabledFeatureLeftToPlatform(t *testing.T) {
+	e, mc := preflightClient(t)
+	mc.EXPECT().ListArgocdInstancesQuota(gomock.Any()).Return(nil, nil).Times(1)
+	mc.EXPECT().GetOrganizationQuota(gomock.Any()).Return(&featuresv1.OrganizationQuota{}, nil).Times(1)
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(&featuresv1.FeatureStatuses{
+		MultiClusterK8SDashboard: featuresv1.FeatureStatus_FEATURE_STATUS_DISABLED,
+	}, nil).Times(1)
+
+	checked, err := e.Preflight(context.Background(), &v1alpha1.Instance{}, e.ArgoCDInstanceQuota("a"), kubeVision, "")
+	require.NoError(t, err)
+	assert.Equal(t, base.RequiredFeaturesHash(kubeVision), checked)
+}
+
+func TestPreflight_LookupFailuresSkipChecks(t *testing.T) {
+	e, mc := preflightClient(t)
+	mc.EXPECT().ListArgocdInstancesQuota(gomock.Any()).Return(nil, errors.New("permission denied")).Times(1)
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(nil, errors.New("permission denied")).Times(1)
+
+	mg := &v1alpha1.Instance{}
+	checked, err := e.Preflight(context.Background(), mg, e.ArgoCDInstanceQuota("a"), kubeVision, "")
+	require.NoError(t, err)
+	assert.Empty(t, checked, "unverified features must be looked up again")
+	assert.Equal(t, v1alpha1.ReasonPreflightPassed, mg.GetCondition(v1alpha1.TypePreflight).Reason)
+}
+
+func TestPreflight_NilQuotaSkipsLookup(t *testing.T) {
+	e, mc := preflightClient(t)
+	mc.EXPECT().ListArgocdInstancesQuota(gomock.Any()).Times(0)
+	mc.EXPECT().GetOrganizationQuota(gomock.Any()).Times(0)
+
+	mg := &v1alpha1.Instance{}
+	_, err := e.Preflight(context.Background(), mg, nil, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.ReasonPreflightPassed, mg.GetCondition(v1alpha1.TypePreflight).Reason)
+}
+
+func TestPreflight_CheckedFeaturesSkipLookup(t *testing.T) {
+	e, mc := preflightClient(t)
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Times(0)
+
+	checked := base.RequiredFeaturesHash(kubeVision)
+	got, err := e.Preflight(context.Background(), &v1alpha1.Instance{}, nil, kubeVision, checked)
+	require.NoError(t, err)
+	assert.Equal(t, checked, got)
+}
+
+func TestPreflight_ChangedFeaturesLookedUpAgain(t *testing.T) {
+	e, mc := preflightClient(t)
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(&featuresv1.FeatureStatuses{
+		SecretManagement: featuresv1.FeatureStatus_FEATURE_STATUS_NOT_AVAILABLE,
+	}, nil).Times(1)
+
+	required := append(slices.Clone(kubeVision), base.RequiredFeature{
+		Field:   "spec.forProvider.argocd.spec.instanceSpec.secrets",
+		Feature: "secret management",
+		Status:  (*featuresv1.FeatureStatuses).GetSecretManagement,
+	})
+	_, err := e.Preflight(context.Background(), &v1alpha1.Instance{}, nil, required, base.RequiredFeaturesHash(kubeVision))
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestRequiredFeaturesHash_IgnoresOrder(t *testing.T) {
+	a := base.RequiredFeature{Field: "a", Feature: "A"}
+	b := base.RequiredFeature{Field: "b", Feature: "B"}
+	assert.Equal(t, base.RequiredFeaturesHash([]base.RequiredFeature{a, b}), base.RequiredFeaturesHash([]base.RequiredFeature{b, a}))
+	assert.NotEqual(t, base.RequiredFeaturesHash([]base.RequiredFeature{a}), base.RequiredFeaturesHash([]base.RequiredFeature{a, b}))
+}
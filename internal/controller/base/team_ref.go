@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"fmt"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+)
+
+// ResolveTeamRef returns the Akuity team name of the Team managed
+// resource named by ref, read from its Status.AtProvider.Name. Gating on
+// the observed name rather than spec.forProvider.name keeps members
+// from being added to a team the Team controller has not created yet.
+func ResolveTeamRef(ctx context.Context, kube client.Reader, namespace string, ref *v1alpha1.LocalReference) (string, error) {
+	if ref == nil || ref.Name == "" {
+		return "", nil
+	}
+	team := &v1alpha1.Team{}
+	key := k8stypes.NamespacedName{Name: ref.Name, Namespace: namespace}
+	if err := kube.Get(ctx, key, team); err != nil {
+		return "", fmt.Errorf("could not resolve TeamRef %s: %w", ref.Name, err)
+	}
+	if team.Status.AtProvider.Name == "" {
+		return "", fmt.Errorf("referenced Team %s has not yet been observed; waiting for its controller to create it", ref.Name)
+	}
+	return team.Status.AtProvider.Name, nil
+}
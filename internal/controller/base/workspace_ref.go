@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"fmt"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// ResolveWorkspaceRef returns the canonical Akuity workspace ID of the
+// Workspace managed resource named by ref, read from its
+// Status.AtProvider.ID. An unobserved Workspace is reported as an error
+// so the caller requeues instead of routing to the default workspace.
+func ResolveWorkspaceRef(ctx context.Context, kube client.Reader, namespace string, ref *v1alpha1.LocalReference) (string, error) {
+	if ref == nil || ref.Name == "" {
+		return "", nil
+	}
+	ws := &v1alpha1.Workspace{}
+	key := k8stypes.NamespacedName{Name: ref.Name, Namespace: namespace}
+	if err := kube.Get(ctx, key, ws); err != nil {
+		return "", fmt.Errorf("could not resolve WorkspaceRef %s: %w", ref.Name, err)
+	}
+	if ws.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced Workspace %s has not yet reported an ID; waiting for its controller to observe", ref.Name)
+	}
+	return ws.Status.AtProvider.ID, nil
+}
+
+// WorkspaceMovePending reports whether desired, a workspace ID or name
+// taken from spec, names a different workspace than current, the
+// canonical ID the platform reports for the instance. An empty desired
+// or current value never asks for a move, and an exact match skips the
+// ResolveWorkspace round-trip. Otherwise desired is resolved only when
+// resolved does not already record it, so an unchanged workspace name
+// costs no gateway call per poll; the returned resolution is the one
+// to record in status. A desired workspace that does not exist is
+// terminal.
+func WorkspaceMovePending(ctx context.Context, c akuity.Client, desired, current string, resolved *v1alpha1.WorkspaceResolution) (bool, *v1alpha1.WorkspaceResolution, error) {
+	if desired == "" || current == "" || desired == current {
+		return false, resolved, nil
+	}
+	if resolved != nil && resolved.Workspace == desired && resolved.ID != "" {
+		return resolved.ID != current, resolved, nil
+	}
+	w, err := c.ResolveWorkspace(ctx, desired)
+	if err != nil {
+		if reason.IsNotFound(err) {
+			return false, resolved, reason.AsTerminal(fmt.Errorf("workspace %q: %w", desired, err))
+		}
+		return false, resolved, err
+	}
+	resolved = &v1alpha1.WorkspaceResolution{Workspace: desired, ID: w.GetId()}
+	return w.GetId() != current, resolved, nil
+}
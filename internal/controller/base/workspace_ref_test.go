@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func workspaceRefKube(t *testing.T, objs ...*v1alpha1.Workspace) *fake.ClientBuilder {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	b := fake.NewClientBuilder().WithScheme(scheme)
+	for _, o := range objs {
+		b = b.WithObjects(o)
+	}
+	return b
+}
+
+func TestResolveWorkspaceRef_NilRef(t *testing.T) {
+	got, err := base.ResolveWorkspaceRef(context.Background(), nil, "", nil)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestResolveWorkspaceRef_ReturnsObservedID(t *testing.T) {
+	ws := &v1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	ws.Status.AtProvider.ID = "ws-123"
+	kube := workspaceRefKube(t, ws).Build()
+
+	got, err := base.ResolveWorkspaceRef(context.Background(), kube, "", &v1alpha1.LocalReference{Name: "team-a"})
+	require.NoError(t, err)
+	assert.Equal(t, "ws-123", got)
+}
+
+func TestResolveWorkspaceRef_UnobservedWorkspaceErrors(t *testing.T) {
+	ws := &v1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	kube := workspaceRefKube(t, ws).Build()
+
+	_, err := base.ResolveWorkspaceRef(context.Background(), kube, "", &v1alpha1.LocalReference{Name: "team-a"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has not yet reported an ID")
+}
+
+func TestResolveWorkspaceRef_MissingWorkspaceErrors(t *testing.T) {
+	kube := workspaceRefKube(t).Build()
+
+	_, err := base.ResolveWorkspaceRef(context.Background(), kube, "", &v1alpha1.LocalReference{Name: "absent"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not resolve WorkspaceRef absent")
+}
+
+func TestWorkspaceMovePending_MatchingIDSkipsResolve(t *testing.T) {
+	mc := mock_akuity_client.NewMockClient(gomock.NewController(t))
+
+	for _, desired := range []string{"", "ws-1"} {
+		pending, _, err := base.WorkspaceMovePending(context.Background(), mc, desired, "ws-1", nil)
+		require.NoError(t, err)
+		assert.False(t, pending)
+	}
+}
+
+func TestWorkspaceMovePending_ResolvesName(t *testing.T) {
+	mc := mock_akuity_client.NewMockClient(gomock.NewController(t))
+	mc.EXPECT().ResolveWorkspace(gomock.Any(), "platform").
+		Return(&orgcv1.Workspace{Id: "ws-1", Name: "platform"}, nil).Times(1)
+	mc.EXPECT().ResolveWorkspace(gomock.Any(), "team").
+		Return(&orgcv1.Workspace{Id: "ws-2", Name: "team"}, nil).Times(1)
+
+	pending, resolved, err := base.WorkspaceMovePending(context.Background(), mc, "platform", "ws-1", nil)
+	require.NoError(t, err)
+	assert.False(t, pending, "a name resolving to the current ID is not a move")
+	assert.Equal(t, &v1alpha1.WorkspaceResolution{Workspace: "platform", ID: "ws-1"}, resolved)
+
+	pending, resolved, err = base.WorkspaceMovePending(context.Background(), mc, "team", "ws-1", resolved)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	assert.Equal(t, &v1alpha1.WorkspaceResolution{Workspace: "team", ID: "ws-2"}, resolved)
+}
+
+func TestWorkspaceMovePending_RecordedResolutionSkipsResolve(t *testing.T) {
+	mc := mock_akuity_client.NewMockClient(gomock.NewController(t))
+	recorded := &v1alpha1.WorkspaceResolution{Workspace: "team", ID: "ws-2"}
+
+	pending, resolved, err := base.WorkspaceMovePending(context.Background(), mc, "team", "ws-2", recorded)
+	require.NoError(t, err)
+	assert.False(t, pending)
+	assert.Same(t, recorded, resolved)
+
+	pending, _, err = base.WorkspaceMovePending(context.Background(), mc, "team", "ws-1", recorded)
+	require.NoError(t, err)
+	assert.True(t, pending, "a recorded resolution still detects a move")
+}
+
+func TestWorkspaceMovePending_UnknownWorkspaceIsTerminal(t *testing.T) {
+	mc := mock_akuity_client.NewMockClient(gomock.NewController(t))
+	mc.EXPECT().ResolveWorkspace(gomock.Any(), "missing").
+		Return(nil, reason.AsNotFound(errors.New("workspace not found"))).Times(1)
+
+	_, _, err := base.WorkspaceMovePending(context.Background(), mc, "missing", "ws-1", nil)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
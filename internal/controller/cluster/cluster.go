@@ -24,6 +24,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -65,7 +67,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		Recorder:  recorder,
 		NewClient: base.DefaultClientFactory,
 		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.Cluster] {
-			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r), now: time.Now}
 		},
 	}
 
@@ -74,6 +76,15 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithTypedExternalConnector[*v1alpha1.Cluster](conn),
 		managed.WithLogger(logger),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(func(mg resource.Managed, pollInterval time.Duration) time.Duration {
+			c, ok := mg.(*v1alpha1.Cluster)
+			if !ok {
+				return pollInterval
+			}
+			now := time.Now()
+			pollInterval = base.MaintenanceWindowPollInterval(c.Status.AtProvider.MaintenanceWindow, now, pollInterval)
+			return supportAccessPollInterval(c, now, pollInterval)
+		}),
 		managed.WithRecorder(recorder),
 		managed.WithManagementPolicies(),
 	)
@@ -88,6 +99,10 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 
 type external struct {
 	base.ExternalClient
+
+	// now is the clock maintenance windows, support-access expiry and
+	// the cluster info refresh cadence are evaluated against.
+	now func() time.Time
 }
 
 func (e *external) Observe(ctx context.Context, mg *v1alpha1.Cluster) (managed.ExternalObservation, error) { //nolint:gocyclo
@@ -148,9 +163,38 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.Cluster) (managed.E
 		return managed.ExternalObservation{}, newErr
 	}
 
+	lastRotation := mg.Status.AtProvider.CredentialRotationStatus
+	lastSupportAccessUntil := mg.Status.AtProvider.SupportAccessUntil
+	lastSupportAccessGranted := mg.Status.AtProvider.SupportAccessGranted
+	lastSinkRevision := mg.Status.AtProvider.ManifestsSinkRevision
+	lastClusterInfo := mg.Status.AtProvider.ClusterInfo
+	lastConnectionDetailsRevision := mg.Status.AtProvider.ConnectionDetailsRevision
+	lastConnectionDetailsRefreshTime := mg.Status.AtProvider.ConnectionDetailsRefreshTime
 	mg.Status.AtProvider = clusterObservation
+	mg.Status.AtProvider.CredentialRotationStatus = lastRotation
+	mg.Status.AtProvider.ConnectionDetailsRevision = lastConnectionDetailsRevision
+	mg.Status.AtProvider.ConnectionDetailsRefreshTime = lastConnectionDetailsRefreshTime
+	if mg.Spec.ForProvider.ManifestsSink != nil {
+		mg.Status.AtProvider.ManifestsSinkRevision = lastSinkRevision
+		mg.Status.AtProvider.ManifestsSinkRevision = manifestsSinkState(mg).Settled()
+	}
+	e.observeSupportAccess(mg, lastSupportAccessUntil, lastSupportAccessGranted)
+	e.observeClusterInfo(ctx, mg, lastClusterInfo)
 	base.SetHealthCondition(mg, clusterObservation.HealthStatus.Code == 1)
 
+	desired := mg.Spec.ForProvider
+	if len(desired.MaintenanceWindows) > 0 {
+		window, err := base.EvaluateMaintenanceWindows(desired.MaintenanceWindows, e.now())
+		if err != nil {
+			newErr := fmt.Errorf("invalid spec.forProvider.maintenanceWindows: %w", err)
+			mg.SetConditions(xpv1.ReconcileError(newErr))
+			return managed.ExternalObservation{}, newErr
+		}
+		mg.Status.AtProvider.MaintenanceWindow = window.Status()
+		desired.ClusterSpec.Data.MaintenanceMode, desired.ClusterSpec.Data.MaintenanceModeExpiry =
+			window.MaintenanceFields(actualCluster.ClusterSpec.Data.MaintenanceMode, actualCluster.ClusterSpec.Data.MaintenanceModeExpiry)
+	}
+
 	// Drift compares against ExportInstanceByID's round-trippable spec,
 	// the same structural shape ApplyInstance sends. If Export succeeds
 	// but the cluster is missing from its Clusters list, fall back to
@@ -176,7 +220,7 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.Cluster) (managed.E
 	// the GetCluster value so real drift surfaces. When the user is
 	// silent, force driftTarget back to nil so a server-stamped value
 	// does not look like user-controlled drift.
-	desiredData := mg.Spec.ForProvider.ClusterSpec.Data
+	desiredData := desired.ClusterSpec.Data
 	if desiredData.MaintenanceMode != nil {
 		driftTarget.ClusterSpec.Data.MaintenanceMode = actualCluster.ClusterSpec.Data.MaintenanceMode
 	} else {
@@ -199,11 +243,24 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.Cluster) (managed.E
 	mg.Status.AtProvider.ClusterSpec = statusClusterSpec
 
 	spec := driftSpec()
-	desired := mg.Spec.ForProvider
 	isUpToDate, err := base.EvaluateDrift(ctx, spec, &desired, &driftTarget, e.Logger, "Cluster")
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
+	if isUpToDate && rotationPending(mg) {
+		e.Logger.Debug("Cluster credential rotation requested; forcing Update",
+			"annotation", mg.GetAnnotations()[v1alpha1.AnnotationRotateCredentials])
+		isUpToDate = false
+	}
+	if isUpToDate && mg.Spec.ForProvider.SupportAccess != nil && supportAccessPending(mg, e.now()) {
+		e.Logger.Debug("Cluster support access differs from spec; forcing Update")
+		isUpToDate = false
+	}
+	if isUpToDate && manifestsSinkState(mg).Pending() {
+		e.Logger.Debug("Cluster manifests sink is stale; forcing Update",
+			"revision", manifestsSinkState(mg).Revision())
+		isUpToDate = false
+	}
 
 	if !isUpToDate {
 		if obs, err, ok := e.suppressTerminalWrite(ctx, mg); ok {
@@ -214,8 +271,9 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.Cluster) (managed.E
 	}
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: isUpToDate,
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate,
+		ConnectionDetails: e.connectionDetails(ctx, mg),
 	}, nil
 }
 
@@ -265,13 +323,15 @@ func (e *external) Create(ctx context.Context, mg *v1alpha1.Cluster) (managed.Ex
 	// One-time apply: manifests are installed on the managed cluster at
 	// Create only. Update intentionally does not re-apply them.
 	// Server-pushed agent upgrades require a spec change or recreate to
-	// land on the managed cluster. A failure here also records a
+	// land on the managed cluster. A manifests sink is the exception:
+	// Create marks the write, Observe tracks the sink revision from
+	// there and Update rewrites it. A failure here also records a
 	// terminal write so the next Observe short-circuits via the
 	// suppress site rather than hot-looping ApplyInstance + rollback
 	// every poll. The terminal-write key is keyed off ForProvider, so a
 	// spec edit (the only way the user can fix a bad kubeconfig source)
 	// rotates the key and lets Create run again.
-	if mg.Spec.ForProvider.EnableInClusterKubeConfig || mg.Spec.ForProvider.KubeConfigSecretRef.Name != "" {
+	if targetKubeConfig(*mg).HasTarget() {
 		e.Logger.Debug("Retrieving cluster manifests....")
 		clusterManifests, err := e.Client.GetClusterManifests(ctx, mg.Spec.ForProvider.InstanceID, mg.Spec.ForProvider.Name)
 		if err != nil {
@@ -288,6 +348,9 @@ func (e *external) Create(ctx context.Context, mg *v1alpha1.Cluster) (managed.Ex
 			e.rollbackCreatedCluster(ctx, mg, "apply-manifests")
 			return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyManifestInstallError(fmt.Errorf("could not apply cluster manifests: %w", err)))
 		}
+		if mg.Spec.ForProvider.ManifestsSink != nil {
+			base.MarkManifestsSinkCreated(mg)
+		}
 	}
 	meta.SetExternalName(mg, mg.Spec.ForProvider.Name)
 
@@ -304,7 +367,7 @@ func (e *external) Create(ctx context.Context, mg *v1alpha1.Cluster) (managed.Ex
 // surface.
 func (e *external) rollbackCreatedCluster(ctx context.Context, mg *v1alpha1.Cluster, stage string) {
 	if mg.Spec.ForProvider.RemoveAgentResourcesOnDestroy &&
-		(mg.Spec.ForProvider.EnableInClusterKubeConfig || mg.Spec.ForProvider.KubeConfigSecretRef.Name != "") {
+		targetKubeConfig(*mg).HasTarget() {
 		manifests, err := e.Client.GetClusterManifests(ctx, mg.Spec.ForProvider.InstanceID, mg.Spec.ForProvider.Name)
 		if err == nil {
 			if applyErr := e.applyClusterManifests(ctx, *mg, manifests, true); applyErr != nil {
@@ -344,6 +407,19 @@ func (e *external) Update(ctx context.Context, mg *v1alpha1.Cluster) (managed.Ex
 	if err := e.syncMaintenanceMode(ctx, mg); err != nil {
 		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
 	}
+	if rotationPending(mg) {
+		if err := e.rotateCredentials(ctx, mg); err != nil {
+			return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, err)
+		}
+	}
+	if err := e.syncSupportAccess(ctx, mg); err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, err)
+	}
+	if manifestsSinkState(mg).Pending() {
+		if err := e.syncManifestsSink(ctx, mg); err != nil {
+			return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, err)
+		}
+	}
 	e.ClearTerminalWrite(key)
 	return managed.ExternalUpdate{}, nil
 }
@@ -365,6 +441,9 @@ func (e *external) Update(ctx context.Context, mg *v1alpha1.Cluster) (managed.Ex
 // every Update otherwise and burns one wire round-trip per poll for any
 // cluster with maintenanceMode pinned in spec.
 func (e *external) syncMaintenanceMode(ctx context.Context, mg *v1alpha1.Cluster) error {
+	if len(mg.Spec.ForProvider.MaintenanceWindows) > 0 {
+		return e.syncMaintenanceWindows(ctx, mg)
+	}
 	data := mg.Spec.ForProvider.ClusterSpec.Data
 	if data.MaintenanceMode == nil && data.MaintenanceModeExpiry == nil {
 		return nil
@@ -387,6 +466,31 @@ func (e *external) syncMaintenanceMode(ctx context.Context, mg *v1alpha1.Cluster
 	return e.Client.SetClusterMaintenanceMode(ctx, mg.Spec.ForProvider.InstanceID, mg.Spec.ForProvider.Name, mode, expiry)
 }
 
+// syncMaintenanceWindows drives maintenance mode from
+// spec.forProvider.maintenanceWindows. An open window enables
+// maintenance with an expiry at the window's end, so the platform
+// leaves maintenance on time even if the provider is down. Once every
+// window is closed, maintenance is switched off only if the platform
+// still reports it on.
+func (e *external) syncMaintenanceWindows(ctx context.Context, mg *v1alpha1.Cluster) error {
+	window, err := base.EvaluateMaintenanceWindows(mg.Spec.ForProvider.MaintenanceWindows, e.now())
+	if err != nil {
+		return reason.AsTerminal(fmt.Errorf("invalid spec.forProvider.maintenanceWindows: %w", err))
+	}
+	if !window.Active {
+		observed := mg.Status.AtProvider.ClusterSpec.Data.MaintenanceMode
+		if observed == nil || !*observed {
+			return nil
+		}
+		return e.Client.SetClusterMaintenanceMode(ctx, mg.Spec.ForProvider.InstanceID, mg.Spec.ForProvider.Name, false, nil)
+	}
+	end := window.End
+	if maintenanceModeAlreadyApplied(mg, true, &end) {
+		return nil
+	}
+	return e.Client.SetClusterMaintenanceMode(ctx, mg.Spec.ForProvider.InstanceID, mg.Spec.ForProvider.Name, true, &end)
+}
+
 // maintenanceModeAlreadyApplied returns true when the observed
 // status.atProvider already reflects (mode, expiry) so the dedicated RPC
 // does not need to fire again. atProvider.MaintenanceMode is populated
@@ -424,7 +528,7 @@ func (e *external) Delete(ctx context.Context, mg *v1alpha1.Cluster) (managed.Ex
 	}
 
 	if mg.Spec.ForProvider.RemoveAgentResourcesOnDestroy &&
-		(mg.Spec.ForProvider.EnableInClusterKubeConfig || mg.Spec.ForProvider.KubeConfigSecretRef.Name != "") {
+		targetKubeConfig(*mg).HasTarget() {
 		clusterManifests, err := e.Client.GetClusterManifests(ctx, mg.Spec.ForProvider.InstanceID, mg.Spec.ForProvider.Name)
 		if err != nil {
 			return managed.ExternalDelete{}, fmt.Errorf("could not get cluster manifests to delete: %w", err)
@@ -461,7 +565,7 @@ func (e *external) clusterTerminalWriteKey(ctx context.Context, mg *v1alpha1.Clu
 	if err != nil {
 		return base.NewTerminalWriteKey(mg, v1alpha1.ClusterGroupVersionKind, "build-error", mg.Spec.ForProvider, targetFP, err.Error())
 	}
-	return base.NewTerminalWriteKey(mg, v1alpha1.ClusterGroupVersionKind, req, targetFP)
+	return base.NewTerminalWriteKey(mg, v1alpha1.ClusterGroupVersionKind, req, targetFP, mg.GetAnnotations()[v1alpha1.AnnotationRotateCredentials])
 }
 
 func (e *external) clearTerminalWrite(ctx context.Context, mg *v1alpha1.Cluster) {
@@ -554,6 +658,7 @@ func targetKubeConfig(mg v1alpha1.Cluster) kube.TargetKubeConfig {
 		EnableInCluster: mg.Spec.ForProvider.EnableInClusterKubeConfig,
 		SecretName:      mg.Spec.ForProvider.KubeConfigSecretRef.Name,
 		SecretNamespace: mg.Spec.ForProvider.KubeConfigSecretRef.Namespace,
+		Sink:            base.ManifestsSink(mg.Spec.ForProvider.ManifestsSink, mg.GetUID()),
 	}
 }
 
@@ -568,6 +673,11 @@ func (e *external) applyClusterManifests(ctx context.Context, mg v1alpha1.Cluste
 // disagree on fields neither the user nor the server treats as drift.
 func driftSpec() base.DriftSpec[v1alpha1.ClusterParameters] {
 	return base.DriftSpec[v1alpha1.ClusterParameters]{
+		// SupportAccess goes through its own RPC and is reconciled by
+		// supportAccessPending against atProvider.supportAccessGranted.
+		Ignore: []cmp.Option{
+			cmpopts.IgnoreFields(v1alpha1.ClusterParameters{}, "SupportAccess"),
+		},
 		Normalize: func(desired, observed *v1alpha1.ClusterParameters) {
 			if desired == nil || observed == nil {
 				return
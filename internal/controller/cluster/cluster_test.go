@@ -22,6 +22,7 @@ import (
 	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpevent "github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/pkg/errors"
@@ -33,13 +34,17 @@ import (
 	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/kubectl/pkg/scheme"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
 	health "github.com/akuity/api-client-go/pkg/api/gen/types/status/health/v1"
+	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
 	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
@@ -91,6 +96,17 @@ current-context: minikube`
 // newExt constructs an *external with the supplied mock akuity client
 // and an optional kube client. Mirrors kargoagent_test.go's newExt.
 func newExt(t *testing.T, kube *fake.ClientBuilder) (*external, *mock_akuity_client.MockClient) {
+	t.Helper()
+	e, mc := newExtWithoutClusterInfo(t, kube)
+	// Observe refreshes cluster info on its own cadence. Tests of that
+	// refresh use newExtWithoutClusterInfo, since this stub would
+	// match their calls first.
+	mc.EXPECT().GetClusterInfo(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&argocdv1.GetInstanceClusterInfoResponse{}, nil).AnyTimes()
+	return e, mc
+}
+
+func newExtWithoutClusterInfo(t *testing.T, kube *fake.ClientBuilder) (*external, *mock_akuity_client.MockClient) {
 	t.Helper()
 	mc := mock_akuity_client.NewMockClient(gomock.NewController(t))
 	if kube == nil {
@@ -100,7 +116,7 @@ func newExt(t *testing.T, kube *fake.ClientBuilder) (*external, *mock_akuity_cli
 		Client: mc,
 		Kube:   kube.Build(),
 		Logger: logging.NewNopLogger(),
-	}}, mc
+	}, now: time.Now}, mc
 }
 
 func exportedClusterWithDescription(t *testing.T, description string) *structpb.Struct {
@@ -1133,3 +1149,778 @@ func TestObserve_DatadogEksAddon_DesiredTrueServerFalse(t *testing.T) {
 	assert.False(t, resp.ResourceUpToDate,
 		"user-pinned Datadog/EksAddon=true with platform=false must surface as drift")
 }
+
+func TestObserve_RotationAnnotationForcesUpdate(t *testing.T) {
+	e, mc := newExt(t, nil)
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{
+			"crossplane.io/external-name":        fixtures.ClusterName,
+			v1alpha1.AnnotationRotateCredentials: "2026-10-18T00:00:00Z",
+		},
+	}
+
+	mc.EXPECT().GetCluster(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(fixtures.ArgocdCluster, nil).Times(1)
+	mc.EXPECT().ExportInstanceByID(ctx, fixtures.InstanceID).
+		Return(&argocdv1.ExportInstanceResponse{Clusters: []*structpb.Struct{fixtures.ExportedCluster}}, nil).Times(1)
+
+	resp, err := e.Observe(ctx, &managedCluster)
+	require.NoError(t, err)
+	assert.Equal(t, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, resp)
+}
+
+func TestObserve_RotationAlreadyHandledIsUpToDate(t *testing.T) {
+	e, mc := newExt(t, nil)
+
+	rotatedAt := metav1.Now()
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{
+			"crossplane.io/external-name":        fixtures.ClusterName,
+			v1alpha1.AnnotationRotateCredentials: "2026-10-18T00:00:00Z",
+		},
+	}
+	managedCluster.Status.AtProvider.LastCredentialRotation = "2026-10-18T00:00:00Z"
+	managedCluster.Status.AtProvider.LastCredentialRotationTime = &rotatedAt
+
+	mc.EXPECT().GetCluster(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(fixtures.ArgocdCluster, nil).Times(1)
+	mc.EXPECT().ExportInstanceByID(ctx, fixtures.InstanceID).
+		Return(&argocdv1.ExportInstanceResponse{Clusters: []*structpb.Struct{fixtures.ExportedCluster}}, nil).Times(1)
+
+	resp, err := e.Observe(ctx, &managedCluster)
+	require.NoError(t, err)
+	assert.Equal(t, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, resp)
+	assert.Equal(t, "2026-10-18T00:00:00Z", managedCluster.Status.AtProvider.LastCredentialRotation)
+	assert.Equal(t, &rotatedAt, managedCluster.Status.AtProvider.LastCredentialRotationTime)
+}
+
+func TestUpdate_RotationWithoutKubeConfigIsTerminal(t *testing.T) {
+	e, mc := newExt(t, nil)
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{v1alpha1.AnnotationRotateCredentials: "nonce-1"},
+	}
+	managedCluster.Spec.ForProvider.EnableInClusterKubeConfig = false
+	managedCluster.Spec.ForProvider.KubeConfigSecretRef = xpv1.SecretReference{}
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+
+	_, err := e.Update(ctx, &managedCluster)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+	assert.Empty(t, managedCluster.Status.AtProvider.LastCredentialRotation)
+}
+
+func TestUpdate_RotationRPCErr(t *testing.T) {
+	e, mc := newExt(t, nil)
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{v1alpha1.AnnotationRotateCredentials: "nonce-1"},
+	}
+	managedCluster.Spec.ForProvider.EnableInClusterKubeConfig = true
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().RotateClusterCredentials(ctx, managedCluster.Spec.ForProvider.InstanceID, managedCluster.Spec.ForProvider.Name).
+		Return(errors.New("fake")).Times(1)
+
+	_, err := e.Update(ctx, &managedCluster)
+	require.Error(t, err)
+	assert.Empty(t, managedCluster.Status.AtProvider.LastCredentialRotation)
+}
+
+func TestUpdate_RotationDeliveryRetryDoesNotRotateAgain(t *testing.T) {
+	// An unowned Secret under the sink's name makes the first delivery
+	// fail.
+	unowned := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "agent-manifests", Namespace: "crossplane-system"}}
+	e, mc := newExt(t, fake.NewClientBuilder().WithObjects(unowned))
+	mg := manifestsSinkCluster()
+	mg.Annotations[v1alpha1.AnnotationRotateCredentials] = "nonce-1"
+	mg.Status.AtProvider.ID = "cluster-id"
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(2)
+	mc.EXPECT().RotateClusterCredentials(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(nil).Times(1)
+	mc.EXPECT().GetClusterManifestsOnce(ctx, fixtures.InstanceID, "cluster-id").
+		Return("kind: Namespace\n", nil).Times(2)
+
+	_, err := e.Update(ctx, mg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "after credential rotation")
+	assert.Equal(t, "nonce-1", mg.Status.AtProvider.LastCredentialRotation)
+	assert.NotNil(t, mg.Status.AtProvider.LastCredentialRotationTime)
+	assert.True(t, mg.Status.AtProvider.CredentialRotationManifestsPending)
+	assert.True(t, rotationPending(mg))
+
+	require.NoError(t, e.Kube.Delete(ctx, unowned))
+	_, err = e.Update(ctx, mg)
+	require.NoError(t, err)
+	assert.False(t, mg.Status.AtProvider.CredentialRotationManifestsPending)
+	assert.False(t, rotationPending(mg))
+
+	secret := &corev1.Secret{}
+	require.NoError(t, e.Kube.Get(ctx, k8stypes.NamespacedName{Namespace: "crossplane-system", Name: "agent-manifests"}, secret))
+	assert.Equal(t, "kind: Namespace\n", string(secret.Data[kube.ManifestsSinkKey]))
+}
+
+var sundayMaintenanceWindows = []v1alpha1.MaintenanceWindow{{
+	Schedule: "0 2 * * 0",
+	Duration: metav1.Duration{Duration: 2 * time.Hour},
+}}
+
+func fixedClock(s string) func() time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return func() time.Time { return t }
+}
+
+func observeWithMaintenanceWindows(t *testing.T, now string) (*v1alpha1.Cluster, managed.ExternalObservation, error) {
+	t.Helper()
+	e, mc := newExt(t, nil)
+	e.now = fixedClock(now)
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{
+			"crossplane.io/external-name": fixtures.ClusterName,
+		},
+	}
+	managedCluster.Spec.ForProvider.MaintenanceWindows = sundayMaintenanceWindows
+
+	mc.EXPECT().GetCluster(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(fixtures.ArgocdCluster, nil).Times(1)
+	mc.EXPECT().ExportInstanceByID(ctx, fixtures.InstanceID).
+		Return(&argocdv1.ExportInstanceResponse{Clusters: []*structpb.Struct{fixtures.ExportedCluster}}, nil).Times(1)
+
+	obs, err := e.Observe(ctx, &managedCluster)
+	return &managedCluster, obs, err
+}
+
+func TestObserve_MaintenanceWindowOpenForcesUpdate(t *testing.T) {
+	mg, obs, err := observeWithMaintenanceWindows(t, "2026-10-18T03:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, obs)
+	require.NotNil(t, mg.Status.AtProvider.MaintenanceWindow)
+	assert.True(t, mg.Status.AtProvider.MaintenanceWindow.Active)
+	require.NotNil(t, mg.Status.AtProvider.MaintenanceWindow.ActiveUntil)
+	assert.Equal(t, "2026-10-18T04:00:00Z", mg.Status.AtProvider.MaintenanceWindow.ActiveUntil.UTC().Format(time.RFC3339))
+}
+
+func TestObserve_MaintenanceWindowClosedIsUpToDate(t *testing.T) {
+	mg, obs, err := observeWithMaintenanceWindows(t, "2026-10-17T12:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, obs)
+	require.NotNil(t, mg.Status.AtProvider.MaintenanceWindow)
+	assert.False(t, mg.Status.AtProvider.MaintenanceWindow.Active)
+	require.NotNil(t, mg.Status.AtProvider.MaintenanceWindow.NextStart)
+	assert.Equal(t, "2026-10-18T02:00:00Z", mg.Status.AtProvider.MaintenanceWindow.NextStart.UTC().Format(time.RFC3339))
+}
+
+func TestObserve_MaintenanceWindowInvalid(t *testing.T) {
+	e, mc := newExt(t, nil)
+	e.now = fixedClock("2026-10-17T12:00:00Z")
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{
+			"crossplane.io/external-name": fixtures.ClusterName,
+		},
+	}
+	managedCluster.Spec.ForProvider.MaintenanceWindows = []v1alpha1.MaintenanceWindow{{Schedule: "not a cron", Duration: metav1.Duration{Duration: time.Hour}}}
+
+	mc.EXPECT().GetCluster(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(fixtures.ArgocdCluster, nil).Times(1)
+
+	_, err := e.Observe(ctx, &managedCluster)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "maintenanceWindows")
+}
+
+func TestUpdate_MaintenanceWindowOpenSetsExpiry(t *testing.T) {
+	e, mc := newExt(t, nil)
+	e.now = fixedClock("2026-10-18T03:00:00Z")
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.Spec.ForProvider.MaintenanceWindows = sundayMaintenanceWindows
+	wantExpiry := time.Date(2026, 10, 18, 4, 0, 0, 0, time.UTC)
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().SetClusterMaintenanceMode(ctx, managedCluster.Spec.ForProvider.InstanceID, managedCluster.Spec.ForProvider.Name, true, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, _ bool, expiry *time.Time) error {
+			require.NotNil(t, expiry)
+			assert.True(t, wantExpiry.Equal(*expiry))
+			return nil
+		}).Times(1)
+
+	_, err := e.Update(ctx, &managedCluster)
+	require.NoError(t, err)
+}
+
+func TestUpdate_MaintenanceWindowClosedDisablesMaintenance(t *testing.T) {
+	e, mc := newExt(t, nil)
+	e.now = fixedClock("2026-10-18T04:00:01Z")
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.Spec.ForProvider.MaintenanceWindows = sundayMaintenanceWindows
+	managedCluster.Status.AtProvider.ClusterSpec.Data.MaintenanceMode = ptr.To(true)
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().SetClusterMaintenanceMode(ctx, managedCluster.Spec.ForProvider.InstanceID, managedCluster.Spec.ForProvider.Name, false, nil).
+		Return(nil).Times(1)
+
+	_, err := e.Update(ctx, &managedCluster)
+	require.NoError(t, err)
+}
+
+func TestUpdate_MaintenanceWindowClosedAndOffSkipsRPC(t *testing.T) {
+	e, mc := newExt(t, nil)
+	e.now = fixedClock("2026-10-17T12:00:00Z")
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.Spec.ForProvider.MaintenanceWindows = sundayMaintenanceWindows
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+
+	_, err := e.Update(ctx, &managedCluster)
+	require.NoError(t, err)
+}
+
+type recordedEvents struct {
+	events []xpevent.Event
+}
+
+func (r *recordedEvents) Event(_ runtime.Object, e xpevent.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recordedEvents) WithAnnotations(_ ...string) xpevent.Recorder { return r }
+
+func newSupportAccessExt(t *testing.T, now string) (*external, *mock_akuity_client.MockClient, *recordedEvents) {
+	t.Helper()
+	e, mc := newExt(t, nil)
+	e.now = fixedClock(now)
+	rec := &recordedEvents{}
+	e.Recorder = rec
+	return e, mc, rec
+}
+
+func supportAccessUntil(s string) *metav1.Time {
+	t := metav1.NewTime(fixedClock(s)())
+	return &t
+}
+
+func TestObserve_SupportAccessRequestedForcesUpdate(t *testing.T) {
+	e, mc, rec := newSupportAccessExt(t, "2026-10-18T10:00:00Z")
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{"crossplane.io/external-name": fixtures.ClusterName},
+	}
+	managedCluster.Spec.ForProvider.SupportAccess = &v1alpha1.ClusterSupportAccess{
+		Enabled:   true,
+		ExpiresAt: supportAccessUntil("2026-10-18T12:00:00Z"),
+	}
+
+	observed := proto.Clone(fixtures.ArgocdCluster).(*argocdv1.Cluster)
+	observed.Id = "cluster-id"
+	mc.EXPECT().GetCluster(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(observed, nil).Times(1)
+	mc.EXPECT().ExportInstanceByID(ctx, fixtures.InstanceID).
+		Return(&argocdv1.ExportInstanceResponse{Clusters: []*structpb.Struct{fixtures.ExportedCluster}}, nil).Times(1)
+
+	resp, err := e.Observe(ctx, &managedCluster)
+	require.NoError(t, err)
+	assert.Equal(t, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, resp)
+	assert.Empty(t, rec.events)
+}
+
+func TestObserve_SupportAccessLapsedOnPlatformEmitsRevokeEvent(t *testing.T) {
+	e, mc, rec := newSupportAccessExt(t, "2026-10-18T12:00:05Z")
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{"crossplane.io/external-name": fixtures.ClusterName},
+	}
+	managedCluster.Spec.ForProvider.SupportAccess = &v1alpha1.ClusterSupportAccess{
+		Enabled:   true,
+		ExpiresAt: supportAccessUntil("2026-10-18T12:00:00Z"),
+	}
+	managedCluster.Status.AtProvider.SupportAccessUntil = supportAccessUntil("2026-10-18T12:00:00Z")
+
+	observed := proto.Clone(fixtures.ArgocdCluster).(*argocdv1.Cluster)
+	observed.Id = "cluster-id"
+	observed.SupportAccessUntil = timestamppb.New(fixedClock("2026-10-18T12:00:00Z")())
+	mc.EXPECT().GetCluster(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(observed, nil).Times(1)
+	mc.EXPECT().ExportInstanceByID(ctx, fixtures.InstanceID).
+		Return(&argocdv1.ExportInstanceResponse{Clusters: []*structpb.Struct{fixtures.ExportedCluster}}, nil).Times(1)
+
+	resp, err := e.Observe(ctx, &managedCluster)
+	require.NoError(t, err)
+	assert.True(t, resp.ResourceUpToDate, "an expired grant the platform already lapsed needs no revoke call")
+	assert.Nil(t, managedCluster.Status.AtProvider.SupportAccessUntil)
+	require.Len(t, rec.events, 1)
+	assert.Equal(t, reasonSupportAccessRevoked, rec.events[0].Reason)
+}
+
+func TestSupportAccessWithoutExpirySettlesAfterGrant(t *testing.T) {
+	e, mc, rec := newSupportAccessExt(t, "2026-10-18T10:00:00Z")
+
+	managedCluster := fixtures.CrossplaneManagedCluster.DeepCopy()
+	managedCluster.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{"crossplane.io/external-name": fixtures.ClusterName},
+	}
+	managedCluster.Spec.ForProvider.SupportAccess = &v1alpha1.ClusterSupportAccess{Enabled: true}
+
+	// The platform reports no lapse time for an open-ended grant.
+	observed := proto.Clone(fixtures.ArgocdCluster).(*argocdv1.Cluster)
+	observed.Id = "cluster-id"
+	mc.EXPECT().GetCluster(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(observed, nil).Times(2)
+	mc.EXPECT().ExportInstanceByID(ctx, fixtures.InstanceID).
+		Return(&argocdv1.ExportInstanceResponse{Clusters: []*structpb.Struct{fixtures.ExportedCluster}}, nil).Times(2)
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().UpdateClusterSupportAccess(ctx, fixtures.InstanceID, "cluster-id", true, time.Duration(0)).
+		Return(nil).Times(1)
+
+	resp, err := e.Observe(ctx, managedCluster)
+	require.NoError(t, err)
+	assert.False(t, resp.ResourceUpToDate)
+
+	_, err = e.Update(ctx, managedCluster)
+	require.NoError(t, err)
+	assert.True(t, managedCluster.Status.AtProvider.SupportAccessGranted)
+	assert.Nil(t, managedCluster.Status.AtProvider.SupportAccessUntil)
+
+	resp, err = e.Observe(ctx, managedCluster)
+	require.NoError(t, err)
+	assert.True(t, resp.ResourceUpToDate, "an open-ended grant must not force another Update")
+	assert.True(t, managedCluster.Status.AtProvider.SupportAccessGranted)
+	require.Len(t, rec.events, 1)
+	assert.Equal(t, reasonSupportAccessGranted, rec.events[0].Reason)
+}
+
+func TestUpdate_RevokesSupportAccessWithoutExpiry(t *testing.T) {
+	e, mc, rec := newSupportAccessExt(t, "2026-10-18T10:00:00Z")
+
+	managedCluster := fixtures.CrossplaneManagedCluster.DeepCopy()
+	managedCluster.Spec.ForProvider.SupportAccess = &v1alpha1.ClusterSupportAccess{Enabled: false}
+	managedCluster.Status.AtProvider.ID = "cluster-id"
+	managedCluster.Status.AtProvider.SupportAccessGranted = true
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().UpdateClusterSupportAccess(ctx, fixtures.InstanceID, "cluster-id", false, time.Duration(0)).
+		Return(nil).Times(1)
+
+	_, err := e.Update(ctx, managedCluster)
+	require.NoError(t, err)
+	assert.False(t, managedCluster.Status.AtProvider.SupportAccessGranted)
+	require.Len(t, rec.events, 1)
+	assert.Equal(t, reasonSupportAccessRevoked, rec.events[0].Reason)
+}
+
+func TestUpdate_GrantsSupportAccess(t *testing.T) {
+	e, mc, rec := newSupportAccessExt(t, "2026-10-18T10:00:00Z")
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.Spec.ForProvider.SupportAccess = &v1alpha1.ClusterSupportAccess{
+		Enabled:   true,
+		ExpiresAt: supportAccessUntil("2026-10-18T12:00:00Z"),
+	}
+	managedCluster.Status.AtProvider.ID = "cluster-id"
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().UpdateClusterSupportAccess(ctx, fixtures.InstanceID, "cluster-id", true, 2*time.Hour).
+		Return(nil).Times(1)
+
+	_, err := e.Update(ctx, &managedCluster)
+	require.NoError(t, err)
+	assert.Equal(t, supportAccessUntil("2026-10-18T12:00:00Z"), managedCluster.Status.AtProvider.SupportAccessUntil)
+	require.Len(t, rec.events, 1)
+	assert.Equal(t, reasonSupportAccessGranted, rec.events[0].Reason)
+	assert.Contains(t, rec.events[0].Message, "2026-10-18T12:00:00Z")
+}
+
+func TestUpdate_RevokesExpiredSupportAccess(t *testing.T) {
+	e, mc, rec := newSupportAccessExt(t, "2026-10-18T12:00:01Z")
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.Spec.ForProvider.SupportAccess = &v1alpha1.ClusterSupportAccess{
+		Enabled:   true,
+		ExpiresAt: supportAccessUntil("2026-10-18T12:00:00Z"),
+	}
+	managedCluster.Status.AtProvider.ID = "cluster-id"
+	managedCluster.Status.AtProvider.SupportAccessUntil = supportAccessUntil("2026-10-18T12:00:30Z")
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().UpdateClusterSupportAccess(ctx, fixtures.InstanceID, "cluster-id", false, time.Duration(0)).
+		Return(nil).Times(1)
+
+	_, err := e.Update(ctx, &managedCluster)
+	require.NoError(t, err)
+	assert.Nil(t, managedCluster.Status.AtProvider.SupportAccessUntil)
+	require.Len(t, rec.events, 1)
+	assert.Equal(t, reasonSupportAccessRevoked, rec.events[0].Reason)
+	assert.Contains(t, rec.events[0].Message, "expired")
+}
+
+func TestUpdate_SupportAccessErrIsRecorded(t *testing.T) {
+	e, mc, rec := newSupportAccessExt(t, "2026-10-18T10:00:00Z")
+
+	managedCluster := fixtures.CrossplaneManagedCluster
+	managedCluster.Spec.ForProvider.SupportAccess = &v1alpha1.ClusterSupportAccess{Enabled: true}
+	managedCluster.Status.AtProvider.ID = "cluster-id"
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().UpdateClusterSupportAccess(ctx, fixtures.InstanceID, "cluster-id", true, time.Duration(0)).
+		Return(errors.New("fake")).Times(1)
+
+	_, err := e.Update(ctx, &managedCluster)
+	require.Error(t, err)
+	assert.Empty(t, rec.events)
+}
+
+func TestSupportAccessPollInterval(t *testing.T) {
+	now := fixedClock("2026-10-18T10:00:00Z")()
+	mg := &v1alpha1.Cluster{}
+	assert.Equal(t, time.Hour, supportAccessPollInterval(mg, now, time.Hour))
+
+	mg.Status.AtProvider.SupportAccessUntil = supportAccessUntil("2026-10-18T10:30:00Z")
+	assert.Equal(t, 30*time.Minute+time.Second, supportAccessPollInterval(mg, now, time.Hour))
+
+	mg.Spec.ForProvider.SupportAccess = &v1alpha1.ClusterSupportAccess{
+		Enabled:   true,
+		ExpiresAt: supportAccessUntil("2026-10-18T10:10:00Z"),
+	}
+	assert.Equal(t, 10*time.Minute+time.Second, supportAccessPollInterval(mg, now, time.Hour))
+	assert.Equal(t, 5*time.Minute, supportAccessPollInterval(mg, now, 5*time.Minute))
+}
+
+// connectionDetailsCluster returns a managed Cluster that asks for a
+// connection Secret, and expects the GetCluster and Export calls that
+// precede connection-detail publishing in Observe.
+func connectionDetailsCluster(mc *mock_akuity_client.MockClient) *v1alpha1.Cluster {
+	mg := fixtures.CrossplaneManagedCluster.DeepCopy()
+	mg.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{"crossplane.io/external-name": fixtures.ClusterName},
+	}
+	mg.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "crossplane-system", Name: "cluster-bootstrap"})
+
+	observed := proto.Clone(fixtures.ArgocdCluster).(*argocdv1.Cluster)
+	observed.Id = "cluster-id"
+	mc.EXPECT().GetCluster(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(observed, nil).Times(1)
+	mc.EXPECT().ExportInstanceByID(ctx, fixtures.InstanceID).
+		Return(&argocdv1.ExportInstanceResponse{Clusters: []*structpb.Struct{fixtures.ExportedCluster}}, nil).Times(1)
+	return mg
+}
+
+func TestObserve_PublishesBootstrapConnectionDetails(t *testing.T) {
+	e, mc := newExt(t, nil)
+	mg := connectionDetailsCluster(mc)
+	mg.Spec.ForProvider.EnableInClusterKubeConfig = false
+	mg.Spec.ForProvider.KubeConfigSecretRef = xpv1.SecretReference{}
+
+	mc.EXPECT().GetClusterInstallCommand(ctx, fixtures.InstanceID, "cluster-id").
+		Return("kubectl apply -f install.yaml", nil).Times(1)
+	mc.EXPECT().GetClusterAPIServerCAData(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return("ca-data", nil).Times(1)
+	mc.EXPECT().GetClusterManifestsOnce(ctx, fixtures.InstanceID, "cluster-id").
+		Return("kind: Namespace", nil).Times(1)
+
+	resp, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.Equal(t, managed.ConnectionDetails{
+		connectionKeyInstallCommand:  []byte("kubectl apply -f install.yaml"),
+		connectionKeyAPIServerCAData: []byte("ca-data"),
+		connectionKeyManifests:       []byte("kind: Namespace"),
+	}, resp.ConnectionDetails)
+}
+
+func TestObserve_ConnectionDetailsSkipManifestsWithKubeConfig(t *testing.T) {
+	e, mc := newExt(t, nil)
+	mg := connectionDetailsCluster(mc)
+	mg.Spec.ForProvider.EnableInClusterKubeConfig = true
+
+	mc.EXPECT().GetClusterInstallCommand(ctx, fixtures.InstanceID, "cluster-id").
+		Return("kubectl apply -f install.yaml", nil).Times(1)
+	mc.EXPECT().GetClusterAPIServerCAData(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return("ca-data", nil).Times(1)
+
+	resp, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.NotContains(t, resp.ConnectionDetails, connectionKeyManifests)
+	assert.Equal(t, []byte("ca-data"), resp.ConnectionDetails[connectionKeyAPIServerCAData])
+}
+
+func TestObserve_ConnectionDetailsErrDoesNotBlockObserve(t *testing.T) {
+	e, mc := newExt(t, nil)
+	mg := connectionDetailsCluster(mc)
+
+	mc.EXPECT().GetClusterInstallCommand(ctx, fixtures.InstanceID, "cluster-id").
+		Return("", errors.New("fake")).Times(1)
+	mc.EXPECT().GetClusterAPIServerCAData(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return("ca-data", nil).Times(1)
+
+	resp, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.True(t, resp.ResourceExists)
+	assert.Equal(t, managed.ConnectionDetails{
+		connectionKeyAPIServerCAData: []byte("ca-data"),
+	}, resp.ConnectionDetails)
+	assert.Empty(t, mg.Status.AtProvider.ConnectionDetailsRevision, "a partial fetch is retried on the next poll")
+	assert.Nil(t, mg.Status.AtProvider.ConnectionDetailsRefreshTime)
+}
+
+func TestObserve_ConnectionDetailsRefreshCadence(t *testing.T) {
+	e, mc := newExt(t, nil)
+	e.now = fixedClock("2026-10-18T10:00:00Z")
+	mg := connectionDetailsCluster(mc)
+	observed := proto.Clone(fixtures.ArgocdCluster).(*argocdv1.Cluster)
+	observed.Id = "cluster-id"
+	mc.EXPECT().GetCluster(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(observed, nil).Times(2)
+	mc.EXPECT().ExportInstanceByID(ctx, fixtures.InstanceID).
+		Return(&argocdv1.ExportInstanceResponse{Clusters: []*structpb.Struct{fixtures.ExportedCluster}}, nil).Times(2)
+	mc.EXPECT().GetClusterInstallCommand(ctx, fixtures.InstanceID, "cluster-id").
+		Return("kubectl apply -f install.yaml", nil).Times(2)
+	mc.EXPECT().GetClusterAPIServerCAData(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return("ca-data", nil).Times(2)
+
+	resp, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.NotNil(t, resp.ConnectionDetails)
+	revision := mg.Status.AtProvider.ConnectionDetailsRevision
+	assert.NotEmpty(t, revision)
+
+	// Within the refresh interval and at the same revision, nothing is
+	// fetched and the published values are kept.
+	e.now = fixedClock("2026-10-18T10:05:00Z")
+	resp, err = e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.Nil(t, resp.ConnectionDetails)
+
+	// A spec change refetches straight away.
+	mg.SetGeneration(mg.GetGeneration() + 1)
+	resp, err = e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("ca-data"), resp.ConnectionDetails[connectionKeyAPIServerCAData])
+	assert.NotEqual(t, revision, mg.Status.AtProvider.ConnectionDetailsRevision)
+}
+
+// manifestsSinkCluster returns a managed Cluster that delivers its
+// manifests to a Secret sink instead of a kubeconfig target.
+func manifestsSinkCluster() *v1alpha1.Cluster {
+	mg := fixtures.CrossplaneManagedCluster.DeepCopy()
+	mg.ObjectMeta = metav1.ObjectMeta{
+		UID:         "cluster-uid",
+		Generation:  3,
+		Annotations: map[string]string{"crossplane.io/external-name": fixtures.ClusterName},
+	}
+	mg.Spec.ForProvider.EnableInClusterKubeConfig = false
+	mg.Spec.ForProvider.KubeConfigSecretRef = xpv1.SecretReference{}
+	mg.Spec.ForProvider.ManifestsSink = &v1alpha1.ManifestsSink{Kind: kube.SinkKindSecret, Name: "agent-manifests", Namespace: "crossplane-system"}
+	return mg
+}
+
+func expectObserveSinkCluster(mc *mock_akuity_client.MockClient) {
+	observed := proto.Clone(fixtures.ArgocdCluster).(*argocdv1.Cluster)
+	observed.Id = "cluster-id"
+	mc.EXPECT().GetCluster(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(observed, nil).Times(1)
+	mc.EXPECT().ExportInstanceByID(ctx, fixtures.InstanceID).
+		Return(&argocdv1.ExportInstanceResponse{Clusters: []*structpb.Struct{fixtures.ExportedCluster}}, nil).Times(1)
+}
+
+func TestObserve_ManifestsSinkStaleForcesUpdate(t *testing.T) {
+	e, mc := newExt(t, nil)
+	mg := manifestsSinkCluster()
+	mg.Status.AtProvider.ManifestsSinkRevision = "2:v1.2.3"
+	expectObserveSinkCluster(mc)
+
+	resp, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.False(t, resp.ResourceUpToDate)
+	assert.Equal(t, "2:v1.2.3", mg.Status.AtProvider.ManifestsSinkRevision)
+}
+
+func TestObserve_ManifestsSinkCurrentIsUpToDate(t *testing.T) {
+	e, mc := newExt(t, nil)
+	mg := manifestsSinkCluster()
+	mg.Status.AtProvider.ManifestsSinkRevision = "3:v1.2.3"
+	expectObserveSinkCluster(mc)
+
+	resp, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.True(t, resp.ResourceUpToDate)
+	assert.Nil(t, resp.ConnectionDetails)
+}
+
+func TestCreate_WritesManifestsSink(t *testing.T) {
+	e, mc := newExt(t, nil)
+	mg := manifestsSinkCluster()
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().GetClusterManifests(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return("kind: Namespace\n", nil).Times(1)
+
+	_, err := e.Create(ctx, mg)
+	require.NoError(t, err)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, e.Kube.Get(ctx, k8stypes.NamespacedName{Namespace: "crossplane-system", Name: "agent-manifests"}, secret))
+	assert.Equal(t, "kind: Namespace\n", string(secret.Data[kube.ManifestsSinkKey]))
+}
+
+func TestCreate_ManifestsSinkThenObserveIsUpToDate(t *testing.T) {
+	e, mc := newExt(t, nil)
+	mg := manifestsSinkCluster()
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().GetClusterManifests(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return("kind: Namespace\n", nil).Times(1)
+	mc.EXPECT().GetClusterManifestsOnce(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := e.Create(ctx, mg)
+	require.NoError(t, err)
+
+	// The managed reconciler reverts status set during Create.
+	mg.Status = v1alpha1.ClusterStatus{}
+	expectObserveSinkCluster(mc)
+	resp, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.True(t, resp.ResourceUpToDate)
+	assert.Equal(t, "3:v1.2.3", mg.Status.AtProvider.ManifestsSinkRevision)
+}
+
+func TestUpdate_RewritesStaleManifestsSink(t *testing.T) {
+	e, mc := newExt(t, nil)
+	mg := manifestsSinkCluster()
+	mg.Status.AtProvider.ID = "cluster-id"
+	mg.Status.AtProvider.ReconciliationStatus.Code = int32(reconv1.StatusCode_STATUS_CODE_SUCCESSFUL)
+	mg.Status.AtProvider.ClusterSpec.Data.TargetVersion = "v1.2.4"
+	mg.Status.AtProvider.ManifestsSinkRevision = "3:v1.2.3"
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().GetClusterManifestsOnce(ctx, fixtures.InstanceID, "cluster-id").
+		Return("kind: Namespace\n", nil).Times(1)
+
+	_, err := e.Update(ctx, mg)
+	require.NoError(t, err)
+	assert.Equal(t, "3:v1.2.4", mg.Status.AtProvider.ManifestsSinkRevision)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, e.Kube.Get(ctx, k8stypes.NamespacedName{Namespace: "crossplane-system", Name: "agent-manifests"}, secret))
+	assert.Equal(t, "kind: Namespace\n", string(secret.Data[kube.ManifestsSinkKey]))
+}
+
+func TestUpdate_ManifestsSinkFetchErrLeavesRevision(t *testing.T) {
+	e, mc := newExt(t, nil)
+	mg := manifestsSinkCluster()
+	mg.Status.AtProvider.ID = "cluster-id"
+	mg.Status.AtProvider.ReconciliationStatus.Code = int32(reconv1.StatusCode_STATUS_CODE_SUCCESSFUL)
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().GetClusterManifestsOnce(ctx, fixtures.InstanceID, "cluster-id").
+		Return("", errors.New("fake")).Times(1)
+
+	_, err := e.Update(ctx, mg)
+	require.Error(t, err)
+	assert.Empty(t, mg.Status.AtProvider.ManifestsSinkRevision)
+}
+
+// clusterInfoCluster returns a managed Cluster and expects the GetCluster
+// and Export calls of one Observe, with the observed cluster reporting
+// Kubernetes status.
+func clusterInfoCluster(mc *mock_akuity_client.MockClient) *v1alpha1.Cluster {
+	mg := fixtures.CrossplaneManagedCluster.DeepCopy()
+	mg.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{"crossplane.io/external-name": fixtures.ClusterName},
+	}
+	observed := proto.Clone(fixtures.ArgocdCluster).(*argocdv1.Cluster)
+	observed.K8SStatus = &argocdv1.ClusterKubernetesStatus{KubernetesVersion: "v1.31.2", ApiResourceCount: 120, ObjectCount: 4500}
+	mc.EXPECT().GetCluster(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(observed, nil).Times(1)
+	mc.EXPECT().ExportInstanceByID(ctx, fixtures.InstanceID).
+		Return(&argocdv1.ExportInstanceResponse{Clusters: []*structpb.Struct{fixtures.ExportedCluster}}, nil).Times(1)
+	return mg
+}
+
+func TestObserve_ClusterInfoRefreshed(t *testing.T) {
+	e, mc := newExtWithoutClusterInfo(t, nil)
+	e.now = fixedClock("2026-10-18T12:00:00Z")
+	mg := clusterInfoCluster(mc)
+	mc.EXPECT().GetClusterInfo(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(&argocdv1.GetInstanceClusterInfoResponse{HasApplications: true}, nil).Times(1)
+
+	_, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	refreshed := metav1.NewTime(e.now())
+	assert.Equal(t, &v1alpha1.ClusterInfo{
+		KubernetesVersion: "v1.31.2",
+		APIResourceCount:  120,
+		ObjectCount:       4500,
+		HasApplications:   ptr.To(true),
+		LastRefreshTime:   &refreshed,
+		LastAttemptTime:   &refreshed,
+	}, mg.Status.AtProvider.ClusterInfo)
+}
+
+func TestObserve_ClusterInfoNotDueSkipsCall(t *testing.T) {
+	e, mc := newExtWithoutClusterInfo(t, nil)
+	e.now = fixedClock("2026-10-18T12:05:00Z")
+	mg := clusterInfoCluster(mc)
+	last := metav1.NewTime(fixedClock("2026-10-18T12:00:00Z")())
+	mg.Status.AtProvider.ClusterInfo = &v1alpha1.ClusterInfo{KubernetesVersion: "v1.30.0", HasApplications: ptr.To(true), LastRefreshTime: &last}
+
+	_, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	info := mg.Status.AtProvider.ClusterInfo
+	require.NotNil(t, info)
+	assert.Equal(t, "v1.31.2", info.KubernetesVersion, "Kubernetes facts come from GetCluster on every poll")
+	assert.Equal(t, ptr.To(true), info.HasApplications)
+	assert.Equal(t, &last, info.LastRefreshTime)
+}
+
+func TestObserve_ClusterInfoErrKeepsPrevious(t *testing.T) {
+	e, mc := newExtWithoutClusterInfo(t, nil)
+	e.now = fixedClock("2026-10-18T12:30:00Z")
+	mg := clusterInfoCluster(mc)
+	last := metav1.NewTime(fixedClock("2026-10-18T12:00:00Z")())
+	mg.Status.AtProvider.ClusterInfo = &v1alpha1.ClusterInfo{HasApplications: ptr.To(false), LastRefreshTime: &last}
+	mc.EXPECT().GetClusterInfo(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(nil, errors.New("fake")).Times(1)
+
+	resp, err := e.Observe(ctx, mg)
+	require.NoError(t, err, "a failed cluster info refresh must not fail Observe")
+	assert.True(t, resp.ResourceExists)
+	assert.Equal(t, ptr.To(false), mg.Status.AtProvider.ClusterInfo.HasApplications)
+	assert.Equal(t, &last, mg.Status.AtProvider.ClusterInfo.LastRefreshTime)
+	attempted := metav1.NewTime(e.now())
+	assert.Equal(t, &attempted, mg.Status.AtProvider.ClusterInfo.LastAttemptTime)
+}
+
+func TestObserve_ClusterInfoErrNotRetriedNextPoll(t *testing.T) {
+	e, mc := newExtWithoutClusterInfo(t, nil)
+	e.now = fixedClock("2026-10-18T12:30:00Z")
+	mg := clusterInfoCluster(mc)
+	mc.EXPECT().GetClusterInfo(ctx, fixtures.InstanceID, fixtures.ClusterName).
+		Return(nil, errors.New("fake")).Times(1)
+
+	_, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+
+	// The next poll is inside clusterInfoRefreshInterval of the failed
+	// attempt, so it does not call GetClusterInfo again.
+	clusterInfoCluster(mc)
+	e.now = fixedClock("2026-10-18T12:31:00Z")
+	_, err = e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.Nil(t, mg.Status.AtProvider.ClusterInfo.LastRefreshTime)
+}
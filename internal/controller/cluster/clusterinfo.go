@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+)
+
+// clusterInfoRefreshInterval is how often Observe calls
+// GetInstanceClusterInfo. The Kubernetes facts in clusterInfo ride on
+// GetCluster, which Observe calls every poll anyway; only the
+// application summary needs the extra call, so it is fetched far less
+// often to keep API traffic flat.
+const clusterInfoRefreshInterval = 10 * time.Minute
+
+// observeClusterInfo carries the application summary from the previous
+// observation into atProvider.clusterInfo, and refreshes it from the
+// platform once clusterInfoRefreshInterval has passed since the last
+// attempt. The summary is informational, so a failed refresh is logged
+// and retried after the same interval instead of failing the
+// observation.
+func (e *external) observeClusterInfo(ctx context.Context, mg *v1alpha1.Cluster, previous *v1alpha1.ClusterInfo) {
+	info := mg.Status.AtProvider.ClusterInfo
+	if info == nil {
+		info = &v1alpha1.ClusterInfo{}
+	}
+	if previous != nil {
+		info.HasApplications = previous.HasApplications
+		info.LastRefreshTime = previous.LastRefreshTime
+		info.LastAttemptTime = previous.LastAttemptTime
+	}
+	last := info.LastAttemptTime
+	if last == nil {
+		last = info.LastRefreshTime
+	}
+	now := e.now()
+	if last == nil || now.Sub(last.Time) >= clusterInfoRefreshInterval {
+		attempted := metav1.NewTime(now)
+		info.LastAttemptTime = &attempted
+		resp, err := e.Client.GetClusterInfo(ctx, mg.Spec.ForProvider.InstanceID, meta.GetExternalName(mg))
+		if err != nil {
+			e.Logger.Debug("Could not refresh cluster info; retrying after the refresh interval", "error", err)
+		} else {
+			info.HasApplications = ptr.To(resp.GetHasApplications())
+			info.LastRefreshTime = &attempted
+		}
+	}
+	if *info == (v1alpha1.ClusterInfo{}) {
+		info = nil
+	}
+	mg.Status.AtProvider.ClusterInfo = info
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+)
+
+// Connection detail keys published for a cluster. Together they let
+// tooling outside the provider install the agent on a cluster the
+// provider cannot reach.
+const (
+	connectionKeyInstallCommand  = "installCommand"
+	connectionKeyAPIServerCAData = "apiServerCAData"
+	connectionKeyManifests       = "manifests"
+)
+
+// connectionDetailsRefreshInterval is how often Observe refetches the
+// cluster's bootstrap material while nothing it depends on has changed.
+// The connection Secret is patched, so keys left out of an observation
+// keep their published values in between.
+const connectionDetailsRefreshInterval = 10 * time.Minute
+
+// connectionDetailsRevision identifies the bootstrap material the
+// connection Secret should hold: the spec generation and targeted agent
+// version shared with the manifests sink, plus whether manifests are
+// published at all.
+func connectionDetailsRevision(mg *v1alpha1.Cluster) string {
+	return fmt.Sprintf("%s:%t", manifestsSinkState(mg).Revision(), publishesManifests(mg))
+}
+
+// publishesManifests reports whether the install manifests belong in
+// the connection Secret: only when neither a kubeconfig target nor a
+// manifests sink is configured, since the controller delivers them
+// itself otherwise, and only after the platform has reconciled the
+// cluster.
+func publishesManifests(mg *v1alpha1.Cluster) bool {
+	reconciled := mg.Status.AtProvider.ReconciliationStatus.Code == int32(reconv1.StatusCode_STATUS_CODE_SUCCESSFUL)
+	return !targetKubeConfig(*mg).HasTarget() && reconciled
+}
+
+// connectionDetails fetches the cluster's bootstrap material. The
+// gateway calls are only made when writeConnectionSecretToRef is set,
+// because the details are otherwise discarded, and once the cluster has
+// an observed ID. They are repeated only when connectionDetailsRevision
+// changes or connectionDetailsRefreshInterval has passed. The material
+// is not needed to observe the cluster, so a failed call is logged, its
+// key is left out, and the fetch is retried on the next poll.
+func (e *external) connectionDetails(ctx context.Context, mg *v1alpha1.Cluster) managed.ConnectionDetails {
+	obs := &mg.Status.AtProvider
+	if mg.GetWriteConnectionSecretToReference() == nil || obs.ID == "" {
+		return nil
+	}
+	now := e.now()
+	revision := connectionDetailsRevision(mg)
+	if obs.ConnectionDetailsRevision == revision && obs.ConnectionDetailsRefreshTime != nil &&
+		now.Sub(obs.ConnectionDetailsRefreshTime.Time) < connectionDetailsRefreshInterval {
+		return nil
+	}
+	fp := mg.Spec.ForProvider
+	details := managed.ConnectionDetails{}
+	complete := true
+
+	if command, err := e.Client.GetClusterInstallCommand(ctx, fp.InstanceID, obs.ID); err != nil {
+		e.Logger.Debug("Could not get cluster install command to publish; retrying next poll", "error", err)
+		complete = false
+	} else {
+		details[connectionKeyInstallCommand] = []byte(command)
+	}
+	if caData, err := e.Client.GetClusterAPIServerCAData(ctx, fp.InstanceID, fp.Name); err != nil {
+		e.Logger.Debug("Could not get cluster API server CA data to publish; retrying next poll", "error", err)
+		complete = false
+	} else {
+		details[connectionKeyAPIServerCAData] = []byte(caData)
+	}
+	if publishesManifests(mg) {
+		if manifests, err := e.Client.GetClusterManifestsOnce(ctx, fp.InstanceID, obs.ID); err != nil {
+			e.Logger.Debug("Could not get cluster manifests to publish; retrying next poll", "error", err)
+			complete = false
+		} else {
+			details[connectionKeyManifests] = []byte(manifests)
+		}
+	}
+
+	if complete {
+		refreshed := metav1.NewTime(now)
+		obs.ConnectionDetailsRevision = revision
+		obs.ConnectionDetailsRefreshTime = &refreshed
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	return details
+}
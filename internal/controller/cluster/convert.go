@@ -61,7 +61,8 @@ func BuildApplyInstanceRequest(instanceID string, cluster v1alpha1.ClusterParame
 
 // APIToSpec rebuilds ClusterParameters from the argocd-plane
 // response. MR-local fields (InstanceRef, KubeConfigSecretRef,
-// EnableInClusterKubeConfig, RemoveAgentResourcesOnDestroy) the
+// EnableInClusterKubeConfig, ManifestsSink,
+// RemoveAgentResourcesOnDestroy, MaintenanceWindows, SupportAccess) the
 // Akuity API does not own are carried from the managed resource.
 func APIToSpec(instanceID string, managedCluster v1alpha1.ClusterParameters, cluster *argocdv1.Cluster) (v1alpha1.ClusterParameters, error) {
 	kustomizationYAML, err := marshal.PBStructToKustomizationYAML(cluster.GetData().GetKustomization())
@@ -130,11 +131,14 @@ func APIToSpec(instanceID string, managedCluster v1alpha1.ClusterParameters, clu
 			},
 		},
 		EnableInClusterKubeConfig: managedCluster.EnableInClusterKubeConfig,
+		ManifestsSink:             managedCluster.ManifestsSink,
 		KubeConfigSecretRef: xpv1.SecretReference{
 			Name:      managedCluster.KubeConfigSecretRef.Name,
 			Namespace: managedCluster.KubeConfigSecretRef.Namespace,
 		},
 		RemoveAgentResourcesOnDestroy: managedCluster.RemoveAgentResourcesOnDestroy,
+		MaintenanceWindows:            managedCluster.MaintenanceWindows,
+		SupportAccess:                 managedCluster.SupportAccess,
 	}, nil
 }
 
@@ -161,11 +165,14 @@ func wireToSpec(instanceID string, managedCluster v1alpha1.ClusterParameters, wi
 			NamespaceScoped: wireCluster.Spec.NamespaceScoped,
 		},
 		EnableInClusterKubeConfig: managedCluster.EnableInClusterKubeConfig,
+		ManifestsSink:             managedCluster.ManifestsSink,
 		KubeConfigSecretRef: xpv1.SecretReference{
 			Name:      managedCluster.KubeConfigSecretRef.Name,
 			Namespace: managedCluster.KubeConfigSecretRef.Namespace,
 		},
 		RemoveAgentResourcesOnDestroy: managedCluster.RemoveAgentResourcesOnDestroy,
+		MaintenanceWindows:            managedCluster.MaintenanceWindows,
+		SupportAccess:                 managedCluster.SupportAccess,
 	}
 	if data := generated.ClusterDataAPIToSpec(&wireCluster.Spec.Data); data != nil {
 		out.ClusterSpec.Data = *data
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+)
+
+// rotationPending reports whether a credential rotation is requested or
+// still has manifests to deliver.
+func rotationPending(mg *v1alpha1.Cluster) bool {
+	return base.CredentialRotationPending(mg, mg.Status.AtProvider.CredentialRotationStatus)
+}
+
+// rotateCredentials rotates the cluster's agent credentials and
+// re-applies the install manifests so the running agent picks them up.
+func (e *external) rotateCredentials(ctx context.Context, mg *v1alpha1.Cluster) error {
+	fp := mg.Spec.ForProvider
+	return e.RotateAgentCredentials(ctx, mg, &mg.Status.AtProvider.CredentialRotationStatus, e.clusterManifests(mg), func(ctx context.Context) error {
+		return e.Client.RotateClusterCredentials(ctx, fp.InstanceID, fp.Name)
+	})
+}
+
+// clusterManifests fetches the cluster's current install manifests for
+// delivery to its kubeconfig target or manifests sink.
+func (e *external) clusterManifests(mg *v1alpha1.Cluster) base.AgentManifests {
+	return base.AgentManifests{
+		Kind:   "cluster",
+		Target: targetKubeConfig(*mg),
+		Fetch: func(ctx context.Context) (string, error) {
+			return e.Client.GetClusterManifestsOnce(ctx, mg.Spec.ForProvider.InstanceID, mg.Status.AtProvider.ID)
+		},
+	}
+}
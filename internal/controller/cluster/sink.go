@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+)
+
+// manifestsSinkState collects what base needs to keep the cluster's
+// manifests sink current.
+func manifestsSinkState(mg *v1alpha1.Cluster) base.ManifestsSinkState {
+	obs := mg.Status.AtProvider
+	return base.ManifestsSinkState{
+		Sink:          mg.Spec.ForProvider.ManifestsSink,
+		Generation:    mg.GetGeneration(),
+		TargetVersion: obs.ClusterSpec.Data.TargetVersion,
+		ID:            obs.ID,
+		Reconciled:    obs.ReconciliationStatus.Code == int32(reconv1.StatusCode_STATUS_CODE_SUCCESSFUL),
+		Written:       obs.ManifestsSinkRevision,
+		Created:       base.ManifestsSinkCreated(mg),
+	}
+}
+
+// syncManifestsSink rewrites the sink with the cluster's current install
+// manifests and records the revision written.
+func (e *external) syncManifestsSink(ctx context.Context, mg *v1alpha1.Cluster) error {
+	revision, err := e.SyncManifestsSink(ctx, e.clusterManifests(mg), manifestsSinkState(mg))
+	if err != nil {
+		return err
+	}
+	mg.Status.AtProvider.ManifestsSinkRevision = revision
+	return nil
+}
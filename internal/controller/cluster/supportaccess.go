@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	xpevent "github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const (
+	reasonSupportAccessGranted xpevent.Reason = "SupportAccessGranted"
+	reasonSupportAccessRevoked xpevent.Reason = "SupportAccessRevoked"
+)
+
+// supportAccessWanted reports whether spec asks for support access at
+// now. An expired grant is treated as a request to revoke.
+func supportAccessWanted(sa *v1alpha1.ClusterSupportAccess, now time.Time) bool {
+	return sa != nil && sa.Enabled && (sa.ExpiresAt == nil || now.Before(sa.ExpiresAt.Time))
+}
+
+// supportAccessGranted reports whether the observed grant is still in
+// effect at now. A grant without an expiry has no lapse time and is
+// tracked by atProvider.supportAccessGranted alone.
+func supportAccessGranted(obs v1alpha1.ClusterObservation, now time.Time) bool {
+	if obs.SupportAccessUntil != nil {
+		return supportAccessActive(obs.SupportAccessUntil, now)
+	}
+	return obs.SupportAccessGranted
+}
+
+// supportAccessActive reports whether a grant lapsing at until is still
+// in effect at now.
+func supportAccessActive(until *metav1.Time, now time.Time) bool {
+	return until != nil && now.Before(until.Time)
+}
+
+// supportAccessPending reports whether the platform's support-access
+// state differs from spec. An unset spec.forProvider.supportAccess has
+// no opinion, and a cluster without an observed ID cannot be addressed
+// yet.
+func supportAccessPending(mg *v1alpha1.Cluster, now time.Time) bool {
+	sa := mg.Spec.ForProvider.SupportAccess
+	if sa == nil || mg.Status.AtProvider.ID == "" {
+		return false
+	}
+	return supportAccessWanted(sa, now) != supportAccessGranted(mg.Status.AtProvider, now)
+}
+
+// observeSupportAccess settles the support-access status after an
+// observation refresh, given the values recorded before it. The
+// platform reports only when a grant lapses: a future time means access
+// is granted, and a lapsed time is cleared so the field is only set
+// while access is in effect. A grant without an expiry reports nothing,
+// so the granted flag recorded by the controller is kept. A timed grant
+// that lapsed on the platform since the last observation is reported as
+// a revoke event: the platform enforces the expiry itself, so the
+// controller may never need to revoke it.
+func (e *external) observeSupportAccess(mg *v1alpha1.Cluster, previous *metav1.Time, previousGranted bool) {
+	obs := &mg.Status.AtProvider
+	obs.SupportAccessGranted = previousGranted
+	switch until := obs.SupportAccessUntil; {
+	case supportAccessActive(until, e.now()):
+		obs.SupportAccessGranted = true
+	case until != nil:
+		obs.SupportAccessUntil = nil
+		obs.SupportAccessGranted = false
+	case previous != nil:
+		obs.SupportAccessGranted = false
+	}
+	if previous != nil && obs.SupportAccessUntil == nil && mg.Spec.ForProvider.SupportAccess != nil {
+		e.Event(mg, xpevent.Normal(reasonSupportAccessRevoked,
+			fmt.Sprintf("Akuity support access granted until %s has ended", previous.UTC().Format(time.RFC3339))))
+	}
+}
+
+// syncSupportAccess grants or revokes support access when the platform
+// disagrees with spec. A grant with an expiry hands the platform the
+// remaining duration so access lapses on time even if the provider is
+// down; the controller still revokes explicitly once the expiry passes.
+func (e *external) syncSupportAccess(ctx context.Context, mg *v1alpha1.Cluster) error {
+	sa := mg.Spec.ForProvider.SupportAccess
+	if sa == nil {
+		return nil
+	}
+	now := e.now()
+	if !supportAccessPending(mg, now) {
+		return nil
+	}
+	fp := mg.Spec.ForProvider
+	if supportAccessWanted(sa, now) {
+		var duration time.Duration
+		msg := "Granted Akuity support access until revoked"
+		if sa.ExpiresAt != nil {
+			duration = sa.ExpiresAt.Sub(now)
+			msg = fmt.Sprintf("Granted Akuity support access until %s", sa.ExpiresAt.UTC().Format(time.RFC3339))
+		}
+		if err := e.Client.UpdateClusterSupportAccess(ctx, fp.InstanceID, mg.Status.AtProvider.ID, true, duration); err != nil {
+			return reason.ClassifyApplyError(err)
+		}
+		mg.Status.AtProvider.SupportAccessUntil = sa.ExpiresAt.DeepCopy()
+		mg.Status.AtProvider.SupportAccessGranted = true
+		e.Event(mg, xpevent.Normal(reasonSupportAccessGranted, msg))
+		return nil
+	}
+	if err := e.Client.UpdateClusterSupportAccess(ctx, fp.InstanceID, mg.Status.AtProvider.ID, false, 0); err != nil {
+		return reason.ClassifyApplyError(err)
+	}
+	msg := "Revoked Akuity support access"
+	if sa.Enabled && sa.ExpiresAt != nil {
+		msg = fmt.Sprintf("Revoked Akuity support access after it expired at %s", sa.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+	mg.Status.AtProvider.SupportAccessUntil = nil
+	mg.Status.AtProvider.SupportAccessGranted = false
+	e.Event(mg, xpevent.Normal(reasonSupportAccessRevoked, msg))
+	return nil
+}
+
+// supportAccessPollInterval shortens pollInterval so the next reconcile
+// lands just after the support-access grant lapses, either on the
+// platform or at spec's expiry.
+func supportAccessPollInterval(mg *v1alpha1.Cluster, now time.Time, pollInterval time.Duration) time.Duration {
+	until := mg.Status.AtProvider.SupportAccessUntil
+	if until == nil {
+		return pollInterval
+	}
+	next := until.Time
+	if sa := mg.Spec.ForProvider.SupportAccess; sa != nil && sa.ExpiresAt != nil && sa.ExpiresAt.Before(until) {
+		next = sa.ExpiresAt.Time
+	}
+	return max(min(pollInterval, next.Sub(now)+time.Second), time.Second)
+}
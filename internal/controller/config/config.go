@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	gwoption "github.com/akuity/api-client-go/pkg/api/gateway/option"
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
 	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
 	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
 	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
@@ -43,6 +44,9 @@ const (
 	DefaultAkuityClientServerURL = "https://akuity.cloud/"
 	CredentialsAPIKeyID          = "apiKeyId"
 	CredentialsAPIKeySecret      = "apiKeySecret"
+	// CredentialsKey is the conventional credentialsSecretRef key under
+	// which the JSON-encoded API key credentials are stored.
+	CredentialsKey = "credentials"
 )
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
@@ -88,7 +92,8 @@ func GetAkuityClientFromProviderConfig(ctx context.Context, kubeClient client.Cl
 	gatewayClient := argocdv1.NewArgoCDServiceGatewayClient(gw)
 	kargoGatewayClient := kargov1.NewKargoServiceGatewayClient(gw)
 	orgGatewayClient := orgcv1.NewOrganizationServiceGatewayClient(gw)
-	akuityClient, err := akuity.NewClient(providerConfig.Spec.OrganizationID, secretData[CredentialsAPIKeyID], secretData[CredentialsAPIKeySecret], gatewayClient, kargoGatewayClient, orgGatewayClient)
+	apiKeyGatewayClient := apikeyv1.NewAPIKeyServiceGatewayClient(gw)
+	akuityClient, err := akuity.NewClient(providerConfig.Spec.OrganizationID, secretData[CredentialsAPIKeyID], secretData[CredentialsAPIKeySecret], gatewayClient, kargoGatewayClient, orgGatewayClient, apiKeyGatewayClient)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create Akuity client: %w", err)
 	}
@@ -96,6 +101,15 @@ func GetAkuityClientFromProviderConfig(ctx context.Context, kubeClient client.Cl
 	return akuityClient, nil
 }
 
+// MarshalCredentials encodes an API key in the JSON shape
+// GetAkuityClientFromProviderConfig reads from the credentials Secret.
+func MarshalCredentials(apiKeyID, apiKeySecret string) ([]byte, error) {
+	return json.Marshal(map[string]string{
+		CredentialsAPIKeyID:     apiKeyID,
+		CredentialsAPIKeySecret: apiKeySecret,
+	})
+}
+
 func getAkuityClientServerURL(serverURL string) string {
 	if serverURL == "" {
 		return DefaultAkuityClientServerURL
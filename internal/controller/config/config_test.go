@@ -108,3 +108,23 @@ func TestGetAkuityClientFromProviderConfig(t *testing.T) {
 	_, err := config.GetAkuityClientFromProviderConfig(context.TODO(), kube, "test-provider-config")
 	require.NoError(t, err)
 }
+
+func TestMarshalCredentials_RoundTripsThroughProviderConfig(t *testing.T) {
+	s := scheme.Scheme
+	apisv1alpha1.SchemeBuilder.AddToScheme(s)
+
+	creds, err := config.MarshalCredentials("generated-key-id", "generated-key-secret")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"`+config.CredentialsAPIKeyID+`": "generated-key-id", "`+config.CredentialsAPIKeySecret+`": "generated-key-secret"}`, string(creds))
+
+	providerConfigCopy := providerConfig.DeepCopy()
+	providerConfigCopy.Spec.CredentialsSecretRef.Key = config.CredentialsKey
+	providerConfigSecretCopy := providerConfigSecret.DeepCopy()
+	providerConfigSecretCopy.Data = map[string][]byte{config.CredentialsKey: creds}
+
+	kube := fake.NewClientBuilder().WithScheme(s).
+		WithRuntimeObjects(providerConfigCopy, providerConfigSecretCopy).Build()
+
+	_, err = config.GetAkuityClientFromProviderConfig(context.TODO(), kube, "test-provider-config")
+	require.NoError(t, err)
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package customrole is the CustomRole controller. It owns an Akuity
+// organization custom role through the Organization gateway's
+// Create/Update/DeleteCustomRole endpoints. The platform-assigned role
+// ID is the external-name; Team resolves spec.forProvider.customRoleRefs
+// through status.atProvider.id.
+package customrole
+
+import (
+	"context"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+//
+// The external-name is the platform-assigned role ID, so the default
+// NameAsExternalName initializer is disabled.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.CustomRoleGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.CustomRole]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.CustomRole] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CustomRoleGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.CustomRole](conn),
+		managed.WithInitializers(),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.CustomRole{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.CustomRole) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		// A CreateCustomRole rejected on bad input (malformed policy,
+		// duplicate name) never stamps the external-name; suppress the
+		// retry loop until the spec changes.
+		if obs, err, ok := e.suppressTerminalWrite(mg); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	role, err := e.Client.GetCustomRole(ctx, id)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	mg.Status.AtProvider = customRoleObservation(role)
+	base.SetHealthCondition(mg, true)
+
+	desired := mg.Spec.ForProvider
+	observed := customRoleParameters(role)
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "CustomRole")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.CustomRole,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.CustomRole) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	key, err := customRoleTerminalWriteKey(mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	fp := mg.Spec.ForProvider
+	role, err := e.Client.CreateCustomRole(ctx, fp.Name, fp.Description, fp.Policy)
+	if err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider = customRoleObservation(role)
+	meta.SetExternalName(mg, role.GetId())
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.CustomRole) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	key, err := customRoleTerminalWriteKey(mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	fp := mg.Spec.ForProvider
+	role, err := e.Client.UpdateCustomRole(ctx, meta.GetExternalName(mg), fp.Name, fp.Description, fp.Policy)
+	if err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider = customRoleObservation(role)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.CustomRole) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.CustomRoleGroupVersionKind)
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	if err := e.Client.DeleteCustomRole(ctx, id); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.CustomRole) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := customRoleTerminalWriteKey(mg)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.CustomRole) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.CustomRoleGroupVersionKind) {
+		return
+	}
+	key, err := customRoleTerminalWriteKey(mg)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func customRoleTerminalWriteKey(mg *v1alpha1.CustomRole) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.CustomRoleGroupVersionKind, meta.GetExternalName(mg), mg.Spec.ForProvider)
+}
+
+// driftSpec is the resource's drift-detection recipe. Name,
+// description, and policy are fully owned by the MR, so edits made in
+// the Akuity UI are reverted on the next Update. The policy is a
+// permission list, so both sides are canonicalized before comparison
+// and reordering or reformatting rules does not trigger an update.
+func driftSpec() base.DriftSpec[v1alpha1.CustomRoleParameters] {
+	return base.DriftSpec[v1alpha1.CustomRoleParameters]{
+		Normalize: func(desired, observed *v1alpha1.CustomRoleParameters) {
+			desired.Policy = base.CanonicalCustomRolePolicy(desired.Policy)
+			observed.Policy = base.CanonicalCustomRolePolicy(observed.Policy)
+		},
+	}
+}
+
+func customRoleParameters(role *orgcv1.CustomRole) v1alpha1.CustomRoleParameters {
+	return v1alpha1.CustomRoleParameters{
+		Name:        role.GetName(),
+		Description: role.GetDescription(),
+		Policy:      role.GetPolicy(),
+	}
+}
+
+func customRoleObservation(role *orgcv1.CustomRole) v1alpha1.CustomRoleObservation {
+	return v1alpha1.CustomRoleObservation{
+		ID:          role.GetId(),
+		Name:        role.GetName(),
+		Description: role.GetDescription(),
+		Policy:      role.GetPolicy(),
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customrole
+
+import (
+	"context"
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const policy = "instances/get\ninstances/update\n"
+
+func newRole() *v1alpha1.CustomRole {
+	return &v1alpha1.CustomRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployer", UID: "role-uid"},
+		Spec: v1alpha1.CustomRoleSpec{
+			ForProvider: v1alpha1.CustomRoleParameters{
+				Name:        "deployer",
+				Description: "deploys apps",
+				Policy:      policy,
+			},
+		},
+	}
+}
+
+func newExt(t *testing.T) (*external, *mockclient.MockClient) {
+	t.Helper()
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func role(policy string) *orgcv1.CustomRole {
+	return &orgcv1.CustomRole{Id: "role-1", Name: "deployer", Description: "deploys apps", Policy: policy}
+}
+
+func TestObserve_NoExternalName(t *testing.T) {
+	e, _ := newExt(t)
+	obs, err := e.Observe(context.Background(), newRole())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_UpToDateIgnoresRuleOrderAndWhitespace(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newRole()
+	meta.SetExternalName(mg, "role-1")
+
+	mc.EXPECT().GetCustomRole(gomock.Any(), "role-1").Return(role("  instances/update\n\ninstances/get"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, "role-1", mg.Status.AtProvider.ID)
+}
+
+func TestObserve_PermissionRemovedOutOfBandDrifts(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newRole()
+	meta.SetExternalName(mg, "role-1")
+
+	mc.EXPECT().GetCustomRole(gomock.Any(), "role-1").Return(role("instances/get"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_DeletedOutOfBand(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newRole()
+	meta.SetExternalName(mg, "role-1")
+
+	mc.EXPECT().GetCustomRole(gomock.Any(), "role-1").Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestCreate_StampsRoleID(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newRole()
+
+	mc.EXPECT().CreateCustomRole(gomock.Any(), "deployer", "deploys apps", policy).Return(role(policy), nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "role-1", meta.GetExternalName(mg))
+	assert.Equal(t, "role-1", mg.Status.AtProvider.ID)
+}
+
+func TestCreate_InvalidArgument_SuppressedOnObserve(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newRole()
+
+	mc.EXPECT().CreateCustomRole(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.InvalidArgument, "invalid policy")).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.Error(t, err)
+
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+}
+
+func TestUpdate_SendsFullRole(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newRole()
+	meta.SetExternalName(mg, "role-1")
+
+	mc.EXPECT().UpdateCustomRole(gomock.Any(), "role-1", "deployer", "deploys apps", policy).Return(role(policy), nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+}
+
+func TestDelete_IgnoresNotFound(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newRole()
+	meta.SetExternalName(mg, "role-1")
+
+	mc.EXPECT().DeleteCustomRole(gomock.Any(), "role-1").Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
@@ -44,6 +44,7 @@ import (
 	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
 	"github.com/akuityio/provider-crossplane-akuity/internal/event"
 	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
 	crossplanetypes "github.com/akuityio/provider-crossplane-akuity/internal/types/generated/crossplane/v1alpha1"
 	"github.com/akuityio/provider-crossplane-akuity/internal/types/observation"
 	utilcmp "github.com/akuityio/provider-crossplane-akuity/internal/utils/cmp"
@@ -52,6 +53,19 @@ import (
 
 const errTransformInstance = "cannot transform Crossplane instance to Akuity API instance"
 
+// Connection detail keys published for an instance.
+const (
+	connectionKeyID                = "id"
+	connectionKeyHostname          = "hostname"
+	connectionKeyURL               = "url"
+	connectionKeyGRPCURL           = "grpcUrl"
+	connectionKeyAdminPasswordHash = "adminPasswordHash"
+)
+
+// argocdSecretAdminPasswordKey is the argocd-secret key holding the
+// bcrypt hash of the admin password.
+const argocdSecretAdminPasswordKey = "admin.password"
+
 // Setup adds a controller that reconciles Instance managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.InstanceGroupKind)
@@ -158,10 +172,15 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.Instance) (managed.
 	// masked/nil) and therefore carries no SecretHash. Assigning the
 	// whole struct would clobber the controller-managed hash every poll
 	// and re-trigger Apply on every reconcile.
-	// Preserve across the assignment.
+	// Preserve across the assignment, along with the cached workspace
+	// resolution and preflight result the gateway knows nothing about.
 	preservedSecretHash := mg.Status.AtProvider.SecretHash
+	preservedResolvedWorkspace := mg.Status.AtProvider.ResolvedWorkspace
+	preservedPreflightFeaturesHash := mg.Status.AtProvider.PreflightFeaturesHash
 	mg.Status.AtProvider = instanceObservation
 	mg.Status.AtProvider.SecretHash = preservedSecretHash
+	mg.Status.AtProvider.ResolvedWorkspace = preservedResolvedWorkspace
+	mg.Status.AtProvider.PreflightFeaturesHash = preservedPreflightFeaturesHash
 	base.SetHealthCondition(mg, instanceObservation.HealthStatus.Code == 1)
 
 	// DeepCopy so Normalize's map mutations (ArgoCDConfigMap rewrites,
@@ -175,6 +194,19 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.Instance) (managed.
 		return managed.ExternalObservation{}, err
 	}
 
+	// Workspace is normalized out of the struct compare because the
+	// gateway reports the canonical ID while spec may carry a name. A
+	// spec workspace that resolves elsewhere is a pending move, which
+	// Update performs before re-applying.
+	if isUpToDate {
+		moving, merr := e.workspaceMovePending(ctx, mg)
+		if merr != nil {
+			mg.SetConditions(xpv1.ReconcileError(merr))
+			return managed.ExternalObservation{}, merr
+		}
+		isUpToDate = !moving
+	}
+
 	// Secret rotation drift: SecretRef fields are ignored by the struct
 	// compare (drift spec IgnoreFields) because the Export response
 	// returns the Secret data masked/nil. When the rest of the struct
@@ -183,12 +215,14 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.Instance) (managed.
 	// rotated one of the Secrets; we flip UpToDate=false so the reconciler
 	// fires Update, which re-resolves, re-Applies with the new payload,
 	// and refreshes SecretHash.
+	var argocdSecret *secrets.ResolvedSecret
 	if isUpToDate {
 		sec, serr := resolveInstanceSecrets(ctx, e.Kube, mg)
 		if serr != nil {
 			mg.SetConditions(xpv1.ReconcileError(serr))
 			return managed.ExternalObservation{}, serr
 		}
+		argocdSecret = &sec.Argocd
 		if sec.Hash() != mg.Status.AtProvider.SecretHash {
 			e.Logger.Debug("Instance secret hash changed; forcing re-Apply",
 				"previous", mg.Status.AtProvider.SecretHash, "current", sec.Hash())
@@ -229,8 +263,9 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.Instance) (managed.
 	}
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: isUpToDate,
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate,
+		ConnectionDetails: e.connectionDetails(ctx, mg, argocdSecret),
 	}, nil
 }
 
@@ -241,22 +276,32 @@ func (e *external) Create(ctx context.Context, mg *v1alpha1.Instance) (managed.E
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
-	key, err := instanceTerminalWriteKey(mg, sec)
+	target, err := e.withWorkspaceRef(ctx, mg)
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
-	request, err := BuildApplyInstanceRequest(*mg, sec)
+	key, err := instanceTerminalWriteKey(target, sec)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	request, err := BuildApplyInstanceRequest(*target, sec)
 	if err != nil {
 		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(fmt.Errorf("%s: %w", errTransformInstance, err)))
 	}
+	if err := e.preflight(ctx, mg, true); err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, err)
+	}
 
 	if err := e.Client.ApplyInstance(ctx, request); err != nil {
+		// The plan may have lost a feature since it was last checked.
+		// Check it again before the next apply.
+		mg.Status.AtProvider.PreflightFeaturesHash = ""
 		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
 	}
 	e.ClearTerminalWrite(key)
 	mg.Status.AtProvider.SecretHash = sec.Hash()
 	meta.SetExternalName(mg, request.GetId())
-	return managed.ExternalCreation{}, nil
+	return managed.ExternalCreation{ConnectionDetails: e.connectionDetails(ctx, mg, &sec.Argocd)}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg *v1alpha1.Instance) (managed.ExternalUpdate, error) {
@@ -266,15 +311,26 @@ func (e *external) Update(ctx context.Context, mg *v1alpha1.Instance) (managed.E
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
-	key, err := instanceTerminalWriteKey(mg, sec)
+	target, err := e.withWorkspaceRef(ctx, mg)
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
-	request, err := BuildApplyInstanceRequest(*mg, sec)
+	key, err := instanceTerminalWriteKey(target, sec)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	request, err := BuildApplyInstanceRequest(*target, sec)
 	if err != nil {
 		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(fmt.Errorf("%s: %w", errTransformInstance, err)))
 	}
+	if err := e.preflight(ctx, mg, false); err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, err)
+	}
+	if err := e.moveWorkspace(ctx, mg, target.Spec.ForProvider.Workspace); err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
 	if err := e.Client.ApplyInstance(ctx, request); err != nil {
+		mg.Status.AtProvider.PreflightFeaturesHash = ""
 		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
 	}
 	e.ClearTerminalWrite(key)
@@ -296,6 +352,51 @@ func (e *external) Delete(ctx context.Context, mg *v1alpha1.Instance) (managed.E
 
 func (e *external) Disconnect(_ context.Context) error { return nil }
 
+// connectionDetails publishes the instance's identifiers and endpoints.
+// The Argo CD API and UI share the instance hostname, so url and
+// grpcUrl differ only in form: url for browsers and HTTP clients,
+// grpcUrl as the host:port the argocd CLI and gRPC clients dial. The
+// admin password hash is included only when publishAdminPassword is set
+// and argocdSecretRef carries admin.password; Argo CD stores it as a
+// bcrypt hash, not a usable password. Keys whose value is not known
+// yet, such as the ID and hostname before the first Observe, are left
+// out.
+//
+// argocdSecret is the argocdSecretRef Secret when the caller has already
+// resolved it; otherwise it is resolved here. A failed lookup only
+// omits the hash: the connection Secret is patched, so the previously
+// published value is kept, and observation is not blocked.
+func (e *external) connectionDetails(ctx context.Context, mg *v1alpha1.Instance, argocdSecret *secrets.ResolvedSecret) managed.ConnectionDetails {
+	publishHash := mg.Spec.ForProvider.PublishAdminPassword
+	if publishHash && argocdSecret == nil {
+		sec, err := secrets.Resolve(ctx, e.Kube, mg.Spec.ForProvider.ArgoCDSecretRef)
+		if err != nil {
+			e.Logger.Debug("Could not resolve argocdSecretRef for connection details; omitting adminPasswordHash", "error", err)
+		} else {
+			argocdSecret = &sec
+		}
+	}
+	obs := mg.Status.AtProvider
+	details := managed.ConnectionDetails{}
+	if obs.ID != "" {
+		details[connectionKeyID] = []byte(obs.ID)
+	}
+	if obs.Hostname != "" {
+		details[connectionKeyHostname] = []byte(obs.Hostname)
+		details[connectionKeyURL] = []byte("https://" + obs.Hostname)
+		details[connectionKeyGRPCURL] = []byte(obs.Hostname + ":443")
+	}
+	if publishHash && argocdSecret != nil {
+		if hash := argocdSecret.Data[argocdSecretAdminPasswordKey]; hash != "" {
+			details[connectionKeyAdminPasswordHash] = []byte(hash)
+		}
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	return details
+}
+
 func (e *external) suppressTerminalWrite(ctx context.Context, mg *v1alpha1.Instance) (managed.ExternalObservation, error, bool) {
 	if e.TerminalWrites == nil {
 		return managed.ExternalObservation{}, nil, false
@@ -304,7 +405,11 @@ func (e *external) suppressTerminalWrite(ctx context.Context, mg *v1alpha1.Insta
 	if err != nil {
 		return e.SkipTerminalWriteGuard(err)
 	}
-	key, err := instanceTerminalWriteKey(mg, sec)
+	target, err := e.withWorkspaceRef(ctx, mg)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	key, err := instanceTerminalWriteKey(target, sec)
 	if err != nil {
 		return e.SkipTerminalWriteGuard(err)
 	}
@@ -328,7 +433,12 @@ func (e *external) clearTerminalWrite(ctx context.Context, mg *v1alpha1.Instance
 		e.LogTerminalWriteGuardSkipped(err)
 		return
 	}
-	key, err := instanceTerminalWriteKey(mg, sec)
+	target, err := e.withWorkspaceRef(ctx, mg)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	key, err := instanceTerminalWriteKey(target, sec)
 	if err != nil {
 		e.LogTerminalWriteGuardSkipped(err)
 		return
@@ -336,6 +446,60 @@ func (e *external) clearTerminalWrite(ctx context.Context, mg *v1alpha1.Instance
 	e.ClearTerminalWrite(key)
 }
 
+// withWorkspaceRef returns mg with spec.forProvider.workspace set to the
+// canonical ID of the Workspace MR named by spec.forProvider.workspaceRef.
+// Without a workspaceRef mg is returned as-is; otherwise the ID lands on
+// a deep copy so the resolved routing value is never persisted onto the
+// user's spec by the reconciler's post-Create update.
+func (e *external) withWorkspaceRef(ctx context.Context, mg *v1alpha1.Instance) (*v1alpha1.Instance, error) {
+	if mg.Spec.ForProvider.WorkspaceRef == nil {
+		return mg, nil
+	}
+	id, err := base.ResolveWorkspaceRef(ctx, e.Kube, mg.GetNamespace(), mg.Spec.ForProvider.WorkspaceRef)
+	if err != nil {
+		return nil, err
+	}
+	out := mg.DeepCopy()
+	out.Spec.ForProvider.Workspace = id
+	return out, nil
+}
+
+// workspaceMovePending reports whether spec.forProvider.workspace (or
+// workspaceRef) names a different workspace than the one the instance
+// was last observed in. A workspace name is resolved once per spec
+// value and cached on status.atProvider.resolvedWorkspace.
+func (e *external) workspaceMovePending(ctx context.Context, mg *v1alpha1.Instance) (bool, error) {
+	target, err := e.withWorkspaceRef(ctx, mg)
+	if err != nil {
+		return false, err
+	}
+	return e.workspaceMovePendingFor(ctx, mg, target.Spec.ForProvider.Workspace)
+}
+
+func (e *external) workspaceMovePendingFor(ctx context.Context, mg *v1alpha1.Instance, workspace string) (bool, error) {
+	pending, resolved, err := base.WorkspaceMovePending(ctx, e.Client, workspace, mg.Status.AtProvider.Workspace, mg.Status.AtProvider.ResolvedWorkspace)
+	mg.Status.AtProvider.ResolvedWorkspace = resolved
+	return pending, err
+}
+
+// moveWorkspace moves the instance into workspace when it was last
+// observed elsewhere. ApplyInstance routes by the requested workspace,
+// so the move must land before the apply. Clusters need no follow-up:
+// they look up the parent instance's workspace on every reconcile, so
+// their next reconcile routes to the new one.
+func (e *external) moveWorkspace(ctx context.Context, mg *v1alpha1.Instance, workspace string) error {
+	pending, err := e.workspaceMovePendingFor(ctx, mg, workspace)
+	if err != nil || !pending {
+		return err
+	}
+	if err := e.Client.MoveInstanceWorkspace(ctx, mg.Status.AtProvider.ID, workspace); err != nil {
+		return err
+	}
+	e.Logger.Debug("Moved Instance to new workspace",
+		"from", mg.Status.AtProvider.Workspace, "to", workspace)
+	return nil
+}
+
 func lateInitializeInstance(in *v1alpha1.InstanceParameters, instance *argocdv1.Instance, exportedInstance *argocdv1.ExportInstanceResponse) error {
 	in.ArgoCD.Spec.InstanceSpec.Subdomain = pointer.LateInitialize(in.ArgoCD.Spec.InstanceSpec.Subdomain, instance.GetSpec().GetSubdomain())
 	in.ArgoCD.Spec.InstanceSpec.DeclarativeManagementEnabled = pointer.LateInitialize(in.ArgoCD.Spec.InstanceSpec.DeclarativeManagementEnabled, ptr.To(instance.GetSpec().GetDeclarativeManagementEnabled()))
@@ -421,6 +585,7 @@ func normalizeInstanceParameters(managedInstance, actualInstance *v1alpha1.Insta
 	// while spec may be empty, an ID, or a name; keep the drift comparison
 	// neutral and let the client resolve it for workspace-scoped calls.
 	actualInstance.Workspace = managedInstance.Workspace
+	actualInstance.WorkspaceRef = managedInstance.WorkspaceRef
 
 	if managedInstance.ArgoCD != nil {
 		// The platform may default MultiClusterK8SDashboardEnabled
@@ -619,11 +784,15 @@ var ignoredArgocdCMKeys = []string{
 // compare would flag desired=[...] vs observed=nil forever. The
 // argocdResourcesUpToDate side-check on the Export response replaces
 // the struct-level comparison for these.
+//
+// PublishAdminPassword only affects the connection details and is
+// never sent to the platform, so it is ignored as well.
 func driftSpec() base.DriftSpec[v1alpha1.InstanceParameters] {
 	return base.DriftSpec[v1alpha1.InstanceParameters]{
 		Ignore: []cmp.Option{
 			cmpopts.IgnoreFields(v1alpha1.InstanceParameters{},
 				"ArgoCDSecretRef",
+				"PublishAdminPassword",
 				"ArgoCDNotificationsSecretRef",
 				"ArgoCDImageUpdaterSecretRef",
 				"ApplicationSetSecretRef",
@@ -29,10 +29,16 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	featuresv1 "github.com/akuity/api-client-go/pkg/api/gen/types/features/v1"
 	health "github.com/akuity/api-client-go/pkg/api/gen/types/status/health/v1"
 
 	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
@@ -48,12 +54,21 @@ var ctx = context.TODO()
 func newExt(t *testing.T) (*external, *mock_akuity_client.MockClient) {
 	t.Helper()
 	mc := mock_akuity_client.NewMockClient(gomock.NewController(t))
+	allowPreflight(mc)
 	return &external{ExternalClient: base.ExternalClient{
 		Client: mc,
 		Logger: logging.NewNopLogger(),
 	}}, mc
 }
 
+// allowPreflight lets the organization plan lookups made before every
+// write succeed with an unrestricted plan.
+func allowPreflight(mc *mock_akuity_client.MockClient) {
+	mc.EXPECT().ListArgocdInstancesQuota(gomock.Any()).Return(nil, nil).AnyTimes()
+	mc.EXPECT().GetOrganizationQuota(gomock.Any()).Return(&featuresv1.OrganizationQuota{}, nil).AnyTimes()
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(&featuresv1.FeatureStatuses{}, nil).AnyTimes()
+}
+
 func TestCreate(t *testing.T) {
 	applyInstanceRequest, err := BuildApplyInstanceRequest(fixtures.CrossplaneManagedInstance, resolvedInstanceSecrets{})
 	require.NoError(t, err)
@@ -63,7 +78,7 @@ func TestCreate(t *testing.T) {
 	mc.EXPECT().ApplyInstance(ctx, applyInstanceRequest).
 		Return(nil).Times(1)
 
-	resp, err := e.Create(ctx, &fixtures.CrossplaneManagedInstance)
+	resp, err := e.Create(ctx, fixtures.CrossplaneManagedInstance.DeepCopy())
 	require.NoError(t, err)
 	assert.Equal(t, managed.ExternalCreation{}, resp)
 }
@@ -77,11 +92,53 @@ func TestCreate_ClientErr(t *testing.T) {
 	mc.EXPECT().ApplyInstance(ctx, applyInstanceRequest).
 		Return(errors.New("fake")).Times(1)
 
-	resp, err := e.Create(ctx, &fixtures.CrossplaneManagedInstance)
+	resp, err := e.Create(ctx, fixtures.CrossplaneManagedInstance.DeepCopy())
 	require.Error(t, err)
 	assert.Equal(t, managed.ExternalCreation{}, resp)
 }
 
+func TestCreate_WorkspaceRefRoutesToObservedID(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	ws := &v1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "team-ws"}}
+	ws.Status.AtProvider.ID = "ws-ref-id"
+
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().WithScheme(scheme).WithObjects(ws).Build()
+
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+	mg.Spec.ForProvider.WorkspaceRef = &v1alpha1.LocalReference{Name: "team-ws"}
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *argocdv1.ApplyInstanceRequest) error {
+			assert.Equal(t, "ws-ref-id", req.GetWorkspaceId())
+			return nil
+		}).Times(1)
+
+	_, err := e.Create(ctx, mg)
+	require.NoError(t, err)
+	assert.Equal(t, fixtures.CrossplaneManagedInstance.Spec.ForProvider.Workspace, mg.Spec.ForProvider.Workspace,
+		"resolved workspace ID must not be written back into spec")
+}
+
+func TestCreate_WorkspaceRefUnobservedBlocksApply(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	ws := &v1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "team-ws"}}
+
+	e, _ := newExt(t)
+	e.Kube = fake.NewClientBuilder().WithScheme(scheme).WithObjects(ws).Build()
+
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+	mg.Spec.ForProvider.WorkspaceRef = &v1alpha1.LocalReference{Name: "team-ws"}
+
+	// No ApplyInstance expectation: routing to the default workspace
+	// while the referenced Workspace is still unobserved would create
+	// the instance in the wrong place.
+	_, err := e.Create(ctx, mg)
+	require.Error(t, err)
+}
+
 func TestUpdate(t *testing.T) {
 	applyInstanceRequest, err := BuildApplyInstanceRequest(fixtures.CrossplaneManagedInstance, resolvedInstanceSecrets{})
 	require.NoError(t, err)
@@ -91,7 +148,7 @@ func TestUpdate(t *testing.T) {
 	mc.EXPECT().ApplyInstance(ctx, applyInstanceRequest).
 		Return(nil).Times(1)
 
-	resp, err := e.Update(ctx, &fixtures.CrossplaneManagedInstance)
+	resp, err := e.Update(ctx, fixtures.CrossplaneManagedInstance.DeepCopy())
 	require.NoError(t, err)
 	assert.Equal(t, managed.ExternalUpdate{}, resp)
 }
@@ -105,7 +162,7 @@ func TestUpdate_ClientErr(t *testing.T) {
 	mc.EXPECT().ApplyInstance(ctx, applyInstanceRequest).
 		Return(errors.New("fake")).Times(1)
 
-	resp, err := e.Update(ctx, &fixtures.CrossplaneManagedInstance)
+	resp, err := e.Update(ctx, fixtures.CrossplaneManagedInstance.DeepCopy())
 	require.Error(t, err)
 	assert.Equal(t, managed.ExternalUpdate{}, resp)
 }
@@ -123,7 +180,7 @@ func TestUpdate_InvalidArgument_Terminal(t *testing.T) {
 	mc.EXPECT().ApplyInstance(ctx, applyInstanceRequest).
 		Return(grpcstatus.Error(codes.InvalidArgument, "reserved key admin.password")).
 		Times(1)
-	_, err = e.Update(ctx, &fixtures.CrossplaneManagedInstance)
+	_, err = e.Update(ctx, fixtures.CrossplaneManagedInstance.DeepCopy())
 	require.Error(t, err)
 	assert.True(t, reason.IsTerminal(err),
 		"InvalidArgument from ApplyInstance must be reason.Terminal-classified, got %T %v", err, err)
@@ -211,7 +268,7 @@ func TestCreate_InvalidArgument_Terminal(t *testing.T) {
 	mc.EXPECT().ApplyInstance(ctx, applyInstanceRequest).
 		Return(grpcstatus.Error(codes.InvalidArgument, "admin.password not in bcrypt format")).
 		Times(1)
-	_, err = e.Create(ctx, &fixtures.CrossplaneManagedInstance)
+	_, err = e.Create(ctx, fixtures.CrossplaneManagedInstance.DeepCopy())
 	require.Error(t, err)
 	assert.True(t, reason.IsTerminal(err),
 		"InvalidArgument from ApplyInstance must be reason.Terminal-classified, got %T %v", err, err)
@@ -227,7 +284,7 @@ func TestUpdate_ProvisioningWait_NotTerminal(t *testing.T) {
 	mc.EXPECT().ApplyInstance(ctx, applyInstanceRequest).
 		Return(grpcstatus.Error(codes.InvalidArgument, "instance still being provisioned")).
 		Times(1)
-	_, err = e.Update(ctx, &fixtures.CrossplaneManagedInstance)
+	_, err = e.Update(ctx, fixtures.CrossplaneManagedInstance.DeepCopy())
 	require.Error(t, err)
 	assert.False(t, reason.IsTerminal(err))
 	assert.True(t, reason.IsRetryable(err))
@@ -716,7 +773,7 @@ func TestObserve_InstanceUpToDate(t *testing.T) {
 func TestObserve_InstanceNotUpToDate(t *testing.T) {
 	e, mc := newExt(t)
 
-	managedInstance := fixtures.CrossplaneManagedInstance
+	managedInstance := *fixtures.CrossplaneManagedInstance.DeepCopy()
 	managedInstance.ObjectMeta = metav1.ObjectMeta{
 		Annotations: map[string]string{
 			"crossplane.io/external-name": fixtures.InstanceName,
@@ -734,3 +791,237 @@ func TestObserve_InstanceNotUpToDate(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, resp)
 }
+
+func TestObserve_WorkspaceChangeIsDrift(t *testing.T) {
+	e, mc := newExt(t)
+
+	managedInstance := *fixtures.CrossplaneManagedInstance.DeepCopy()
+	managedInstance.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{
+			"crossplane.io/external-name": fixtures.InstanceName,
+		},
+	}
+	managedInstance.Spec.ForProvider.Workspace = "team"
+
+	observed := proto.Clone(fixtures.AkuityInstance).(*argocdv1.Instance)
+	observed.WorkspaceId = "ws-old"
+	mc.EXPECT().GetInstance(ctx, fixtures.InstanceName).
+		Return(observed, nil).Times(1)
+	mc.EXPECT().ExportInstance(ctx, fixtures.InstanceName).
+		Return(&argocdv1.ExportInstanceResponse{}, nil).Times(1)
+	mc.EXPECT().ResolveWorkspace(ctx, "team").
+		Return(&orgcv1.Workspace{Id: "ws-new", Name: "team"}, nil).Times(1)
+
+	resp, err := e.Observe(ctx, &managedInstance)
+	require.NoError(t, err)
+	assert.Equal(t, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, resp)
+}
+
+func TestObserve_WorkspaceNameResolvedOncePerSpecValue(t *testing.T) {
+	e, mc := newExt(t)
+
+	managedInstance := *fixtures.CrossplaneManagedInstance.DeepCopy()
+	managedInstance.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{
+			"crossplane.io/external-name": fixtures.InstanceName,
+		},
+	}
+	managedInstance.Spec.ForProvider.Workspace = "team"
+
+	observed := proto.Clone(fixtures.AkuityInstance).(*argocdv1.Instance)
+	observed.WorkspaceId = "ws-team"
+	mc.EXPECT().GetInstance(ctx, fixtures.InstanceName).
+		Return(observed, nil).Times(2)
+	mc.EXPECT().ExportInstance(ctx, fixtures.InstanceName).
+		Return(&argocdv1.ExportInstanceResponse{}, nil).Times(2)
+	mc.EXPECT().ResolveWorkspace(ctx, "team").
+		Return(&orgcv1.Workspace{Id: "ws-team", Name: "team"}, nil).Times(1)
+
+	for range 2 {
+		resp, err := e.Observe(ctx, &managedInstance)
+		require.NoError(t, err)
+		assert.True(t, resp.ResourceUpToDate)
+	}
+	assert.Equal(t, &v1alpha1.WorkspaceResolution{Workspace: "team", ID: "ws-team"}, managedInstance.Status.AtProvider.ResolvedWorkspace)
+}
+
+func TestObserve_PreservesPreflightFeaturesHash(t *testing.T) {
+	e, mc := newExt(t)
+
+	managedInstance := *fixtures.CrossplaneManagedInstance.DeepCopy()
+	managedInstance.ObjectMeta = metav1.ObjectMeta{
+		Annotations: map[string]string{
+			"crossplane.io/external-name": fixtures.InstanceName,
+		},
+	}
+	managedInstance.Status.AtProvider.PreflightFeaturesHash = "checked"
+
+	mc.EXPECT().GetInstance(ctx, fixtures.InstanceName).
+		Return(fixtures.AkuityInstance, nil).Times(1)
+	mc.EXPECT().ExportInstance(ctx, fixtures.InstanceName).
+		Return(&argocdv1.ExportInstanceResponse{}, nil).Times(1)
+
+	_, err := e.Observe(ctx, &managedInstance)
+	require.NoError(t, err)
+	assert.Equal(t, "checked", managedInstance.Status.AtProvider.PreflightFeaturesHash)
+}
+
+func TestUpdate_MovesWorkspaceBeforeApply(t *testing.T) {
+	e, mc := newExt(t)
+
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+	mg.Spec.ForProvider.Workspace = "ws-new"
+	mg.Status.AtProvider.ID = "instance-id"
+	mg.Status.AtProvider.Workspace = "ws-old"
+
+	mc.EXPECT().ResolveWorkspace(ctx, "ws-new").
+		Return(&orgcv1.Workspace{Id: "ws-new"}, nil).Times(1)
+	gomock.InOrder(
+		mc.EXPECT().MoveInstanceWorkspace(ctx, "instance-id", "ws-new").Return(nil).Times(1),
+		mc.EXPECT().ApplyInstance(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *argocdv1.ApplyInstanceRequest) error {
+				assert.Equal(t, "ws-new", req.GetWorkspaceId())
+				return nil
+			}).Times(1),
+	)
+
+	_, err := e.Update(ctx, mg)
+	require.NoError(t, err)
+}
+
+func TestUpdate_WorkspaceMoveErrSkipsApply(t *testing.T) {
+	e, mc := newExt(t)
+
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+	mg.Spec.ForProvider.Workspace = "ws-new"
+	mg.Status.AtProvider.ID = "instance-id"
+	mg.Status.AtProvider.Workspace = "ws-old"
+
+	mc.EXPECT().ResolveWorkspace(ctx, "ws-new").
+		Return(&orgcv1.Workspace{Id: "ws-new"}, nil).Times(1)
+	mc.EXPECT().MoveInstanceWorkspace(ctx, "instance-id", "ws-new").
+		Return(errors.New("fake")).Times(1)
+
+	_, err := e.Update(ctx, mg)
+	require.Error(t, err)
+}
+
+func TestObserve_PublishesConnectionDetails(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "argocd-secret"},
+		Data:       map[string][]byte{"admin.password": []byte("$2a$10$hash")},
+	}).Build()
+
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+	mg.SetAnnotations(map[string]string{"crossplane.io/external-name": fixtures.InstanceName})
+	mg.Spec.ForProvider.ArgoCDSecretRef = &xpv1.SecretReference{Namespace: "crossplane-system", Name: "argocd-secret"}
+	mg.Spec.ForProvider.PublishAdminPassword = true
+
+	observed := proto.Clone(fixtures.AkuityInstance).(*argocdv1.Instance)
+	observed.Id = "instance-id"
+	observed.Hostname = "abc123.cd.akuity.cloud"
+	mc.EXPECT().GetInstance(ctx, fixtures.InstanceName).Return(observed, nil).Times(1)
+	mc.EXPECT().ExportInstance(ctx, fixtures.InstanceName).
+		Return(&argocdv1.ExportInstanceResponse{}, nil).Times(1)
+
+	resp, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.Equal(t, managed.ConnectionDetails{
+		connectionKeyID:                []byte("instance-id"),
+		connectionKeyHostname:          []byte("abc123.cd.akuity.cloud"),
+		connectionKeyURL:               []byte("https://abc123.cd.akuity.cloud"),
+		connectionKeyGRPCURL:           []byte("abc123.cd.akuity.cloud:443"),
+		connectionKeyAdminPasswordHash: []byte("$2a$10$hash"),
+	}, resp.ConnectionDetails)
+}
+
+func TestObserve_ConnectionDetailsOmitAdminPasswordHashWithoutSecretKey(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "argocd-secret"},
+		Data:       map[string][]byte{"server.secretkey": []byte("key")},
+	}).Build()
+
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+	mg.SetAnnotations(map[string]string{"crossplane.io/external-name": fixtures.InstanceName})
+	mg.Spec.ForProvider.ArgoCDSecretRef = &xpv1.SecretReference{Namespace: "crossplane-system", Name: "argocd-secret"}
+	mg.Spec.ForProvider.PublishAdminPassword = true
+
+	observed := proto.Clone(fixtures.AkuityInstance).(*argocdv1.Instance)
+	observed.Id = "instance-id"
+	mc.EXPECT().GetInstance(ctx, fixtures.InstanceName).Return(observed, nil).Times(1)
+	mc.EXPECT().ExportInstance(ctx, fixtures.InstanceName).
+		Return(&argocdv1.ExportInstanceResponse{}, nil).Times(1)
+
+	resp, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.Equal(t, managed.ConnectionDetails{connectionKeyID: []byte("instance-id")}, resp.ConnectionDetails)
+}
+
+func TestObserve_MissingArgoCDSecretDoesNotBlockObserve(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().Build()
+
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+	mg.SetAnnotations(map[string]string{"crossplane.io/external-name": fixtures.InstanceName})
+	mg.Spec.ForProvider.ArgoCDSecretRef = &xpv1.SecretReference{Namespace: "crossplane-system", Name: "argocd-secret"}
+	mg.Spec.ForProvider.PublishAdminPassword = true
+	mg.Spec.ForProvider.ArgoCD.Spec.Description = "new-description"
+
+	observed := proto.Clone(fixtures.AkuityInstance).(*argocdv1.Instance)
+	observed.Id = "instance-id"
+	mc.EXPECT().GetInstance(ctx, fixtures.InstanceName).Return(observed, nil).Times(1)
+	mc.EXPECT().ExportInstance(ctx, fixtures.InstanceName).
+		Return(&argocdv1.ExportInstanceResponse{}, nil).Times(1)
+
+	resp, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.True(t, resp.ResourceExists)
+	assert.False(t, resp.ResourceUpToDate)
+	assert.Equal(t, managed.ConnectionDetails{connectionKeyID: []byte("instance-id")}, resp.ConnectionDetails)
+}
+
+func TestCreate_PublishesAdminPasswordHash(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "argocd-secret"},
+		Data:       map[string][]byte{"admin.password": []byte("$2a$10$hash")},
+	}).Build()
+
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+	mg.Spec.ForProvider.ArgoCDSecretRef = &xpv1.SecretReference{Namespace: "crossplane-system", Name: "argocd-secret"}
+	mg.Spec.ForProvider.PublishAdminPassword = true
+
+	mc.EXPECT().ApplyInstance(ctx, gomock.Any()).Return(nil).Times(1)
+
+	resp, err := e.Create(ctx, mg)
+	require.NoError(t, err)
+	// ID and hostname are assigned by the platform and published by the
+	// next Observe.
+	assert.Equal(t, managed.ConnectionDetails{
+		connectionKeyAdminPasswordHash: []byte("$2a$10$hash"),
+	}, resp.ConnectionDetails)
+}
+
+func TestObserve_ConnectionDetailsOmitAdminPasswordHashByDefault(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "argocd-secret"},
+		Data:       map[string][]byte{"admin.password": []byte("$2a$10$hash")},
+	}).Build()
+
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+	mg.SetAnnotations(map[string]string{"crossplane.io/external-name": fixtures.InstanceName})
+	mg.Spec.ForProvider.ArgoCDSecretRef = &xpv1.SecretReference{Namespace: "crossplane-system", Name: "argocd-secret"}
+
+	observed := proto.Clone(fixtures.AkuityInstance).(*argocdv1.Instance)
+	observed.Id = "instance-id"
+	mc.EXPECT().GetInstance(ctx, fixtures.InstanceName).Return(observed, nil).Times(1)
+	mc.EXPECT().ExportInstance(ctx, fixtures.InstanceName).
+		Return(&argocdv1.ExportInstanceResponse{}, nil).Times(1)
+
+	resp, err := e.Observe(ctx, mg)
+	require.NoError(t, err)
+	assert.Equal(t, managed.ConnectionDetails{connectionKeyID: []byte("instance-id")}, resp.ConnectionDetails)
+}
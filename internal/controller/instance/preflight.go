@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+
+	featuresv1 "github.com/akuity/api-client-go/pkg/api/gen/types/features/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+)
+
+const instanceSpecPath = "spec.forProvider.argocd.spec.instanceSpec."
+
+// preflight checks the desired instance against the organization's
+// Argo CD instance quota and plan before ApplyInstance. The quota only
+// applies when create is set: an existing instance never counts against
+// it.
+func (e *external) preflight(ctx context.Context, mg *v1alpha1.Instance, create bool) error {
+	var quota *base.InstanceQuota
+	if create {
+		quota = e.ArgoCDInstanceQuota(mg.Spec.ForProvider.Name)
+	}
+	checked, err := e.Preflight(ctx, mg, quota, requiredFeatures(mg.Spec.ForProvider), mg.Status.AtProvider.PreflightFeaturesHash)
+	if err != nil {
+		return err
+	}
+	mg.Status.AtProvider.PreflightFeaturesHash = checked
+	return nil
+}
+
+// requiredFeatures lists the plan features the fields set in in depend
+// on. Fields the controller late-initializes from the platform, such as
+// the subdomain, are left out: they are set on every observed instance.
+func requiredFeatures(in v1alpha1.InstanceParameters) []base.RequiredFeature {
+	var out []base.RequiredFeature
+	add := func(set bool, field, feature string, status func(*featuresv1.FeatureStatuses) featuresv1.FeatureStatus) {
+		if set {
+			out = append(out, base.RequiredFeature{Field: field, Feature: feature, Status: status})
+		}
+	}
+	add(len(in.ConfigManagementPlugins) > 0, "spec.forProvider.configManagementPlugins", "config management plugins", (*featuresv1.FeatureStatuses).GetConfigManagementPlugins)
+	if in.ArgoCD == nil {
+		return out
+	}
+	spec := in.ArgoCD.Spec.InstanceSpec
+	add(ptr.Deref(spec.MultiClusterK8SDashboardEnabled, false), instanceSpecPath+"multiClusterK8sDashboardEnabled", "KubeVision", (*featuresv1.FeatureStatuses).GetMultiClusterK8SDashboard)
+	add(spec.KubeVisionConfig != nil, instanceSpecPath+"kubeVisionConfig", "KubeVision", (*featuresv1.FeatureStatuses).GetMultiClusterK8SDashboard)
+	if ai := spec.AkuityIntelligenceExtension; ai != nil {
+		add(ptr.Deref(ai.Enabled, false), instanceSpecPath+"akuityIntelligenceExtension.enabled", "Akuity Intelligence", (*featuresv1.FeatureStatuses).GetAiSupportEngineer)
+		add(ptr.Deref(ai.AiSupportEngineerEnabled, false), instanceSpecPath+"akuityIntelligenceExtension.aiSupportEngineerEnabled", "Akuity Intelligence", (*featuresv1.FeatureStatuses).GetAiSupportEngineer)
+	}
+	add(spec.Fqdn != "", instanceSpecPath+"fqdn", "custom domains", (*featuresv1.FeatureStatuses).GetArgocdCustomDomain)
+	add(len(spec.Extensions) > 0, instanceSpecPath+"extensions", "Akuity Argo CD extensions", (*featuresv1.FeatureStatuses).GetAkuityArgocdExtensions)
+	add(spec.Secrets != nil && (len(spec.Secrets.Sources) > 0 || len(spec.Secrets.Destinations) > 0), instanceSpecPath+"secrets", "secret management", (*featuresv1.FeatureStatuses).GetSecretManagement)
+	return out
+}
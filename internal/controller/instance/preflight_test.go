@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"errors"
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	featuresv1 "github.com/akuity/api-client-go/pkg/api/gen/types/features/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mock_akuity_client "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+	crossplanetypes "github.com/akuityio/provider-crossplane-akuity/internal/types/generated/crossplane/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/types/test/fixtures"
+)
+
+func TestRequiredFeatures(t *testing.T) {
+	in := v1alpha1.InstanceParameters{
+		ArgoCD: &crossplanetypes.ArgoCD{Spec: crossplanetypes.ArgoCDSpec{InstanceSpec: crossplanetypes.InstanceSpec{
+			Subdomain:                       "late-initialized",
+			MultiClusterK8SDashboardEnabled: ptr.To(true),
+			AkuityIntelligenceExtension:     &crossplanetypes.AkuityIntelligenceExtension{Enabled: ptr.To(false)},
+			Fqdn:                            "argocd.example.com",
+		}}},
+	}
+
+	var fields []string
+	for _, r := range requiredFeatures(in) {
+		fields = append(fields, r.Field)
+	}
+	assert.Equal(t, []string{
+		"spec.forProvider.argocd.spec.instanceSpec.multiClusterK8sDashboardEnabled",
+		"spec.forProvider.argocd.spec.instanceSpec.fqdn",
+	}, fields)
+}
+
+func TestCreate_PreflightBlocksApply(t *testing.T) {
+	mc := mock_akuity_client.NewMockClient(gomock.NewController(t))
+	e := &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+	mg.Spec.ForProvider.ArgoCD.Spec.InstanceSpec.MultiClusterK8SDashboardEnabled = ptr.To(true)
+
+	mc.EXPECT().ListArgocdInstancesQuota(gomock.Any()).Return(nil, nil).Times(1)
+	mc.EXPECT().GetOrganizationQuota(gomock.Any()).Return(&featuresv1.OrganizationQuota{}, nil).Times(1)
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(&featuresv1.FeatureStatuses{
+		MultiClusterK8SDashboard: featuresv1.FeatureStatus_FEATURE_STATUS_NOT_AVAILABLE,
+	}, nil).Times(1)
+	mc.EXPECT().ApplyInstance(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := e.Create(ctx, mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+	assert.Equal(t, v1alpha1.ReasonFeatureNotEntitled, mg.GetCondition(v1alpha1.TypePreflight).Reason)
+	assert.True(t, e.HasTerminalWriteResource(mg, v1alpha1.InstanceGroupVersionKind))
+}
+
+func TestCreate_PreflightQuotaExceededIsTerminal(t *testing.T) {
+	mc := mock_akuity_client.NewMockClient(gomock.NewController(t))
+	e := &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+
+	mc.EXPECT().ListArgocdInstancesQuota(gomock.Any()).Return([]*orgcv1.InstanceQuota{
+		{Instance: &orgcv1.InstanceQuotaSummary{Name: "other"}},
+	}, nil).Times(1)
+	mc.EXPECT().GetOrganizationQuota(gomock.Any()).Return(&featuresv1.OrganizationQuota{MaxInstances: 1}, nil).Times(1)
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(&featuresv1.FeatureStatuses{}, nil).AnyTimes()
+	mc.EXPECT().ApplyInstance(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := e.Create(ctx, mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+	assert.Equal(t, v1alpha1.ReasonQuotaExceeded, mg.GetCondition(v1alpha1.TypePreflight).Reason)
+	assert.True(t, e.HasTerminalWriteResource(mg, v1alpha1.InstanceGroupVersionKind))
+}
+
+func TestUpdate_PreflightSkipsQuotaAndCheckedFeatures(t *testing.T) {
+	mc := mock_akuity_client.NewMockClient(gomock.NewController(t))
+	e := &external{ExternalClient: base.ExternalClient{Client: mc, Logger: logging.NewNopLogger()}}
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+	mg.Spec.ForProvider.ArgoCD.Spec.InstanceSpec.MultiClusterK8SDashboardEnabled = ptr.To(true)
+
+	// No quota lookups: the instance already exists.
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(&featuresv1.FeatureStatuses{}, nil).Times(1)
+	mc.EXPECT().ApplyInstance(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	_, err := e.Update(ctx, mg)
+	require.NoError(t, err)
+	assert.NotEmpty(t, mg.Status.AtProvider.PreflightFeaturesHash)
+	_, err = e.Update(ctx, mg)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.ReasonPreflightPassed, mg.GetCondition(v1alpha1.TypePreflight).Reason)
+}
+
+func TestUpdate_PreflightRechecksFeaturesAfterFailedApply(t *testing.T) {
+	mc := mock_akuity_client.NewMockClient(gomock.NewController(t))
+	e := &external{ExternalClient: base.ExternalClient{Client: mc, Logger: logging.NewNopLogger()}}
+	mg := fixtures.CrossplaneManagedInstance.DeepCopy()
+	mg.Spec.ForProvider.ArgoCD.Spec.InstanceSpec.MultiClusterK8SDashboardEnabled = ptr.To(true)
+
+	// A failed apply forgets the checked features, so the retry looks
+	// the plan up again.
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(&featuresv1.FeatureStatuses{}, nil).Times(2)
+	gomock.InOrder(
+		mc.EXPECT().ApplyInstance(gomock.Any(), gomock.Any()).Return(errors.New("plan changed")),
+		mc.EXPECT().ApplyInstance(gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	_, err := e.Update(ctx, mg)
+	require.Error(t, err)
+	assert.Empty(t, mg.Status.AtProvider.PreflightFeaturesHash)
+	_, err = e.Update(ctx, mg)
+	require.NoError(t, err)
+	assert.NotEmpty(t, mg.Status.AtProvider.PreflightFeaturesHash)
+}
@@ -0,0 +1,454 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instanceaccount is the InstanceAccount controller. It owns an
+// Argo CD local account on an Akuity instance through the Argo CD
+// gateway's UpsertInstanceAccount / DeleteInstanceAccount endpoints.
+// The account name is the external-name.
+//
+// The account password is write-only on the platform. The controller
+// sets it on create, either from passwordSecretRef through
+// UpdateInstanceAccountPassword or by having the platform generate one
+// through RegenerateInstanceAccountPassword, and publishes it as
+// connection details. Bumping the rotate-password annotation sets a new
+// password the same way.
+package instanceaccount
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
+)
+
+// Connection detail keys published for an account.
+const (
+	connectionKeyUsername = "username"
+	connectionKeyPassword = "password"
+)
+
+// Setup registers the controller with the manager.
+//
+// The external-name is stamped from spec.forProvider.name on Create, so
+// the default NameAsExternalName initializer is disabled; otherwise an
+// unrelated account that happens to share the MR name would be adopted.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceAccountGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.InstanceAccount]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.InstanceAccount] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceAccountGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.InstanceAccount](conn),
+		managed.WithInitializers(),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.InstanceAccount{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.InstanceAccount) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if meta.GetExternalName(mg) == "" {
+		// An UpsertInstanceAccount rejected on bad input never stamps
+		// the external-name; suppress the retry loop until the spec
+		// changes.
+		if obs, err, ok := e.suppressTerminalWrite(ctx, mg, instanceID); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	acct, err := e.Client.GetInstanceAccount(ctx, instanceID, meta.GetExternalName(mg))
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	// The password bookkeeping is written after a successful password
+	// write; preserve it across the assignment.
+	prev := mg.Status.AtProvider
+	mg.Status.AtProvider = accountObservation(instanceID, acct)
+	mg.Status.AtProvider.PasswordUpdateTime = prev.PasswordUpdateTime
+	mg.Status.AtProvider.PasswordRotation = prev.PasswordRotation
+	mg.Status.AtProvider.SecretHash = prev.SecretHash
+	base.SetHealthCondition(mg, true)
+
+	desired := mg.Spec.ForProvider
+	observed := accountParameters(acct)
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "InstanceAccount")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if upToDate {
+		rotate, err := e.passwordRotationPending(ctx, mg)
+		if err != nil {
+			mg.SetConditions(xpv1.ReconcileError(err))
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = !rotate
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(ctx, mg, instanceID); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(ctx, mg, instanceID)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.InstanceAccount,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.InstanceAccount) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	sec, password, err := resolvePassword(ctx, e.Kube, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	key, err := instanceAccountTerminalWriteKey(mg, instanceID, sec)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	fp := mg.Spec.ForProvider
+	acct, err := e.Client.UpsertInstanceAccount(ctx, instanceID, fp.Name, capabilitiesToProto(fp.Capabilities), fp.Disabled)
+	if err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	mg.Status.AtProvider = accountObservation(instanceID, acct)
+
+	// The external-name is stamped only once the password is set, so a
+	// failed password write re-enters Create (the upsert is idempotent)
+	// instead of leaving a live account with no published password.
+	details, err := e.setPassword(ctx, mg, instanceID, fp.Name, sec, password)
+	if err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	meta.SetExternalName(mg, fp.Name)
+	return managed.ExternalCreation{ConnectionDetails: details}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.InstanceAccount) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	sec, password, err := resolvePassword(ctx, e.Kube, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	key, err := instanceAccountTerminalWriteKey(mg, instanceID, sec)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	fp := mg.Spec.ForProvider
+	acct, err := e.Client.UpsertInstanceAccount(ctx, instanceID, meta.GetExternalName(mg), capabilitiesToProto(fp.Capabilities), fp.Disabled)
+	if err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	prev := mg.Status.AtProvider
+	mg.Status.AtProvider = accountObservation(instanceID, acct)
+	mg.Status.AtProvider.PasswordUpdateTime = prev.PasswordUpdateTime
+	mg.Status.AtProvider.PasswordRotation = prev.PasswordRotation
+	mg.Status.AtProvider.SecretHash = prev.SecretHash
+
+	var details managed.ConnectionDetails
+	if passwordChanged(mg, sec) {
+		details, err = e.setPassword(ctx, mg, instanceID, meta.GetExternalName(mg), sec, password)
+		if err != nil {
+			return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+		}
+	}
+	e.ClearTerminalWrite(key)
+	return managed.ExternalUpdate{ConnectionDetails: details}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.InstanceAccount) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.InstanceAccountGroupVersionKind)
+
+	name := meta.GetExternalName(mg)
+	if name == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	if err := e.Client.DeleteInstanceAccount(ctx, instanceID, name); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// setPassword writes the account password and returns the connection
+// details to publish. A password from passwordSecretRef is set through
+// UpdateInstanceAccountPassword; otherwise, for accounts that can log
+// in, the platform generates one. Accounts without the login
+// capability and without a passwordSecretRef get no password, and only
+// the username is published.
+func (e *external) setPassword(ctx context.Context, mg *v1alpha1.InstanceAccount, instanceID, name string, sec secrets.ResolvedSecret, password string) (managed.ConnectionDetails, error) {
+	details := managed.ConnectionDetails{connectionKeyUsername: []byte(name)}
+	rotation := mg.GetAnnotations()[v1alpha1.AnnotationRotatePassword]
+
+	switch {
+	case mg.Spec.ForProvider.PasswordSecretRef != nil:
+		if err := e.Client.UpdateInstanceAccountPassword(ctx, instanceID, name, password); err != nil {
+			return nil, err
+		}
+	case mg.Spec.ForProvider.Capabilities.Login:
+		generated, err := e.Client.RegenerateInstanceAccountPassword(ctx, instanceID, name)
+		if err != nil {
+			return nil, err
+		}
+		password = generated
+	default:
+		mg.Status.AtProvider.PasswordRotation = rotation
+		mg.Status.AtProvider.SecretHash = ""
+		return details, nil
+	}
+
+	details[connectionKeyPassword] = []byte(password)
+	mg.Status.AtProvider.PasswordUpdateTime = time.Now().UTC().Format(time.RFC3339)
+	mg.Status.AtProvider.PasswordRotation = rotation
+	mg.Status.AtProvider.SecretHash = sec.Hash()
+	return details, nil
+}
+
+// passwordRotationPending reports whether the account password must be
+// set again: the rotate-password annotation carries a value the
+// controller has not handled, the passwordSecretRef value changed, or
+// the account gained the login capability without ever having had a
+// password set.
+func (e *external) passwordRotationPending(ctx context.Context, mg *v1alpha1.InstanceAccount) (bool, error) {
+	sec, _, err := resolvePassword(ctx, e.Kube, mg)
+	if err != nil {
+		return false, err
+	}
+	if !passwordChanged(mg, sec) {
+		return false, nil
+	}
+	e.Logger.Debug("InstanceAccount password rotation pending; forcing Update",
+		"rotation", mg.GetAnnotations()[v1alpha1.AnnotationRotatePassword],
+		"previousHash", mg.Status.AtProvider.SecretHash, "currentHash", sec.Hash())
+	return true, nil
+}
+
+func passwordChanged(mg *v1alpha1.InstanceAccount, sec secrets.ResolvedSecret) bool {
+	if mg.GetAnnotations()[v1alpha1.AnnotationRotatePassword] != mg.Status.AtProvider.PasswordRotation {
+		return true
+	}
+	if sec.Hash() != mg.Status.AtProvider.SecretHash {
+		return true
+	}
+	fp := mg.Spec.ForProvider
+	return fp.PasswordSecretRef == nil && fp.Capabilities.Login && mg.Status.AtProvider.PasswordUpdateTime == ""
+}
+
+// resolvePassword loads the password selected by passwordSecretRef.
+// Missing or malformed references are terminal configuration errors.
+func resolvePassword(ctx context.Context, kube client.Client, mg *v1alpha1.InstanceAccount) (secrets.ResolvedSecret, string, error) {
+	resolved, value, err := secrets.ResolveKey(ctx, kube, mg.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return secrets.ResolvedSecret{}, "", secrets.AsTerminalIfConfig(fmt.Errorf("passwordSecretRef: %w", err))
+	}
+	return resolved, value, nil
+}
+
+// resolveInstanceID returns the opaque Akuity ID of the target Instance.
+// ForProvider.InstanceID takes precedence; if absent, InstanceRef is
+// resolved against an Instance MR and its Status.AtProvider.ID is used.
+// The cached Status.AtProvider.InstanceID is only consulted during
+// deletion once the referenced Instance MR is gone.
+func (e *external) resolveInstanceID(ctx context.Context, mg *v1alpha1.InstanceAccount) (string, error) {
+	if id := mg.Spec.ForProvider.InstanceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.InstanceRef == nil || mg.Spec.ForProvider.InstanceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.instanceId or spec.forProvider.instanceRef must be set")
+	}
+
+	inst := &v1alpha1.Instance{}
+	key := k8stypes.NamespacedName{Name: mg.Spec.ForProvider.InstanceRef.Name, Namespace: mg.GetNamespace()}
+	if err := e.Kube.Get(ctx, key, inst); err != nil {
+		if apierrors.IsNotFound(err) && meta.WasDeleted(mg) {
+			if cached := mg.Status.AtProvider.InstanceID; cached != "" {
+				return cached, nil
+			}
+		}
+		return "", fmt.Errorf("could not resolve InstanceRef %s: %w", key.Name, err)
+	}
+	if inst.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced Instance %s has not yet reported an ID; waiting for its controller to observe", key.Name)
+	}
+	return inst.Status.AtProvider.ID, nil
+}
+
+func (e *external) suppressTerminalWrite(ctx context.Context, mg *v1alpha1.InstanceAccount, instanceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	sec, _, err := resolvePassword(ctx, e.Kube, mg)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	key, err := instanceAccountTerminalWriteKey(mg, instanceID, sec)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(ctx context.Context, mg *v1alpha1.InstanceAccount, instanceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.InstanceAccountGroupVersionKind) {
+		return
+	}
+	sec, _, err := resolvePassword(ctx, e.Kube, mg)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	key, err := instanceAccountTerminalWriteKey(mg, instanceID, sec)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func instanceAccountTerminalWriteKey(mg *v1alpha1.InstanceAccount, instanceID string, sec secrets.ResolvedSecret) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.InstanceAccountGroupVersionKind,
+		instanceID, mg.Spec.ForProvider, sec.Hash(), mg.GetAnnotations()[v1alpha1.AnnotationRotatePassword])
+}
+
+// driftSpec is the resource's drift-detection recipe. The instance
+// target is resolved separately and the password is tracked through
+// the rotation bookkeeping, so only the account fields are compared.
+func driftSpec() base.DriftSpec[v1alpha1.InstanceAccountParameters] {
+	return base.DriftSpec[v1alpha1.InstanceAccountParameters]{
+		Ignore: []cmp.Option{
+			cmpopts.IgnoreFields(v1alpha1.InstanceAccountParameters{}, "InstanceID", "InstanceRef", "Name", "PasswordSecretRef"),
+		},
+	}
+}
+
+func capabilitiesToProto(c v1alpha1.InstanceAccountCapabilities) *argocdv1.InstanceAccountCapabilities {
+	return &argocdv1.InstanceAccountCapabilities{Login: c.Login, ApiKey: c.APIKey}
+}
+
+func capabilitiesFromProto(c *argocdv1.InstanceAccountCapabilities) v1alpha1.InstanceAccountCapabilities {
+	return v1alpha1.InstanceAccountCapabilities{Login: c.GetLogin(), APIKey: c.GetApiKey()}
+}
+
+func accountParameters(a *argocdv1.InstanceAccount) v1alpha1.InstanceAccountParameters {
+	return v1alpha1.InstanceAccountParameters{
+		Name:         a.GetName(),
+		Capabilities: capabilitiesFromProto(a.GetCapabilities()),
+		Disabled:     a.GetDisabled(),
+	}
+}
+
+// accountObservation summarizes the platform account for status. The
+// password bookkeeping fields are controller-owned and not set here.
+func accountObservation(instanceID string, a *argocdv1.InstanceAccount) v1alpha1.InstanceAccountObservation {
+	return v1alpha1.InstanceAccountObservation{
+		InstanceID:   instanceID,
+		Name:         a.GetName(),
+		Capabilities: capabilitiesFromProto(a.GetCapabilities()),
+		Disabled:     a.GetDisabled(),
+	}
+}
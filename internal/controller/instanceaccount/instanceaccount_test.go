@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceaccount
+
+import (
+	"context"
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
+)
+
+const (
+	instanceID  = "inst-1"
+	accountName = "ci-bot"
+)
+
+func newAccount() *v1alpha1.InstanceAccount {
+	mg := &v1alpha1.InstanceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci-bot", UID: "ci-bot-uid"},
+		Spec: v1alpha1.InstanceAccountSpec{
+			ForProvider: v1alpha1.InstanceAccountParameters{
+				InstanceRef:  &v1alpha1.LocalReference{Name: "prod"},
+				Name:         accountName,
+				Capabilities: v1alpha1.InstanceAccountCapabilities{Login: true, APIKey: true},
+			},
+		},
+	}
+	meta.SetExternalName(mg, accountName)
+	mg.Status.AtProvider.PasswordUpdateTime = "2026-01-01T00:00:00Z"
+	return mg
+}
+
+func prodInstance() *v1alpha1.Instance {
+	inst := &v1alpha1.Instance{ObjectMeta: metav1.ObjectMeta{Name: "prod"}}
+	inst.Status.AtProvider.ID = instanceID
+	return inst
+}
+
+func passwordSecret(value string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "akuity", Name: "ci-bot"},
+		Data:       map[string][]byte{"password": []byte(value)},
+	}
+}
+
+func passwordSecretRef() *xpv1.SecretKeySelector {
+	return &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "akuity", Name: "ci-bot"},
+		Key:             "password",
+	}
+}
+
+func secretHash(value string) string {
+	return secrets.ResolvedSecret{
+		Namespace: "akuity",
+		Name:      "ci-bot",
+		Data:      map[string]string{"password": value},
+	}.Hash()
+}
+
+func platformAccount(login, apiKey bool) *argocdv1.InstanceAccount {
+	return &argocdv1.InstanceAccount{
+		Name:         accountName,
+		Capabilities: &argocdv1.InstanceAccountCapabilities{Login: login, ApiKey: apiKey},
+	}
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_InstanceRefNotReady(t *testing.T) {
+	inst := prodInstance()
+	inst.Status.AtProvider.ID = ""
+	e, _ := newExt(t, inst)
+
+	_, err := e.Observe(context.Background(), newAccount())
+	require.Error(t, err)
+}
+
+func TestObserve_NotFound(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mc.EXPECT().GetInstanceAccount(gomock.Any(), instanceID, accountName).Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), newAccount())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_UpToDate(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAccount()
+	mc.EXPECT().GetInstanceAccount(gomock.Any(), instanceID, accountName).Return(platformAccount(true, true), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, instanceID, mg.Status.AtProvider.InstanceID)
+	assert.Equal(t, "2026-01-01T00:00:00Z", mg.Status.AtProvider.PasswordUpdateTime)
+}
+
+func TestObserve_CapabilityDrift(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mc.EXPECT().GetInstanceAccount(gomock.Any(), instanceID, accountName).Return(platformAccount(true, false), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), newAccount())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_RotationAnnotationDrifts(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAccount()
+	meta.AddAnnotations(mg, map[string]string{v1alpha1.AnnotationRotatePassword: "2026-02-01"})
+	mc.EXPECT().GetInstanceAccount(gomock.Any(), instanceID, accountName).Return(platformAccount(true, true), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_RotatedPasswordSecretDrifts(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), passwordSecret("rotated"))
+	mg := newAccount()
+	mg.Spec.ForProvider.PasswordSecretRef = passwordSecretRef()
+	mg.Status.AtProvider.SecretHash = secretHash("s3cr3t")
+	mc.EXPECT().GetInstanceAccount(gomock.Any(), instanceID, accountName).Return(platformAccount(true, true), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestCreate_GeneratesPassword(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAccount()
+	meta.SetExternalName(mg, "")
+	mg.Status.AtProvider = v1alpha1.InstanceAccountObservation{}
+	mc.EXPECT().UpsertInstanceAccount(gomock.Any(), instanceID, accountName,
+		&argocdv1.InstanceAccountCapabilities{Login: true, ApiKey: true}, false).
+		Return(platformAccount(true, true), nil).Times(1)
+	mc.EXPECT().RegenerateInstanceAccountPassword(gomock.Any(), instanceID, accountName).Return("generated", nil).Times(1)
+
+	cre, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, accountName, meta.GetExternalName(mg))
+	assert.Equal(t, []byte(accountName), cre.ConnectionDetails[connectionKeyUsername])
+	assert.Equal(t, []byte("generated"), cre.ConnectionDetails[connectionKeyPassword])
+	assert.NotEmpty(t, mg.Status.AtProvider.PasswordUpdateTime)
+}
+
+func TestCreate_PasswordFailureLeavesExternalNameUnset(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAccount()
+	meta.SetExternalName(mg, "")
+	mc.EXPECT().UpsertInstanceAccount(gomock.Any(), instanceID, accountName, gomock.Any(), false).
+		Return(platformAccount(true, true), nil).Times(1)
+	mc.EXPECT().RegenerateInstanceAccountPassword(gomock.Any(), instanceID, accountName).Return("", errors.New("unavailable")).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.Error(t, err)
+	assert.Empty(t, meta.GetExternalName(mg))
+}
+
+func TestCreate_UsesPasswordSecret(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), passwordSecret("s3cr3t"))
+	mg := newAccount()
+	meta.SetExternalName(mg, "")
+	mg.Spec.ForProvider.PasswordSecretRef = passwordSecretRef()
+	mc.EXPECT().UpsertInstanceAccount(gomock.Any(), instanceID, accountName, gomock.Any(), false).
+		Return(platformAccount(true, true), nil).Times(1)
+	mc.EXPECT().UpdateInstanceAccountPassword(gomock.Any(), instanceID, accountName, "s3cr3t").Return(nil).Times(1)
+
+	cre, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("s3cr3t"), cre.ConnectionDetails[connectionKeyPassword])
+	assert.Equal(t, secretHash("s3cr3t"), mg.Status.AtProvider.SecretHash)
+}
+
+func TestCreate_APIKeyOnlySetsNoPassword(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAccount()
+	meta.SetExternalName(mg, "")
+	mg.Status.AtProvider = v1alpha1.InstanceAccountObservation{}
+	mg.Spec.ForProvider.Capabilities = v1alpha1.InstanceAccountCapabilities{APIKey: true}
+	mc.EXPECT().UpsertInstanceAccount(gomock.Any(), instanceID, accountName, gomock.Any(), false).
+		Return(platformAccount(false, true), nil).Times(1)
+
+	cre, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.NotContains(t, cre.ConnectionDetails, connectionKeyPassword)
+	assert.Empty(t, mg.Status.AtProvider.PasswordUpdateTime)
+}
+
+func TestCreate_RejectedIsTerminal(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAccount()
+	meta.SetExternalName(mg, "")
+	mc.EXPECT().UpsertInstanceAccount(gomock.Any(), instanceID, accountName, gomock.Any(), false).
+		Return(nil, reason.AsTerminal(errors.New("invalid account name"))).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.Error(t, err)
+
+	// The next Observe suppresses the retry until the spec changes.
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestUpdate_RotatesOnAnnotation(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAccount()
+	meta.AddAnnotations(mg, map[string]string{v1alpha1.AnnotationRotatePassword: "2026-02-01"})
+	mc.EXPECT().UpsertInstanceAccount(gomock.Any(), instanceID, accountName, gomock.Any(), false).
+		Return(platformAccount(true, true), nil).Times(1)
+	mc.EXPECT().RegenerateInstanceAccountPassword(gomock.Any(), instanceID, accountName).Return("rotated", nil).Times(1)
+
+	upd, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("rotated"), upd.ConnectionDetails[connectionKeyPassword])
+	assert.Equal(t, "2026-02-01", mg.Status.AtProvider.PasswordRotation)
+}
+
+func TestUpdate_CapabilitiesOnlyKeepsPassword(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAccount()
+	mg.Spec.ForProvider.Disabled = true
+	mc.EXPECT().UpsertInstanceAccount(gomock.Any(), instanceID, accountName, gomock.Any(), true).
+		Return(platformAccount(true, true), nil).Times(1)
+
+	upd, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Empty(t, upd.ConnectionDetails)
+	assert.Equal(t, "2026-01-01T00:00:00Z", mg.Status.AtProvider.PasswordUpdateTime)
+}
+
+func TestDelete_UsesCachedInstanceID(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newAccount()
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+	mg.Status.AtProvider.InstanceID = instanceID
+	mc.EXPECT().DeleteInstanceAccount(gomock.Any(), instanceID, accountName).Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
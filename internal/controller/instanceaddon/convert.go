@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceaddon
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+)
+
+const (
+	operatorIn    = "In"
+	operatorNotIn = "NotIn"
+)
+
+// addonPatch builds the PatchInstanceAddon body for the fields set on
+// the spec. Fields left unset are omitted so the repository's values
+// stand. It returns nil when the spec manages no addon fields.
+func addonPatch(fp v1alpha1.InstanceAddonParameters) (*structpb.Struct, error) {
+	spec := map[string]any{}
+	if fp.Enabled != nil {
+		spec["enabled"] = *fp.Enabled
+	}
+	if fp.ClusterSelector != nil {
+		spec["clusterSelector"] = map[string]any{
+			"nameFilters":  selectorsToPatch(fp.ClusterSelector.NameFilters),
+			"labelFilters": selectorsToPatch(fp.ClusterSelector.LabelFilters),
+		}
+	}
+	if len(spec) == 0 {
+		return nil, nil
+	}
+	patch, err := structpb.NewStruct(map[string]any{"spec": spec})
+	if err != nil {
+		return nil, fmt.Errorf("build addon patch: %w", err)
+	}
+	return patch, nil
+}
+
+// selectorsToPatch renders selectors in the gateway's JSON shape. An
+// empty list is kept so a cleared filter is written as cleared.
+func selectorsToPatch(in []v1alpha1.AddonSelector) []any {
+	out := make([]any, 0, len(in))
+	for _, s := range in {
+		values := make([]any, 0, len(s.Values))
+		for _, v := range s.Values {
+			values = append(values, v)
+		}
+		sel := map[string]any{
+			"selectorOperator": operatorToProto(s.Operator).String(),
+			"values":           values,
+		}
+		if s.Key != "" {
+			sel["key"] = s.Key
+		}
+		out = append(out, sel)
+	}
+	return out
+}
+
+func operatorToProto(op string) argocdv1.SelectorOperator {
+	switch op {
+	case operatorIn:
+		return argocdv1.SelectorOperator_SELECTOR_OPERATOR_IN
+	case operatorNotIn:
+		return argocdv1.SelectorOperator_SELECTOR_OPERATOR_NOT_IN
+	default:
+		return argocdv1.SelectorOperator_SELECTOR_OPERATOR_UNSPECIFIED
+	}
+}
+
+func operatorFromProto(op argocdv1.SelectorOperator) string {
+	switch op {
+	case argocdv1.SelectorOperator_SELECTOR_OPERATOR_IN:
+		return operatorIn
+	case argocdv1.SelectorOperator_SELECTOR_OPERATOR_NOT_IN:
+		return operatorNotIn
+	default:
+		return ""
+	}
+}
+
+func clusterSelectorFromProto(in *argocdv1.ClusterSelector) *v1alpha1.AddonClusterSelector {
+	if in == nil {
+		return nil
+	}
+	return &v1alpha1.AddonClusterSelector{
+		NameFilters:  selectorsFromProto(in.GetNameFilters()),
+		LabelFilters: selectorsFromProto(in.GetLabelFilters()),
+	}
+}
+
+func selectorsFromProto(in []*argocdv1.Selector) []v1alpha1.AddonSelector {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]v1alpha1.AddonSelector, 0, len(in))
+	for _, s := range in {
+		out = append(out, v1alpha1.AddonSelector{
+			Key:      s.GetKey(),
+			Operator: operatorFromProto(s.GetSelectorOperator()),
+			Values:   s.GetValues(),
+		})
+	}
+	return out
+}
+
+// addonParameters projects the platform addon onto the managed fields
+// of the spec for drift comparison.
+func addonParameters(a *argocdv1.Addon) v1alpha1.InstanceAddonParameters {
+	enabled := a.GetSpec().GetEnabled()
+	return v1alpha1.InstanceAddonParameters{
+		Name:            a.GetSpec().GetName(),
+		Enabled:         &enabled,
+		ClusterSelector: clusterSelectorFromProto(a.GetSpec().GetClusterSelector()),
+	}
+}
+
+func addonObservation(instanceID string, a *argocdv1.Addon) v1alpha1.InstanceAddonObservation {
+	return v1alpha1.InstanceAddonObservation{
+		ID:              a.GetId(),
+		InstanceID:      instanceID,
+		RepoID:          a.GetRepoId(),
+		Name:            a.GetSpec().GetName(),
+		AddonType:       a.GetSpec().GetAddonType(),
+		Enabled:         a.GetSpec().GetEnabled(),
+		ClusterSelector: clusterSelectorFromProto(a.GetSpec().GetClusterSelector()),
+		ClusterCount:    a.GetStatus().GetClusterCount(),
+		LastSyncTime:    a.GetStatus().GetLastSyncTime(),
+		LastSyncCommit:  a.GetStatus().GetLastSyncCommit(),
+		ReconciliationStatus: v1alpha1.ResourceStatusCode{
+			Code:    int32(a.GetStatus().GetReconciliationStatus().GetCode()),
+			Message: a.GetStatus().GetReconciliationStatus().GetMessage(),
+		},
+	}
+}
+
+// addonErrorMessages flattens the per-scope error lists returned by
+// ListInstanceAddonErrors into sorted "<scope>: <type>: <message>"
+// lines.
+func addonErrorMessages(in map[string]*argocdv1.AddonErrorList) []string {
+	var out []string
+	for scope, list := range in {
+		for _, e := range list.GetErrors() {
+			out = append(out, strings.Join([]string{scope, e.GetType(), e.GetError()}, ": "))
+		}
+	}
+	slices.Sort(out)
+	return out
+}
@@ -0,0 +1,409 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instanceaddon is the InstanceAddon controller. Addons are
+// discovered by the platform from an addon repository rather than
+// created, so the controller takes over an addon the repository already
+// defines: Create finds it by name with ListInstanceAddons and stamps
+// its Akuity ID as the external-name. The fields set on the spec are
+// written with PatchInstanceAddon; unset fields stay as the repository
+// defines them.
+//
+// Errors reported by ListInstanceAddonErrors are surfaced as a
+// Ready=False condition with the AddonErrors reason. The
+// akuity.crossplane.io/refresh annotation triggers RefreshInstanceAddon.
+package instanceaddon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+//
+// The external-name is the server-assigned addon ID stamped on Create,
+// so the default NameAsExternalName initializer is disabled.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceAddonGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.InstanceAddon]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.InstanceAddon] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceAddonGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.InstanceAddon](conn),
+		managed.WithInitializers(),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.InstanceAddon{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.InstanceAddon) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if meta.GetExternalName(mg) == "" {
+		// A PatchInstanceAddon rejected on bad input during Create
+		// never stamps the external-name; suppress the retry loop
+		// until the spec changes.
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	id := meta.GetExternalName(mg)
+	addon, err := e.Client.GetInstanceAddon(ctx, instanceID, id)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+	if meta.WasDeleted(mg) && addon.GetDeleteTime() != nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	lastRefresh := mg.Status.AtProvider.LastRefresh
+	mg.Status.AtProvider = addonObservation(instanceID, addon)
+	mg.Status.AtProvider.LastRefresh = lastRefresh
+	if errs, lerr := e.Client.ListInstanceAddonErrors(ctx, instanceID, id); lerr != nil {
+		e.Logger.Debug("Cannot read addon errors", "id", id, "error", lerr)
+	} else {
+		mg.Status.AtProvider.Errors = addonErrorMessages(errs)
+	}
+	if len(mg.Status.AtProvider.Errors) > 0 {
+		mg.SetConditions(v1alpha1.AddonErrors(strings.Join(mg.Status.AtProvider.Errors, "; ")))
+	} else {
+		base.SetHealthCondition(mg, addon.GetStatus().GetReconciliationStatus().GetCode() != reconv1.StatusCode_STATUS_CODE_FAILED)
+	}
+
+	desired := mg.Spec.ForProvider
+	observed := addonParameters(addon)
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "InstanceAddon")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if upToDate && refreshPending(mg) {
+		e.Logger.Debug("InstanceAddon refresh requested; forcing Update",
+			"annotation", mg.GetAnnotations()[v1alpha1.AnnotationRefresh])
+		upToDate = false
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg, instanceID)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.InstanceAddon,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+// Create takes over the addon the repository defines under
+// spec.forProvider.name. An addon the platform has not discovered yet
+// is a plain error so the create is retried after the next repository
+// sync.
+func (e *external) Create(ctx context.Context, mg *v1alpha1.InstanceAddon) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	repoID, err := e.resolveRepoID(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	fp := mg.Spec.ForProvider
+	addons, err := e.Client.ListInstanceAddons(ctx, instanceID, fp.Name)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	id := ""
+	for _, a := range addons {
+		if a.GetRepoId() == repoID && a.GetSpec().GetName() == fp.Name && a.GetDeleteTime() == nil {
+			id = a.GetId()
+			break
+		}
+	}
+	if id == "" {
+		return managed.ExternalCreation{}, fmt.Errorf("addon %s has not been discovered in addon repository %s yet", fp.Name, repoID)
+	}
+
+	if err := e.patch(ctx, mg, instanceID, id); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	mg.Status.AtProvider.InstanceID = instanceID
+	meta.SetExternalName(mg, id)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.InstanceAddon) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	id := meta.GetExternalName(mg)
+	if e.specDrifted(ctx, mg) {
+		if err := e.patch(ctx, mg, instanceID, id); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if refreshPending(mg) {
+		key, err := instanceAddonTerminalWriteKey(mg, instanceID)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if err := e.Client.RefreshInstanceAddon(ctx, instanceID, id); err != nil {
+			return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+		}
+		e.ClearTerminalWrite(key)
+		mg.Status.AtProvider.LastRefresh = mg.GetAnnotations()[v1alpha1.AnnotationRefresh]
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.InstanceAddon) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.InstanceAddonGroupVersionKind)
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	if err := e.Client.DeleteInstanceAddon(ctx, instanceID, id); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// patch writes the managed addon fields. Nothing is sent when the spec
+// manages none.
+func (e *external) patch(ctx context.Context, mg *v1alpha1.InstanceAddon, instanceID, id string) error {
+	patch, err := addonPatch(mg.Spec.ForProvider)
+	if err != nil || patch == nil {
+		return err
+	}
+	key, err := instanceAddonTerminalWriteKey(mg, instanceID)
+	if err != nil {
+		return err
+	}
+	if _, err := e.Client.PatchInstanceAddon(ctx, instanceID, id, patch); err != nil {
+		return e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	return nil
+}
+
+// specDrifted compares the spec against the addon fields Observe just
+// recorded in status.atProvider.
+func (e *external) specDrifted(ctx context.Context, mg *v1alpha1.InstanceAddon) bool {
+	obs := mg.Status.AtProvider
+	desired := mg.Spec.ForProvider
+	observed := v1alpha1.InstanceAddonParameters{
+		Enabled:         &obs.Enabled,
+		ClusterSelector: obs.ClusterSelector,
+	}
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "InstanceAddon")
+	return err != nil || !upToDate
+}
+
+// refreshPending reports whether the refresh annotation carries a value
+// the controller has not yet acted on.
+func refreshPending(mg *v1alpha1.InstanceAddon) bool {
+	v := mg.GetAnnotations()[v1alpha1.AnnotationRefresh]
+	return v != "" && v != mg.Status.AtProvider.LastRefresh
+}
+
+// resolveInstanceID returns the opaque Akuity ID of the target Instance.
+// ForProvider.InstanceID takes precedence; if absent, InstanceRef is
+// resolved against an Instance MR and its Status.AtProvider.ID is used.
+// The cached Status.AtProvider.InstanceID is only consulted during
+// deletion once the referenced Instance MR is gone.
+func (e *external) resolveInstanceID(ctx context.Context, mg *v1alpha1.InstanceAddon) (string, error) {
+	if id := mg.Spec.ForProvider.InstanceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.InstanceRef == nil || mg.Spec.ForProvider.InstanceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.instanceId or spec.forProvider.instanceRef must be set")
+	}
+
+	inst := &v1alpha1.Instance{}
+	key := k8stypes.NamespacedName{Name: mg.Spec.ForProvider.InstanceRef.Name, Namespace: mg.GetNamespace()}
+	if err := e.Kube.Get(ctx, key, inst); err != nil {
+		if apierrors.IsNotFound(err) && meta.WasDeleted(mg) {
+			if cached := mg.Status.AtProvider.InstanceID; cached != "" {
+				return cached, nil
+			}
+		}
+		return "", fmt.Errorf("could not resolve InstanceRef %s: %w", key.Name, err)
+	}
+	if inst.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced Instance %s has not yet reported an ID; waiting for its controller to observe", key.Name)
+	}
+	return inst.Status.AtProvider.ID, nil
+}
+
+// resolveRepoID returns the Akuity ID of the addon repository.
+// ForProvider.RepoID takes precedence; if absent, RepoRef is resolved
+// against an InstanceAddonRepo MR and its Status.AtProvider.ID is used.
+// Only Create needs the repository, so there is no deletion fallback.
+func (e *external) resolveRepoID(ctx context.Context, mg *v1alpha1.InstanceAddon) (string, error) {
+	if id := mg.Spec.ForProvider.RepoID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.RepoRef == nil || mg.Spec.ForProvider.RepoRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.repoId or spec.forProvider.repoRef must be set")
+	}
+
+	repo := &v1alpha1.InstanceAddonRepo{}
+	key := k8stypes.NamespacedName{Name: mg.Spec.ForProvider.RepoRef.Name, Namespace: mg.GetNamespace()}
+	if err := e.Kube.Get(ctx, key, repo); err != nil {
+		return "", fmt.Errorf("could not resolve RepoRef %s: %w", key.Name, err)
+	}
+	if repo.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced InstanceAddonRepo %s has not yet reported an ID; waiting for its controller to observe", key.Name)
+	}
+	return repo.Status.AtProvider.ID, nil
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.InstanceAddon, instanceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := instanceAddonTerminalWriteKey(mg, instanceID)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.InstanceAddon, instanceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.InstanceAddonGroupVersionKind) {
+		return
+	}
+	key, err := instanceAddonTerminalWriteKey(mg, instanceID)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+// instanceAddonTerminalWriteKey includes the refresh annotation so a
+// failed refresh is retried once the annotation is bumped.
+func instanceAddonTerminalWriteKey(mg *v1alpha1.InstanceAddon, instanceID string) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.InstanceAddonGroupVersionKind, instanceID, mg.Spec.ForProvider, mg.GetAnnotations()[v1alpha1.AnnotationRefresh])
+}
+
+// driftSpec is the resource's drift-detection recipe. The instance and
+// repository targets are resolved separately and the name is immutable,
+// so only the patched fields are compared, and only when the spec sets
+// them.
+func driftSpec() base.DriftSpec[v1alpha1.InstanceAddonParameters] {
+	return base.DriftSpec[v1alpha1.InstanceAddonParameters]{
+		Ignore: []cmp.Option{
+			cmpopts.IgnoreFields(v1alpha1.InstanceAddonParameters{}, "InstanceID", "InstanceRef", "RepoID", "RepoRef", "Name"),
+		},
+		Normalize: func(desired, observed *v1alpha1.InstanceAddonParameters) {
+			if desired.Enabled == nil {
+				observed.Enabled = nil
+			}
+			if desired.ClusterSelector == nil {
+				observed.ClusterSelector = nil
+			} else if observed.ClusterSelector == nil {
+				observed.ClusterSelector = &v1alpha1.AddonClusterSelector{}
+			}
+		},
+	}
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceaddon
+
+import (
+	"context"
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/structpb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const (
+	instanceID = "inst-1"
+	repoID     = "repo-1"
+	addonID    = "addon-1"
+	addonName  = "cert-manager"
+)
+
+func newAddon() *v1alpha1.InstanceAddon {
+	mg := &v1alpha1.InstanceAddon{
+		ObjectMeta: metav1.ObjectMeta{Name: "cert-manager", UID: "cert-manager-uid"},
+		Spec: v1alpha1.InstanceAddonSpec{
+			ForProvider: v1alpha1.InstanceAddonParameters{
+				InstanceRef: &v1alpha1.LocalReference{Name: "prod"},
+				RepoRef:     &v1alpha1.LocalReference{Name: "addons"},
+				Name:        addonName,
+				Enabled:     ptr.To(true),
+				ClusterSelector: &v1alpha1.AddonClusterSelector{
+					LabelFilters: []v1alpha1.AddonSelector{{Key: "env", Operator: "In", Values: []string{"prod"}}},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(mg, addonID)
+	return mg
+}
+
+func prodInstance() *v1alpha1.Instance {
+	inst := &v1alpha1.Instance{ObjectMeta: metav1.ObjectMeta{Name: "prod"}}
+	inst.Status.AtProvider.ID = instanceID
+	return inst
+}
+
+func addonsRepo() *v1alpha1.InstanceAddonRepo {
+	repo := &v1alpha1.InstanceAddonRepo{ObjectMeta: metav1.ObjectMeta{Name: "addons"}}
+	repo.Status.AtProvider.ID = repoID
+	return repo
+}
+
+func platformAddon(enabled bool) *argocdv1.Addon {
+	return &argocdv1.Addon{
+		Id:     addonID,
+		RepoId: repoID,
+		Spec: &argocdv1.AddonSpec{
+			Name:      addonName,
+			AddonType: "helm",
+			Enabled:   enabled,
+			ClusterSelector: &argocdv1.ClusterSelector{
+				LabelFilters: []*argocdv1.Selector{{
+					Key:              ptr.To("env"),
+					SelectorOperator: argocdv1.SelectorOperator_SELECTOR_OPERATOR_IN,
+					Values:           []string{"prod"},
+				}},
+			},
+		},
+		Status: &argocdv1.AddonStatus{ClusterCount: 2},
+	}
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_NotFound(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mc.EXPECT().GetInstanceAddon(gomock.Any(), instanceID, addonID).Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), newAddon())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_UpToDate(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAddon()
+	mc.EXPECT().GetInstanceAddon(gomock.Any(), instanceID, addonID).Return(platformAddon(true), nil).Times(1)
+	mc.EXPECT().ListInstanceAddonErrors(gomock.Any(), instanceID, addonID).Return(nil, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, uint32(2), mg.Status.AtProvider.ClusterCount)
+	assert.Equal(t, corev1.ConditionTrue, mg.GetCondition(xpv1.TypeReady).Status)
+}
+
+func TestObserve_UnsetFieldsAreUnmanaged(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAddon()
+	mg.Spec.ForProvider.Enabled = nil
+	mg.Spec.ForProvider.ClusterSelector = nil
+	mc.EXPECT().GetInstanceAddon(gomock.Any(), instanceID, addonID).Return(platformAddon(false), nil).Times(1)
+	mc.EXPECT().ListInstanceAddonErrors(gomock.Any(), instanceID, addonID).Return(nil, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_EnabledDrift(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mc.EXPECT().GetInstanceAddon(gomock.Any(), instanceID, addonID).Return(platformAddon(false), nil).Times(1)
+	mc.EXPECT().ListInstanceAddonErrors(gomock.Any(), instanceID, addonID).Return(nil, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), newAddon())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_AddonErrorsSetReadyFalse(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAddon()
+	mc.EXPECT().GetInstanceAddon(gomock.Any(), instanceID, addonID).Return(platformAddon(true), nil).Times(1)
+	mc.EXPECT().ListInstanceAddonErrors(gomock.Any(), instanceID, addonID).Return(map[string]*argocdv1.AddonErrorList{
+		"prod-east": {Errors: []*argocdv1.AddonError{{Type: "helm", Error: "values.yaml: invalid"}}},
+	}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, []string{"prod-east: helm: values.yaml: invalid"}, mg.Status.AtProvider.Errors)
+	ready := mg.GetCondition(xpv1.TypeReady)
+	assert.Equal(t, corev1.ConditionFalse, ready.Status)
+	assert.Equal(t, v1alpha1.ReasonAddonErrors, ready.Reason)
+	assert.Equal(t, "prod-east: helm: values.yaml: invalid", ready.Message)
+}
+
+func TestObserve_RefreshAnnotationDrifts(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAddon()
+	meta.AddAnnotations(mg, map[string]string{v1alpha1.AnnotationRefresh: "1"})
+	mc.EXPECT().GetInstanceAddon(gomock.Any(), instanceID, addonID).Return(platformAddon(true), nil).Times(1)
+	mc.EXPECT().ListInstanceAddonErrors(gomock.Any(), instanceID, addonID).Return(nil, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestCreate_AdoptsDiscoveredAddon(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), addonsRepo())
+	mg := newAddon()
+	meta.SetExternalName(mg, "")
+	other := platformAddon(true)
+	other.Id = "addon-other"
+	other.RepoId = "repo-other"
+	mc.EXPECT().ListInstanceAddons(gomock.Any(), instanceID, addonName).Return([]*argocdv1.Addon{other, platformAddon(false)}, nil).Times(1)
+	mc.EXPECT().PatchInstanceAddon(gomock.Any(), instanceID, addonID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, patch *structpb.Struct) (*argocdv1.Addon, error) {
+			spec := patch.GetFields()["spec"].GetStructValue().GetFields()
+			assert.True(t, spec["enabled"].GetBoolValue())
+			filter := spec["clusterSelector"].GetStructValue().GetFields()["labelFilters"].GetListValue().GetValues()[0].GetStructValue().GetFields()
+			assert.Equal(t, "SELECTOR_OPERATOR_IN", filter["selectorOperator"].GetStringValue())
+			assert.Equal(t, "env", filter["key"].GetStringValue())
+			return platformAddon(true), nil
+		}).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, addonID, meta.GetExternalName(mg))
+	assert.Equal(t, instanceID, mg.Status.AtProvider.InstanceID)
+}
+
+func TestCreate_NotYetDiscovered(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), addonsRepo())
+	mg := newAddon()
+	meta.SetExternalName(mg, "")
+	mc.EXPECT().ListInstanceAddons(gomock.Any(), instanceID, addonName).Return(nil, nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.Error(t, err)
+	assert.False(t, reason.IsTerminal(err))
+	assert.Empty(t, meta.GetExternalName(mg))
+}
+
+func TestUpdate_RefreshOnlySkipsPatch(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAddon()
+	mg.Status.AtProvider = addonObservation(instanceID, platformAddon(true))
+	meta.AddAnnotations(mg, map[string]string{v1alpha1.AnnotationRefresh: "1"})
+	mc.EXPECT().RefreshInstanceAddon(gomock.Any(), instanceID, addonID).Return(nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "1", mg.Status.AtProvider.LastRefresh)
+}
+
+func TestUpdate_RejectedIsTerminal(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newAddon()
+	mg.Status.AtProvider = addonObservation(instanceID, platformAddon(false))
+	mc.EXPECT().PatchInstanceAddon(gomock.Any(), instanceID, addonID, gomock.Any()).
+		Return(nil, reason.AsTerminal(errors.New("invalid selector"))).Times(1)
+	mc.EXPECT().GetInstanceAddon(gomock.Any(), instanceID, addonID).Return(platformAddon(false), nil).Times(1)
+	mc.EXPECT().ListInstanceAddonErrors(gomock.Any(), instanceID, addonID).Return(nil, nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.Error(t, err)
+
+	// The next Observe suppresses the retry until the spec changes.
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestDelete_UsesCachedInstanceID(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newAddon()
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+	mg.Status.AtProvider.InstanceID = instanceID
+	mc.EXPECT().DeleteInstanceAddon(gomock.Any(), instanceID, addonID).Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instanceaddonrepo is the InstanceAddonRepo controller. It owns
+// an addon repository on an Argo CD instance through the Argo CD
+// gateway's Create/Get/Refresh/DeleteInstanceAddonRepo endpoints. The
+// Akuity-assigned repository ID is the external-name.
+//
+// Addon repositories cannot be updated in place; the repository URL
+// and revision are immutable on the spec. The only update the
+// controller performs is a refresh, requested through the
+// akuity.crossplane.io/refresh annotation.
+package instanceaddonrepo
+
+import (
+	"context"
+	"fmt"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+//
+// The external-name is the server-assigned repository ID stamped on
+// Create, so the default NameAsExternalName initializer is disabled.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceAddonRepoGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.InstanceAddonRepo]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.InstanceAddonRepo] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceAddonRepoGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.InstanceAddonRepo](conn),
+		managed.WithInitializers(),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.InstanceAddonRepo{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.InstanceAddonRepo) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if meta.GetExternalName(mg) == "" {
+		// A CreateInstanceAddonRepo rejected on bad input never stamps
+		// the external-name; suppress the retry loop until the spec
+		// changes.
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	repo, err := e.Client.GetInstanceAddonRepo(ctx, instanceID, meta.GetExternalName(mg))
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+	if meta.WasDeleted(mg) && repo.GetDeleteTime() != nil {
+		// The platform removes repositories asynchronously; one that
+		// is already being deleted is gone as far as the MR goes.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	lastRefresh := mg.Status.AtProvider.LastRefresh
+	mg.Status.AtProvider = addonRepoObservation(instanceID, repo)
+	mg.Status.AtProvider.LastRefresh = lastRefresh
+	base.SetHealthCondition(mg, repo.GetStatus().GetReconciliationStatus().GetCode() != reconv1.StatusCode_STATUS_CODE_FAILED)
+
+	upToDate := !refreshPending(mg)
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg, instanceID)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.InstanceAddonRepo,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.InstanceAddonRepo) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	key, err := instanceAddonRepoTerminalWriteKey(mg, instanceID)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	fp := mg.Spec.ForProvider
+	repo, err := e.Client.CreateInstanceAddonRepo(ctx, instanceID, &argocdv1.RepoSpec{
+		RepoUrl:  fp.RepoURL,
+		Revision: fp.Revision,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider.InstanceID = instanceID
+	// A new repository is read on creation, so a refresh requested
+	// before it existed is already satisfied.
+	mg.Status.AtProvider.LastRefresh = mg.GetAnnotations()[v1alpha1.AnnotationRefresh]
+	meta.SetExternalName(mg, repo.GetId())
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.InstanceAddonRepo) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	if !refreshPending(mg) {
+		return managed.ExternalUpdate{}, nil
+	}
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	key, err := instanceAddonRepoTerminalWriteKey(mg, instanceID)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.Client.RefreshInstanceAddonRepo(ctx, instanceID, meta.GetExternalName(mg)); err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider.LastRefresh = mg.GetAnnotations()[v1alpha1.AnnotationRefresh]
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.InstanceAddonRepo) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.InstanceAddonRepoGroupVersionKind)
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	if err := e.Client.DeleteInstanceAddonRepo(ctx, instanceID, id); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// refreshPending reports whether the refresh annotation carries a value
+// the controller has not yet acted on.
+func refreshPending(mg *v1alpha1.InstanceAddonRepo) bool {
+	v := mg.GetAnnotations()[v1alpha1.AnnotationRefresh]
+	return v != "" && v != mg.Status.AtProvider.LastRefresh
+}
+
+// resolveInstanceID returns the opaque Akuity ID of the target Instance.
+// ForProvider.InstanceID takes precedence; if absent, InstanceRef is
+// resolved against an Instance MR and its Status.AtProvider.ID is used.
+// The cached Status.AtProvider.InstanceID is only consulted during
+// deletion once the referenced Instance MR is gone.
+func (e *external) resolveInstanceID(ctx context.Context, mg *v1alpha1.InstanceAddonRepo) (string, error) {
+	if id := mg.Spec.ForProvider.InstanceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.InstanceRef == nil || mg.Spec.ForProvider.InstanceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.instanceId or spec.forProvider.instanceRef must be set")
+	}
+
+	inst := &v1alpha1.Instance{}
+	key := k8stypes.NamespacedName{Name: mg.Spec.ForProvider.InstanceRef.Name, Namespace: mg.GetNamespace()}
+	if err := e.Kube.Get(ctx, key, inst); err != nil {
+		if apierrors.IsNotFound(err) && meta.WasDeleted(mg) {
+			if cached := mg.Status.AtProvider.InstanceID; cached != "" {
+				return cached, nil
+			}
+		}
+		return "", fmt.Errorf("could not resolve InstanceRef %s: %w", key.Name, err)
+	}
+	if inst.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced Instance %s has not yet reported an ID; waiting for its controller to observe", key.Name)
+	}
+	return inst.Status.AtProvider.ID, nil
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.InstanceAddonRepo, instanceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := instanceAddonRepoTerminalWriteKey(mg, instanceID)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.InstanceAddonRepo, instanceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.InstanceAddonRepoGroupVersionKind) {
+		return
+	}
+	key, err := instanceAddonRepoTerminalWriteKey(mg, instanceID)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+// instanceAddonRepoTerminalWriteKey includes the refresh annotation so
+// a failed refresh is retried once the annotation is bumped.
+func instanceAddonRepoTerminalWriteKey(mg *v1alpha1.InstanceAddonRepo, instanceID string) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.InstanceAddonRepoGroupVersionKind, instanceID, mg.Spec.ForProvider, mg.GetAnnotations()[v1alpha1.AnnotationRefresh])
+}
+
+func addonRepoObservation(instanceID string, r *argocdv1.AddonRepo) v1alpha1.InstanceAddonRepoObservation {
+	return v1alpha1.InstanceAddonRepoObservation{
+		ID:             r.GetId(),
+		InstanceID:     instanceID,
+		RepoURL:        r.GetSpec().GetRepoUrl(),
+		Revision:       r.GetSpec().GetRevision(),
+		LastSyncTime:   r.GetStatus().GetLastSyncTime(),
+		LastSyncCommit: r.GetStatus().GetLastSyncCommit(),
+		AddonCount:     r.GetStatus().GetAddonCount(),
+		ReconciliationStatus: v1alpha1.ResourceStatusCode{
+			Code:    int32(r.GetStatus().GetReconciliationStatus().GetCode()),
+			Message: r.GetStatus().GetReconciliationStatus().GetMessage(),
+		},
+	}
+}
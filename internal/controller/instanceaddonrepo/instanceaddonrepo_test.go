@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceaddonrepo
+
+import (
+	"context"
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const (
+	instanceID = "inst-1"
+	repoID     = "repo-1"
+	repoURL    = "https://github.com/example/addons.git"
+)
+
+func newRepo() *v1alpha1.InstanceAddonRepo {
+	mg := &v1alpha1.InstanceAddonRepo{
+		ObjectMeta: metav1.ObjectMeta{Name: "addons", UID: "addons-uid"},
+		Spec: v1alpha1.InstanceAddonRepoSpec{
+			ForProvider: v1alpha1.InstanceAddonRepoParameters{
+				InstanceRef: &v1alpha1.LocalReference{Name: "prod"},
+				RepoURL:     repoURL,
+				Revision:    "main",
+			},
+		},
+	}
+	meta.SetExternalName(mg, repoID)
+	return mg
+}
+
+func prodInstance() *v1alpha1.Instance {
+	inst := &v1alpha1.Instance{ObjectMeta: metav1.ObjectMeta{Name: "prod"}}
+	inst.Status.AtProvider.ID = instanceID
+	return inst
+}
+
+func platformRepo(code reconv1.StatusCode) *argocdv1.AddonRepo {
+	return &argocdv1.AddonRepo{
+		Id:         repoID,
+		InstanceId: instanceID,
+		Spec:       &argocdv1.RepoSpec{RepoUrl: repoURL, Revision: "main"},
+		Status: &argocdv1.RepoStatus{
+			LastSyncCommit:       "abc123",
+			AddonCount:           3,
+			ReconciliationStatus: &reconv1.Status{Code: code, Message: "status"},
+		},
+	}
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_NoExternalName(t *testing.T) {
+	e, _ := newExt(t, prodInstance())
+	mg := newRepo()
+	meta.SetExternalName(mg, "")
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_NotFound(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mc.EXPECT().GetInstanceAddonRepo(gomock.Any(), instanceID, repoID).Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), newRepo())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_UpToDate(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newRepo()
+	mc.EXPECT().GetInstanceAddonRepo(gomock.Any(), instanceID, repoID).Return(platformRepo(reconv1.StatusCode_STATUS_CODE_SUCCESSFUL), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, uint32(3), mg.Status.AtProvider.AddonCount)
+	assert.Equal(t, "abc123", mg.Status.AtProvider.LastSyncCommit)
+	assert.Equal(t, corev1.ConditionTrue, mg.GetCondition(xpv1.TypeReady).Status)
+}
+
+func TestObserve_FailedReconciliationIsUnavailable(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newRepo()
+	mc.EXPECT().GetInstanceAddonRepo(gomock.Any(), instanceID, repoID).Return(platformRepo(reconv1.StatusCode_STATUS_CODE_FAILED), nil).Times(1)
+
+	_, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, corev1.ConditionFalse, mg.GetCondition(xpv1.TypeReady).Status)
+}
+
+func TestObserve_RefreshAnnotationDrifts(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newRepo()
+	mg.Status.AtProvider.LastRefresh = "1"
+	meta.AddAnnotations(mg, map[string]string{v1alpha1.AnnotationRefresh: "2"})
+	mc.EXPECT().GetInstanceAddonRepo(gomock.Any(), instanceID, repoID).Return(platformRepo(reconv1.StatusCode_STATUS_CODE_SUCCESSFUL), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+	assert.Equal(t, "1", mg.Status.AtProvider.LastRefresh)
+}
+
+func TestCreate_StampsID(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newRepo()
+	meta.SetExternalName(mg, "")
+	meta.AddAnnotations(mg, map[string]string{v1alpha1.AnnotationRefresh: "1"})
+	mc.EXPECT().CreateInstanceAddonRepo(gomock.Any(), instanceID, &argocdv1.RepoSpec{RepoUrl: repoURL, Revision: "main"}).
+		Return(&argocdv1.AddonRepo{Id: repoID}, nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, repoID, meta.GetExternalName(mg))
+	assert.Equal(t, instanceID, mg.Status.AtProvider.InstanceID)
+	assert.Equal(t, "1", mg.Status.AtProvider.LastRefresh)
+}
+
+func TestCreate_RejectedIsSuppressed(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newRepo()
+	meta.SetExternalName(mg, "")
+	mc.EXPECT().CreateInstanceAddonRepo(gomock.Any(), instanceID, gomock.Any()).
+		Return(nil, reason.AsTerminal(errors.New("invalid repo url"))).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.Error(t, err)
+
+	// The next Observe suppresses the retry until the spec changes.
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestUpdate_RefreshesOnce(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newRepo()
+	meta.AddAnnotations(mg, map[string]string{v1alpha1.AnnotationRefresh: "2"})
+	mc.EXPECT().RefreshInstanceAddonRepo(gomock.Any(), instanceID, repoID).Return(nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "2", mg.Status.AtProvider.LastRefresh)
+
+	// Already acted on: a second Update is a no-op.
+	_, err = e.Update(context.Background(), mg)
+	require.NoError(t, err)
+}
+
+func TestDelete_UsesCachedInstanceID(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newRepo()
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+	mg.Status.AtProvider.InstanceID = instanceID
+	mc.EXPECT().DeleteInstanceAddonRepo(gomock.Any(), instanceID, repoID).Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instancecss is the InstanceCSS controller. It owns the custom
+// UI stylesheet of an Akuity ArgoCD Instance via the dedicated
+// Get/UpdateInstanceCSS endpoints. Other instance settings are
+// untouched, so this MR can coexist with an Instance MR.
+package instancecss
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceCSSGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.InstanceCSS]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.InstanceCSS] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceCSSGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.InstanceCSS](conn),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.InstanceCSS{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.InstanceCSS) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	// Short-circuit on a cached terminal write before any gateway round-
+	// trip; with NameAsExternalName the external-name is stamped before
+	// Create runs.
+	if e.HasTerminalWriteResource(mg, v1alpha1.InstanceCSSGroupVersionKind) {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	}
+	if meta.GetExternalName(mg) == "" {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.Client.GetInstanceCSS(ctx, instanceID)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+	mg.Status.AtProvider = v1alpha1.InstanceCSSObservation{
+		CSS:        observed,
+		InstanceID: instanceID,
+	}
+	base.SetHealthCondition(mg, true)
+
+	// Deletion fast-path: Delete() writes an empty stylesheet, so once
+	// the instance reports none the MR is gone.
+	if meta.WasDeleted(mg) && observed == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	desired := mg.Spec.ForProvider.CSS
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "InstanceCSS")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg, instanceID)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.InstanceCSS,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.InstanceCSS) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	if err := e.update(ctx, mg, mg.Spec.ForProvider.CSS); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(mg, mg.GetName())
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.InstanceCSS) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	return managed.ExternalUpdate{}, e.update(ctx, mg, mg.Spec.ForProvider.CSS)
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.InstanceCSS) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.InstanceCSSGroupVersionKind)
+
+	// Delete clears the stylesheet rather than deleting the Instance.
+	return managed.ExternalDelete{}, e.update(ctx, mg, "")
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// update writes the stylesheet through UpdateInstanceCSS, which
+// replaces it wholesale.
+func (e *external) update(ctx context.Context, mg *v1alpha1.InstanceCSS, css string) error {
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return err
+	}
+	key, err := terminalWriteKey(mg, instanceID, css)
+	if err != nil {
+		return err
+	}
+
+	if err := e.Client.UpdateInstanceCSS(ctx, instanceID, css); err != nil {
+		err = reason.ClassifyApplyError(err)
+		if meta.WasDeleted(mg) {
+			return err
+		}
+		return e.RecordTerminalWrite(key, err)
+	}
+	if !meta.WasDeleted(mg) {
+		e.ClearTerminalWrite(key)
+	}
+	return nil
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.InstanceCSS, instanceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := terminalWriteKey(mg, instanceID, mg.Spec.ForProvider.CSS)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.InstanceCSS, instanceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.InstanceCSSGroupVersionKind) {
+		return
+	}
+	key, err := terminalWriteKey(mg, instanceID, mg.Spec.ForProvider.CSS)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func terminalWriteKey(mg *v1alpha1.InstanceCSS, instanceID, css string) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.InstanceCSSGroupVersionKind, map[string]any{
+		"instanceID": instanceID,
+		"css":        css,
+	})
+}
+
+// resolveInstanceID returns the opaque Akuity ID of the target Instance.
+// ForProvider.InstanceID takes precedence; if absent, InstanceRef is
+// resolved against an Instance MR in the same namespace and its
+// Status.AtProvider.ID is used. The cached Status.AtProvider.InstanceID
+// is only consulted during deletion once the referenced Instance MR is
+// gone.
+func (e *external) resolveInstanceID(ctx context.Context, mg *v1alpha1.InstanceCSS) (string, error) {
+	if id := mg.Spec.ForProvider.InstanceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.InstanceRef == nil || mg.Spec.ForProvider.InstanceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.instanceId or spec.forProvider.instanceRef must be set")
+	}
+
+	inst := &v1alpha1.Instance{}
+	key := k8stypes.NamespacedName{Name: mg.Spec.ForProvider.InstanceRef.Name, Namespace: mg.GetNamespace()}
+	if err := e.Kube.Get(ctx, key, inst); err != nil {
+		if apierrors.IsNotFound(err) && meta.WasDeleted(mg) {
+			if cached := mg.Status.AtProvider.InstanceID; cached != "" {
+				return cached, nil
+			}
+		}
+		return "", fmt.Errorf("could not resolve InstanceRef %s/%s: %w", key.Namespace, key.Name, err)
+	}
+	if inst.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced Instance %s/%s has not yet reported an ID; waiting for its controller to observe", key.Namespace, key.Name)
+	}
+	return inst.Status.AtProvider.ID, nil
+}
+
+// driftSpec is the resource's drift-detection recipe: a string compare
+// that ignores surrounding whitespace, which YAML block scalars add and
+// the platform may trim.
+func driftSpec() base.DriftSpec[string] {
+	return base.DriftSpec[string]{
+		Normalize: func(desired, observed *string) {
+			*desired = strings.TrimSpace(*desired)
+			*observed = strings.TrimSpace(*observed)
+		},
+	}
+}
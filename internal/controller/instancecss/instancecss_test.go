@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancecss
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const (
+	instanceID = "inst-1"
+	css        = ".sidebar { background: #123456; }\n"
+)
+
+func newCSS() *v1alpha1.InstanceCSS {
+	mg := &v1alpha1.InstanceCSS{
+		ObjectMeta: metav1.ObjectMeta{Name: "theme", Namespace: "ns", UID: "theme-uid"},
+		Spec: v1alpha1.InstanceCSSSpec{
+			ForProvider: v1alpha1.InstanceCSSParameters{
+				InstanceRef: &v1alpha1.LocalReference{Name: "inst"},
+				CSS:         css,
+			},
+		},
+	}
+	meta.SetExternalName(mg, "theme")
+	return mg
+}
+
+func newInst() *v1alpha1.Instance {
+	inst := &v1alpha1.Instance{ObjectMeta: metav1.ObjectMeta{Name: "inst", Namespace: "ns"}}
+	inst.Status.AtProvider.ID = instanceID
+	return inst
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_UpToDateIgnoresTrailingWhitespace(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCSS()
+	mc.EXPECT().GetInstanceCSS(gomock.Any(), instanceID).Return(".sidebar { background: #123456; }", nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, instanceID, mg.Status.AtProvider.InstanceID)
+}
+
+func TestObserve_Drift(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mc.EXPECT().GetInstanceCSS(gomock.Any(), instanceID).Return("", nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), newCSS())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_DeletedAndCleared(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCSS()
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+	mc.EXPECT().GetInstanceCSS(gomock.Any(), instanceID).Return("", nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestCreate_WritesCSS(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCSS()
+	meta.SetExternalName(mg, "")
+	mc.EXPECT().UpdateInstanceCSS(gomock.Any(), instanceID, css).Return(nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "theme", meta.GetExternalName(mg))
+}
+
+func TestUpdate_RejectedIsTerminal(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCSS()
+	mc.EXPECT().UpdateInstanceCSS(gomock.Any(), instanceID, css).
+		Return(reason.AsTerminal(errors.New("css too large"))).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.Error(t, err)
+
+	mc.EXPECT().GetInstanceCSS(gomock.Any(), gomock.Any()).Times(0)
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestDelete_ClearsCSS(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mc.EXPECT().UpdateInstanceCSS(gomock.Any(), instanceID, "").Return(nil).Times(1)
+
+	_, err := e.Delete(context.Background(), newCSS())
+	require.NoError(t, err)
+}
@@ -0,0 +1,326 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instancequota is the InstanceQuota controller. It sets the
+// application quota of an Akuity Argo CD instance or the stage quota of
+// a Kargo instance through the organization-plane quota endpoints, and
+// surfaces the current usage in status.
+//
+// Every instance has a quota whether or not an InstanceQuota manages
+// it, so the MR adopts the existing value on Create. The platform has
+// no notion of removing a quota, and the meaning of a zero quota is
+// not defined by the API, so deleting the MR stops managing the quota
+// and leaves the last written value in place.
+package instancequota
+
+import (
+	"context"
+	"fmt"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceQuotaGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.InstanceQuota]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.InstanceQuota] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceQuotaGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.InstanceQuota](conn),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.InstanceQuota{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.InstanceQuota) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+
+	// A quota cannot be removed, so there is nothing for Delete to wait
+	// on. Reporting the resource as gone lets the managed reconciler
+	// release the finalizer and leaves the quota in place.
+	if meta.WasDeleted(mg) {
+		e.ClearTerminalWriteResource(mg, v1alpha1.InstanceQuotaGroupVersionKind)
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	// Short-circuit on a cached terminal write before any gateway round-
+	// trip; with NameAsExternalName the external-name is stamped before
+	// Create runs.
+	if e.HasTerminalWriteResource(mg, v1alpha1.InstanceQuotaGroupVersionKind) {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	}
+	if meta.GetExternalName(mg) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.getQuota(ctx, mg.Spec.ForProvider.Kind, instanceID)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+	mg.Status.AtProvider = observed
+	base.SetHealthCondition(mg, true)
+
+	desired := mg.Spec.ForProvider.MaxCount
+	upToDate, err := base.EvaluateDrift(ctx, base.DriftSpec[int32]{}, &desired, &observed.MaxCount, e.Logger, "InstanceQuota")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg, instanceID)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.InstanceQuota,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+// Create adopts the instance's existing quota and writes the desired
+// value over it.
+func (e *external) Create(ctx context.Context, mg *v1alpha1.InstanceQuota) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	if err := e.update(ctx, mg); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(mg, mg.GetName())
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.InstanceQuota) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	return managed.ExternalUpdate{}, e.update(ctx, mg)
+}
+
+// Delete leaves the quota at its last written value. Observe normally
+// reports a deleted MR as gone before Delete is reached.
+func (e *external) Delete(_ context.Context, mg *v1alpha1.InstanceQuota) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.InstanceQuotaGroupVersionKind)
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// update writes the desired quota through the update route matching
+// the instance kind. Only the target instance is changed.
+func (e *external) update(ctx context.Context, mg *v1alpha1.InstanceQuota) error {
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return err
+	}
+	key, err := terminalWriteKey(mg, instanceID)
+	if err != nil {
+		return err
+	}
+
+	maxCount := mg.Spec.ForProvider.MaxCount
+	switch kind := mg.Spec.ForProvider.Kind; kind {
+	case v1alpha1.InstanceQuotaTargetArgoCD:
+		err = e.Client.UpdateArgocdInstanceQuota(ctx, instanceID, maxCount)
+	case v1alpha1.InstanceQuotaTargetKargo:
+		err = e.Client.UpdateKargoInstanceQuota(ctx, instanceID, maxCount)
+	default:
+		err = reason.AsTerminal(fmt.Errorf("spec.forProvider.kind: unsupported instance kind %q", kind))
+	}
+	if err != nil {
+		return e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider.InstanceID = instanceID
+	return nil
+}
+
+// getQuota lists the organization's quotas for the instance kind and
+// returns the entry for instanceID. An instance missing from the list
+// is reported as not found.
+func (e *external) getQuota(ctx context.Context, kind v1alpha1.InstanceQuotaTarget, instanceID string) (v1alpha1.InstanceQuotaObservation, error) {
+	switch kind {
+	case v1alpha1.InstanceQuotaTargetArgoCD:
+		quotas, err := e.Client.ListArgocdInstancesQuota(ctx)
+		if err != nil {
+			return v1alpha1.InstanceQuotaObservation{}, err
+		}
+		for _, q := range quotas {
+			if q.GetInstance().GetId() == instanceID {
+				return v1alpha1.InstanceQuotaObservation{
+					InstanceID:   instanceID,
+					InstanceName: q.GetInstance().GetName(),
+					MaxCount:     q.GetMaxAppsCount(),
+					CurrentCount: q.GetCurrentAppsCount(),
+				}, nil
+			}
+		}
+	case v1alpha1.InstanceQuotaTargetKargo:
+		quotas, err := e.Client.ListKargoInstancesQuota(ctx)
+		if err != nil {
+			return v1alpha1.InstanceQuotaObservation{}, err
+		}
+		for _, q := range quotas {
+			if q.GetInstance().GetId() == instanceID {
+				return v1alpha1.InstanceQuotaObservation{
+					InstanceID:   instanceID,
+					InstanceName: q.GetInstance().GetName(),
+					MaxCount:     q.GetMaxStageCount(),
+					CurrentCount: q.GetCurrentStageCount(),
+				}, nil
+			}
+		}
+	default:
+		return v1alpha1.InstanceQuotaObservation{}, reason.AsTerminal(fmt.Errorf("spec.forProvider.kind: unsupported instance kind %q", kind))
+	}
+	return v1alpha1.InstanceQuotaObservation{}, reason.AsNotFound(fmt.Errorf("no %s quota reported for instance %s", kind, instanceID))
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.InstanceQuota, instanceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := terminalWriteKey(mg, instanceID)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.InstanceQuota, instanceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.InstanceQuotaGroupVersionKind) {
+		return
+	}
+	key, err := terminalWriteKey(mg, instanceID)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func terminalWriteKey(mg *v1alpha1.InstanceQuota, instanceID string) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.InstanceQuotaGroupVersionKind, map[string]any{
+		"kind":       mg.Spec.ForProvider.Kind,
+		"instanceID": instanceID,
+		"maxCount":   mg.Spec.ForProvider.MaxCount,
+	})
+}
+
+// resolveInstanceID returns the opaque Akuity ID of the target
+// instance. ForProvider.InstanceID takes precedence; if absent,
+// InstanceRef is resolved against an Instance (kind ArgoCD) or
+// KargoInstance (kind Kargo) MR in the same namespace and its
+// Status.AtProvider.ID is used.
+func (e *external) resolveInstanceID(ctx context.Context, mg *v1alpha1.InstanceQuota) (string, error) {
+	if id := mg.Spec.ForProvider.InstanceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.InstanceRef == nil || mg.Spec.ForProvider.InstanceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.instanceId or spec.forProvider.instanceRef must be set")
+	}
+
+	key := k8stypes.NamespacedName{Name: mg.Spec.ForProvider.InstanceRef.Name, Namespace: mg.GetNamespace()}
+	var (
+		refKind string
+		id      func() string
+		obj     client.Object
+	)
+	switch mg.Spec.ForProvider.Kind {
+	case v1alpha1.InstanceQuotaTargetKargo:
+		ki := &v1alpha1.KargoInstance{}
+		refKind, id, obj = v1alpha1.KargoInstanceKind, func() string { return ki.Status.AtProvider.ID }, ki
+	default:
+		inst := &v1alpha1.Instance{}
+		refKind, id, obj = v1alpha1.InstanceKind, func() string { return inst.Status.AtProvider.ID }, inst
+	}
+	if err := e.Kube.Get(ctx, key, obj); err != nil {
+		return "", fmt.Errorf("could not resolve InstanceRef %s: %w", key.Name, err)
+	}
+	if id() == "" {
+		return "", fmt.Errorf("referenced %s %s has not yet reported an ID; waiting for its controller to observe", refKind, key.Name)
+	}
+	return id(), nil
+}
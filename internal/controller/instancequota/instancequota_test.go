@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancequota
+
+import (
+	"context"
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const (
+	instanceID      = "inst-1"
+	kargoInstanceID = "kargo-1"
+)
+
+func newQuota(kind v1alpha1.InstanceQuotaTarget, ref string, maxCount int32) *v1alpha1.InstanceQuota {
+	mg := &v1alpha1.InstanceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "ns", UID: "quota-uid"},
+		Spec: v1alpha1.InstanceQuotaSpec{
+			ForProvider: v1alpha1.InstanceQuotaParameters{
+				Kind:        kind,
+				InstanceRef: &v1alpha1.LocalReference{Name: ref},
+				MaxCount:    maxCount,
+			},
+		},
+	}
+	meta.SetExternalName(mg, "quota")
+	return mg
+}
+
+func newInst() *v1alpha1.Instance {
+	inst := &v1alpha1.Instance{ObjectMeta: metav1.ObjectMeta{Name: "inst", Namespace: "ns"}}
+	inst.Status.AtProvider.ID = instanceID
+	return inst
+}
+
+func newKargoInst() *v1alpha1.KargoInstance {
+	ki := &v1alpha1.KargoInstance{ObjectMeta: metav1.ObjectMeta{Name: "kargo", Namespace: "ns"}}
+	ki.Status.AtProvider.ID = kargoInstanceID
+	return ki
+}
+
+func argoQuota(id string, current, maxApps int32) *orgcv1.InstanceQuota {
+	return &orgcv1.InstanceQuota{
+		Instance:         &orgcv1.InstanceQuotaSummary{Id: id, Name: "prod"},
+		CurrentAppsCount: current,
+		MaxAppsCount:     maxApps,
+	}
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_ArgoCDUpToDate(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newQuota(v1alpha1.InstanceQuotaTargetArgoCD, "inst", 100)
+	mc.EXPECT().ListArgocdInstancesQuota(gomock.Any()).Return([]*orgcv1.InstanceQuota{
+		argoQuota("other", 5, 10),
+		argoQuota(instanceID, 42, 100),
+	}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.InstanceQuotaObservation{
+		InstanceID:   instanceID,
+		InstanceName: "prod",
+		MaxCount:     100,
+		CurrentCount: 42,
+	}, mg.Status.AtProvider)
+}
+
+func TestObserve_KargoDrift(t *testing.T) {
+	e, mc := newExt(t, newKargoInst())
+	mg := newQuota(v1alpha1.InstanceQuotaTargetKargo, "kargo", 20)
+	mc.EXPECT().ListKargoInstancesQuota(gomock.Any()).Return([]*orgcv1.KargoInstanceQuota{{
+		Instance:          &orgcv1.InstanceQuotaSummary{Id: kargoInstanceID},
+		CurrentStageCount: 3,
+		MaxStageCount:     10,
+	}}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.False(t, obs.ResourceUpToDate)
+	assert.Equal(t, int32(3), mg.Status.AtProvider.CurrentCount)
+}
+
+func TestObserve_InstanceMissingFromList(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mc.EXPECT().ListArgocdInstancesQuota(gomock.Any()).Return([]*orgcv1.InstanceQuota{argoQuota("other", 0, 10)}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), newQuota(v1alpha1.InstanceQuotaTargetArgoCD, "inst", 100))
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_DeletedReportsGone(t *testing.T) {
+	e, _ := newExt(t)
+	mg := newQuota(v1alpha1.InstanceQuotaTargetArgoCD, "inst", 100)
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_RefNotYetObserved(t *testing.T) {
+	ki := newKargoInst()
+	ki.Status.AtProvider.ID = ""
+	e, _ := newExt(t, ki)
+
+	_, err := e.Observe(context.Background(), newQuota(v1alpha1.InstanceQuotaTargetKargo, "kargo", 20))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "KargoInstance kargo has not yet reported an ID")
+}
+
+func TestCreate_WritesArgoCDQuota(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newQuota(v1alpha1.InstanceQuotaTargetArgoCD, "inst", 100)
+	meta.SetExternalName(mg, "")
+	mc.EXPECT().UpdateArgocdInstanceQuota(gomock.Any(), instanceID, int32(100)).Return(nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "quota", meta.GetExternalName(mg))
+	assert.Equal(t, instanceID, mg.Status.AtProvider.InstanceID)
+}
+
+func TestUpdate_WritesKargoQuota(t *testing.T) {
+	e, mc := newExt(t, newKargoInst())
+	mc.EXPECT().UpdateKargoInstanceQuota(gomock.Any(), kargoInstanceID, int32(20)).Return(nil).Times(1)
+
+	_, err := e.Update(context.Background(), newQuota(v1alpha1.InstanceQuotaTargetKargo, "kargo", 20))
+	require.NoError(t, err)
+}
+
+func TestUpdate_RejectedIsTerminal(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newQuota(v1alpha1.InstanceQuotaTargetArgoCD, "inst", 100)
+	mc.EXPECT().UpdateArgocdInstanceQuota(gomock.Any(), instanceID, int32(100)).
+		Return(reason.AsTerminal(errors.New("quota exceeds organization limit"))).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.Error(t, err)
+
+	mc.EXPECT().ListArgocdInstancesQuota(gomock.Any()).Times(0)
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestDelete_LeavesQuota(t *testing.T) {
+	e, _ := newExt(t)
+
+	_, err := e.Delete(context.Background(), newQuota(v1alpha1.InstanceQuotaTargetArgoCD, "inst", 100))
+	require.NoError(t, err)
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instancerepo is the InstanceRepo controller. It registers a
+// single repository on an Akuity Argo CD Instance, separately from the
+// Instance spec, so repository registrations can be owned by different
+// teams with their own RBAC and lifecycle.
+//
+// Like InstanceIpAllowList it is a narrow resource: it writes only the
+// one repository and leaves the rest of the instance alone. The
+// gateway exposes CreateInstanceRepo and ListInstanceRepos only, so the
+// repository URL is the identity, Update re-issues CreateInstanceRepo
+// with the full payload, and there is no way to remove a registration.
+// Deleting the MR stops managing the repository but leaves it on the
+// instance.
+//
+// Credentials are sourced from an optional Kubernetes Secret. The
+// platform never returns them, so the controller records a hash of the
+// source Secret after each write and compares it on Observe to detect
+// rotation.
+package instancerepo
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
+)
+
+// Setup registers the controller with the manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceRepoGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.InstanceRepo]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.InstanceRepo] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceRepoGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.InstanceRepo](conn),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.InstanceRepo{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.InstanceRepo) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+
+	// The gateway cannot remove a repository registration, so there is
+	// nothing for Delete to wait on. Reporting the resource as gone
+	// lets the managed reconciler release the finalizer straight away
+	// and leaves the repository on the instance.
+	if meta.WasDeleted(mg) {
+		e.ClearTerminalWriteResource(mg, v1alpha1.InstanceRepoGroupVersionKind)
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	// Short-circuit on a cached terminal write before any gateway round-
+	// trip. With NameAsExternalName the external-name is stamped before
+	// Create runs, so a rejected write would otherwise loop
+	// ListInstanceRepos->CreateInstanceRepo at controller-runtime
+	// backoff.
+	if e.HasTerminalWriteResource(mg, v1alpha1.InstanceRepoGroupVersionKind) {
+		if obs, err, ok := e.suppressTerminalWrite(ctx, mg, instanceID); ok {
+			return obs, err
+		}
+	}
+	if meta.GetExternalName(mg) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	repo, err := e.Client.GetInstanceRepo(ctx, instanceID, mg.Spec.ForProvider.URL)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	// SecretHash is written after a successful write; preserve it
+	// across the assignment.
+	prevHash := mg.Status.AtProvider.SecretHash
+	mg.Status.AtProvider = instanceRepoObservation(instanceID, repo)
+	mg.Status.AtProvider.SecretHash = prevHash
+	base.SetHealthCondition(mg, true)
+
+	desired := mg.Spec.ForProvider
+	observed := instanceRepoParameters(repo)
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "InstanceRepo")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Credential drift: the gateway never returns credentials, so
+	// compare the source Secret's digest against the last-written hash.
+	if upToDate {
+		sec, err := resolveSource(ctx, e.Kube, mg)
+		if err != nil {
+			mg.SetConditions(xpv1.ReconcileError(err))
+			return managed.ExternalObservation{}, err
+		}
+		if sec.Hash() != mg.Status.AtProvider.SecretHash {
+			e.Logger.Debug("InstanceRepo secret hash changed; forcing Update",
+				"previous", mg.Status.AtProvider.SecretHash, "current", sec.Hash())
+			upToDate = false
+		}
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(ctx, mg, instanceID); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(ctx, mg, instanceID)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.InstanceRepo,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.InstanceRepo) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	if err := e.write(ctx, mg); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(mg, mg.GetName())
+	return managed.ExternalCreation{}, nil
+}
+
+// Update re-issues CreateInstanceRepo, the only write the gateway
+// exposes for repositories, with the full desired payload.
+func (e *external) Update(ctx context.Context, mg *v1alpha1.InstanceRepo) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	return managed.ExternalUpdate{}, e.write(ctx, mg)
+}
+
+// Delete is a no-op beyond clearing cached terminal writes: the gateway
+// has no endpoint to remove a repository registration, so it stays on
+// the instance. Observe normally reports a deleted MR as gone before
+// Delete is reached.
+func (e *external) Delete(_ context.Context, mg *v1alpha1.InstanceRepo) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.InstanceRepoGroupVersionKind)
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// write sends the desired registration, merged with the source
+// credentials, through CreateInstanceRepo.
+func (e *external) write(ctx context.Context, mg *v1alpha1.InstanceRepo) error {
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return err
+	}
+	sec, err := resolveSource(ctx, e.Kube, mg)
+	if err != nil {
+		return err
+	}
+	key, err := instanceRepoTerminalWriteKey(mg, instanceID, sec)
+	if err != nil {
+		return err
+	}
+	if _, err := e.Client.CreateInstanceRepo(ctx, instanceID, repoData(mg.Spec.ForProvider, sec.Data)); err != nil {
+		return e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider.InstanceID = instanceID
+	mg.Status.AtProvider.SecretHash = sec.Hash()
+	return nil
+}
+
+// repoData builds the Argo CD repository secret payload. Spec fields
+// take precedence over the same keys in the credential Secret.
+func repoData(fp v1alpha1.InstanceRepoParameters, creds map[string]string) map[string]string {
+	data := make(map[string]string, len(creds)+4)
+	maps.Copy(data, creds)
+	data["url"] = fp.URL
+	for k, v := range map[string]string{"type": fp.Type, "project": fp.Project, "name": fp.Name} {
+		if v != "" {
+			data[k] = v
+		}
+	}
+	return data
+}
+
+// resolveSource loads the optional credential Secret. Missing, empty or
+// malformed references are terminal configuration errors.
+func resolveSource(ctx context.Context, kube client.Client, mg *v1alpha1.InstanceRepo) (secrets.ResolvedSecret, error) {
+	sec, err := secrets.Resolve(ctx, kube, mg.Spec.ForProvider.SecretRef)
+	if err != nil {
+		return secrets.ResolvedSecret{}, secrets.AsTerminalIfConfig(fmt.Errorf("secretRef: %w", err))
+	}
+	return sec, nil
+}
+
+// resolveInstanceID returns the opaque Akuity ID of the target Instance.
+// ForProvider.InstanceID takes precedence; if absent, InstanceRef is
+// resolved against an Instance MR and its Status.AtProvider.ID is used.
+// The cached Status.AtProvider.InstanceID is only consulted during
+// deletion once the referenced Instance MR is gone.
+func (e *external) resolveInstanceID(ctx context.Context, mg *v1alpha1.InstanceRepo) (string, error) {
+	if id := mg.Spec.ForProvider.InstanceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.InstanceRef == nil || mg.Spec.ForProvider.InstanceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.instanceId or spec.forProvider.instanceRef must be set")
+	}
+
+	inst := &v1alpha1.Instance{}
+	key := k8stypes.NamespacedName{Name: mg.Spec.ForProvider.InstanceRef.Name, Namespace: mg.GetNamespace()}
+	if err := e.Kube.Get(ctx, key, inst); err != nil {
+		if apierrors.IsNotFound(err) && meta.WasDeleted(mg) {
+			if cached := mg.Status.AtProvider.InstanceID; cached != "" {
+				return cached, nil
+			}
+		}
+		return "", fmt.Errorf("could not resolve InstanceRef %s: %w", key.Name, err)
+	}
+	if inst.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced Instance %s has not yet reported an ID; waiting for its controller to observe", key.Name)
+	}
+	return inst.Status.AtProvider.ID, nil
+}
+
+func (e *external) suppressTerminalWrite(ctx context.Context, mg *v1alpha1.InstanceRepo, instanceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	sec, err := resolveSource(ctx, e.Kube, mg)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	key, err := instanceRepoTerminalWriteKey(mg, instanceID, sec)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(ctx context.Context, mg *v1alpha1.InstanceRepo, instanceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.InstanceRepoGroupVersionKind) {
+		return
+	}
+	sec, err := resolveSource(ctx, e.Kube, mg)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	key, err := instanceRepoTerminalWriteKey(mg, instanceID, sec)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func instanceRepoTerminalWriteKey(mg *v1alpha1.InstanceRepo, instanceID string, sec secrets.ResolvedSecret) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.InstanceRepoGroupVersionKind, instanceID, mg.Spec.ForProvider, sec.Hash())
+}
+
+// driftSpec is the resource's drift-detection recipe. The URL is the
+// lookup key and the credentials are tracked through SecretHash, so
+// only type and project are compared. The platform neither reports
+// the display name nor can clear a field once set, so an unset type or
+// project is treated as unmanaged.
+func driftSpec() base.DriftSpec[v1alpha1.InstanceRepoParameters] {
+	return base.DriftSpec[v1alpha1.InstanceRepoParameters]{
+		Ignore: []cmp.Option{
+			cmpopts.IgnoreFields(v1alpha1.InstanceRepoParameters{}, "InstanceID", "InstanceRef", "URL", "Name", "SecretRef"),
+		},
+		Normalize: func(desired, observed *v1alpha1.InstanceRepoParameters) {
+			if desired.Type == "" {
+				observed.Type = ""
+			}
+			if desired.Project == "" {
+				observed.Project = ""
+			}
+		},
+	}
+}
+
+func instanceRepoParameters(r *argocdv1.Repository) v1alpha1.InstanceRepoParameters {
+	return v1alpha1.InstanceRepoParameters{
+		URL:     r.GetRepo(),
+		Type:    r.GetType(),
+		Project: r.GetProject(),
+	}
+}
+
+func instanceRepoObservation(instanceID string, r *argocdv1.Repository) v1alpha1.InstanceRepoObservation {
+	return v1alpha1.InstanceRepoObservation{
+		InstanceID: instanceID,
+		URL:        r.GetRepo(),
+		Type:       r.GetType(),
+		Project:    r.GetProject(),
+	}
+}
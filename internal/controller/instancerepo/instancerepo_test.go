@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancerepo
+
+import (
+	"context"
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
+)
+
+const (
+	instanceID = "inst-1"
+	repoURL    = "https://github.com/example/apps.git"
+)
+
+var creds = map[string]string{"username": "bot", "password": "s3cr3t"}
+
+func newInstanceRepo() *v1alpha1.InstanceRepo {
+	mg := &v1alpha1.InstanceRepo{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", UID: "apps-uid"},
+		Spec: v1alpha1.InstanceRepoSpec{
+			ForProvider: v1alpha1.InstanceRepoParameters{
+				InstanceRef: &v1alpha1.LocalReference{Name: "prod"},
+				URL:         repoURL,
+				Type:        "git",
+				Project:     "team-a",
+				SecretRef:   &xpv1.SecretReference{Namespace: "akuity", Name: "apps-creds"},
+			},
+		},
+	}
+	meta.SetExternalName(mg, "apps")
+	return mg
+}
+
+func prodInstance() *v1alpha1.Instance {
+	inst := &v1alpha1.Instance{ObjectMeta: metav1.ObjectMeta{Name: "prod"}}
+	inst.Status.AtProvider.ID = instanceID
+	return inst
+}
+
+func sourceSecret(data map[string]string) *corev1.Secret {
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "akuity", Name: "apps-creds"},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range data {
+		s.Data[k] = []byte(v)
+	}
+	return s
+}
+
+func sourceHash(data map[string]string) string {
+	return secrets.ResolvedSecret{Namespace: "akuity", Name: "apps-creds", Data: data}.Hash()
+}
+
+func platformRepo(project string) *argocdv1.Repository {
+	return &argocdv1.Repository{Repo: ptr.To(repoURL), Type: ptr.To("git"), Project: ptr.To(project)}
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_NotFound(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(creds))
+	mc.EXPECT().GetInstanceRepo(gomock.Any(), instanceID, repoURL).Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), newInstanceRepo())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_UpToDate(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(creds))
+	mg := newInstanceRepo()
+	mg.Status.AtProvider.SecretHash = sourceHash(creds)
+	mc.EXPECT().GetInstanceRepo(gomock.Any(), instanceID, repoURL).Return(platformRepo("team-a"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, repoURL, mg.Status.AtProvider.URL)
+	assert.Equal(t, "team-a", mg.Status.AtProvider.Project)
+	assert.Equal(t, sourceHash(creds), mg.Status.AtProvider.SecretHash)
+}
+
+func TestObserve_ProjectDrift(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(creds))
+	mg := newInstanceRepo()
+	mg.Status.AtProvider.SecretHash = sourceHash(creds)
+	mc.EXPECT().GetInstanceRepo(gomock.Any(), instanceID, repoURL).Return(platformRepo("team-b"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_UnsetFieldsAreUnmanaged(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(creds))
+	mg := newInstanceRepo()
+	mg.Spec.ForProvider.Type = ""
+	mg.Spec.ForProvider.Project = ""
+	mg.Status.AtProvider.SecretHash = sourceHash(creds)
+	mc.EXPECT().GetInstanceRepo(gomock.Any(), instanceID, repoURL).Return(platformRepo("team-b"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_RotatedSecretDrifts(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(map[string]string{"username": "bot", "password": "rotated"}))
+	mg := newInstanceRepo()
+	mg.Status.AtProvider.SecretHash = sourceHash(creds)
+	mc.EXPECT().GetInstanceRepo(gomock.Any(), instanceID, repoURL).Return(platformRepo("team-a"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_MissingSourceSecretIsTerminal(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mc.EXPECT().GetInstanceRepo(gomock.Any(), instanceID, repoURL).Return(platformRepo("team-a"), nil).Times(1)
+
+	_, err := e.Observe(context.Background(), newInstanceRepo())
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestObserve_DeletedReleasesWithoutGatewayCall(t *testing.T) {
+	e, _ := newExt(t)
+	mg := newInstanceRepo()
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestCreate_MergesCredentials(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(map[string]string{"username": "bot", "password": "s3cr3t", "url": "https://ignored"}))
+	mg := newInstanceRepo()
+	mc.EXPECT().CreateInstanceRepo(gomock.Any(), instanceID, map[string]string{
+		"url":      repoURL,
+		"type":     "git",
+		"project":  "team-a",
+		"username": "bot",
+		"password": "s3cr3t",
+	}).Return(platformRepo("team-a"), nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "apps", meta.GetExternalName(mg))
+	assert.Equal(t, instanceID, mg.Status.AtProvider.InstanceID)
+	assert.NotEmpty(t, mg.Status.AtProvider.SecretHash)
+}
+
+func TestCreate_WithoutSecret(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mg := newInstanceRepo()
+	mg.Spec.ForProvider.SecretRef = nil
+	mg.Spec.ForProvider.Project = ""
+	mc.EXPECT().CreateInstanceRepo(gomock.Any(), instanceID, map[string]string{"url": repoURL, "type": "git"}).
+		Return(platformRepo(""), nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Empty(t, mg.Status.AtProvider.SecretHash)
+}
+
+func TestUpdate_RejectedIsTerminal(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(creds))
+	mg := newInstanceRepo()
+	mc.EXPECT().CreateInstanceRepo(gomock.Any(), instanceID, gomock.Any()).
+		Return(nil, reason.AsTerminal(errors.New("invalid repo"))).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.Error(t, err)
+
+	mc.EXPECT().GetInstanceRepo(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestDelete_LeavesRepository(t *testing.T) {
+	e, _ := newExt(t)
+	_, err := e.Delete(context.Background(), newInstanceRepo())
+	require.NoError(t, err)
+}
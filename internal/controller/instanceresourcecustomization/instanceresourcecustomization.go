@@ -0,0 +1,386 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instanceresourcecustomization is the
+// InstanceResourceCustomization controller. It owns the resource
+// customizations (Lua health checks, actions, ignore-differences and
+// known type fields) of an Akuity ArgoCD Instance via the dedicated
+// Get/UpdateInstanceResourceCustomizations endpoints. Other instance
+// settings are untouched, so this MR can coexist with an Instance MR
+// that leaves resource.customizations out of its argocd-cm.
+//
+// Lua scripts are compiled locally before every write. A script that
+// does not compile is a terminal spec error and is never sent.
+package instanceresourcecustomization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceResourceCustomizationGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.InstanceResourceCustomization]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.InstanceResourceCustomization] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceResourceCustomizationGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.InstanceResourceCustomization](conn),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.InstanceResourceCustomization{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.InstanceResourceCustomization) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	// Short-circuit on a cached terminal write before any gateway round-
+	// trip. With NameAsExternalName the external-name is stamped before
+	// Create runs, so a write rejected locally (Lua that does not
+	// compile) or by the platform would otherwise loop at
+	// controller-runtime backoff.
+	if e.HasTerminalWriteResource(mg, v1alpha1.InstanceResourceCustomizationGroupVersionKind) {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	}
+	if meta.GetExternalName(mg) == "" {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	resp, err := e.Client.GetInstanceResourceCustomizations(ctx, instanceID)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	observed := v1alpha1.InstanceResourceCustomizationParameters{
+		Customizations:               customizationsFromProto(resp.GetResourceCustomizations()),
+		IgnoreResourceUpdatesEnabled: resp.IgnoreResourceUpdatesEnabled,
+	}
+	mg.Status.AtProvider = v1alpha1.InstanceResourceCustomizationObservation{
+		Customizations:               observed.Customizations,
+		IgnoreResourceUpdatesEnabled: observed.IgnoreResourceUpdatesEnabled,
+		InstanceID:                   instanceID,
+	}
+	base.SetHealthCondition(mg, true)
+
+	// Deletion fast-path: Delete() writes an empty list, so once the
+	// instance reports no customizations the MR is gone. Without this
+	// the drift compare below keeps dispatching Delete for as long as
+	// the MR lingers on its finalizer.
+	if meta.WasDeleted(mg) && len(observed.Customizations) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	desired := mg.Spec.ForProvider
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "InstanceResourceCustomization")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg, instanceID)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.InstanceResourceCustomization,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.InstanceResourceCustomization) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	fp := mg.Spec.ForProvider
+	if err := e.update(ctx, mg, fp.Customizations, fp.IgnoreResourceUpdatesEnabled); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(mg, mg.GetName())
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.InstanceResourceCustomization) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	fp := mg.Spec.ForProvider
+	return managed.ExternalUpdate{}, e.update(ctx, mg, fp.Customizations, fp.IgnoreResourceUpdatesEnabled)
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.InstanceResourceCustomization) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.InstanceResourceCustomizationGroupVersionKind)
+
+	// Delete clears the customization list rather than deleting the
+	// Instance. ignoreResourceUpdatesEnabled is left as is. This
+	// assumes the MR exclusively owns the instance's customizations.
+	return managed.ExternalDelete{}, e.update(ctx, mg, nil, nil)
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// update validates the desired customizations and writes them through
+// UpdateInstanceResourceCustomizations, which replaces the whole list.
+func (e *external) update(ctx context.Context, mg *v1alpha1.InstanceResourceCustomization, desired []v1alpha1.ResourceCustomization, enabled *bool) error {
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return err
+	}
+	key, err := terminalWriteKey(mg, instanceID, desired, enabled)
+	if err != nil {
+		return err
+	}
+	if err := validate(desired); err != nil {
+		return e.RecordTerminalWrite(key, err)
+	}
+
+	if err := e.Client.UpdateInstanceResourceCustomizations(ctx, instanceID, customizationsToProto(desired), enabled); err != nil {
+		err = reason.ClassifyApplyError(err)
+		if meta.WasDeleted(mg) {
+			return err
+		}
+		return e.RecordTerminalWrite(key, err)
+	}
+	if !meta.WasDeleted(mg) {
+		e.ClearTerminalWrite(key)
+	}
+	return nil
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.InstanceResourceCustomization, instanceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	fp := mg.Spec.ForProvider
+	key, err := terminalWriteKey(mg, instanceID, fp.Customizations, fp.IgnoreResourceUpdatesEnabled)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.InstanceResourceCustomization, instanceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.InstanceResourceCustomizationGroupVersionKind) {
+		return
+	}
+	fp := mg.Spec.ForProvider
+	key, err := terminalWriteKey(mg, instanceID, fp.Customizations, fp.IgnoreResourceUpdatesEnabled)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func terminalWriteKey(mg *v1alpha1.InstanceResourceCustomization, instanceID string, desired []v1alpha1.ResourceCustomization, enabled *bool) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.InstanceResourceCustomizationGroupVersionKind, map[string]any{
+		"instanceID":                   instanceID,
+		"customizations":               desired,
+		"ignoreResourceUpdatesEnabled": enabled,
+	})
+}
+
+// resolveInstanceID returns the opaque Akuity ID of the target Instance.
+// ForProvider.InstanceID takes precedence; if absent, InstanceRef is
+// resolved against an Instance MR in the same namespace and its
+// Status.AtProvider.ID is used. The cached Status.AtProvider.InstanceID
+// is only consulted during deletion once the referenced Instance MR is
+// gone.
+func (e *external) resolveInstanceID(ctx context.Context, mg *v1alpha1.InstanceResourceCustomization) (string, error) {
+	if id := mg.Spec.ForProvider.InstanceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.InstanceRef == nil || mg.Spec.ForProvider.InstanceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.instanceId or spec.forProvider.instanceRef must be set")
+	}
+
+	inst := &v1alpha1.Instance{}
+	key := k8stypes.NamespacedName{Name: mg.Spec.ForProvider.InstanceRef.Name, Namespace: mg.GetNamespace()}
+	if err := e.Kube.Get(ctx, key, inst); err != nil {
+		if apierrors.IsNotFound(err) && meta.WasDeleted(mg) {
+			if cached := mg.Status.AtProvider.InstanceID; cached != "" {
+				return cached, nil
+			}
+		}
+		return "", fmt.Errorf("could not resolve InstanceRef %s/%s: %w", key.Namespace, key.Name, err)
+	}
+	if inst.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced Instance %s/%s has not yet reported an ID; waiting for its controller to observe", key.Namespace, key.Name)
+	}
+	return inst.Status.AtProvider.ID, nil
+}
+
+// driftSpec is the resource's drift-detection recipe. The list is a
+// set keyed by group and kind, so both sides are sorted. Scripts are
+// compared ignoring trailing newlines, which YAML block scalars add and
+// the platform may trim. Unset useOpenLibs and
+// ignoreResourceUpdatesEnabled are left unmanaged.
+func driftSpec() base.DriftSpec[v1alpha1.InstanceResourceCustomizationParameters] {
+	return base.DriftSpec[v1alpha1.InstanceResourceCustomizationParameters]{
+		Ignore: []cmp.Option{
+			cmpopts.IgnoreFields(v1alpha1.InstanceResourceCustomizationParameters{}, "InstanceID", "InstanceRef"),
+		},
+		Normalize: func(desired, observed *v1alpha1.InstanceResourceCustomizationParameters) {
+			if desired.IgnoreResourceUpdatesEnabled == nil {
+				observed.IgnoreResourceUpdatesEnabled = nil
+			}
+			desired.Customizations = normalizeCustomizations(desired.Customizations)
+			observed.Customizations = normalizeCustomizations(observed.Customizations)
+			byGK := make(map[string]v1alpha1.ResourceCustomization, len(observed.Customizations))
+			for _, c := range observed.Customizations {
+				byGK[groupKind(c)] = c
+			}
+			for i := range desired.Customizations {
+				if desired.Customizations[i].UseOpenLibs == nil {
+					desired.Customizations[i].UseOpenLibs = byGK[groupKind(desired.Customizations[i])].UseOpenLibs
+				}
+			}
+		},
+	}
+}
+
+func normalizeCustomizations(in []v1alpha1.ResourceCustomization) []v1alpha1.ResourceCustomization {
+	out := make([]v1alpha1.ResourceCustomization, 0, len(in))
+	for _, c := range in {
+		c.Health = strings.TrimRight(c.Health, "\n")
+		c.Actions = strings.TrimRight(c.Actions, "\n")
+		c.IgnoreDifferences = strings.TrimRight(c.IgnoreDifferences, "\n")
+		c.IgnoreResourceUpdates = strings.TrimRight(c.IgnoreResourceUpdates, "\n")
+		c.KnownTypeFields = strings.TrimRight(c.KnownTypeFields, "\n")
+		out = append(out, c)
+	}
+	slices.SortFunc(out, func(a, b v1alpha1.ResourceCustomization) int {
+		return strings.Compare(groupKind(a), groupKind(b))
+	})
+	return out
+}
+
+func customizationsToProto(in []v1alpha1.ResourceCustomization) []*argocdv1.ResourceCustomizationConfig {
+	out := make([]*argocdv1.ResourceCustomizationConfig, 0, len(in))
+	for _, c := range in {
+		out = append(out, &argocdv1.ResourceCustomizationConfig{
+			Group:                 c.Group,
+			Kind:                  c.Kind,
+			Health:                c.Health,
+			Actions:               c.Actions,
+			IgnoreDifferences:     c.IgnoreDifferences,
+			IgnoreResourceUpdates: c.IgnoreResourceUpdates,
+			KnownTypeFields:       c.KnownTypeFields,
+			UseOpenLibs:           c.UseOpenLibs,
+		})
+	}
+	return out
+}
+
+func customizationsFromProto(in []*argocdv1.ResourceCustomizationConfig) []v1alpha1.ResourceCustomization {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]v1alpha1.ResourceCustomization, 0, len(in))
+	for _, c := range in {
+		if c == nil {
+			continue
+		}
+		out = append(out, v1alpha1.ResourceCustomization{
+			Group:                 c.GetGroup(),
+			Kind:                  c.GetKind(),
+			Health:                c.GetHealth(),
+			Actions:               c.GetActions(),
+			IgnoreDifferences:     c.GetIgnoreDifferences(),
+			IgnoreResourceUpdates: c.GetIgnoreResourceUpdates(),
+			KnownTypeFields:       c.GetKnownTypeFields(),
+			UseOpenLibs:           c.UseOpenLibs,
+		})
+	}
+	return out
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceresourcecustomization
+
+import (
+	"context"
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const (
+	instanceID = "inst-1"
+
+	healthLua = `hs = {}
+hs.status = "Healthy"
+return hs
+`
+	actionsYAML = `discovery.lua: |
+  actions = {}
+  actions["restart"] = {}
+  return actions
+definitions:
+- name: restart
+  action.lua: |
+    return obj
+`
+)
+
+func newCustomization() *v1alpha1.InstanceResourceCustomization {
+	mg := &v1alpha1.InstanceResourceCustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "ns", UID: "custom-uid"},
+		Spec: v1alpha1.InstanceResourceCustomizationSpec{
+			ForProvider: v1alpha1.InstanceResourceCustomizationParameters{
+				InstanceRef: &v1alpha1.LocalReference{Name: "inst"},
+				Customizations: []v1alpha1.ResourceCustomization{
+					{Group: "argoproj.io", Kind: "Rollout", Health: healthLua},
+					{Group: "apps", Kind: "Deployment", Actions: actionsYAML},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(mg, "custom")
+	return mg
+}
+
+func newInst() *v1alpha1.Instance {
+	inst := &v1alpha1.Instance{ObjectMeta: metav1.ObjectMeta{Name: "inst", Namespace: "ns"}}
+	inst.Status.AtProvider.ID = instanceID
+	return inst
+}
+
+func platformCustomizations() *argocdv1.GetInstanceResourceCustomizationsResponse {
+	return &argocdv1.GetInstanceResourceCustomizationsResponse{
+		ResourceCustomizations: []*argocdv1.ResourceCustomizationConfig{
+			{Group: "apps", Kind: "Deployment", Actions: actionsYAML, UseOpenLibs: ptr.To(false)},
+			{Group: "argoproj.io", Kind: "Rollout", Health: "hs = {}\nhs.status = \"Healthy\"\nreturn hs", UseOpenLibs: ptr.To(false)},
+		},
+		IgnoreResourceUpdatesEnabled: ptr.To(true),
+	}
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_UpToDateIgnoresOrderAndUnsetFields(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCustomization()
+	mc.EXPECT().GetInstanceResourceCustomizations(gomock.Any(), instanceID).Return(platformCustomizations(), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Len(t, mg.Status.AtProvider.Customizations, 2)
+	assert.Equal(t, instanceID, mg.Status.AtProvider.InstanceID)
+}
+
+func TestObserve_ScriptDrift(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCustomization()
+	mg.Spec.ForProvider.Customizations[0].Health = "return { status = \"Degraded\" }"
+	mc.EXPECT().GetInstanceResourceCustomizations(gomock.Any(), instanceID).Return(platformCustomizations(), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_ExtraObservedEntryDrifts(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCustomization()
+	mg.Spec.ForProvider.Customizations = mg.Spec.ForProvider.Customizations[:1]
+	mc.EXPECT().GetInstanceResourceCustomizations(gomock.Any(), instanceID).Return(platformCustomizations(), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_IgnoreResourceUpdatesEnabledDrift(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCustomization()
+	mg.Spec.ForProvider.IgnoreResourceUpdatesEnabled = ptr.To(false)
+	mc.EXPECT().GetInstanceResourceCustomizations(gomock.Any(), instanceID).Return(platformCustomizations(), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_DeletedAndCleared(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCustomization()
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+	mc.EXPECT().GetInstanceResourceCustomizations(gomock.Any(), instanceID).
+		Return(&argocdv1.GetInstanceResourceCustomizationsResponse{}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestCreate_WritesCustomizations(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCustomization()
+	meta.SetExternalName(mg, "")
+	mg.Spec.ForProvider.IgnoreResourceUpdatesEnabled = ptr.To(true)
+	mc.EXPECT().UpdateInstanceResourceCustomizations(gomock.Any(), instanceID, []*argocdv1.ResourceCustomizationConfig{
+		{Group: "argoproj.io", Kind: "Rollout", Health: healthLua},
+		{Group: "apps", Kind: "Deployment", Actions: actionsYAML},
+	}, ptr.To(true)).Return(nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "custom", meta.GetExternalName(mg))
+}
+
+func TestUpdate_LuaSyntaxErrorIsTerminal(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCustomization()
+	mg.Spec.ForProvider.Customizations[0].Health = "hs = {\nreturn hs"
+	mc.EXPECT().UpdateInstanceResourceCustomizations(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := e.Update(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+	assert.Contains(t, err.Error(), "customizations[0].health")
+
+	// The cached terminal write suppresses the next Observe without a
+	// gateway round-trip.
+	mc.EXPECT().GetInstanceResourceCustomizations(gomock.Any(), gomock.Any()).Times(0)
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestUpdate_ActionLuaSyntaxErrorIsTerminal(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCustomization()
+	mg.Spec.ForProvider.Customizations[1].Actions = "definitions:\n- name: restart\n  action.lua: |\n    if obj then\n"
+	mc.EXPECT().UpdateInstanceResourceCustomizations(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := e.Update(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+	assert.Contains(t, err.Error(), `action "restart"`)
+}
+
+func TestUpdate_DuplicateGroupKindIsTerminal(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCustomization()
+	mg.Spec.ForProvider.Customizations = append(mg.Spec.ForProvider.Customizations,
+		v1alpha1.ResourceCustomization{Group: "apps", Kind: "Deployment"})
+	mc.EXPECT().UpdateInstanceResourceCustomizations(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := e.Update(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestUpdate_PlatformRejectionIsRecorded(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCustomization()
+	mc.EXPECT().UpdateInstanceResourceCustomizations(gomock.Any(), instanceID, gomock.Any(), gomock.Any()).
+		Return(reason.AsTerminal(errors.New("invalid customization"))).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestDelete_ClearsList(t *testing.T) {
+	e, mc := newExt(t, newInst())
+	mg := newCustomization()
+	mc.EXPECT().UpdateInstanceResourceCustomizations(gomock.Any(), instanceID, []*argocdv1.ResourceCustomizationConfig{}, nil).
+		Return(nil).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceresourcecustomization
+
+import (
+	"fmt"
+	"strings"
+
+	lua "github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/parse"
+	"sigs.k8s.io/yaml"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// resourceActions is the subset of Argo CD's resource actions
+// definition that carries Lua.
+type resourceActions struct {
+	Discovery   string `json:"discovery.lua,omitempty"`
+	Definitions []struct {
+		Name   string `json:"name"`
+		Action string `json:"action.lua"`
+	} `json:"definitions,omitempty"`
+}
+
+// validate rejects customizations the platform would accept but Argo
+// CD could never run: duplicate group/kind entries and Lua that does
+// not compile. Every failure is a spec error, so it is returned as
+// terminal and the write is not attempted.
+func validate(in []v1alpha1.ResourceCustomization) error {
+	seen := make(map[string]bool, len(in))
+	for i, c := range in {
+		gk := groupKind(c)
+		if seen[gk] {
+			return reason.AsTerminal(fmt.Errorf("spec.forProvider.customizations[%d]: duplicate entry for %s", i, gk))
+		}
+		seen[gk] = true
+
+		if err := compileLua(c.Health, gk+" health"); err != nil {
+			return reason.AsTerminal(fmt.Errorf("spec.forProvider.customizations[%d].health: %w", i, err))
+		}
+		if err := validateActions(c.Actions, gk); err != nil {
+			return reason.AsTerminal(fmt.Errorf("spec.forProvider.customizations[%d].actions: %w", i, err))
+		}
+	}
+	return nil
+}
+
+func validateActions(actions, gk string) error {
+	if strings.TrimSpace(actions) == "" {
+		return nil
+	}
+	var a resourceActions
+	if err := yaml.Unmarshal([]byte(actions), &a); err != nil {
+		return fmt.Errorf("could not parse actions: %w", err)
+	}
+	if err := compileLua(a.Discovery, gk+" discovery"); err != nil {
+		return fmt.Errorf("discovery.lua: %w", err)
+	}
+	for _, d := range a.Definitions {
+		if err := compileLua(d.Action, gk+" action "+d.Name); err != nil {
+			return fmt.Errorf("action %q: %w", d.Name, err)
+		}
+	}
+	return nil
+}
+
+// compileLua parses and compiles script without running it. An empty
+// script is valid.
+func compileLua(script, name string) error {
+	if strings.TrimSpace(script) == "" {
+		return nil
+	}
+	chunk, err := parse.Parse(strings.NewReader(script), name)
+	if err != nil {
+		return err
+	}
+	_, err = lua.Compile(chunk, name)
+	return err
+}
+
+func groupKind(c v1alpha1.ResourceCustomization) string {
+	if c.Group == "" {
+		return c.Kind
+	}
+	return c.Group + "/" + c.Kind
+}
@@ -89,11 +89,12 @@ func BuildApplyKargoInstanceRequest(kargoInstanceID string, p v1alpha1.KargoAgen
 
 // apiToSpec rebuilds KargoAgentParameters from the
 // observed Akuity KargoAgent. Fields that the user owns locally
-// (KargoInstanceID / KargoInstanceRef / Workspace, plus the agent-install
-// kubeconfig trio that never round-trips through the Akuity gateway:
-// KubeConfigSecretRef / EnableInClusterKubeConfig /
-// RemoveAgentResourcesOnDestroy) are carried over from the managed
-// resource so drift detection compares apples to apples. Namespace /
+// (KargoInstanceID / KargoInstanceRef / Workspace / WorkspaceRef, plus
+// the agent-install target fields that never round-trip through the
+// Akuity gateway: KubeConfigSecretRef / EnableInClusterKubeConfig /
+// ManifestsSink / RemoveAgentResourcesOnDestroy, and MaintenanceWindows) are carried
+// over from the managed resource so drift detection compares apples to
+// apples. Namespace /
 // Labels / Annotations live inside the proto Data sub-tree on the wire.
 func apiToSpec(desired v1alpha1.KargoAgentParameters, agent *kargov1.KargoAgent) v1alpha1.KargoAgentParameters {
 	data := agent.GetData()
@@ -103,11 +104,14 @@ func apiToSpec(desired v1alpha1.KargoAgentParameters, agent *kargov1.KargoAgent)
 		Name:                          agent.GetName(),
 		Namespace:                     data.GetNamespace(),
 		Workspace:                     desired.Workspace,
+		WorkspaceRef:                  desired.WorkspaceRef,
 		Labels:                        data.GetLabels(),
 		Annotations:                   data.GetAnnotations(),
 		KubeConfigSecretRef:           desired.KubeConfigSecretRef,
 		EnableInClusterKubeConfig:     desired.EnableInClusterKubeConfig,
+		ManifestsSink:                 desired.ManifestsSink,
 		RemoveAgentResourcesOnDestroy: desired.RemoveAgentResourcesOnDestroy,
+		MaintenanceWindows:            desired.MaintenanceWindows,
 	}
 	// Description + data live under the KargoAgentSpec wrapper,
 	// mirroring the Cluster shape where payload lives under
@@ -126,10 +130,11 @@ func apiToSpec(desired v1alpha1.KargoAgentParameters, agent *kargov1.KargoAgent)
 // KargoAgent that ExportKargoInstance returns inside its Agents slice.
 // Namespace / Labels / Annotations live on ObjectMeta in the wire
 // form (not on Data, as in the proto). Spec-only fields that the Akuity
-// API does not own (KargoInstanceID / KargoInstanceRef / Workspace,
-// plus the agent-install kubeconfig trio: KubeConfigSecretRef /
-// EnableInClusterKubeConfig / RemoveAgentResourcesOnDestroy) are
-// carried from desired so drift detection compares apples to apples.
+// API does not own (KargoInstanceID / KargoInstanceRef / Workspace /
+// WorkspaceRef, plus the agent-install target fields:
+// KubeConfigSecretRef / EnableInClusterKubeConfig / ManifestsSink /
+// RemoveAgentResourcesOnDestroy, and MaintenanceWindows) are carried
+// from desired so drift detection compares apples to apples.
 func wireToSpec(desired v1alpha1.KargoAgentParameters, wire *akuitytypes.KargoAgent) v1alpha1.KargoAgentParameters {
 	if wire == nil {
 		return v1alpha1.KargoAgentParameters{}
@@ -140,11 +145,14 @@ func wireToSpec(desired v1alpha1.KargoAgentParameters, wire *akuitytypes.KargoAg
 		Name:                          wire.GetName(),
 		Namespace:                     wire.Namespace,
 		Workspace:                     desired.Workspace,
+		WorkspaceRef:                  desired.WorkspaceRef,
 		Labels:                        wire.Labels,
 		Annotations:                   wire.Annotations,
 		KubeConfigSecretRef:           desired.KubeConfigSecretRef,
 		EnableInClusterKubeConfig:     desired.EnableInClusterKubeConfig,
+		ManifestsSink:                 desired.ManifestsSink,
 		RemoveAgentResourcesOnDestroy: desired.RemoveAgentResourcesOnDestroy,
+		MaintenanceWindows:            desired.MaintenanceWindows,
 	}
 	out.KargoAgentSpec.Description = wire.Spec.Description
 	if d := crossplanetypes.KargoAgentDataAPIToSpec(&wire.Spec.Data); d != nil {
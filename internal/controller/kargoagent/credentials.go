@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kargoagent
+
+import (
+	"context"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+)
+
+// rotationPending reports whether a credential rotation is requested or
+// still has manifests to deliver.
+func rotationPending(mg *v1alpha1.KargoAgent) bool {
+	return base.CredentialRotationPending(mg, mg.Status.AtProvider.CredentialRotationStatus)
+}
+
+// rotateCredentials rotates the agent credentials and re-applies the
+// install manifests so the running agent picks them up.
+func (e *external) rotateCredentials(ctx context.Context, mg *v1alpha1.KargoAgent) error {
+	fp := mg.Spec.ForProvider
+	return e.RotateAgentCredentials(ctx, mg, &mg.Status.AtProvider.CredentialRotationStatus, e.agentManifests(mg), func(ctx context.Context) error {
+		return e.Client.RotateKargoAgentCredentials(ctx, fp.KargoInstanceID, fp.Name)
+	})
+}
+
+// agentManifests fetches the agent's current install manifests for
+// delivery to its kubeconfig target or manifests sink.
+func (e *external) agentManifests(mg *v1alpha1.KargoAgent) base.AgentManifests {
+	return base.AgentManifests{
+		Kind:   "kargo agent",
+		Target: targetKubeConfig(mg.Spec.ForProvider, mg.GetUID()),
+		Fetch: func(ctx context.Context) (string, error) {
+			return e.Client.GetKargoInstanceAgentManifestsOnce(ctx, mg.Spec.ForProvider.KargoInstanceID, mg.Status.AtProvider.ID)
+		},
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
@@ -177,20 +178,25 @@ func knownKargoAgentSize(size string) bool {
 }
 
 // absorbServerClamps adopts observed for fields the platform either
-// silently drops on Apply or rotates out-of-band, so user-pinned values
-// stop fighting the platform.
+// stamps out-of-band or rotates on its own, so user-pinned values stop
+// fighting the platform.
 //
-//   - MaintenanceMode and MaintenanceModeExpiry: the Apply→Update pipeline
-//     never persists these — they route only through SetAgentMaintenanceMode.
+//   - MaintenanceMode and MaintenanceModeExpiry: these route through
+//     SetAgentMaintenanceMode rather than Apply, and the platform stamps
+//     a rolling expiry when maintenance is enabled without one. Adopt
+//     observed only when the user is silent; pinned values stay so real
+//     drift surfaces and Update re-syncs them. An expiry that names the
+//     same instant in a different RFC3339 spelling is not drift.
 //   - ArgocdNamespace and TargetVersion (akuityManaged only): platform
 //     clears ArgocdNamespace on Update when akuityManaged is true, and a
 //     separate ManagedAgent reconciler force-rotates TargetVersion to the
 //     latest supported patch.
 func absorbServerClamps(desired, observed *v1alpha1.KargoAgentParameters) {
-	desired.KargoAgentSpec.Data.MaintenanceMode =
-		observed.KargoAgentSpec.Data.MaintenanceMode
-	desired.KargoAgentSpec.Data.MaintenanceModeExpiry =
-		observed.KargoAgentSpec.Data.MaintenanceModeExpiry
+	normalizePtrField(&desired.KargoAgentSpec.Data.MaintenanceMode, &observed.KargoAgentSpec.Data.MaintenanceMode)
+	normalizePtrField(&desired.KargoAgentSpec.Data.MaintenanceModeExpiry, &observed.KargoAgentSpec.Data.MaintenanceModeExpiry)
+	if sameInstant(desired.KargoAgentSpec.Data.MaintenanceModeExpiry, observed.KargoAgentSpec.Data.MaintenanceModeExpiry) {
+		desired.KargoAgentSpec.Data.MaintenanceModeExpiry = observed.KargoAgentSpec.Data.MaintenanceModeExpiry
+	}
 	if observed.KargoAgentSpec.Data.AkuityManaged == nil ||
 		!*observed.KargoAgentSpec.Data.AkuityManaged {
 		return
@@ -201,6 +207,23 @@ func absorbServerClamps(desired, observed *v1alpha1.KargoAgentParameters) {
 		observed.KargoAgentSpec.Data.TargetVersion
 }
 
+// sameInstant reports whether two RFC3339 timestamps name the same
+// instant. Unparseable or nil values never match.
+func sameInstant(a, b *string) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	ta, err := time.Parse(time.RFC3339, *a)
+	if err != nil {
+		return false
+	}
+	tb, err := time.Parse(time.RFC3339, *b)
+	if err != nil {
+		return false
+	}
+	return ta.Equal(tb)
+}
+
 func normalizePtrField[T any](desired, observed **T) {
 	if desired == nil || observed == nil {
 		return
@@ -280,7 +303,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		Recorder:  recorder,
 		NewClient: base.DefaultClientFactory,
 		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.KargoAgent] {
-			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r), now: time.Now}
 		},
 	}
 
@@ -289,6 +312,13 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithTypedExternalConnector[*v1alpha1.KargoAgent](conn),
 		managed.WithLogger(logger),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(func(mg resource.Managed, pollInterval time.Duration) time.Duration {
+			a, ok := mg.(*v1alpha1.KargoAgent)
+			if !ok {
+				return pollInterval
+			}
+			return base.MaintenanceWindowPollInterval(a.Status.AtProvider.MaintenanceWindow, time.Now(), pollInterval)
+		}),
 		managed.WithRecorder(recorder),
 		managed.WithManagementPolicies(),
 	)
@@ -303,6 +333,9 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 
 type external struct {
 	base.ExternalClient
+
+	// now is the clock maintenance windows are evaluated against.
+	now func() time.Time
 }
 
 func (e *external) Observe(ctx context.Context, mg *v1alpha1.KargoAgent) (managed.ExternalObservation, error) { //nolint:gocyclo
@@ -312,10 +345,12 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.KargoAgent) (manage
 		return managed.ExternalObservation{}, err
 	}
 	mg.Spec.ForProvider.KargoInstanceID = instanceID
-	terminalFP := mg.Spec.ForProvider
-	if terminalFP.Workspace == "" {
-		terminalFP.Workspace = e.resolveWorkspaceFromParent(ctx, mg)
+	workspace, err := e.resolveWorkspace(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
 	}
+	terminalFP := mg.Spec.ForProvider
+	terminalFP.Workspace = workspace
 
 	// Short-circuit on a cached terminal write before any gateway
 	// round-trip. With Crossplane's NameAsExternalName initializer the
@@ -349,7 +384,18 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.KargoAgent) (manage
 	}
 
 	actual := apiToSpec(mg.Spec.ForProvider, agent)
+	lastRotation := mg.Status.AtProvider.CredentialRotationStatus
+	lastSinkRevision := mg.Status.AtProvider.ManifestsSinkRevision
+	inheritedWorkspace := mg.Status.AtProvider.Workspace
 	mg.Status.AtProvider = observation.KargoAgent(agent)
+	mg.Status.AtProvider.CredentialRotationStatus = lastRotation
+	if inheritsWorkspace(mg) {
+		mg.Status.AtProvider.Workspace = inheritedWorkspace
+	}
+	if mg.Spec.ForProvider.ManifestsSink != nil {
+		mg.Status.AtProvider.ManifestsSinkRevision = lastSinkRevision
+		mg.Status.AtProvider.ManifestsSinkRevision = manifestsSinkState(mg).Settled()
+	}
 	base.SetHealthCondition(mg, mg.Status.AtProvider.HealthStatus.Code == 1)
 
 	// Drift compares against the ExportKargoInstance round-trippable
@@ -376,13 +422,41 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.KargoAgent) (manage
 	// that ApplyKargoInstance round-trips. Get remains the fallback
 	// while Export is unavailable or the agent is absent during
 	// provisioning.
+	// MaintenanceMode and MaintenanceModeExpiry are owned by the
+	// dedicated set-maintenance-mode RPC; GetKargoInstanceAgent is the
+	// authoritative read for them, matching Cluster.
+	driftTarget.KargoAgentSpec.Data.MaintenanceMode = actual.KargoAgentSpec.Data.MaintenanceMode
+	driftTarget.KargoAgentSpec.Data.MaintenanceModeExpiry = actual.KargoAgentSpec.Data.MaintenanceModeExpiry
+	statusSpec.Data.MaintenanceMode = actual.KargoAgentSpec.Data.MaintenanceMode
+	statusSpec.Data.MaintenanceModeExpiry = actual.KargoAgentSpec.Data.MaintenanceModeExpiry
 	mg.Status.AtProvider.KargoAgentSpec = statusSpec
 	spec := driftSpec()
 	desired := mg.Spec.ForProvider
+	if len(desired.MaintenanceWindows) > 0 {
+		window, werr := base.EvaluateMaintenanceWindows(desired.MaintenanceWindows, e.now())
+		if werr != nil {
+			newErr := fmt.Errorf("invalid spec.forProvider.maintenanceWindows: %w", werr)
+			mg.SetConditions(xpv1.ReconcileError(newErr))
+			return managed.ExternalObservation{}, newErr
+		}
+		mg.Status.AtProvider.MaintenanceWindow = window.Status()
+		desired.KargoAgentSpec.Data.MaintenanceMode, desired.KargoAgentSpec.Data.MaintenanceModeExpiry =
+			window.MaintenanceFields(actual.KargoAgentSpec.Data.MaintenanceMode, actual.KargoAgentSpec.Data.MaintenanceModeExpiry)
+	}
 	upToDate, err := base.EvaluateDrift(ctx, spec, &desired, &driftTarget, e.Logger, "KargoAgent")
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
+	if upToDate && rotationPending(mg) {
+		e.Logger.Debug("KargoAgent credential rotation requested; forcing Update",
+			"annotation", mg.GetAnnotations()[v1alpha1.AnnotationRotateCredentials])
+		upToDate = false
+	}
+	if upToDate && manifestsSinkState(mg).Pending() {
+		e.Logger.Debug("KargoAgent manifests sink is stale; forcing Update",
+			"revision", manifestsSinkState(mg).Revision())
+		upToDate = false
+	}
 
 	if !upToDate {
 		if obs, err, ok := e.suppressTerminalWrite(ctx, mg, terminalFP); ok {
@@ -413,28 +487,121 @@ func (e *external) apply(ctx context.Context, mg *v1alpha1.KargoAgent) error {
 	// ApplyKargoInstance is workspace-scoped at the HTTP gateway
 	// (`/orgs/{org}/workspaces/{workspace_id}/kargo/instances/{id}/apply`);
 	// an unset WorkspaceId on the request substitutes empty into the
-	// URL template and portal-server 404s. When the user didn't pin a
-	// workspace on the KargoAgent CR, inherit it from the parent
-	// KargoInstance's spec, the same MR reference the controller
-	// already used to resolve the instance ID.
-	if mg.Spec.ForProvider.Workspace == "" {
-		mg.Spec.ForProvider.Workspace = e.resolveWorkspaceFromParent(ctx, mg)
-	}
-	key, err := e.kargoAgentTerminalWriteKey(ctx, mg, mg.Spec.ForProvider)
+	// URL template and portal-server 404s. Without a workspaceRef or
+	// an explicit workspace, the workspace follows the parent
+	// KargoInstance, the same MR reference the controller already used
+	// to resolve the instance ID. The inherited value is recorded on
+	// status, never in spec, so it keeps tracking the parent across
+	// workspace moves. A workspaceRef is resolved onto the outbound
+	// payload only and never persisted.
+	workspace, err := e.resolveWorkspace(ctx, mg)
+	if err != nil {
+		return err
+	}
+	if inheritsWorkspace(mg) {
+		mg.Status.AtProvider.Workspace = workspace
+	}
+	fp := mg.Spec.ForProvider
+	fp.Workspace = workspace
+	key, err := e.kargoAgentTerminalWriteKey(ctx, mg, fp)
 	if err != nil {
 		return err
 	}
-	req, err := BuildApplyKargoInstanceRequest(instanceID, mg.Spec.ForProvider)
+	req, err := BuildApplyKargoInstanceRequest(instanceID, fp)
 	if err != nil {
 		return e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
 	}
 	if err := e.Client.ApplyKargoInstance(ctx, req); err != nil {
 		return e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
 	}
+	if err := e.syncMaintenanceMode(ctx, mg); err != nil {
+		return e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
 	e.ClearTerminalWrite(key)
 	return nil
 }
 
+// syncMaintenanceMode pushes data.maintenanceMode and
+// data.maintenanceModeExpiry through SetAgentMaintenanceMode when the
+// user has configured either field. ApplyKargoInstance silently drops
+// both, so without this RPC a pinned value never reaches the platform.
+// Mirrors Cluster.syncMaintenanceMode: unset fields skip the call, and
+// so does a desired state status.atProvider already reflects.
+func (e *external) syncMaintenanceMode(ctx context.Context, mg *v1alpha1.KargoAgent) error {
+	if len(mg.Spec.ForProvider.MaintenanceWindows) > 0 {
+		return e.syncMaintenanceWindows(ctx, mg)
+	}
+	data := mg.Spec.ForProvider.KargoAgentSpec.Data
+	if data.MaintenanceMode == nil && data.MaintenanceModeExpiry == nil {
+		return nil
+	}
+	mode := false
+	if data.MaintenanceMode != nil {
+		mode = *data.MaintenanceMode
+	}
+	var expiry *time.Time
+	if data.MaintenanceModeExpiry != nil && *data.MaintenanceModeExpiry != "" {
+		t, err := time.Parse(time.RFC3339, *data.MaintenanceModeExpiry)
+		if err != nil {
+			return reason.AsTerminal(fmt.Errorf("could not parse spec.forProvider.kargoAgentSpec.data.maintenanceModeExpiry as RFC3339: %w", err))
+		}
+		expiry = &t
+	}
+	if maintenanceModeAlreadyApplied(mg, mode, expiry) {
+		return nil
+	}
+	return e.Client.SetKargoAgentMaintenanceMode(ctx, mg.Spec.ForProvider.KargoInstanceID, mg.Spec.ForProvider.Name, mode, expiry)
+}
+
+// syncMaintenanceWindows drives maintenance mode from
+// spec.forProvider.maintenanceWindows, mirroring
+// Cluster.syncMaintenanceWindows: an open window enables maintenance
+// with an expiry at its end, and a closed one switches maintenance off
+// only if the platform still reports it on.
+func (e *external) syncMaintenanceWindows(ctx context.Context, mg *v1alpha1.KargoAgent) error {
+	window, err := base.EvaluateMaintenanceWindows(mg.Spec.ForProvider.MaintenanceWindows, e.now())
+	if err != nil {
+		return reason.AsTerminal(fmt.Errorf("invalid spec.forProvider.maintenanceWindows: %w", err))
+	}
+	if !window.Active {
+		observed := mg.Status.AtProvider.KargoAgentSpec.Data.MaintenanceMode
+		if observed == nil || !*observed {
+			return nil
+		}
+		return e.Client.SetKargoAgentMaintenanceMode(ctx, mg.Spec.ForProvider.KargoInstanceID, mg.Spec.ForProvider.Name, false, nil)
+	}
+	end := window.End
+	if maintenanceModeAlreadyApplied(mg, true, &end) {
+		return nil
+	}
+	return e.Client.SetKargoAgentMaintenanceMode(ctx, mg.Spec.ForProvider.KargoInstanceID, mg.Spec.ForProvider.Name, true, &end)
+}
+
+// maintenanceModeAlreadyApplied returns true when the observed
+// status.atProvider already reflects (mode, expiry). Observe fills
+// atProvider from GetKargoInstanceAgent, so this is false on Create and
+// the initial sync still runs.
+func maintenanceModeAlreadyApplied(mg *v1alpha1.KargoAgent, mode bool, expiry *time.Time) bool {
+	observed := mg.Status.AtProvider.KargoAgentSpec.Data
+	if observed.MaintenanceMode == nil {
+		return false
+	}
+	if *observed.MaintenanceMode != mode {
+		return false
+	}
+	if expiry == nil {
+		return observed.MaintenanceModeExpiry == nil
+	}
+	if observed.MaintenanceModeExpiry == nil {
+		return false
+	}
+	observedTime, err := time.Parse(time.RFC3339, *observed.MaintenanceModeExpiry)
+	if err != nil {
+		return false
+	}
+	return observedTime.Equal(*expiry)
+}
+
 func (e *external) Create(ctx context.Context, mg *v1alpha1.KargoAgent) (managed.ExternalCreation, error) {
 	defer base.PropagateObservedGeneration(mg)
 	if err := e.apply(ctx, mg); err != nil {
@@ -451,8 +618,10 @@ func (e *external) Create(ctx context.Context, mg *v1alpha1.KargoAgent) (managed
 	// One-time apply: when a kubeconfig source is configured, install
 	// the agent manifests on the managed cluster before setting the
 	// external-name. Server-pushed agent upgrades require a spec change
-	// or recreate to re-land on the managed cluster.
-	if target := targetKubeConfig(mg.Spec.ForProvider); target.HasKubeConfig() {
+	// or recreate to re-land on the managed cluster. A manifests sink is
+	// written here too; Create marks the write and Update keeps the
+	// sink current afterwards.
+	if target := targetKubeConfig(mg.Spec.ForProvider, mg.GetUID()); target.HasTarget() {
 		if err := e.installAgentManifests(ctx, mg, target, false); err != nil {
 			e.rollbackCreatedAgent(ctx, mg, target, "install-manifests")
 			// Classify install failure as terminal so the next Observe
@@ -467,6 +636,9 @@ func (e *external) Create(ctx context.Context, mg *v1alpha1.KargoAgent) (managed
 			}
 			return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyManifestInstallError(err))
 		}
+		if mg.Spec.ForProvider.ManifestsSink != nil {
+			base.MarkManifestsSinkCreated(mg)
+		}
 	}
 
 	meta.SetExternalName(mg, mg.Spec.ForProvider.Name)
@@ -480,7 +652,7 @@ func (e *external) Create(ctx context.Context, mg *v1alpha1.KargoAgent) (managed
 // caller's original failure is the user-visible error and a rollback
 // failure must not mask it.
 func (e *external) rollbackCreatedAgent(ctx context.Context, mg *v1alpha1.KargoAgent, target kube.TargetKubeConfig, stage string) {
-	if mg.Spec.ForProvider.RemoveAgentResourcesOnDestroy && target.HasKubeConfig() {
+	if mg.Spec.ForProvider.RemoveAgentResourcesOnDestroy && target.HasTarget() {
 		if err := e.installAgentManifests(ctx, mg, target, true); err != nil {
 			e.Logger.Info("rollback could not strip partially-installed kargo agent manifests",
 				"stage", stage,
@@ -502,7 +674,34 @@ func (e *external) rollbackCreatedAgent(ctx context.Context, mg *v1alpha1.KargoA
 
 func (e *external) Update(ctx context.Context, mg *v1alpha1.KargoAgent) (managed.ExternalUpdate, error) {
 	defer base.PropagateObservedGeneration(mg)
-	return managed.ExternalUpdate{}, e.apply(ctx, mg)
+	if err := e.apply(ctx, mg); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	rotate, sink := rotationPending(mg), manifestsSinkState(mg).Pending()
+	if !rotate && !sink {
+		return managed.ExternalUpdate{}, nil
+	}
+	workspace, err := e.resolveWorkspace(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	fp := mg.Spec.ForProvider
+	fp.Workspace = workspace
+	key, err := e.kargoAgentTerminalWriteKey(ctx, mg, fp)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if rotate {
+		if err := e.rotateCredentials(ctx, mg); err != nil {
+			return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, err)
+		}
+	}
+	if sink {
+		if err := e.syncManifestsSink(ctx, mg); err != nil {
+			return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, err)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg *v1alpha1.KargoAgent) (managed.ExternalDelete, error) {
@@ -524,7 +723,7 @@ func (e *external) Delete(ctx context.Context, mg *v1alpha1.KargoAgent) (managed
 	// platform row. Mirrors Cluster.Delete with
 	// removeAgentResourcesOnDestroy=true.
 	if mg.Spec.ForProvider.RemoveAgentResourcesOnDestroy {
-		if target := targetKubeConfig(mg.Spec.ForProvider); target.HasKubeConfig() {
+		if target := targetKubeConfig(mg.Spec.ForProvider, mg.GetUID()); target.HasTarget() {
 			if err := e.installAgentManifests(ctx, mg, target, true); err != nil {
 				return managed.ExternalDelete{}, err
 			}
@@ -544,13 +743,15 @@ func (e *external) Delete(ctx context.Context, mg *v1alpha1.KargoAgent) (managed
 	return managed.ExternalDelete{}, nil
 }
 
-// targetKubeConfig projects the forProvider kubeconfig fields into the
-// shared TargetKubeConfig struct.
-func targetKubeConfig(fp v1alpha1.KargoAgentParameters) kube.TargetKubeConfig {
+// targetKubeConfig projects the forProvider kubeconfig and manifests
+// sink fields into the shared TargetKubeConfig struct. owner is the
+// KargoAgent's UID, which marks the sink objects it writes.
+func targetKubeConfig(fp v1alpha1.KargoAgentParameters, owner k8stypes.UID) kube.TargetKubeConfig {
 	return kube.TargetKubeConfig{
 		EnableInCluster: fp.EnableInClusterKubeConfig,
 		SecretName:      fp.KubeConfigSecretRef.Name,
 		SecretNamespace: fp.KubeConfigSecretRef.Namespace,
+		Sink:            base.ManifestsSink(fp.ManifestsSink, owner),
 	}
 }
 
@@ -599,11 +800,11 @@ func (e *external) suppressTerminalWrite(ctx context.Context, mg *v1alpha1.Kargo
 
 func (e *external) kargoAgentTerminalWriteKey(ctx context.Context, mg *v1alpha1.KargoAgent, fp v1alpha1.KargoAgentParameters) (base.TerminalWriteKey, error) {
 	req, err := BuildApplyKargoInstanceRequest(fp.KargoInstanceID, fp)
-	targetFP := kube.TargetFingerprint(ctx, e.Kube, targetKubeConfig(fp))
+	targetFP := kube.TargetFingerprint(ctx, e.Kube, targetKubeConfig(fp, mg.GetUID()))
 	if err != nil {
 		return base.NewTerminalWriteKey(mg, v1alpha1.KargoAgentGroupVersionKind, "build-error", fp, targetFP, err.Error())
 	}
-	return base.NewTerminalWriteKey(mg, v1alpha1.KargoAgentGroupVersionKind, req, targetFP)
+	return base.NewTerminalWriteKey(mg, v1alpha1.KargoAgentGroupVersionKind, req, targetFP, mg.GetAnnotations()[v1alpha1.AnnotationRotateCredentials])
 }
 
 func (e *external) clearTerminalWrite(ctx context.Context, mg *v1alpha1.KargoAgent, fp v1alpha1.KargoAgentParameters) {
@@ -628,13 +829,14 @@ func (e *external) clearTerminalWrite(ctx context.Context, mg *v1alpha1.KargoAge
 func (e *external) exportedAgentSpec(ctx context.Context, mg *v1alpha1.KargoAgent, instanceID string) (v1alpha1.KargoAgentParameters, bool, error) {
 	// ExportKargoInstance is workspace-scoped at the HTTP gateway; an
 	// empty workspace 404s on multi-workspace orgs. Resolve the same
-	// way apply() does: prefer the spec value, fall back to the parent
-	// KargoInstance's workspace. Keeps Export on the happy path so the
-	// GetKargoInstanceAgent fallback only triggers on real Export
-	// failures rather than a deterministic 404.
-	workspace := mg.Spec.ForProvider.Workspace
-	if workspace == "" {
-		workspace = e.resolveWorkspaceFromParent(ctx, mg)
+	// way apply() does: prefer the workspaceRef, then the spec value,
+	// then the parent KargoInstance's workspace. Keeps Export on the
+	// happy path so the GetKargoInstanceAgent fallback only triggers on
+	// real Export failures rather than a deterministic 404.
+	workspace, err := e.resolveWorkspace(ctx, mg)
+	if err != nil {
+		e.Logger.Debug("workspace resolution failed; falling back to GetKargoInstanceAgent for drift", "err", err)
+		return v1alpha1.KargoAgentParameters{}, false, nil
 	}
 	exp, err := e.Client.ExportKargoInstance(ctx, instanceID, workspace)
 	if err != nil {
@@ -662,12 +864,42 @@ func (e *external) exportedAgentSpec(ctx context.Context, mg *v1alpha1.KargoAgen
 	return v1alpha1.KargoAgentParameters{}, false, nil
 }
 
+// resolveWorkspace returns the workspace routing value for mg's gateway
+// calls, in order: the referenced Workspace MR's ID when workspaceRef
+// is set, spec.forProvider.workspace, the parent KargoInstance's
+// workspace, and finally the workspace last inherited from the parent,
+// recorded on status.atProvider.workspace, when the parent cannot be
+// read. Only the workspaceRef branch can fail; an unobserved Workspace
+// must requeue rather than route to the default workspace.
+func (e *external) resolveWorkspace(ctx context.Context, mg *v1alpha1.KargoAgent) (string, error) {
+	if ref := mg.Spec.ForProvider.WorkspaceRef; ref != nil {
+		return base.ResolveWorkspaceRef(ctx, e.Kube, mg.GetNamespace(), ref)
+	}
+	if ws := mg.Spec.ForProvider.Workspace; ws != "" {
+		return ws, nil
+	}
+	if ws := e.resolveWorkspaceFromParent(ctx, mg); ws != "" {
+		return ws, nil
+	}
+	if inheritsWorkspace(mg) {
+		return mg.Status.AtProvider.Workspace, nil
+	}
+	return "", nil
+}
+
+// inheritsWorkspace reports whether mg takes its workspace from the
+// parent KargoInstance: neither workspaceRef nor workspace is set and
+// kargoInstanceRef names the parent.
+func inheritsWorkspace(mg *v1alpha1.KargoAgent) bool {
+	fp := mg.Spec.ForProvider
+	return fp.WorkspaceRef == nil && fp.Workspace == "" && fp.KargoInstanceRef != nil && fp.KargoInstanceRef.Name != ""
+}
+
 // resolveWorkspaceFromParent returns the workspace of the parent
 // KargoInstance pointed at by mg.Spec.ForProvider.KargoInstanceRef, or
-// "" when there is no ref or the lookup fails. Used by apply() and
-// exportedAgentSpec() when the user did not pin a workspace on the
-// KargoAgent CR. Mirrors
-// the workspace resolution used by apply().
+// "" when there is no ref or the lookup fails. The parent's observed
+// workspace ID is preferred over its spec so a pending workspace move
+// keeps routing to where the instance currently lives.
 func (e *external) resolveWorkspaceFromParent(ctx context.Context, mg *v1alpha1.KargoAgent) string {
 	ref := mg.Spec.ForProvider.KargoInstanceRef
 	if ref == nil || ref.Name == "" {
@@ -678,10 +910,10 @@ func (e *external) resolveWorkspaceFromParent(ctx context.Context, mg *v1alpha1.
 	if err := e.Kube.Get(ctx, key, parent); err != nil {
 		return ""
 	}
-	if parent.Spec.ForProvider.Workspace != "" {
-		return parent.Spec.ForProvider.Workspace
+	if parent.Status.AtProvider.Workspace != "" {
+		return parent.Status.AtProvider.Workspace
 	}
-	return parent.Status.AtProvider.Workspace
+	return parent.Spec.ForProvider.Workspace
 }
 
 // resolveKargoInstanceID returns the Akuity ID of the owning Kargo
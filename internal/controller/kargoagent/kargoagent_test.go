@@ -19,6 +19,7 @@ package kargoagent
 import (
 	"context"
 	"testing"
+	"time"
 
 	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
 	health "github.com/akuity/api-client-go/pkg/api/gen/types/status/health/v1"
@@ -33,12 +34,15 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
 	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/kube"
 	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
 	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
 	crossplanetypes "github.com/akuityio/provider-crossplane-akuity/internal/types/generated/crossplane/v1alpha1"
@@ -572,26 +576,21 @@ func TestDriftSpec_PodInheritMetadataDesiredSetDoesNotAdoptObserved(t *testing.T
 }
 
 func TestDriftSpec_AbsorbsAkuityManagedClampedFields(t *testing.T) {
-	expiry := "2099-01-01T00:00:00Z"
 	desired := v1alpha1.KargoAgentParameters{
 		KargoAgentSpec: crossplanetypes.KargoAgentSpec{
 			Data: crossplanetypes.KargoAgentData{
-				AkuityManaged:         boolPtr(true),
-				ArgocdNamespace:       "argocd2",
-				TargetVersion:         "0.5.87",
-				MaintenanceMode:       boolPtr(true),
-				MaintenanceModeExpiry: &expiry,
+				AkuityManaged:   boolPtr(true),
+				ArgocdNamespace: "argocd2",
+				TargetVersion:   "0.5.87",
 			},
 		},
 	}
 	observed := v1alpha1.KargoAgentParameters{
 		KargoAgentSpec: crossplanetypes.KargoAgentSpec{
 			Data: crossplanetypes.KargoAgentData{
-				AkuityManaged:         boolPtr(true),
-				ArgocdNamespace:       "",
-				TargetVersion:         "0.5.88",
-				MaintenanceMode:       boolPtr(false),
-				MaintenanceModeExpiry: nil,
+				AkuityManaged:   boolPtr(true),
+				ArgocdNamespace: "",
+				TargetVersion:   "0.5.88",
 			},
 		},
 	}
@@ -602,11 +601,128 @@ func TestDriftSpec_AbsorbsAkuityManagedClampedFields(t *testing.T) {
 		"akuityManaged ArgocdNamespace must adopt observed because the platform clears it on Update")
 	assert.Equal(t, "0.5.88", desired.KargoAgentSpec.Data.TargetVersion,
 		"akuityManaged TargetVersion must adopt observed because the ManagedAgent reconciler force-rotates it")
+}
+
+func TestDriftSpec_PinnedMaintenanceModeDrifts(t *testing.T) {
+	expiry := "2099-01-01T00:00:00Z"
+	desired := v1alpha1.KargoAgentParameters{
+		KargoAgentSpec: crossplanetypes.KargoAgentSpec{
+			Data: crossplanetypes.KargoAgentData{
+				MaintenanceMode:       boolPtr(true),
+				MaintenanceModeExpiry: &expiry,
+			},
+		},
+	}
+	observed := v1alpha1.KargoAgentParameters{
+		KargoAgentSpec: crossplanetypes.KargoAgentSpec{
+			Data: crossplanetypes.KargoAgentData{
+				MaintenanceMode: boolPtr(false),
+			},
+		},
+	}
+
+	driftSpec().Normalize(&desired, &observed)
+
 	require.NotNil(t, desired.KargoAgentSpec.Data.MaintenanceMode)
-	assert.False(t, *desired.KargoAgentSpec.Data.MaintenanceMode,
-		"MaintenanceMode must adopt observed because Apply does not route this field")
-	assert.Nil(t, desired.KargoAgentSpec.Data.MaintenanceModeExpiry,
-		"MaintenanceModeExpiry must adopt observed because Apply does not route this field")
+	assert.True(t, *desired.KargoAgentSpec.Data.MaintenanceMode,
+		"user-pinned maintenanceMode must survive normalization so drift routes to SetAgentMaintenanceMode")
+	assert.Equal(t, &expiry, desired.KargoAgentSpec.Data.MaintenanceModeExpiry)
+}
+
+func TestDriftSpec_UnsetMaintenanceModeAdoptsObserved(t *testing.T) {
+	stamped := "2099-01-01T00:00:00Z"
+	desired := v1alpha1.KargoAgentParameters{}
+	observed := v1alpha1.KargoAgentParameters{
+		KargoAgentSpec: crossplanetypes.KargoAgentSpec{
+			Data: crossplanetypes.KargoAgentData{
+				MaintenanceMode:       boolPtr(true),
+				MaintenanceModeExpiry: &stamped,
+			},
+		},
+	}
+
+	driftSpec().Normalize(&desired, &observed)
+
+	assert.Equal(t, observed.KargoAgentSpec.Data.MaintenanceMode, desired.KargoAgentSpec.Data.MaintenanceMode)
+	assert.Equal(t, observed.KargoAgentSpec.Data.MaintenanceModeExpiry, desired.KargoAgentSpec.Data.MaintenanceModeExpiry)
+}
+
+func TestDriftSpec_MaintenanceModeExpirySameInstant(t *testing.T) {
+	pinned := "2099-01-01T02:00:00+02:00"
+	stamped := "2099-01-01T00:00:00Z"
+	desired := v1alpha1.KargoAgentParameters{
+		KargoAgentSpec: crossplanetypes.KargoAgentSpec{
+			Data: crossplanetypes.KargoAgentData{MaintenanceModeExpiry: &pinned},
+		},
+	}
+	observed := v1alpha1.KargoAgentParameters{
+		KargoAgentSpec: crossplanetypes.KargoAgentSpec{
+			Data: crossplanetypes.KargoAgentData{MaintenanceModeExpiry: &stamped},
+		},
+	}
+
+	driftSpec().Normalize(&desired, &observed)
+
+	assert.Equal(t, &stamped, desired.KargoAgentSpec.Data.MaintenanceModeExpiry)
+}
+
+func TestUpdate_MaintenanceModeSetCallsSetEndpoint(t *testing.T) {
+	e, mc := newExt(t)
+	a := newAgent()
+	meta.SetExternalName(a, "agt")
+	expiry := "2099-01-01T00:00:00Z"
+	a.Spec.ForProvider.KargoAgentSpec.Data.MaintenanceMode = boolPtr(true)
+	a.Spec.ForProvider.KargoAgentSpec.Data.MaintenanceModeExpiry = &expiry
+	wantExpiry := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().SetKargoAgentMaintenanceMode(gomock.Any(), "ki-1", "agt", true, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, _ bool, got *time.Time) error {
+			require.NotNil(t, got)
+			assert.True(t, wantExpiry.Equal(*got))
+			return nil
+		}).Times(1)
+
+	_, err := e.Update(context.Background(), a)
+	require.NoError(t, err)
+}
+
+func TestUpdate_MaintenanceModeUnsetSkipsSetEndpoint(t *testing.T) {
+	e, mc := newExt(t)
+	a := newAgent()
+	meta.SetExternalName(a, "agt")
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().SetKargoAgentMaintenanceMode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := e.Update(context.Background(), a)
+	require.NoError(t, err)
+}
+
+func TestUpdate_MaintenanceModeAlreadyAppliedSkipsRPC(t *testing.T) {
+	e, mc := newExt(t)
+	a := newAgent()
+	meta.SetExternalName(a, "agt")
+	a.Spec.ForProvider.KargoAgentSpec.Data.MaintenanceMode = boolPtr(true)
+	a.Status.AtProvider.KargoAgentSpec.Data.MaintenanceMode = boolPtr(true)
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().SetKargoAgentMaintenanceMode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := e.Update(context.Background(), a)
+	require.NoError(t, err)
+}
+
+func TestUpdate_MaintenanceModeBadExpiryFails(t *testing.T) {
+	e, mc := newExt(t)
+	a := newAgent()
+	meta.SetExternalName(a, "agt")
+	bad := "tomorrow"
+	a.Spec.ForProvider.KargoAgentSpec.Data.MaintenanceMode = boolPtr(true)
+	a.Spec.ForProvider.KargoAgentSpec.Data.MaintenanceModeExpiry = &bad
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	_, err := e.Update(context.Background(), a)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
 }
 
 func TestDriftSpec_SelfManagedKeepsArgocdNamespaceAndTargetVersion(t *testing.T) {
@@ -656,6 +772,136 @@ func TestResolveWorkspaceFromParentUsesStatusFallback(t *testing.T) {
 	assert.Equal(t, "ws-cached-id", got)
 }
 
+func TestResolveWorkspace_FollowsParentAfterMove(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	parent := &v1alpha1.KargoInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "ki-ref", Namespace: "ns"},
+	}
+	parent.Spec.ForProvider.Workspace = "team"
+	parent.Status.AtProvider.Workspace = "ws-new"
+	e := &external{ExternalClient: base.ExternalClient{
+		Kube:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(parent).Build(),
+		Logger: logging.NewNopLogger(),
+	}}
+	a := newAgent()
+	a.Spec.ForProvider.KargoInstanceID = ""
+	a.Spec.ForProvider.KargoInstanceRef = &v1alpha1.LocalReference{Name: "ki-ref"}
+	// Inherited by an earlier apply() before the parent moved.
+	a.Status.AtProvider.Workspace = "ws-old"
+
+	got, err := e.resolveWorkspace(context.Background(), a)
+	require.NoError(t, err)
+	assert.Equal(t, "ws-new", got)
+}
+
+func TestResolveWorkspace_ExplicitSpecWinsOverParent(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	parent := &v1alpha1.KargoInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "ki-ref", Namespace: "ns"},
+	}
+	parent.Status.AtProvider.Workspace = "ws-parent"
+	e := &external{ExternalClient: base.ExternalClient{
+		Kube:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(parent).Build(),
+		Logger: logging.NewNopLogger(),
+	}}
+	a := newAgent()
+	a.Spec.ForProvider.KargoInstanceID = ""
+	a.Spec.ForProvider.KargoInstanceRef = &v1alpha1.LocalReference{Name: "ki-ref"}
+	a.Spec.ForProvider.Workspace = "platform"
+
+	got, err := e.resolveWorkspace(context.Background(), a)
+	require.NoError(t, err)
+	assert.Equal(t, "platform", got)
+}
+
+func TestResolveWorkspace_UnreadableParentUsesInheritedWorkspace(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	e := &external{ExternalClient: base.ExternalClient{
+		Kube:   fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Logger: logging.NewNopLogger(),
+	}}
+	a := newAgent()
+	a.Spec.ForProvider.KargoInstanceID = ""
+	a.Spec.ForProvider.KargoInstanceRef = &v1alpha1.LocalReference{Name: "ki-ref"}
+	a.Status.AtProvider.Workspace = "ws-inherited"
+
+	got, err := e.resolveWorkspace(context.Background(), a)
+	require.NoError(t, err)
+	assert.Equal(t, "ws-inherited", got)
+}
+
+func TestCreate_InheritedWorkspaceRecordedOnStatusNotSpec(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	parent := &v1alpha1.KargoInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "ki-ref", Namespace: "ns"},
+	}
+	parent.Status.AtProvider.ID = "ki-1"
+	parent.Status.AtProvider.Workspace = "ws-parent"
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().WithScheme(scheme).WithObjects(parent).Build()
+	a := newAgent()
+	a.Spec.ForProvider.KargoInstanceID = ""
+	a.Spec.ForProvider.KargoInstanceRef = &v1alpha1.LocalReference{Name: "ki-ref"}
+
+	var capturedReq *kargov1.ApplyKargoInstanceRequest
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *kargov1.ApplyKargoInstanceRequest) error {
+		capturedReq = req
+		return nil
+	}).Times(1)
+
+	_, err := e.Create(context.Background(), a)
+	require.NoError(t, err)
+	require.NotNil(t, capturedReq)
+	assert.Equal(t, "ws-parent", capturedReq.GetWorkspaceId())
+	assert.Empty(t, a.Spec.ForProvider.Workspace, "the inherited workspace stays out of spec")
+	assert.Equal(t, "ws-parent", a.Status.AtProvider.Workspace)
+}
+
+func TestResolveWorkspace_WorkspaceRefWinsOverSpecAndParent(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	ws := &v1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "team-ws", Namespace: "ns"}}
+	ws.Status.AtProvider.ID = "ws-ref-id"
+	e := &external{ExternalClient: base.ExternalClient{
+		Kube:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(ws).Build(),
+		Logger: logging.NewNopLogger(),
+	}}
+	a := newAgent()
+	a.Spec.ForProvider.Workspace = "platform"
+	a.Spec.ForProvider.WorkspaceRef = &v1alpha1.LocalReference{Name: "team-ws"}
+
+	got, err := e.resolveWorkspace(context.Background(), a)
+	require.NoError(t, err)
+	assert.Equal(t, "ws-ref-id", got)
+}
+
+func TestCreate_WorkspaceRefDoesNotPersistIntoSpec(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	ws := &v1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "team-ws", Namespace: "ns"}}
+	ws.Status.AtProvider.ID = "ws-ref-id"
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().WithScheme(scheme).WithObjects(ws).Build()
+	a := newAgent()
+	a.Spec.ForProvider.WorkspaceRef = &v1alpha1.LocalReference{Name: "team-ws"}
+
+	var capturedReq *kargov1.ApplyKargoInstanceRequest
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *kargov1.ApplyKargoInstanceRequest) error {
+		capturedReq = req
+		return nil
+	}).Times(1)
+
+	_, err := e.Create(context.Background(), a)
+	require.NoError(t, err)
+	require.NotNil(t, capturedReq)
+	assert.Equal(t, "ws-ref-id", capturedReq.GetWorkspaceId())
+	assert.Empty(t, a.Spec.ForProvider.Workspace, "workspaceRef-resolved IDs stay out of spec")
+}
+
 // TestObserve_ProvisioningWait covers the short-circuit: the Kargo
 // instance is still bootstrapping, so fetchAgent returns
 // ProvisioningWait and Observe reports Unavailable + UpToDate to park
@@ -689,3 +935,280 @@ func TestObserve_GenericErrPropagates(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "boom")
 }
+
+func expectObservedAgent(mc *mockclient.MockClient) {
+	mc.EXPECT().GetKargoInstanceAgent(gomock.Any(), "ki-1", "agt").Return(&kargov1.KargoAgent{
+		Id:                   "ag-1",
+		Name:                 "agt",
+		Data:                 &kargov1.KargoAgentData{},
+		ReconciliationStatus: &reconv1.Status{Code: reconv1.StatusCode_STATUS_CODE_SUCCESSFUL},
+		HealthStatus:         &health.Status{Code: health.StatusCode_STATUS_CODE_HEALTHY},
+	}, nil).Times(1)
+	mc.EXPECT().ExportKargoInstance(gomock.Any(), "ki-1", "").
+		Return(nil, errors.New("export down")).Times(1)
+}
+
+func TestObserve_RotationAnnotationForcesUpdate(t *testing.T) {
+	e, mc := newExt(t)
+	a := newAgent()
+	a.Spec.ForProvider.KargoAgentSpec.Data = crossplanetypes.KargoAgentData{}
+	a.SetAnnotations(map[string]string{
+		meta.AnnotationKeyExternalName:       "agt",
+		v1alpha1.AnnotationRotateCredentials: "nonce-1",
+	})
+	expectObservedAgent(mc)
+
+	obs, err := e.Observe(context.Background(), a)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_RotationAlreadyHandledIsUpToDate(t *testing.T) {
+	e, mc := newExt(t)
+	a := newAgent()
+	a.Spec.ForProvider.KargoAgentSpec.Data = crossplanetypes.KargoAgentData{}
+	a.SetAnnotations(map[string]string{
+		meta.AnnotationKeyExternalName:       "agt",
+		v1alpha1.AnnotationRotateCredentials: "nonce-1",
+	})
+	rotatedAt := metav1.Now()
+	a.Status.AtProvider.LastCredentialRotation = "nonce-1"
+	a.Status.AtProvider.LastCredentialRotationTime = &rotatedAt
+	expectObservedAgent(mc)
+
+	obs, err := e.Observe(context.Background(), a)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, "nonce-1", a.Status.AtProvider.LastCredentialRotation)
+	assert.Equal(t, &rotatedAt, a.Status.AtProvider.LastCredentialRotationTime)
+}
+
+func TestUpdate_RotationWithoutKubeConfigIsTerminal(t *testing.T) {
+	e, mc := newExt(t)
+	a := newAgent()
+	a.SetAnnotations(map[string]string{v1alpha1.AnnotationRotateCredentials: "nonce-1"})
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	_, err := e.Update(context.Background(), a)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+	assert.Empty(t, a.Status.AtProvider.LastCredentialRotation)
+}
+
+func TestUpdate_RotationDeliveryRetryDoesNotRotateAgain(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().Build()
+	a := newSinkAgent()
+	a.SetAnnotations(map[string]string{
+		meta.AnnotationKeyExternalName:       "agt",
+		v1alpha1.AnnotationRotateCredentials: "nonce-1",
+	})
+	a.Status.AtProvider.ID = "ag-1"
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	mc.EXPECT().RotateKargoAgentCredentials(gomock.Any(), "ki-1", "agt").Return(nil).Times(1)
+	gomock.InOrder(
+		mc.EXPECT().GetKargoInstanceAgentManifestsOnce(gomock.Any(), "ki-1", "ag-1").Return("", errors.New("fake")),
+		mc.EXPECT().GetKargoInstanceAgentManifestsOnce(gomock.Any(), "ki-1", "ag-1").Return("kind: Namespace\n", nil),
+	)
+
+	_, err := e.Update(context.Background(), a)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "after credential rotation")
+	assert.Equal(t, "nonce-1", a.Status.AtProvider.LastCredentialRotation)
+	assert.True(t, a.Status.AtProvider.CredentialRotationManifestsPending)
+
+	_, err = e.Update(context.Background(), a)
+	require.NoError(t, err)
+	assert.False(t, a.Status.AtProvider.CredentialRotationManifestsPending)
+	assert.False(t, rotationPending(a))
+}
+
+// newSinkAgent returns an agent that delivers its manifests to a
+// ConfigMap sink, observed at generation 2.
+func newSinkAgent() *v1alpha1.KargoAgent {
+	a := newAgent()
+	a.SetUID("agent-uid")
+	a.SetGeneration(2)
+	a.Spec.ForProvider.KargoAgentSpec.Data = crossplanetypes.KargoAgentData{}
+	a.Spec.ForProvider.ManifestsSink = &v1alpha1.ManifestsSink{Kind: kube.SinkKindConfigMap, Name: "agt-manifests", Namespace: "crossplane-system"}
+	meta.SetExternalName(a, "agt")
+	return a
+}
+
+func TestObserve_ManifestsSinkStaleForcesUpdate(t *testing.T) {
+	e, mc := newExt(t)
+	a := newSinkAgent()
+	a.Status.AtProvider.ManifestsSinkRevision = "1:"
+	expectObservedAgent(mc)
+
+	obs, err := e.Observe(context.Background(), a)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+	assert.Equal(t, "1:", a.Status.AtProvider.ManifestsSinkRevision)
+}
+
+func TestObserve_ManifestsSinkCurrentIsUpToDate(t *testing.T) {
+	e, mc := newExt(t)
+	a := newSinkAgent()
+	a.Status.AtProvider.ManifestsSinkRevision = "2:"
+	expectObservedAgent(mc)
+
+	obs, err := e.Observe(context.Background(), a)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+}
+
+func TestCreate_WritesManifestsSink(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().Build()
+	a := newSinkAgent()
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().GetKargoInstanceAgentManifests(gomock.Any(), "ki-1", "agt").Return("kind: Namespace\n", nil).Times(1)
+
+	_, err := e.Create(context.Background(), a)
+	require.NoError(t, err)
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, e.Kube.Get(context.Background(), k8stypes.NamespacedName{Namespace: "crossplane-system", Name: "agt-manifests"}, cm))
+	assert.Equal(t, "kind: Namespace\n", cm.Data[kube.ManifestsSinkKey])
+}
+
+func TestCreate_ManifestsSinkThenObserveIsUpToDate(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().Build()
+	a := newSinkAgent()
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().GetKargoInstanceAgentManifests(gomock.Any(), "ki-1", "agt").Return("kind: Namespace\n", nil).Times(1)
+	mc.EXPECT().GetKargoInstanceAgentManifestsOnce(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := e.Create(context.Background(), a)
+	require.NoError(t, err)
+
+	// The managed reconciler reverts status set during Create.
+	a.Status = v1alpha1.KargoAgentStatus{}
+	expectObservedAgent(mc)
+	obs, err := e.Observe(context.Background(), a)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, "2:", a.Status.AtProvider.ManifestsSinkRevision)
+}
+
+func TestUpdate_RewritesStaleManifestsSink(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().Build()
+	a := newSinkAgent()
+	a.Status.AtProvider.ID = "ag-1"
+	a.Status.AtProvider.ReconciliationStatus.Code = int32(reconv1.StatusCode_STATUS_CODE_SUCCESSFUL)
+	a.Status.AtProvider.KargoAgentSpec.Data.TargetVersion = "v1.4.0"
+	a.Status.AtProvider.ManifestsSinkRevision = "2:v1.3.0"
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().GetKargoInstanceAgentManifestsOnce(gomock.Any(), "ki-1", "ag-1").Return("kind: Namespace\n", nil).Times(1)
+
+	_, err := e.Update(context.Background(), a)
+	require.NoError(t, err)
+	assert.Equal(t, "2:v1.4.0", a.Status.AtProvider.ManifestsSinkRevision)
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, e.Kube.Get(context.Background(), k8stypes.NamespacedName{Namespace: "crossplane-system", Name: "agt-manifests"}, cm))
+	assert.Equal(t, "kind: Namespace\n", cm.Data[kube.ManifestsSinkKey])
+}
+
+func TestUpdate_RotationWithManifestsSink(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().Build()
+	a := newSinkAgent()
+	a.SetAnnotations(map[string]string{
+		meta.AnnotationKeyExternalName:       "agt",
+		v1alpha1.AnnotationRotateCredentials: "nonce-1",
+	})
+	a.Status.AtProvider.ID = "ag-1"
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().RotateKargoAgentCredentials(gomock.Any(), "ki-1", "agt").Return(nil).Times(1)
+	mc.EXPECT().GetKargoInstanceAgentManifestsOnce(gomock.Any(), "ki-1", "ag-1").Return("kind: Namespace\n", nil).Times(1)
+
+	_, err := e.Update(context.Background(), a)
+	require.NoError(t, err)
+	assert.Equal(t, "nonce-1", a.Status.AtProvider.LastCredentialRotation)
+}
+
+var sundayMaintenanceWindows = []v1alpha1.MaintenanceWindow{{
+	Schedule: "0 2 * * 0",
+	Duration: metav1.Duration{Duration: 2 * time.Hour},
+}}
+
+func fixedClock(s string) func() time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return func() time.Time { return t }
+}
+
+func TestObserve_MaintenanceWindowOpenForcesUpdate(t *testing.T) {
+	e, mc := newExt(t)
+	e.now = fixedClock("2026-10-18T03:00:00Z")
+	a := newAgent()
+	a.Spec.ForProvider.KargoAgentSpec.Data = crossplanetypes.KargoAgentData{}
+	a.Spec.ForProvider.MaintenanceWindows = sundayMaintenanceWindows
+	meta.SetExternalName(a, "agt")
+	expectObservedAgent(mc)
+
+	obs, err := e.Observe(context.Background(), a)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+	require.NotNil(t, a.Status.AtProvider.MaintenanceWindow)
+	assert.True(t, a.Status.AtProvider.MaintenanceWindow.Active)
+}
+
+func TestObserve_MaintenanceWindowClosedIsUpToDate(t *testing.T) {
+	e, mc := newExt(t)
+	e.now = fixedClock("2026-10-17T12:00:00Z")
+	a := newAgent()
+	a.Spec.ForProvider.KargoAgentSpec.Data = crossplanetypes.KargoAgentData{}
+	a.Spec.ForProvider.MaintenanceWindows = sundayMaintenanceWindows
+	meta.SetExternalName(a, "agt")
+	expectObservedAgent(mc)
+
+	obs, err := e.Observe(context.Background(), a)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	require.NotNil(t, a.Status.AtProvider.MaintenanceWindow)
+	assert.False(t, a.Status.AtProvider.MaintenanceWindow.Active)
+	require.NotNil(t, a.Status.AtProvider.MaintenanceWindow.NextStart)
+}
+
+func TestUpdate_MaintenanceWindowOpenSetsExpiry(t *testing.T) {
+	e, mc := newExt(t)
+	e.now = fixedClock("2026-10-18T03:00:00Z")
+	a := newAgent()
+	a.Spec.ForProvider.MaintenanceWindows = sundayMaintenanceWindows
+	meta.SetExternalName(a, "agt")
+	wantExpiry := time.Date(2026, 10, 18, 4, 0, 0, 0, time.UTC)
+
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().SetKargoAgentMaintenanceMode(gomock.Any(), "ki-1", "agt", true, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, _ bool, got *time.Time) error {
+			require.NotNil(t, got)
+			assert.True(t, wantExpiry.Equal(*got))
+			return nil
+		}).Times(1)
+
+	_, err := e.Update(context.Background(), a)
+	require.NoError(t, err)
+}
+
+func TestUpdate_MaintenanceWindowClosedDisablesMaintenance(t *testing.T) {
+	e, mc := newExt(t)
+	e.now = fixedClock("2026-10-18T04:00:01Z")
+	a := newAgent()
+	a.Spec.ForProvider.MaintenanceWindows = sundayMaintenanceWindows
+	a.Status.AtProvider.KargoAgentSpec.Data.MaintenanceMode = boolPtr(true)
+	meta.SetExternalName(a, "agt")
+
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mc.EXPECT().SetKargoAgentMaintenanceMode(gomock.Any(), "ki-1", "agt", false, nil).Return(nil).Times(1)
+
+	_, err := e.Update(context.Background(), a)
+	require.NoError(t, err)
+}
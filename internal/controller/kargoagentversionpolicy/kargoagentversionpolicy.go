@@ -0,0 +1,479 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kargoagentversionpolicy is the KargoAgentVersionPolicy
+// controller. It rolls an agent version out to the KargoAgent managed
+// resources of one Kargo instance in batches through
+// UpdateInstanceAgentVersion.
+//
+// Every poll reads each selected agent with GetKargoInstanceAgent. A
+// batch succeeds once all of its agents report the target version, a
+// healthy status, and a successful reconciliation; the next batch
+// starts after PauseBetweenWaves. An agent that runs the target
+// version and reports Degraded health or a failed reconciliation halts
+// the rollout until the target version changes.
+//
+// Agents with akuityManaged set are skipped: the platform keeps their
+// version current on its own.
+//
+// The policy has no platform-side object. Deleting it stops the
+// rollout and leaves every agent at the version it was last set to.
+package kargoagentversionpolicy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	healthv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/health/v1"
+	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.KargoAgentVersionPolicyGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.KargoAgentVersionPolicy]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.KargoAgentVersionPolicy] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r), now: time.Now}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.KargoAgentVersionPolicyGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.KargoAgentVersionPolicy](conn),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(func(mg resource.Managed, pollInterval time.Duration) time.Duration {
+			p, ok := mg.(*v1alpha1.KargoAgentVersionPolicy)
+			if !ok {
+				return pollInterval
+			}
+			st := p.Status.AtProvider
+			return base.RolloutPollInterval(st.Phase == v1alpha1.AgentRolloutPaused, p.Spec.ForProvider.PauseBetweenWaves, st.WaveCompletionTime, time.Now(), pollInterval)
+		}),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.KargoAgentVersionPolicy{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+	now func() time.Time
+}
+
+// agentState is the platform view of one selected agent.
+type agentState struct {
+	mr             *v1alpha1.KargoAgent
+	name           string
+	version        string
+	health         healthv1.StatusCode
+	healthMessage  string
+	reconciliation reconv1.StatusCode
+	reconMessage   string
+}
+
+// succeeded reports whether the agent runs target and has settled.
+func (a agentState) succeeded(target string) bool {
+	return base.AgentVersionMatches(a.version, target) &&
+		a.health == healthv1.StatusCode_STATUS_CODE_HEALTHY &&
+		a.reconciliation == reconv1.StatusCode_STATUS_CODE_SUCCESSFUL
+}
+
+// failure describes why an agent that runs target halts the rollout,
+// or returns "" if it does not.
+func (a agentState) failure(target string) string {
+	if !base.AgentVersionMatches(a.version, target) {
+		return ""
+	}
+	switch {
+	case a.health == healthv1.StatusCode_STATUS_CODE_DEGRADED:
+		return fmt.Sprintf("agent %s is degraded after upgrading to %s: %s", a.name, target, a.healthMessage)
+	case a.reconciliation == reconv1.StatusCode_STATUS_CODE_FAILED:
+		return fmt.Sprintf("agent %s failed to reconcile after upgrading to %s: %s", a.name, target, a.reconMessage)
+	}
+	return ""
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.KargoAgentVersionPolicy) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+
+	// The policy has no platform-side object, so there is nothing for
+	// Delete to wait on.
+	if meta.WasDeleted(mg) {
+		e.ClearTerminalWriteResource(mg, v1alpha1.KargoAgentVersionPolicyGroupVersionKind)
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if e.HasTerminalWriteResource(mg, v1alpha1.KargoAgentVersionPolicyGroupVersionKind) {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	}
+	if meta.GetExternalName(mg) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	agents, err := e.observeAgents(ctx, mg, instanceID)
+	if err != nil {
+		mg.SetConditions(xpv1.ReconcileError(err))
+		return managed.ExternalObservation{}, err
+	}
+
+	upToDate := evaluateRollout(mg, instanceID, agents, e.now())
+	base.SetHealthCondition(mg, mg.Status.AtProvider.Phase != v1alpha1.AgentRolloutHalted)
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg, instanceID); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg, instanceID)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+// Create only marks the policy as existing. The first batch is started
+// by the Update that follows the next Observe.
+func (e *external) Create(ctx context.Context, mg *v1alpha1.KargoAgentVersionPolicy) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	mg.Status.AtProvider.KargoInstanceID = instanceID
+	meta.SetExternalName(mg, mg.GetName())
+	return managed.ExternalCreation{}, nil
+}
+
+// Update starts the next batch: it sets the target version on the next
+// agents that do not yet run it.
+func (e *external) Update(ctx context.Context, mg *v1alpha1.KargoAgentVersionPolicy) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	agents, err := e.observeAgents(ctx, mg, instanceID)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	key, err := terminalWriteKey(mg, instanceID)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	target := mg.Spec.ForProvider.TargetVersion
+	var pending []agentState
+	for _, a := range agents {
+		if !base.AgentVersionMatches(a.version, target) {
+			pending = append(pending, a)
+		}
+	}
+	if len(pending) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	size, err := base.RolloutWaveSize(mg.Spec.ForProvider.WaveSize, len(agents))
+	if err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, err)
+	}
+	wave := pending[:min(size, len(pending))]
+	names := make([]string, 0, len(wave))
+	for _, a := range wave {
+		names = append(names, a.name)
+	}
+
+	if err := e.Client.UpdateKargoAgentsVersion(ctx, instanceID, names, target); err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+
+	st := &mg.Status.AtProvider
+	st.Wave++
+	st.WaveAgents = names
+	st.WaveCompletionTime = nil
+	st.Phase = v1alpha1.AgentRolloutProgressing
+	st.Message = fmt.Sprintf("started batch %d: %s", st.Wave, strings.Join(names, ", "))
+
+	// A KargoAgent that pins a different target version would revert
+	// the upgrade on its next poll. Agents that leave it unset adopt
+	// the platform's version and need no change.
+	for _, a := range wave {
+		if err := e.pinTargetVersion(ctx, a.mr, target); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete stops the rollout. Agents keep the version they were last set
+// to.
+func (e *external) Delete(_ context.Context, mg *v1alpha1.KargoAgentVersionPolicy) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.KargoAgentVersionPolicyGroupVersionKind)
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// evaluateRollout refreshes the rollout status from the selected
+// agents and reports whether the policy is up to date, i.e. whether no
+// batch should be started right now.
+func evaluateRollout(mg *v1alpha1.KargoAgentVersionPolicy, instanceID string, agents []agentState, now time.Time) bool {
+	target := mg.Spec.ForProvider.TargetVersion
+	st := &mg.Status.AtProvider
+	if st.TargetVersion != target {
+		*st = v1alpha1.KargoAgentVersionPolicyObservation{TargetVersion: target}
+	}
+	st.KargoInstanceID = instanceID
+	st.SelectedAgents = int32(len(agents)) //nolint:gosec // bounded by the number of KargoAgent MRs
+
+	var (
+		updated, remaining int32
+		byName             = make(map[string]agentState, len(agents))
+	)
+	for _, a := range agents {
+		byName[a.name] = a
+		switch {
+		case !base.AgentVersionMatches(a.version, target):
+			remaining++
+		case a.succeeded(target):
+			updated++
+		default:
+			if msg := a.failure(target); msg != "" && st.Phase != v1alpha1.AgentRolloutHalted {
+				st.Phase = v1alpha1.AgentRolloutHalted
+				st.Message = msg
+			}
+		}
+	}
+	st.UpdatedAgents = updated
+	if st.Phase == v1alpha1.AgentRolloutHalted {
+		return true
+	}
+
+	// Agents dropped from the selection since the batch started no
+	// longer gate it.
+	var waiting []string
+	for _, name := range st.WaveAgents {
+		if a, ok := byName[name]; ok && !a.succeeded(target) {
+			waiting = append(waiting, name)
+		}
+	}
+	if len(waiting) > 0 {
+		st.Phase = v1alpha1.AgentRolloutProgressing
+		st.Message = fmt.Sprintf("waiting for batch %d: %s", st.Wave, strings.Join(waiting, ", "))
+		return true
+	}
+	if st.Wave > 0 && st.WaveCompletionTime == nil {
+		st.WaveCompletionTime = &metav1.Time{Time: now}
+	}
+
+	if remaining == 0 {
+		if updated == st.SelectedAgents {
+			st.Phase = v1alpha1.AgentRolloutCompleted
+			st.Message = ""
+		} else {
+			st.Phase = v1alpha1.AgentRolloutProgressing
+			st.Message = fmt.Sprintf("waiting for %d agent(s) to report healthy and reconciled", st.SelectedAgents-updated)
+		}
+		return true
+	}
+
+	if next, ok := base.NextRolloutWave(mg.Spec.ForProvider.PauseBetweenWaves, st.WaveCompletionTime); ok && now.Before(next) {
+		st.Phase = v1alpha1.AgentRolloutPaused
+		st.Message = fmt.Sprintf("batch %d succeeded; next batch at %s", st.Wave, next.UTC().Format(time.RFC3339))
+		return true
+	}
+	return false
+}
+
+// observeAgents lists the KargoAgent MRs matched by AgentSelector that
+// belong to instanceID and reads each one from the platform, sorted by
+// agent name so batches are picked in a stable order. Agents being
+// deleted, agents not yet created on the platform, and akuityManaged
+// agents are skipped.
+func (e *external) observeAgents(ctx context.Context, mg *v1alpha1.KargoAgentVersionPolicy, instanceID string) ([]agentState, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&mg.Spec.ForProvider.AgentSelector)
+	if err != nil {
+		return nil, reason.AsTerminal(fmt.Errorf("spec.forProvider.agentSelector: %w", err))
+	}
+	list := &v1alpha1.KargoAgentList{}
+	if err := e.Kube.List(ctx, list, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("could not list kargo agents: %w", err)
+	}
+
+	ref := mg.Spec.ForProvider.KargoInstanceRef
+	agents := make([]agentState, 0, len(list.Items))
+	for i := range list.Items {
+		mr := &list.Items[i]
+		if meta.WasDeleted(mr) {
+			continue
+		}
+		p := mr.Spec.ForProvider
+		sameInstance := p.KargoInstanceID == instanceID ||
+			(p.KargoInstanceID == "" && ref != nil && p.KargoInstanceRef != nil && p.KargoInstanceRef.Name == ref.Name)
+		if !sameInstance {
+			continue
+		}
+
+		name := agentName(mr)
+		agent, err := e.Client.GetKargoInstanceAgent(ctx, instanceID, name)
+		if reason.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if agent.GetData().GetAkuityManaged() {
+			continue
+		}
+		agents = append(agents, agentState{
+			mr:             mr,
+			name:           name,
+			version:        agent.GetAgentState().GetVersion(),
+			health:         agent.GetHealthStatus().GetCode(),
+			healthMessage:  agent.GetHealthStatus().GetMessage(),
+			reconciliation: agent.GetReconciliationStatus().GetCode(),
+			reconMessage:   agent.GetReconciliationStatus().GetMessage(),
+		})
+	}
+	slices.SortFunc(agents, func(a, b agentState) int {
+		return strings.Compare(a.name, b.name)
+	})
+	return agents, nil
+}
+
+// agentName returns the Akuity agent name of a KargoAgent MR.
+func agentName(a *v1alpha1.KargoAgent) string {
+	if name := meta.GetExternalName(a); name != "" {
+		return name
+	}
+	return a.Spec.ForProvider.Name
+}
+
+// pinTargetVersion rewrites a KargoAgent's pinned
+// spec.forProvider.kargoAgentSpec.data.targetVersion to version. An
+// unset target version is left alone.
+func (e *external) pinTargetVersion(ctx context.Context, a *v1alpha1.KargoAgent, version string) error {
+	current := a.Spec.ForProvider.KargoAgentSpec.Data.TargetVersion
+	if current == "" || current == version {
+		return nil
+	}
+	patch := client.MergeFrom(a.DeepCopy())
+	a.Spec.ForProvider.KargoAgentSpec.Data.TargetVersion = version
+	if err := e.Kube.Patch(ctx, a, patch); err != nil {
+		return fmt.Errorf("could not pin target version on KargoAgent %s: %w", a.GetName(), err)
+	}
+	return nil
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.KargoAgentVersionPolicy, instanceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := terminalWriteKey(mg, instanceID)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.KargoAgentVersionPolicy, instanceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.KargoAgentVersionPolicyGroupVersionKind) {
+		return
+	}
+	key, err := terminalWriteKey(mg, instanceID)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func terminalWriteKey(mg *v1alpha1.KargoAgentVersionPolicy, instanceID string) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.KargoAgentVersionPolicyGroupVersionKind, map[string]any{
+		"kargoInstanceID": instanceID,
+		"targetVersion":   mg.Spec.ForProvider.TargetVersion,
+		"waveSize":        mg.Spec.ForProvider.WaveSize.String(),
+	})
+}
+
+// resolveInstanceID returns the opaque Akuity ID of the target Kargo
+// instance. ForProvider.KargoInstanceID takes precedence; if absent,
+// KargoInstanceRef is resolved against a KargoInstance MR and its
+// Status.AtProvider.ID is used.
+func (e *external) resolveInstanceID(ctx context.Context, mg *v1alpha1.KargoAgentVersionPolicy) (string, error) {
+	if id := mg.Spec.ForProvider.KargoInstanceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.KargoInstanceRef == nil || mg.Spec.ForProvider.KargoInstanceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.kargoInstanceId or spec.forProvider.kargoInstanceRef must be set")
+	}
+	key := k8stypes.NamespacedName{Name: mg.Spec.ForProvider.KargoInstanceRef.Name, Namespace: mg.GetNamespace()}
+	ki := &v1alpha1.KargoInstance{}
+	if err := e.Kube.Get(ctx, key, ki); err != nil {
+		return "", fmt.Errorf("could not resolve KargoInstanceRef %s: %w", key.Name, err)
+	}
+	if ki.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced KargoInstance %s has not yet reported an ID; waiting for its controller to observe", key.Name)
+	}
+	return ki.Status.AtProvider.ID, nil
+}
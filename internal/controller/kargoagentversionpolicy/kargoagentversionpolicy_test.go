@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kargoagentversionpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
+	healthv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/health/v1"
+	reconv1 "github.com/akuity/api-client-go/pkg/api/gen/types/status/reconciliation/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+const (
+	kargoInstanceID = "ki-1"
+	oldVersion      = "0.5.9"
+	newVersion      = "0.5.10"
+)
+
+var fixedNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newPolicy(waveSize intstr.IntOrString, pause time.Duration) *v1alpha1.KargoAgentVersionPolicy {
+	mg := &v1alpha1.KargoAgentVersionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "rollout", UID: "rollout-uid"},
+		Spec: v1alpha1.KargoAgentVersionPolicySpec{
+			ForProvider: v1alpha1.KargoAgentVersionPolicyParameters{
+				KargoInstanceID: kargoInstanceID,
+				AgentSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"tier": "prod"},
+				},
+				TargetVersion: newVersion,
+				WaveSize:      waveSize,
+			},
+		},
+	}
+	if pause > 0 {
+		mg.Spec.ForProvider.PauseBetweenWaves = &metav1.Duration{Duration: pause}
+	}
+	meta.SetExternalName(mg, "rollout")
+	return mg
+}
+
+func newAgentMR(name string) *v1alpha1.KargoAgent {
+	a := &v1alpha1.KargoAgent{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"tier": "prod"}},
+		Spec: v1alpha1.KargoAgentSpec{
+			ForProvider: v1alpha1.KargoAgentParameters{KargoInstanceID: kargoInstanceID, Name: name},
+		},
+	}
+	return a
+}
+
+// platformAgent returns the GetKargoInstanceAgent view of an agent.
+func platformAgent(name, version string, health healthv1.StatusCode, recon reconv1.StatusCode) *kargov1.KargoAgent {
+	return &kargov1.KargoAgent{
+		Name:                 name,
+		Data:                 &kargov1.KargoAgentData{},
+		AgentState:           &kargov1.KargoAgentState{Version: version},
+		HealthStatus:         &healthv1.Status{Code: health, Message: "health " + name},
+		ReconciliationStatus: &reconv1.Status{Code: recon, Message: "recon " + name},
+	}
+}
+
+func healthy(name, version string) *kargov1.KargoAgent {
+	return platformAgent(name, version, healthv1.StatusCode_STATUS_CODE_HEALTHY, reconv1.StatusCode_STATUS_CODE_SUCCESSFUL)
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{
+		ExternalClient: base.ExternalClient{
+			Client:         mc,
+			Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+			Logger:         logging.NewNopLogger(),
+			TerminalWrites: base.NewTerminalWriteGuard(),
+		},
+		now: func() time.Time { return fixedNow },
+	}, mc
+}
+
+// expectAgents serves GetKargoInstanceAgent from the given platform
+// agents, keyed by name.
+func expectAgents(mc *mockclient.MockClient, agents ...*kargov1.KargoAgent) {
+	for _, a := range agents {
+		mc.EXPECT().GetKargoInstanceAgent(gomock.Any(), kargoInstanceID, a.GetName()).Return(a, nil).AnyTimes()
+	}
+}
+
+func TestObserve_FirstBatchPending(t *testing.T) {
+	e, mc := newExt(t, newAgentMR("a"), newAgentMR("b"))
+	expectAgents(mc, healthy("a", oldVersion), healthy("b", oldVersion))
+	mg := newPolicy(intstr.FromInt32(1), 0)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.False(t, obs.ResourceUpToDate)
+	assert.Equal(t, int32(2), mg.Status.AtProvider.SelectedAgents)
+	assert.Equal(t, kargoInstanceID, mg.Status.AtProvider.KargoInstanceID)
+}
+
+func TestObserve_SkipsUnselectedMissingAndManagedAgents(t *testing.T) {
+	other := newAgentMR("other-instance")
+	other.Spec.ForProvider.KargoInstanceID = "ki-2"
+	unlabelled := newAgentMR("unlabelled")
+	unlabelled.Labels = nil
+	e, mc := newExt(t, newAgentMR("a"), newAgentMR("missing"), newAgentMR("managed"), other, unlabelled)
+	managedAgent := healthy("managed", oldVersion)
+	managedAgent.Data.AkuityManaged = true
+	expectAgents(mc, healthy("a", newVersion), managedAgent)
+	mc.EXPECT().GetKargoInstanceAgent(gomock.Any(), kargoInstanceID, "missing").
+		Return(nil, reason.AsNotFound(errors.New("not found"))).AnyTimes()
+	mg := newPolicy(intstr.FromInt32(1), 0)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.AgentRolloutCompleted, mg.Status.AtProvider.Phase)
+	assert.Equal(t, int32(1), mg.Status.AtProvider.SelectedAgents)
+	assert.Equal(t, int32(1), mg.Status.AtProvider.UpdatedAgents)
+}
+
+func TestObserve_WaitsForReconciliation(t *testing.T) {
+	e, mc := newExt(t, newAgentMR("a"), newAgentMR("b"))
+	expectAgents(mc,
+		platformAgent("a", newVersion, healthv1.StatusCode_STATUS_CODE_HEALTHY, reconv1.StatusCode_STATUS_CODE_PROGRESSING),
+		healthy("b", oldVersion),
+	)
+	mg := newPolicy(intstr.FromInt32(1), 0)
+	mg.Status.AtProvider = v1alpha1.KargoAgentVersionPolicyObservation{
+		TargetVersion: newVersion,
+		Wave:          1,
+		WaveAgents:    []string{"a"},
+	}
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.AgentRolloutProgressing, mg.Status.AtProvider.Phase)
+	assert.Contains(t, mg.Status.AtProvider.Message, "waiting for batch 1: a")
+}
+
+func TestObserve_PausesBetweenBatches(t *testing.T) {
+	e, mc := newExt(t, newAgentMR("a"), newAgentMR("b"))
+	expectAgents(mc, healthy("a", newVersion), healthy("b", oldVersion))
+	mg := newPolicy(intstr.FromInt32(1), 5*time.Minute)
+	mg.Status.AtProvider = v1alpha1.KargoAgentVersionPolicyObservation{
+		TargetVersion: newVersion,
+		Wave:          1,
+		WaveAgents:    []string{"a"},
+	}
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.AgentRolloutPaused, mg.Status.AtProvider.Phase)
+
+	e.now = func() time.Time { return fixedNow.Add(5 * time.Minute) }
+	obs, err = e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_HaltsOnFailedReconciliation(t *testing.T) {
+	e, mc := newExt(t, newAgentMR("a"), newAgentMR("b"))
+	expectAgents(mc,
+		platformAgent("a", newVersion, healthv1.StatusCode_STATUS_CODE_PROGRESSING, reconv1.StatusCode_STATUS_CODE_FAILED),
+		healthy("b", oldVersion),
+	)
+	mg := newPolicy(intstr.FromInt32(1), 0)
+	mg.Status.AtProvider = v1alpha1.KargoAgentVersionPolicyObservation{
+		TargetVersion: newVersion,
+		Wave:          1,
+		WaveAgents:    []string{"a"},
+	}
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, v1alpha1.AgentRolloutHalted, mg.Status.AtProvider.Phase)
+	assert.Equal(t, "agent a failed to reconcile after upgrading to 0.5.10: recon a", mg.Status.AtProvider.Message)
+	assert.Equal(t, corev1.ConditionFalse, mg.GetCondition(xpv1.TypeReady).Status)
+}
+
+func TestObserve_HaltsOnDegradedAgent(t *testing.T) {
+	e, mc := newExt(t, newAgentMR("a"))
+	expectAgents(mc, platformAgent("a", newVersion, healthv1.StatusCode_STATUS_CODE_DEGRADED, reconv1.StatusCode_STATUS_CODE_SUCCESSFUL))
+	mg := newPolicy(intstr.FromInt32(1), 0)
+
+	_, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.AgentRolloutHalted, mg.Status.AtProvider.Phase)
+	assert.Contains(t, mg.Status.AtProvider.Message, "agent a is degraded")
+}
+
+func TestUpdate_StartsBatchAndPinsAgents(t *testing.T) {
+	pinned := newAgentMR("b")
+	pinned.Spec.ForProvider.KargoAgentSpec.Data.TargetVersion = oldVersion
+	e, mc := newExt(t, newAgentMR("c"), newAgentMR("a"), pinned)
+	expectAgents(mc, healthy("a", newVersion), healthy("b", oldVersion), healthy("c", oldVersion))
+	mg := newPolicy(intstr.FromInt32(2), 0)
+	mc.EXPECT().UpdateKargoAgentsVersion(gomock.Any(), kargoInstanceID, []string{"b", "c"}, newVersion).Return(nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), mg.Status.AtProvider.Wave)
+	assert.Equal(t, []string{"b", "c"}, mg.Status.AtProvider.WaveAgents)
+
+	for name, want := range map[string]string{"b": newVersion, "c": ""} {
+		got := &v1alpha1.KargoAgent{}
+		require.NoError(t, e.Kube.Get(context.Background(), k8stypes.NamespacedName{Name: name}, got))
+		assert.Equal(t, want, got.Spec.ForProvider.KargoAgentSpec.Data.TargetVersion, name)
+	}
+}
+
+func TestUpdate_RejectedIsTerminal(t *testing.T) {
+	e, mc := newExt(t, newAgentMR("a"))
+	expectAgents(mc, healthy("a", oldVersion))
+	mg := newPolicy(intstr.FromInt32(1), 0)
+	mc.EXPECT().UpdateKargoAgentsVersion(gomock.Any(), kargoInstanceID, []string{"a"}, newVersion).
+		Return(reason.AsTerminal(errors.New("unknown agent version"))).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.Error(t, err)
+
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestObserve_RefNotYetObserved(t *testing.T) {
+	ki := &v1alpha1.KargoInstance{ObjectMeta: metav1.ObjectMeta{Name: "kargo"}}
+	e, _ := newExt(t, ki)
+	mg := newPolicy(intstr.FromInt32(1), 0)
+	mg.Spec.ForProvider.KargoInstanceID = ""
+	mg.Spec.ForProvider.KargoInstanceRef = &v1alpha1.LocalReference{Name: "kargo"}
+
+	_, err := e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "KargoInstance kargo has not yet reported an ID")
+}
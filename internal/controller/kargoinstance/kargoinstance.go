@@ -68,6 +68,20 @@ const (
 	kargoSecretKey = "kargo-secret"
 )
 
+// Connection detail keys published for a Kargo instance. The names
+// match the Instance connection details so compositions bind both the
+// same way.
+const (
+	connectionKeyID                = "id"
+	connectionKeyHostname          = "hostname"
+	connectionKeyURL               = "url"
+	connectionKeyAdminPasswordHash = "adminPasswordHash"
+)
+
+// kargoSecretAdminPasswordKey is the canonical kargo-secret key holding
+// the admin account password hash.
+const kargoSecretAdminPasswordKey = "adminAccountPasswordHash"
+
 // Declarative Kargo child-resource contract. Each entry in
 // spec.forProvider.resources must carry one of these
 // apiVersion/kind pairs; anything else is rejected during reconcile.
@@ -127,11 +141,13 @@ func driftSpec() base.DriftSpec[v1alpha1.KargoInstanceParameters] {
 			if desired == nil || observed == nil {
 				return
 			}
-			// Workspace is a spec-only field; Kargo Export doesn't echo
-			// it. Copy desired onto observed so the compare is neutral.
+			// Workspace and WorkspaceRef are spec-only fields; Kargo
+			// Export doesn't echo them. Copy desired onto observed so
+			// the compare is neutral.
 			if observed.Workspace == "" {
 				observed.Workspace = desired.Workspace
 			}
+			observed.WorkspaceRef = desired.WorkspaceRef
 			// Server sets Subdomain and AkuityIntelligenceExtension
 			// defaults on every KargoInstance. Inherit them into the
 			// desired side when the CR didn't pin a value.
@@ -277,7 +293,11 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.KargoInstance) (man
 	prevWorkspace := mg.Status.AtProvider.Workspace
 	prevKargoConfigMapHash := mg.Status.AtProvider.KargoConfigMapHash
 	prevKargoResourcesHash := mg.Status.AtProvider.KargoResourcesHash
+	prevResolvedWorkspace := mg.Status.AtProvider.ResolvedWorkspace
+	prevPreflightFeaturesHash := mg.Status.AtProvider.PreflightFeaturesHash
 	mg.Status.AtProvider = observation.KargoInstance(ki)
+	mg.Status.AtProvider.ResolvedWorkspace = prevResolvedWorkspace
+	mg.Status.AtProvider.PreflightFeaturesHash = prevPreflightFeaturesHash
 	mg.Status.AtProvider.SecretHash = prevSecretHash
 	mg.Status.AtProvider.KargoConfigMapHash = prevKargoConfigMapHash
 	mg.Status.AtProvider.KargoResourcesHash = prevKargoResourcesHash
@@ -329,6 +349,10 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.KargoInstance) (man
 	// carrying it over, so real drift can surface.
 	actual.KargoSecretRef = mg.Spec.ForProvider.KargoSecretRef
 
+	// PublishAdminPassword only affects the connection details and is
+	// never sent to the gateway; carry it forward for the same reason.
+	actual.PublishAdminPassword = mg.Spec.ForProvider.PublishAdminPassword
+
 	// DexConfigSecretRef lives nested under spec.oidcConfig and is
 	// spec-only; carry it forward so the struct comparison doesn't
 	// flag it as drift. Inline spec.oidcConfig.dexConfigSecret values
@@ -363,6 +387,18 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.KargoInstance) (man
 		return managed.ExternalObservation{}, err
 	}
 
+	// Workspace is a routing selector rather than struct drift. A spec
+	// workspace that resolves somewhere other than the observed one is a
+	// pending move, which apply() performs before re-applying.
+	if upToDate {
+		moving, merr := e.workspaceMovePending(ctx, mg)
+		if merr != nil {
+			mg.SetConditions(xpv1.ReconcileError(merr))
+			return managed.ExternalObservation{}, merr
+		}
+		upToDate = !moving
+	}
+
 	// Export-based drift check runs when the user has spec-level
 	// opinions on either kargoConfigMap or kargoResources. A single
 	// Export serves both, minimizing gateway round-trips.
@@ -406,12 +442,14 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.KargoInstance) (man
 		}
 	}
 
+	var kargoSecret *kargoResolvedSecret
 	if upToDate {
 		sec, serr := resolveKargoSecrets(ctx, e.Kube, mg)
 		if serr != nil {
 			mg.SetConditions(xpv1.ReconcileError(serr))
 			return managed.ExternalObservation{}, serr
 		}
+		kargoSecret = &sec.Kargo
 		if sec.Hash() != getSecretHash(mg) {
 			e.Logger.Debug("KargoInstance secret hash changed; forcing re-Apply",
 				"previous", getSecretHash(mg), "current", sec.Hash())
@@ -419,27 +457,34 @@ func (e *external) Observe(ctx context.Context, mg *v1alpha1.KargoInstance) (man
 		}
 	}
 	if !upToDate {
-		if obs, err, ok := e.suppressTerminalWrite(ctx, mg); ok {
+		// resolveWorkspaceID stamps the desired workspace onto status.
+		// Keep the observed one so a pending move stays visible to
+		// apply() in this reconcile.
+		observedWorkspace := mg.Status.AtProvider.Workspace
+		obs, err, ok := e.suppressTerminalWrite(ctx, mg)
+		mg.Status.AtProvider.Workspace = observedWorkspace
+		if ok {
 			return obs, err
 		}
 	} else {
 		e.clearTerminalWrite(ctx, mg)
 	}
-	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+	details := e.connectionDetails(ctx, mg, kargoSecret)
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate, ConnectionDetails: details}, nil
 }
 
 func (e *external) Create(ctx context.Context, mg *v1alpha1.KargoInstance) (managed.ExternalCreation, error) {
 	defer base.PropagateObservedGeneration(mg)
-	if err := e.apply(ctx, mg); err != nil {
+	if err := e.apply(ctx, mg, true); err != nil {
 		return managed.ExternalCreation{}, err
 	}
 	meta.SetExternalName(mg, mg.Spec.ForProvider.Name)
-	return managed.ExternalCreation{}, nil
+	return managed.ExternalCreation{ConnectionDetails: e.connectionDetails(ctx, mg, nil)}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg *v1alpha1.KargoInstance) (managed.ExternalUpdate, error) {
 	defer base.PropagateObservedGeneration(mg)
-	return managed.ExternalUpdate{}, e.apply(ctx, mg)
+	return managed.ExternalUpdate{}, e.apply(ctx, mg, false)
 }
 
 func (e *external) Delete(ctx context.Context, mg *v1alpha1.KargoInstance) (managed.ExternalDelete, error) {
@@ -455,16 +500,61 @@ func (e *external) Delete(ctx context.Context, mg *v1alpha1.KargoInstance) (mana
 
 func (e *external) Disconnect(_ context.Context) error { return nil }
 
-// apply is shared by Create and Update.
+// connectionDetails publishes the Kargo instance's identifiers and API
+// endpoint. The Kargo API and UI share the instance hostname, so url
+// serves both the kargo CLI and browsers. The admin password hash is
+// included only when publishAdminPassword is set and kargoSecretRef
+// carries adminAccountPasswordHash under any accepted spelling. It is a
+// bcrypt hash, not a usable password, and is named accordingly. Keys
+// whose value is not known yet, such as the ID and hostname before the
+// first Observe, are left out.
+//
+// kargoSecret is the kargoSecretRef Secret when the caller has already
+// resolved it; otherwise it is resolved here. A failed lookup only
+// omits the hash: the connection Secret is patched, so the previously
+// published value is kept, and observation is not blocked.
+func (e *external) connectionDetails(ctx context.Context, mg *v1alpha1.KargoInstance, kargoSecret *kargoResolvedSecret) managed.ConnectionDetails {
+	publishHash := mg.Spec.ForProvider.PublishAdminPassword
+	if publishHash && kargoSecret == nil {
+		sec, err := resolveKargoSecret(ctx, e.Kube, mg.Spec.ForProvider.KargoSecretRef)
+		if err != nil {
+			e.Logger.Debug("Could not resolve kargoSecretRef for connection details; omitting adminPasswordHash", "error", err)
+		} else {
+			kargoSecret = &sec
+		}
+	}
+	details := managed.ConnectionDetails{}
+	if id := mg.Status.AtProvider.ID; id != "" {
+		details[connectionKeyID] = []byte(id)
+	}
+	if host := mg.Status.AtProvider.Hostname; host != "" {
+		details[connectionKeyHostname] = []byte(host)
+		details[connectionKeyURL] = []byte("https://" + host)
+	}
+	if publishHash && kargoSecret != nil {
+		if hash := kargoSecret.Data[kargoSecretAdminPasswordKey]; hash != "" {
+			details[connectionKeyAdminPasswordHash] = []byte(hash)
+		}
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	return details
+}
+
+// apply is shared by Create and Update; create is set for Create.
 //
 //nolint:gocyclo // apply orchestrates 6 independent subsystems (secrets, configmap, spec, children, repo creds, status writeback); splitting them yields 6 trivial wrappers without clarity gain.
-func (e *external) apply(ctx context.Context, mg *v1alpha1.KargoInstance) error {
+func (e *external) apply(ctx context.Context, mg *v1alpha1.KargoInstance, create bool) error {
 	if acd := mg.Spec.ForProvider.Kargo.KargoInstanceSpec.AgentCustomizationDefaults; acd != nil {
 		if err := crossplanetypes.ValidateKustomizationYAML(acd.Kustomization); err != nil {
 			return fmt.Errorf("spec.forProvider.spec.kargoInstanceSpec.agentCustomizationDefaults.kustomization: %w", err)
 		}
 	}
 
+	if err := e.moveWorkspace(ctx, mg); err != nil {
+		return err
+	}
 	workspaceID, err := e.resolveWorkspaceID(ctx, mg)
 	if err != nil {
 		return err
@@ -486,7 +576,13 @@ func (e *external) apply(ctx context.Context, mg *v1alpha1.KargoInstance) error
 	if err != nil {
 		return err
 	}
+	if err := e.preflight(ctx, mg, create); err != nil {
+		return e.RecordTerminalWrite(key, err)
+	}
 	if err := e.Client.ApplyKargoInstance(ctx, req); err != nil {
+		// The plan may have lost a feature since it was last checked.
+		// Check it again before the next apply.
+		mg.Status.AtProvider.PreflightFeaturesHash = ""
 		return e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
 	}
 	e.ClearTerminalWrite(key)
@@ -632,17 +728,27 @@ func (e *external) clearTerminalWrite(ctx context.Context, mg *v1alpha1.KargoIns
 // at roughly 350 wasted writes in 12 minutes.
 //
 // Resolution order:
+//  0. spec.forProvider.workspaceRef (the referenced Workspace MR's
+//     status.atProvider.id)
 //  1. spec.forProvider.workspace (user-pinned ID or name; canonical ID
 //     short-circuits against status.atProvider.workspace)
 //  2. status.atProvider.workspace (controller-cached canonical ID, when spec is empty)
 //  3. organization default discovered via the org gateway's ListWorkspaces
 //
-// On (1) and (3) the resolved canonical ID is stamped on
+// On (0), (1) and (3) the resolved canonical ID is stamped on
 // status.atProvider.workspace so subsequent reconciles short-circuit
 // when the spec is empty. The function preserves spec.forProvider.workspace
 // verbatim; the spec carries the user's intent (ID, name, or empty) and
 // must not be rewritten by Observe.
 func (e *external) resolveWorkspaceID(ctx context.Context, mg *v1alpha1.KargoInstance) (string, error) {
+	if ref := mg.Spec.ForProvider.WorkspaceRef; ref != nil {
+		id, err := base.ResolveWorkspaceRef(ctx, e.Kube, mg.GetNamespace(), ref)
+		if err != nil {
+			return "", err
+		}
+		mg.Status.AtProvider.Workspace = id
+		return id, nil
+	}
 	if ref := mg.Spec.ForProvider.Workspace; ref != "" {
 		if ref == mg.Status.AtProvider.Workspace {
 			return mg.Status.AtProvider.Workspace, nil
@@ -668,6 +774,55 @@ func (e *external) resolveWorkspaceID(ctx context.Context, mg *v1alpha1.KargoIns
 	return w.GetId(), nil
 }
 
+// desiredWorkspace returns the workspace spec asks for without touching
+// status: the referenced Workspace MR's ID, else
+// spec.forProvider.workspace. Empty leaves the instance where it is.
+func (e *external) desiredWorkspace(ctx context.Context, mg *v1alpha1.KargoInstance) (string, error) {
+	if ref := mg.Spec.ForProvider.WorkspaceRef; ref != nil {
+		return base.ResolveWorkspaceRef(ctx, e.Kube, mg.GetNamespace(), ref)
+	}
+	return mg.Spec.ForProvider.Workspace, nil
+}
+
+// workspaceMovePending reports whether spec names a different workspace
+// than the one the instance was last observed in. A workspace name is
+// resolved once per spec value and cached on
+// status.atProvider.resolvedWorkspace.
+func (e *external) workspaceMovePending(ctx context.Context, mg *v1alpha1.KargoInstance) (bool, error) {
+	if mg.Status.AtProvider.ID == "" {
+		return false, nil
+	}
+	desired, err := e.desiredWorkspace(ctx, mg)
+	if err != nil {
+		return false, err
+	}
+	pending, resolved, err := base.WorkspaceMovePending(ctx, e.Client, desired, mg.Status.AtProvider.Workspace, mg.Status.AtProvider.ResolvedWorkspace)
+	mg.Status.AtProvider.ResolvedWorkspace = resolved
+	return pending, err
+}
+
+// moveWorkspace moves an existing instance into the desired workspace
+// when it was last observed elsewhere. ApplyKargoInstance is
+// workspace-scoped, so the move must land before the apply. KargoAgents
+// need no follow-up: they resolve the parent's observed workspace on
+// every reconcile, so their next reconcile routes to the new one.
+func (e *external) moveWorkspace(ctx context.Context, mg *v1alpha1.KargoInstance) error {
+	pending, err := e.workspaceMovePending(ctx, mg)
+	if err != nil || !pending {
+		return err
+	}
+	desired, err := e.desiredWorkspace(ctx, mg)
+	if err != nil {
+		return err
+	}
+	if err := e.Client.MoveKargoInstanceWorkspace(ctx, mg.Status.AtProvider.ID, desired); err != nil {
+		return err
+	}
+	e.Logger.Debug("Moved KargoInstance to new workspace",
+		"from", mg.Status.AtProvider.Workspace, "to", desired)
+	return nil
+}
+
 // kargoChildren is the per-kind breakdown of the user's
 // spec.forProvider.resources bundle, already marshalled into the
 // structpb.Struct shape the ApplyKargoInstance proto expects.
@@ -1137,17 +1292,11 @@ func kargoHashRepoCreds(entries []kargoResolvedRepoCred) string {
 // spec (kargo-secret + dex config + repo credentials).
 func resolveKargoSecrets(ctx context.Context, kube client.Client, mg *v1alpha1.KargoInstance) (resolvedKargoSecrets, error) {
 	out := resolvedKargoSecrets{}
-	if ref := mg.Spec.ForProvider.KargoSecretRef; ref != nil {
-		resolved, kerr := secrets.Resolve(ctx, kube, ref)
-		if kerr != nil {
-			return out, secrets.AsTerminalIfConfig(fmt.Errorf("kargoSecretRef: %w", kerr))
-		}
-		normalized, nerr := normalizeKargoSecretData(resolved.Data)
-		if nerr != nil {
-			return out, reason.AsTerminal(fmt.Errorf("kargoSecretRef: %w", nerr))
-		}
-		out.Kargo = kargoResolvedSecret{Namespace: resolved.Namespace, Name: resolved.Name, Data: normalized}
+	kargo, err := resolveKargoSecret(ctx, kube, mg.Spec.ForProvider.KargoSecretRef)
+	if err != nil {
+		return out, err
 	}
+	out.Kargo = kargo
 	if dex, derr := resolveKargoDexSecret(ctx, kube, mg); derr != nil {
 		return out, secrets.AsTerminalIfConfig(derr)
 	} else if dex != nil {
@@ -1163,6 +1312,24 @@ func resolveKargoSecrets(ctx context.Context, kube client.Client, mg *v1alpha1.K
 	return out, nil
 }
 
+// resolveKargoSecret resolves the kargoSecretRef Secret and normalizes
+// its keys onto their canonical spelling. Returns the zero value when
+// ref is nil.
+func resolveKargoSecret(ctx context.Context, kube client.Client, ref *xpv1.SecretReference) (kargoResolvedSecret, error) {
+	if ref == nil {
+		return kargoResolvedSecret{}, nil
+	}
+	resolved, err := secrets.Resolve(ctx, kube, ref)
+	if err != nil {
+		return kargoResolvedSecret{}, secrets.AsTerminalIfConfig(fmt.Errorf("kargoSecretRef: %w", err))
+	}
+	normalized, err := normalizeKargoSecretData(resolved.Data)
+	if err != nil {
+		return kargoResolvedSecret{}, reason.AsTerminal(fmt.Errorf("kargoSecretRef: %w", err))
+	}
+	return kargoResolvedSecret{Namespace: resolved.Namespace, Name: resolved.Name, Data: normalized}, nil
+}
+
 // resolveKargoDexSecret resolves the OIDC dex config Secret when the CR
 // references one. Returns nil when the CR carries no dexConfigSecretRef.
 func resolveKargoDexSecret(ctx context.Context, kube client.Client, mg *v1alpha1.KargoInstance) (*kargoResolvedSecret, error) {
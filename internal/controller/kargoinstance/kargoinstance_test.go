@@ -22,10 +22,12 @@ import (
 
 	kargov1 "github.com/akuity/api-client-go/pkg/api/gen/kargo/v1"
 	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	featuresv1 "github.com/akuity/api-client-go/pkg/api/gen/types/features/v1"
 	health "github.com/akuity/api-client-go/pkg/api/gen/types/status/health/v1"
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -73,9 +75,18 @@ func newKI() *v1alpha1.KargoInstance {
 func newExt(t *testing.T) (*external, *mockclient.MockClient) {
 	t.Helper()
 	mc := mockclient.NewMockClient(gomock.NewController(t))
+	allowPreflight(mc)
 	return &external{ExternalClient: base.ExternalClient{Client: mc, Logger: logging.NewNopLogger()}}, mc
 }
 
+// allowPreflight lets the organization plan lookups made before every
+// write succeed with an unrestricted plan.
+func allowPreflight(mc *mockclient.MockClient) {
+	mc.EXPECT().ListKargoInstancesQuota(gomock.Any()).Return(nil, nil).AnyTimes()
+	mc.EXPECT().GetOrganizationQuota(gomock.Any()).Return(&featuresv1.OrganizationQuota{}, nil).AnyTimes()
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(&featuresv1.FeatureStatuses{}, nil).AnyTimes()
+}
+
 func TestObserve_NoExternalName(t *testing.T) {
 	e, _ := newExt(t)
 	obs, err := e.Observe(context.Background(), newKI())
@@ -97,6 +108,7 @@ func TestObserve_Available(t *testing.T) {
 	e, mc := newExt(t)
 	ki := newKI()
 	meta.SetExternalName(ki, "ki")
+	ki.Status.AtProvider.PreflightFeaturesHash = "checked"
 	mc.EXPECT().GetKargoInstance(gomock.Any(), "ki").Return(&kargov1.KargoInstance{
 		Id:           "id-1",
 		Name:         "ki",
@@ -110,6 +122,7 @@ func TestObserve_Available(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, obs.ResourceExists)
 	assert.Equal(t, "id-1", ki.Status.AtProvider.ID)
+	assert.Equal(t, "checked", ki.Status.AtProvider.PreflightFeaturesHash)
 }
 
 func TestObserve_ExportedKargoPropagatesToAtProvider(t *testing.T) {
@@ -169,6 +182,81 @@ func TestCreate_Apply(t *testing.T) {
 	assert.Equal(t, "ki", meta.GetExternalName(ki))
 }
 
+func TestObserve_PublishesConnectionDetails(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "kargo-admin"},
+		Data:       map[string][]byte{"admin_account_password_hash": []byte("$2a$10$hash")},
+	}).Build()
+	ki := newKI()
+	ki.Spec.ForProvider.KargoSecretRef = &xpv1.SecretReference{Namespace: "ns", Name: "kargo-admin"}
+	ki.Spec.ForProvider.PublishAdminPassword = true
+	meta.SetExternalName(ki, "ki")
+	mc.EXPECT().GetKargoInstance(gomock.Any(), "ki").Return(&kargov1.KargoInstance{
+		Id:           "id-1",
+		Name:         "ki",
+		Hostname:     "abc123.kargo.akuity.cloud",
+		Version:      "v1.0.0",
+		HealthStatus: &health.Status{Code: health.StatusCode_STATUS_CODE_HEALTHY},
+	}, nil).Times(1)
+	mc.EXPECT().ExportKargoInstance(gomock.Any(), "id-1", "ws-cached").
+		Return(&kargov1.ExportKargoInstanceResponse{}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), ki)
+	require.NoError(t, err)
+	assert.Equal(t, managed.ConnectionDetails{
+		connectionKeyID:                []byte("id-1"),
+		connectionKeyHostname:          []byte("abc123.kargo.akuity.cloud"),
+		connectionKeyURL:               []byte("https://abc123.kargo.akuity.cloud"),
+		connectionKeyAdminPasswordHash: []byte("$2a$10$hash"),
+	}, obs.ConnectionDetails)
+}
+
+func TestCreate_PublishesAdminPasswordHash(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "kargo-admin"},
+		Data:       map[string][]byte{"adminAccountPasswordHash": []byte("$2a$10$hash")},
+	}).Build()
+	ki := newKI()
+	ki.Spec.ForProvider.KargoSecretRef = &xpv1.SecretReference{Namespace: "ns", Name: "kargo-admin"}
+	ki.Spec.ForProvider.PublishAdminPassword = true
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	cre, err := e.Create(context.Background(), ki)
+	require.NoError(t, err)
+	// ID and hostname are assigned by the platform and published by the
+	// next Observe.
+	assert.Equal(t, managed.ConnectionDetails{
+		connectionKeyAdminPasswordHash: []byte("$2a$10$hash"),
+	}, cre.ConnectionDetails)
+}
+
+func TestCreate_OmitsAdminPasswordHashByDefault(t *testing.T) {
+	e, mc := newExt(t)
+	e.Kube = fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "kargo-admin"},
+		Data:       map[string][]byte{"adminAccountPasswordHash": []byte("$2a$10$hash")},
+	}).Build()
+	ki := newKI()
+	ki.Spec.ForProvider.KargoSecretRef = &xpv1.SecretReference{Namespace: "ns", Name: "kargo-admin"}
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	cre, err := e.Create(context.Background(), ki)
+	require.NoError(t, err)
+	assert.Nil(t, cre.ConnectionDetails)
+}
+
+func TestCreate_NoConnectionDetailsBeforeObserve(t *testing.T) {
+	e, mc := newExt(t)
+	ki := newKI()
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	cre, err := e.Create(context.Background(), ki)
+	require.NoError(t, err)
+	assert.Nil(t, cre.ConnectionDetails)
+}
+
 func TestDelete_CallsDelete(t *testing.T) {
 	e, mc := newExt(t)
 	ki := newKI()
@@ -646,6 +734,37 @@ func TestCreate_ResolvesNamedWorkspace(t *testing.T) {
 	assert.Equal(t, "ws-platform-id", ki.Status.AtProvider.Workspace)
 }
 
+// TestCreate_ResolvesWorkspaceRef verifies workspaceRef wins over both
+// the cached status ID and the name-based lookup: the referenced
+// Workspace MR's observed ID is routed verbatim with no org-gateway
+// round-trip.
+func TestCreate_ResolvesWorkspaceRef(t *testing.T) {
+	e, mc := newExt(t)
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	ws := &v1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "team-ws", Namespace: "ns"}}
+	ws.Status.AtProvider.ID = "ws-ref-id"
+	e.Kube = fake.NewClientBuilder().WithScheme(scheme).WithObjects(ws).Build()
+
+	ki := newKI()
+	ki.Spec.ForProvider.Workspace = "platform"
+	ki.Spec.ForProvider.WorkspaceRef = &v1alpha1.LocalReference{Name: "team-ws"}
+
+	var captured *kargov1.ApplyKargoInstanceRequest
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *kargov1.ApplyKargoInstanceRequest) error {
+			captured = req
+			return nil
+		}).Times(1)
+
+	_, err := e.Create(context.Background(), ki)
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	assert.Equal(t, "ws-ref-id", captured.GetWorkspaceId())
+	assert.Equal(t, "ws-ref-id", ki.Status.AtProvider.Workspace)
+	assert.Equal(t, "platform", ki.Spec.ForProvider.Workspace, "resolved ID must not be written back into spec")
+}
+
 // TestUpdate_ReusesCachedWorkspace verifies the short-circuit: once
 // status.atProvider.workspace carries a canonical ID, the apply path
 // must not re-resolve via the org gateway. Re-resolving on every
@@ -789,3 +908,101 @@ func TestObserve_GenericErrPropagates(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "boom")
 }
+
+func TestObserve_WorkspaceChangeIsMovePending(t *testing.T) {
+	e, mc := newExt(t)
+	// The terminal-write guard resolves the desired workspace onto
+	// status; the observed one must survive it.
+	e.TerminalWrites = base.NewTerminalWriteGuard()
+	ki := newKI()
+	meta.SetExternalName(ki, "ki")
+	ki.Spec.ForProvider.Workspace = "team"
+
+	mc.EXPECT().GetKargoInstance(gomock.Any(), "ki").Return(&kargov1.KargoInstance{
+		Id:           "id-1",
+		Name:         "ki",
+		Version:      "v1.0.0",
+		WorkspaceId:  "ws-old",
+		HealthStatus: &health.Status{Code: health.StatusCode_STATUS_CODE_HEALTHY},
+	}, nil).Times(1)
+	mc.EXPECT().ExportKargoInstance(gomock.Any(), "id-1", "ws-old").
+		Return(&kargov1.ExportKargoInstanceResponse{}, nil).Times(1)
+	mc.EXPECT().ResolveWorkspace(gomock.Any(), "team").
+		Return(&orgcv1.Workspace{Id: "ws-new", Name: "team"}, nil).AnyTimes()
+
+	obs, err := e.Observe(context.Background(), ki)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+	assert.Equal(t, "ws-old", ki.Status.AtProvider.Workspace,
+		"status must keep the observed workspace until the move lands")
+}
+
+func TestObserve_WorkspaceNameResolvedOncePerSpecValue(t *testing.T) {
+	e, mc := newExt(t)
+	ki := newKI()
+	meta.SetExternalName(ki, "ki")
+	ki.Spec.ForProvider.Workspace = "team"
+
+	mc.EXPECT().GetKargoInstance(gomock.Any(), "ki").Return(&kargov1.KargoInstance{
+		Id:           "id-1",
+		Name:         "ki",
+		Version:      "v1.0.0",
+		WorkspaceId:  "ws-team",
+		HealthStatus: &health.Status{Code: health.StatusCode_STATUS_CODE_HEALTHY},
+	}, nil).Times(2)
+	mc.EXPECT().ExportKargoInstance(gomock.Any(), "id-1", "ws-team").
+		Return(&kargov1.ExportKargoInstanceResponse{}, nil).Times(2)
+	mc.EXPECT().ResolveWorkspace(gomock.Any(), "team").
+		Return(&orgcv1.Workspace{Id: "ws-team", Name: "team"}, nil).Times(1)
+
+	for range 2 {
+		obs, err := e.Observe(context.Background(), ki)
+		require.NoError(t, err)
+		assert.True(t, obs.ResourceUpToDate)
+	}
+	assert.Equal(t, &v1alpha1.WorkspaceResolution{Workspace: "team", ID: "ws-team"}, ki.Status.AtProvider.ResolvedWorkspace)
+}
+
+func TestUpdate_MovesWorkspaceBeforeApply(t *testing.T) {
+	e, mc := newExt(t)
+	ki := newKI()
+	meta.SetExternalName(ki, "ki")
+	ki.Spec.ForProvider.Workspace = "team"
+	ki.Status.AtProvider.ID = "id-1"
+	ki.Status.AtProvider.Workspace = "ws-old"
+
+	mc.EXPECT().ResolveWorkspace(gomock.Any(), "team").
+		Return(&orgcv1.Workspace{Id: "ws-new", Name: "team"}, nil).AnyTimes()
+	var captured *kargov1.ApplyKargoInstanceRequest
+	gomock.InOrder(
+		mc.EXPECT().MoveKargoInstanceWorkspace(gomock.Any(), "id-1", "team").Return(nil).Times(1),
+		mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *kargov1.ApplyKargoInstanceRequest) error {
+				captured = req
+				return nil
+			}).Times(1),
+	)
+
+	_, err := e.Update(context.Background(), ki)
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	assert.Equal(t, "ws-new", captured.GetWorkspaceId())
+	assert.Equal(t, "ws-new", ki.Status.AtProvider.Workspace)
+}
+
+func TestUpdate_WorkspaceMoveErrSkipsApply(t *testing.T) {
+	e, mc := newExt(t)
+	ki := newKI()
+	meta.SetExternalName(ki, "ki")
+	ki.Spec.ForProvider.Workspace = "team"
+	ki.Status.AtProvider.ID = "id-1"
+	ki.Status.AtProvider.Workspace = "ws-old"
+
+	mc.EXPECT().ResolveWorkspace(gomock.Any(), "team").
+		Return(&orgcv1.Workspace{Id: "ws-new", Name: "team"}, nil).AnyTimes()
+	mc.EXPECT().MoveKargoInstanceWorkspace(gomock.Any(), "id-1", "team").
+		Return(errors.New("boom")).Times(1)
+
+	_, err := e.Update(context.Background(), ki)
+	require.Error(t, err)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kargoinstance
+
+import (
+	"context"
+
+	featuresv1 "github.com/akuity/api-client-go/pkg/api/gen/types/features/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+)
+
+const kargoInstanceSpecPath = "spec.forProvider.kargo.kargoInstanceSpec."
+
+// preflight checks the desired instance against the organization's
+// Kargo instance quota and plan before ApplyKargoInstance. The quota
+// only applies when create is set: an existing instance never counts
+// against it.
+func (e *external) preflight(ctx context.Context, mg *v1alpha1.KargoInstance, create bool) error {
+	var quota *base.InstanceQuota
+	if create {
+		quota = e.KargoInstanceQuota(mg.Spec.ForProvider.Name)
+	}
+	checked, err := e.Preflight(ctx, mg, quota, requiredFeatures(mg.Spec.ForProvider), mg.Status.AtProvider.PreflightFeaturesHash)
+	if err != nil {
+		return err
+	}
+	mg.Status.AtProvider.PreflightFeaturesHash = checked
+	return nil
+}
+
+// requiredFeatures lists the plan features the fields set in in depend
+// on. Every KargoInstance needs the Kargo feature itself.
+func requiredFeatures(in v1alpha1.KargoInstanceParameters) []base.RequiredFeature {
+	out := []base.RequiredFeature{{Field: "spec.forProvider.kargo", Feature: "Kargo", Status: (*featuresv1.FeatureStatuses).GetKargo}}
+	add := func(set bool, field, feature string, status func(*featuresv1.FeatureStatuses) featuresv1.FeatureStatus) {
+		if set {
+			out = append(out, base.RequiredFeature{Field: field, Feature: feature, Status: status})
+		}
+	}
+	spec := in.Kargo.KargoInstanceSpec
+	if ai := spec.AkuityIntelligence; ai != nil {
+		add(ptr.Deref(ai.Enabled, false), kargoInstanceSpecPath+"akuityIntelligence.enabled", "Akuity Intelligence", (*featuresv1.FeatureStatuses).GetAiSupportEngineer)
+		add(ptr.Deref(ai.AiSupportEngineerEnabled, false), kargoInstanceSpecPath+"akuityIntelligence.aiSupportEngineerEnabled", "Akuity Intelligence", (*featuresv1.FeatureStatuses).GetAiSupportEngineer)
+	}
+	add(len(spec.Secrets.Sources) > 0 || len(spec.Secrets.Destinations) > 0, kargoInstanceSpecPath+"secrets", "secret management", (*featuresv1.FeatureStatuses).GetSecretManagement)
+	return out
+}
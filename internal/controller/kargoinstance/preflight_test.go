@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kargoinstance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	featuresv1 "github.com/akuity/api-client-go/pkg/api/gen/types/features/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func TestCreate_PreflightKargoNotEntitled(t *testing.T) {
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	e := &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}
+	ki := newKI()
+
+	mc.EXPECT().ListKargoInstancesQuota(gomock.Any()).Return(nil, nil).Times(1)
+	mc.EXPECT().GetOrganizationQuota(gomock.Any()).Return(&featuresv1.OrganizationQuota{}, nil).Times(1)
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(&featuresv1.FeatureStatuses{
+		Kargo: featuresv1.FeatureStatus_FEATURE_STATUS_NOT_AVAILABLE,
+	}, nil).Times(1)
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := e.Create(context.Background(), ki)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+	cond := ki.GetCondition(v1alpha1.TypePreflight)
+	assert.Equal(t, v1alpha1.ReasonFeatureNotEntitled, cond.Reason)
+	assert.Contains(t, cond.Message, "spec.forProvider.kargo requires Kargo")
+
+	_, err = e.Observe(context.Background(), ki)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestUpdate_PreflightSkipsQuotaAndCheckedFeatures(t *testing.T) {
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	e := &external{ExternalClient: base.ExternalClient{Client: mc, Logger: logging.NewNopLogger()}}
+	ki := newKI()
+
+	// No quota lookups: the instance already exists.
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(&featuresv1.FeatureStatuses{}, nil).Times(1)
+	mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	_, err := e.Update(context.Background(), ki)
+	require.NoError(t, err)
+	assert.NotEmpty(t, ki.Status.AtProvider.PreflightFeaturesHash)
+	_, err = e.Update(context.Background(), ki)
+	require.NoError(t, err)
+}
+
+func TestUpdate_PreflightRechecksFeaturesAfterFailedApply(t *testing.T) {
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	e := &external{ExternalClient: base.ExternalClient{Client: mc, Logger: logging.NewNopLogger()}}
+	ki := newKI()
+
+	mc.EXPECT().GetFeatureStatuses(gomock.Any()).Return(&featuresv1.FeatureStatuses{}, nil).Times(2)
+	gomock.InOrder(
+		mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(errors.New("plan changed")),
+		mc.EXPECT().ApplyKargoInstance(gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	_, err := e.Update(context.Background(), ki)
+	require.Error(t, err)
+	assert.Empty(t, ki.Status.AtProvider.PreflightFeaturesHash)
+	_, err = e.Update(context.Background(), ki)
+	require.NoError(t, err)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedsecret
+
+import (
+	"slices"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+)
+
+// managedSecretToProto translates the spec metadata into the gateway
+// shape. The secret data travels separately on the request.
+func managedSecretToProto(fp v1alpha1.ManagedSecretParameters) *argocdv1.ManagedSecret {
+	return &argocdv1.ManagedSecret{
+		Name:            fp.Name,
+		Labels:          fp.Labels,
+		ClusterSelector: selectorToProto(fp.ClusterSelector),
+		AllowedClusters: fp.AllowedClusters,
+	}
+}
+
+func selectorToProto(s *metav1.LabelSelector) *argocdv1.ObjectSelector {
+	if s == nil {
+		return nil
+	}
+	out := &argocdv1.ObjectSelector{MatchLabels: s.MatchLabels}
+	for _, r := range s.MatchExpressions {
+		out.MatchExpressions = append(out.MatchExpressions, &argocdv1.LabelSelectorRequirement{
+			Key:      ptr.To(r.Key),
+			Operator: ptr.To(string(r.Operator)),
+			Values:   r.Values,
+		})
+	}
+	return out
+}
+
+func selectorFromProto(s *argocdv1.ObjectSelector) *metav1.LabelSelector {
+	if s == nil {
+		return nil
+	}
+	out := &metav1.LabelSelector{MatchLabels: s.GetMatchLabels()}
+	for _, r := range s.GetMatchExpressions() {
+		out.MatchExpressions = append(out.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      r.GetKey(),
+			Operator: metav1.LabelSelectorOperator(r.GetOperator()),
+			Values:   r.GetValues(),
+		})
+	}
+	return out
+}
+
+// managedSecretParameters projects the gateway secret back onto the
+// spec shape for drift comparison. The data is write-only and compared
+// through the key list and SecretHash instead.
+func managedSecretParameters(s *argocdv1.ManagedSecret) v1alpha1.ManagedSecretParameters {
+	return v1alpha1.ManagedSecretParameters{
+		Name:            s.GetName(),
+		Labels:          s.GetLabels(),
+		ClusterSelector: selectorFromProto(s.GetClusterSelector()),
+		AllowedClusters: s.GetAllowedClusters(),
+	}
+}
+
+// managedSecretObservation summarizes the gateway secret for status.
+// SecretHash is controller-owned and not set here.
+func managedSecretObservation(instanceID string, s *argocdv1.ManagedSecret) v1alpha1.ManagedSecretObservation {
+	return v1alpha1.ManagedSecretObservation{
+		InstanceID:      instanceID,
+		Name:            s.GetName(),
+		Labels:          s.GetLabels(),
+		ClusterSelector: selectorFromProto(s.GetClusterSelector()),
+		AllowedClusters: s.GetAllowedClusters(),
+		SecretKeys:      s.GetSecretKeys(),
+	}
+}
+
+// sortedKeys returns the keys of data in the order the platform reports
+// secretKeys.
+func sortedKeys(data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
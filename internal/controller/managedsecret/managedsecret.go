@@ -0,0 +1,385 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package managedsecret is the ManagedSecret controller. It owns an
+// Akuity-managed secret on an Argo CD instance through the Argo CD
+// gateway's Create/Update/Patch/DeleteManagedSecret endpoints, with
+// the data sourced from a Kubernetes Secret. The secret name is the
+// external-name.
+//
+// The platform never returns secret values, only key names. The
+// controller records a hash of the source Secret after each write and
+// compares it on Observe to detect rotation. A rotation that keeps the
+// same keys is written with PatchManagedSecret; metadata or key-set
+// changes replace the secret with UpdateManagedSecret so removed keys
+// do not linger.
+package managedsecret
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
+)
+
+// Setup registers the controller with the manager.
+//
+// The external-name is stamped from spec.forProvider.name on Create, so
+// the default NameAsExternalName initializer is disabled; otherwise an
+// unrelated secret that happens to share the MR name would be adopted.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ManagedSecretGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.ManagedSecret]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.ManagedSecret] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ManagedSecretGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.ManagedSecret](conn),
+		managed.WithInitializers(),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.ManagedSecret{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.ManagedSecret) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if meta.GetExternalName(mg) == "" {
+		// A CreateManagedSecret rejected on bad input never stamps the
+		// external-name; suppress the retry loop until the spec or the
+		// source Secret changes.
+		if obs, err, ok := e.suppressTerminalWrite(ctx, mg, instanceID); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	ms, err := e.Client.GetManagedSecret(ctx, instanceID, meta.GetExternalName(mg))
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	// SecretHash is written after a successful write; preserve it
+	// across the assignment.
+	prevHash := mg.Status.AtProvider.SecretHash
+	mg.Status.AtProvider = managedSecretObservation(instanceID, ms)
+	mg.Status.AtProvider.SecretHash = prevHash
+	base.SetHealthCondition(mg, true)
+
+	desired := mg.Spec.ForProvider
+	observed := managedSecretParameters(ms)
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "ManagedSecret")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Data drift: the gateway returns only key names, so compare the
+	// key set and the source Secret's digest against the last-written
+	// hash.
+	if upToDate {
+		sec, err := resolveSource(ctx, e.Kube, mg)
+		if err != nil {
+			mg.SetConditions(xpv1.ReconcileError(err))
+			return managed.ExternalObservation{}, err
+		}
+		switch {
+		case !slices.Equal(sortedKeys(sec.Data), mg.Status.AtProvider.SecretKeys):
+			e.Logger.Debug("ManagedSecret keys changed; forcing Update",
+				"observed", mg.Status.AtProvider.SecretKeys, "desired", sortedKeys(sec.Data))
+			upToDate = false
+		case sec.Hash() != mg.Status.AtProvider.SecretHash:
+			e.Logger.Debug("ManagedSecret secret hash changed; forcing Update",
+				"previous", mg.Status.AtProvider.SecretHash, "current", sec.Hash())
+			upToDate = false
+		}
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(ctx, mg, instanceID); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(ctx, mg, instanceID)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.ManagedSecret,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.ManagedSecret) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	sec, err := resolveSource(ctx, e.Kube, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	key, err := managedSecretTerminalWriteKey(mg, instanceID, sec)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	fp := mg.Spec.ForProvider
+	if err := e.Client.CreateManagedSecret(ctx, instanceID, managedSecretToProto(fp), sec.Data); err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider.InstanceID = instanceID
+	mg.Status.AtProvider.SecretHash = sec.Hash()
+	meta.SetExternalName(mg, fp.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.ManagedSecret) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	sec, err := resolveSource(ctx, e.Kube, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	key, err := managedSecretTerminalWriteKey(mg, instanceID, sec)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// status.atProvider holds what Observe just read. A pure value
+	// rotation patches the data only; anything else replaces the
+	// secret so metadata converges and removed keys are dropped.
+	name := meta.GetExternalName(mg)
+	if e.onlyValuesChanged(ctx, mg, sec) {
+		err = e.Client.PatchManagedSecret(ctx, instanceID, name, sec.Data)
+	} else {
+		secret := managedSecretToProto(mg.Spec.ForProvider)
+		secret.Name = name
+		err = e.Client.UpdateManagedSecret(ctx, instanceID, secret, sec.Data)
+	}
+	if err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider.SecretHash = sec.Hash()
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.ManagedSecret) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.ManagedSecretGroupVersionKind)
+
+	name := meta.GetExternalName(mg)
+	if name == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	instanceID, err := e.resolveInstanceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	if err := e.Client.DeleteManagedSecret(ctx, instanceID, name); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// onlyValuesChanged reports whether the observed secret matches the
+// spec metadata and carries the same keys as the source Secret, so
+// only values need writing.
+func (e *external) onlyValuesChanged(ctx context.Context, mg *v1alpha1.ManagedSecret, sec secrets.ResolvedSecret) bool {
+	obs := mg.Status.AtProvider
+	if !slices.Equal(sortedKeys(sec.Data), obs.SecretKeys) {
+		return false
+	}
+	desired := mg.Spec.ForProvider
+	observed := v1alpha1.ManagedSecretParameters{
+		Labels:          obs.Labels,
+		ClusterSelector: obs.ClusterSelector,
+		AllowedClusters: obs.AllowedClusters,
+	}
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "ManagedSecret")
+	return err == nil && upToDate
+}
+
+// resolveSource loads the source Secret. Missing, empty or malformed
+// references are terminal configuration errors.
+func resolveSource(ctx context.Context, kube client.Client, mg *v1alpha1.ManagedSecret) (secrets.ResolvedSecret, error) {
+	sec, err := secrets.Resolve(ctx, kube, &mg.Spec.ForProvider.SecretRef)
+	if err != nil {
+		return secrets.ResolvedSecret{}, secrets.AsTerminalIfConfig(fmt.Errorf("secretRef: %w", err))
+	}
+	return sec, nil
+}
+
+// resolveInstanceID returns the opaque Akuity ID of the target Instance.
+// ForProvider.InstanceID takes precedence; if absent, InstanceRef is
+// resolved against an Instance MR and its Status.AtProvider.ID is used.
+// The cached Status.AtProvider.InstanceID is only consulted during
+// deletion once the referenced Instance MR is gone.
+func (e *external) resolveInstanceID(ctx context.Context, mg *v1alpha1.ManagedSecret) (string, error) {
+	if id := mg.Spec.ForProvider.InstanceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.InstanceRef == nil || mg.Spec.ForProvider.InstanceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.instanceId or spec.forProvider.instanceRef must be set")
+	}
+
+	inst := &v1alpha1.Instance{}
+	key := k8stypes.NamespacedName{Name: mg.Spec.ForProvider.InstanceRef.Name, Namespace: mg.GetNamespace()}
+	if err := e.Kube.Get(ctx, key, inst); err != nil {
+		if apierrors.IsNotFound(err) && meta.WasDeleted(mg) {
+			if cached := mg.Status.AtProvider.InstanceID; cached != "" {
+				return cached, nil
+			}
+		}
+		return "", fmt.Errorf("could not resolve InstanceRef %s: %w", key.Name, err)
+	}
+	if inst.Status.AtProvider.ID == "" {
+		return "", fmt.Errorf("referenced Instance %s has not yet reported an ID; waiting for its controller to observe", key.Name)
+	}
+	return inst.Status.AtProvider.ID, nil
+}
+
+func (e *external) suppressTerminalWrite(ctx context.Context, mg *v1alpha1.ManagedSecret, instanceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	sec, err := resolveSource(ctx, e.Kube, mg)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	key, err := managedSecretTerminalWriteKey(mg, instanceID, sec)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(ctx context.Context, mg *v1alpha1.ManagedSecret, instanceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.ManagedSecretGroupVersionKind) {
+		return
+	}
+	sec, err := resolveSource(ctx, e.Kube, mg)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	key, err := managedSecretTerminalWriteKey(mg, instanceID, sec)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func managedSecretTerminalWriteKey(mg *v1alpha1.ManagedSecret, instanceID string, sec secrets.ResolvedSecret) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.ManagedSecretGroupVersionKind, instanceID, mg.Spec.ForProvider, sec.Hash())
+}
+
+// driftSpec is the resource's drift-detection recipe. The instance
+// target is resolved separately and the data is tracked through the
+// key list and SecretHash, so only the secret metadata is compared.
+// allowedClusters is a set on the platform, so both sides are sorted.
+func driftSpec() base.DriftSpec[v1alpha1.ManagedSecretParameters] {
+	return base.DriftSpec[v1alpha1.ManagedSecretParameters]{
+		Ignore: []cmp.Option{
+			cmpopts.IgnoreFields(v1alpha1.ManagedSecretParameters{}, "InstanceID", "InstanceRef", "Name", "SecretRef"),
+		},
+		Normalize: func(desired, observed *v1alpha1.ManagedSecretParameters) {
+			desired.AllowedClusters = slices.Sorted(slices.Values(desired.AllowedClusters))
+			observed.AllowedClusters = slices.Sorted(slices.Values(observed.AllowedClusters))
+			if desired.ClusterSelector != nil && len(desired.ClusterSelector.MatchLabels) == 0 && len(desired.ClusterSelector.MatchExpressions) == 0 {
+				desired.ClusterSelector = nil
+			}
+			if observed.ClusterSelector != nil && len(observed.ClusterSelector.MatchLabels) == 0 && len(observed.ClusterSelector.MatchExpressions) == 0 {
+				observed.ClusterSelector = nil
+			}
+		},
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedsecret
+
+import (
+	"context"
+	"testing"
+
+	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
+)
+
+const (
+	instanceID = "inst-1"
+	secretName = "registry-creds"
+)
+
+func newManagedSecret() *v1alpha1.ManagedSecret {
+	mg := &v1alpha1.ManagedSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry", UID: "registry-uid"},
+		Spec: v1alpha1.ManagedSecretSpec{
+			ForProvider: v1alpha1.ManagedSecretParameters{
+				InstanceRef:     &v1alpha1.LocalReference{Name: "prod"},
+				Name:            secretName,
+				Labels:          map[string]string{"akuity.io/secret-sync": "true"},
+				AllowedClusters: []string{"prod-east", "prod-west"},
+				ClusterSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{
+						Key:      "env",
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   []string{"prod"},
+					}},
+				},
+				SecretRef: xpv1.SecretReference{Namespace: "akuity", Name: "registry"},
+			},
+		},
+	}
+	meta.SetExternalName(mg, secretName)
+	return mg
+}
+
+func prodInstance() *v1alpha1.Instance {
+	inst := &v1alpha1.Instance{ObjectMeta: metav1.ObjectMeta{Name: "prod"}}
+	inst.Status.AtProvider.ID = instanceID
+	return inst
+}
+
+func sourceSecret(data map[string]string) *corev1.Secret {
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "akuity", Name: "registry"},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range data {
+		s.Data[k] = []byte(v)
+	}
+	return s
+}
+
+func sourceHash(data map[string]string) string {
+	return secrets.ResolvedSecret{Namespace: "akuity", Name: "registry", Data: data}.Hash()
+}
+
+func platformSecret(keys ...string) *argocdv1.ManagedSecret {
+	return &argocdv1.ManagedSecret{
+		Name:            secretName,
+		Labels:          map[string]string{"akuity.io/secret-sync": "true"},
+		AllowedClusters: []string{"prod-west", "prod-east"},
+		ClusterSelector: &argocdv1.ObjectSelector{
+			MatchExpressions: []*argocdv1.LabelSelectorRequirement{{
+				Key:      ptr.To("env"),
+				Operator: ptr.To("In"),
+				Values:   []string{"prod"},
+			}},
+		},
+		SecretKeys: keys,
+	}
+}
+
+var creds = map[string]string{"username": "bot", "password": "s3cr3t"}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_NotFound(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(creds))
+	mc.EXPECT().GetManagedSecret(gomock.Any(), instanceID, secretName).Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), newManagedSecret())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_UpToDateKeepsValuesOutOfStatus(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(creds))
+	mg := newManagedSecret()
+	mg.Status.AtProvider.SecretHash = sourceHash(creds)
+	mc.EXPECT().GetManagedSecret(gomock.Any(), instanceID, secretName).Return(platformSecret("password", "username"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, []string{"password", "username"}, mg.Status.AtProvider.SecretKeys)
+	assert.Equal(t, sourceHash(creds), mg.Status.AtProvider.SecretHash)
+}
+
+func TestObserve_MetadataDrift(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(creds))
+	mg := newManagedSecret()
+	mg.Status.AtProvider.SecretHash = sourceHash(creds)
+	ms := platformSecret("password", "username")
+	ms.AllowedClusters = []string{"prod-east"}
+	mc.EXPECT().GetManagedSecret(gomock.Any(), instanceID, secretName).Return(ms, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_KeySetDrift(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(creds))
+	mg := newManagedSecret()
+	mg.Status.AtProvider.SecretHash = sourceHash(creds)
+	mc.EXPECT().GetManagedSecret(gomock.Any(), instanceID, secretName).Return(platformSecret("password"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_RotatedSecretDrifts(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(map[string]string{"username": "bot", "password": "rotated"}))
+	mg := newManagedSecret()
+	mg.Status.AtProvider.SecretHash = sourceHash(creds)
+	mc.EXPECT().GetManagedSecret(gomock.Any(), instanceID, secretName).Return(platformSecret("password", "username"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_MissingSourceSecretIsTerminal(t *testing.T) {
+	e, mc := newExt(t, prodInstance())
+	mc.EXPECT().GetManagedSecret(gomock.Any(), instanceID, secretName).Return(platformSecret("password", "username"), nil).Times(1)
+
+	_, err := e.Observe(context.Background(), newManagedSecret())
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestCreate_SendsSourceData(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(creds))
+	mg := newManagedSecret()
+	meta.SetExternalName(mg, "")
+	mc.EXPECT().CreateManagedSecret(gomock.Any(), instanceID, gomock.Any(), creds).
+		DoAndReturn(func(_ context.Context, _ string, ms *argocdv1.ManagedSecret, _ map[string]string) error {
+			assert.Equal(t, secretName, ms.GetName())
+			assert.Equal(t, []string{"prod-east", "prod-west"}, ms.GetAllowedClusters())
+			assert.Equal(t, "In", ms.GetClusterSelector().GetMatchExpressions()[0].GetOperator())
+			return nil
+		}).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, secretName, meta.GetExternalName(mg))
+	assert.Equal(t, sourceHash(creds), mg.Status.AtProvider.SecretHash)
+	assert.Equal(t, instanceID, mg.Status.AtProvider.InstanceID)
+}
+
+func TestUpdate_ValueRotationPatches(t *testing.T) {
+	rotated := map[string]string{"username": "bot", "password": "rotated"}
+	e, mc := newExt(t, prodInstance(), sourceSecret(rotated))
+	mg := newManagedSecret()
+	mg.Status.AtProvider = managedSecretObservation(instanceID, platformSecret("password", "username"))
+	mg.Status.AtProvider.SecretHash = sourceHash(creds)
+	mc.EXPECT().PatchManagedSecret(gomock.Any(), instanceID, secretName, rotated).Return(nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, sourceHash(rotated), mg.Status.AtProvider.SecretHash)
+}
+
+func TestUpdate_KeyRemovalReplaces(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(creds))
+	mg := newManagedSecret()
+	mg.Status.AtProvider = managedSecretObservation(instanceID, platformSecret("password", "token", "username"))
+	mc.EXPECT().UpdateManagedSecret(gomock.Any(), instanceID, gomock.Any(), creds).Return(nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+}
+
+func TestUpdate_RejectedIsTerminal(t *testing.T) {
+	e, mc := newExt(t, prodInstance(), sourceSecret(creds))
+	mg := newManagedSecret()
+	mc.EXPECT().UpdateManagedSecret(gomock.Any(), instanceID, gomock.Any(), gomock.Any()).
+		Return(reason.AsTerminal(errors.New("protected secret"))).Times(1)
+	mc.EXPECT().GetManagedSecret(gomock.Any(), instanceID, secretName).Return(platformSecret("password"), nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.Error(t, err)
+
+	// The next Observe suppresses the retry until the spec changes.
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestDelete_UsesCachedInstanceID(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newManagedSecret()
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+	mg.Status.AtProvider.InstanceID = instanceID
+	mc.EXPECT().DeleteManagedSecret(gomock.Any(), instanceID, secretName).Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
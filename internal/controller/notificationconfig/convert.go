@@ -0,0 +1,262 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationconfig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
+)
+
+// Delivery methods and statuses reported on status.atProvider.
+const (
+	deliveryMethodWebhook = "webhook"
+	deliveryMethodEmail   = "email"
+	deliveryMethodWeb     = "web"
+
+	deliveryStatusSuccess = "Success"
+	deliveryStatusFailure = "Failure"
+)
+
+// resolveWebhookSecret loads the webhook signing secret referenced by
+// the spec. Missing or malformed references are terminal configuration
+// errors.
+func resolveWebhookSecret(ctx context.Context, kube client.Client, mg *v1alpha1.NotificationConfig) (secrets.ResolvedSecret, string, error) {
+	wh := mg.Spec.ForProvider.Webhook
+	if wh == nil {
+		return secrets.ResolvedSecret{}, "", nil
+	}
+	resolved, value, err := secrets.ResolveKey(ctx, kube, wh.SecretRef)
+	if err != nil {
+		return secrets.ResolvedSecret{}, "", secrets.AsTerminalIfConfig(fmt.Errorf("webhook.secretRef: %w", err))
+	}
+	return resolved, value, nil
+}
+
+// resolveFilter returns a copy of the spec filter with the Instance and
+// KargoInstance references folded into the name lists. The referenced
+// managed resources' spec.forProvider.name is the platform instance
+// name, so the references resolve without waiting for the instances to
+// be observed.
+func resolveFilter(ctx context.Context, kube client.Reader, f *v1alpha1.NotificationFilter) (*v1alpha1.NotificationFilter, error) {
+	if f == nil {
+		return nil, nil
+	}
+	out := f.DeepCopy()
+	for _, ref := range f.ArgoCDInstanceRefs {
+		inst := &v1alpha1.Instance{}
+		if err := kube.Get(ctx, k8stypes.NamespacedName{Name: ref.Name}, inst); err != nil {
+			return nil, fmt.Errorf("could not resolve argocdInstanceRef %s: %w", ref.Name, err)
+		}
+		if !slices.Contains(out.ArgoCDInstanceNames, inst.Spec.ForProvider.Name) {
+			out.ArgoCDInstanceNames = append(out.ArgoCDInstanceNames, inst.Spec.ForProvider.Name)
+		}
+	}
+	for _, ref := range f.KargoInstanceRefs {
+		inst := &v1alpha1.KargoInstance{}
+		if err := kube.Get(ctx, k8stypes.NamespacedName{Name: ref.Name}, inst); err != nil {
+			return nil, fmt.Errorf("could not resolve kargoInstanceRef %s: %w", ref.Name, err)
+		}
+		if !slices.Contains(out.KargoInstanceNames, inst.Spec.ForProvider.Name) {
+			out.KargoInstanceNames = append(out.KargoInstanceNames, inst.Spec.ForProvider.Name)
+		}
+	}
+	out.ArgoCDInstanceRefs = nil
+	out.KargoInstanceRefs = nil
+	return out, nil
+}
+
+// filterToProto translates a resolved filter. Each filter_* flag is set
+// when its name list is non-empty; an empty list matches everything.
+func filterToProto(f *v1alpha1.NotificationFilter) *orgcv1.WebhookNotificationFilter {
+	if f == nil {
+		return nil
+	}
+	return &orgcv1.WebhookNotificationFilter{
+		Events:                    f.Events,
+		FilterArgocdInstanceNames: len(f.ArgoCDInstanceNames) > 0,
+		ArgocdInstanceNames:       f.ArgoCDInstanceNames,
+		FilterKargoInstanceNames:  len(f.KargoInstanceNames) > 0,
+		KargoInstanceNames:        f.KargoInstanceNames,
+		FilterArgocdClusterNames:  len(f.ArgoCDClusterNames) > 0,
+		ArgocdClusterNames:        f.ArgoCDClusterNames,
+		FilterKargoAgentNames:     len(f.KargoAgentNames) > 0,
+		KargoAgentNames:           f.KargoAgentNames,
+	}
+}
+
+func filterFromProto(f *orgcv1.WebhookNotificationFilter) *v1alpha1.NotificationFilter {
+	if f == nil {
+		return nil
+	}
+	out := &v1alpha1.NotificationFilter{Events: f.GetEvents()}
+	if f.GetFilterArgocdInstanceNames() {
+		out.ArgoCDInstanceNames = f.GetArgocdInstanceNames()
+	}
+	if f.GetFilterKargoInstanceNames() {
+		out.KargoInstanceNames = f.GetKargoInstanceNames()
+	}
+	if f.GetFilterArgocdClusterNames() {
+		out.ArgoCDClusterNames = f.GetArgocdClusterNames()
+	}
+	if f.GetFilterKargoAgentNames() {
+		out.KargoAgentNames = f.GetKargoAgentNames()
+	}
+	return out
+}
+
+// buildCreateRequest translates the spec into the gateway create
+// request. The create payload has no active flag; a config declared
+// inactive is created active and switched off by the following Update.
+func buildCreateRequest(fp v1alpha1.NotificationConfigParameters, filter *v1alpha1.NotificationFilter, secret string) *orgcv1.CreateNotificationConfigRequest {
+	req := &orgcv1.CreateNotificationConfigRequest{Name: fp.Name}
+	switch {
+	case fp.Webhook != nil:
+		req.Payload = &orgcv1.CreateNotificationConfigRequest_Webhook{Webhook: &orgcv1.WebhookNotificationCreatePayload{
+			Url:    fp.Webhook.URL,
+			Secret: secret,
+			Filter: filterToProto(filter),
+		}}
+	case fp.Email != nil:
+		req.Payload = &orgcv1.CreateNotificationConfigRequest_Email{Email: &orgcv1.EmailNotificationCreatePayload{
+			Emails: fp.Email.Emails,
+			Filter: filterToProto(filter),
+		}}
+	}
+	return req
+}
+
+// buildUpdateRequest translates the spec into the gateway update
+// request. The secret is always sent so removing secretRef clears the
+// signing secret.
+func buildUpdateRequest(id string, fp v1alpha1.NotificationConfigParameters, filter *v1alpha1.NotificationFilter, secret string) *orgcv1.UpdateNotificationConfigRequest {
+	req := &orgcv1.UpdateNotificationConfigRequest{Id: id, Name: fp.Name}
+	active := ptr.Deref(fp.Active, true)
+	switch {
+	case fp.Webhook != nil:
+		req.Payload = &orgcv1.UpdateNotificationConfigRequest_Webhook{Webhook: &orgcv1.WebhookNotificationUpdatePayload{
+			Url:    fp.Webhook.URL,
+			Secret: secret,
+			Active: active,
+			Filter: filterToProto(filter),
+		}}
+	case fp.Email != nil:
+		req.Payload = &orgcv1.UpdateNotificationConfigRequest_Email{Email: &orgcv1.EmailNotificationUpdatePayload{
+			Emails: fp.Email.Emails,
+			Active: active,
+			Filter: filterToProto(filter),
+		}}
+	}
+	return req
+}
+
+// notificationParameters projects the gateway config back onto the
+// spec shape for drift comparison. The webhook secret is masked by the
+// gateway and tracked through SecretHash instead.
+func notificationParameters(nc *orgcv1.NotificationConfig) v1alpha1.NotificationConfigParameters {
+	out := v1alpha1.NotificationConfigParameters{Name: nc.GetName()}
+	if wh := nc.GetWebhook(); wh != nil {
+		out.Active = ptr.To(wh.GetActive())
+		out.Webhook = &v1alpha1.NotificationWebhook{URL: wh.GetUrl()}
+		out.Filter = filterFromProto(wh.GetFilter())
+	}
+	if em := nc.GetEmail(); em != nil {
+		out.Active = ptr.To(em.GetActive())
+		out.Email = &v1alpha1.NotificationEmail{Emails: em.GetEmails()}
+		out.Filter = filterFromProto(em.GetFilter())
+	}
+	return out
+}
+
+// notificationObservation summarizes the gateway config for status.
+// SecretHash and LastDeliveryFailure are controller-owned and not set
+// here.
+func notificationObservation(nc *orgcv1.NotificationConfig) v1alpha1.NotificationConfigObservation {
+	out := v1alpha1.NotificationConfigObservation{
+		ID:   nc.GetId(),
+		Name: nc.GetName(),
+	}
+	switch {
+	case nc.GetWebhook() != nil:
+		out.DeliveryMethod = deliveryMethodWebhook
+		out.Active = nc.GetWebhook().GetActive()
+		out.URL = nc.GetWebhook().GetUrl()
+	case nc.GetEmail() != nil:
+		out.DeliveryMethod = deliveryMethodEmail
+		out.Active = nc.GetEmail().GetActive()
+		out.Emails = nc.GetEmail().GetEmails()
+	case nc.GetWeb() != nil:
+		out.DeliveryMethod = deliveryMethodWeb
+	}
+	if nc.LastDelivery != nil {
+		out.LastDeliveryStatus = deliveryStatus(nc.GetLastDelivery().GetDeliveryStatus())
+	}
+	return out
+}
+
+func deliveryStatus(s orgcv1.NotificationDeliveryStatus) string {
+	switch s {
+	case orgcv1.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SUCCESS:
+		return deliveryStatusSuccess
+	case orgcv1.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_FAILURE:
+		return deliveryStatusFailure
+	case orgcv1.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_UNSPECIFIED:
+	}
+	return ""
+}
+
+// latestDeliveryFailure returns the most recent failed delivery in
+// history, by initial delivery time, or nil when none failed.
+func latestDeliveryFailure(history []*orgcv1.NotificationDelivery) *v1alpha1.NotificationDeliveryFailure {
+	var latest *orgcv1.NotificationDelivery
+	for _, d := range history {
+		if d.GetDeliveryStatus() != orgcv1.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_FAILURE {
+			continue
+		}
+		if latest == nil || d.GetInitialDeliveryTime().AsTime().After(latest.GetInitialDeliveryTime().AsTime()) {
+			latest = d
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+	out := &v1alpha1.NotificationDeliveryFailure{
+		ID:         latest.GetId(),
+		EventType:  latest.GetEventType(),
+		RetryCount: int64(latest.GetRetryCount()),
+	}
+	if t := latest.GetInitialDeliveryTime(); t != nil {
+		out.Time = t.AsTime().UTC().Format(time.RFC3339)
+	}
+	if wh := latest.GetWebhook(); wh != nil {
+		out.StatusCode = wh.StatusCode
+		out.Error = wh.GetError()
+	}
+	if em := latest.GetEmail(); em != nil {
+		out.Error = em.GetError()
+	}
+	return out
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notificationconfig is the NotificationConfig controller. It
+// owns an Akuity organization notification config (webhook or email)
+// through the Organization gateway's Create/Update/Delete
+// NotificationConfig endpoints. The platform-assigned config ID is the
+// external-name.
+//
+// After each write the controller can send a test event through
+// PingNotificationConfig and report the result on the DeliveryTest
+// condition. Every Observe also reads the config's delivery history and
+// surfaces the most recent failure on status.atProvider.
+package notificationconfig
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
+)
+
+// deliveryHistoryLimit bounds the delivery history page read on every
+// Observe when looking for the most recent failure.
+const deliveryHistoryLimit = 25
+
+// Setup registers the controller with the manager.
+//
+// The external-name is the platform-assigned config ID, so the default
+// NameAsExternalName initializer is disabled.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.NotificationConfigGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.NotificationConfig]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.NotificationConfig] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.NotificationConfigGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.NotificationConfig](conn),
+		managed.WithInitializers(),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.NotificationConfig{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.NotificationConfig) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		// A CreateNotificationConfig rejected on bad input never stamps
+		// the external-name; suppress the retry loop until the spec
+		// changes.
+		if obs, err, ok := e.suppressTerminalWrite(ctx, mg); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	nc, err := e.Client.GetNotificationConfig(ctx, id)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	// SecretHash is written after a successful write and the last
+	// delivery failure comes from a separate call; preserve both across
+	// the assignment so a failed history read keeps the previous value.
+	prev := mg.Status.AtProvider
+	mg.Status.AtProvider = notificationObservation(nc)
+	mg.Status.AtProvider.SecretHash = prev.SecretHash
+	mg.Status.AtProvider.LastDeliveryFailure = prev.LastDeliveryFailure
+	if history, herr := e.Client.ListNotificationDeliveryHistory(ctx, id, deliveryHistoryLimit); herr != nil {
+		e.Logger.Debug("Cannot read notification delivery history", "id", id, "error", herr)
+	} else {
+		mg.Status.AtProvider.LastDeliveryFailure = latestDeliveryFailure(history)
+	}
+	base.SetHealthCondition(mg, true)
+
+	desired := mg.Spec.ForProvider.DeepCopy()
+	filter, err := resolveFilter(ctx, e.Kube, desired.Filter)
+	if err != nil {
+		mg.SetConditions(xpv1.ReconcileError(err))
+		return managed.ExternalObservation{}, err
+	}
+	desired.Filter = filter
+	observed := notificationParameters(nc)
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), desired, &observed, e.Logger, "NotificationConfig")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Secret rotation drift: the gateway masks the webhook secret, so
+	// compare the resolved Secret's digest against the last-written
+	// hash instead.
+	if upToDate {
+		sec, _, serr := resolveWebhookSecret(ctx, e.Kube, mg)
+		if serr != nil {
+			mg.SetConditions(xpv1.ReconcileError(serr))
+			return managed.ExternalObservation{}, serr
+		}
+		if sec.Hash() != mg.Status.AtProvider.SecretHash {
+			e.Logger.Debug("NotificationConfig secret hash changed; forcing Update",
+				"previous", mg.Status.AtProvider.SecretHash, "current", sec.Hash())
+			upToDate = false
+		}
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(ctx, mg); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(ctx, mg)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.NotificationConfig,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.NotificationConfig) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	sec, secret, filter, err := e.resolve(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	key, err := notificationConfigTerminalWriteKey(mg, sec)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	nc, err := e.Client.CreateNotificationConfig(ctx, buildCreateRequest(mg.Spec.ForProvider, filter, secret))
+	if err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider = notificationObservation(nc)
+	mg.Status.AtProvider.SecretHash = sec.Hash()
+	meta.SetExternalName(mg, nc.GetId())
+	e.ping(ctx, mg, nc.GetId())
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.NotificationConfig) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	sec, secret, filter, err := e.resolve(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	key, err := notificationConfigTerminalWriteKey(mg, sec)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	id := meta.GetExternalName(mg)
+	nc, err := e.Client.UpdateNotificationConfig(ctx, buildUpdateRequest(id, mg.Spec.ForProvider, filter, secret))
+	if err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	failure := mg.Status.AtProvider.LastDeliveryFailure
+	mg.Status.AtProvider = notificationObservation(nc)
+	mg.Status.AtProvider.LastDeliveryFailure = failure
+	mg.Status.AtProvider.SecretHash = sec.Hash()
+	e.ping(ctx, mg, id)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.NotificationConfig) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.NotificationConfigGroupVersionKind)
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	if err := e.Client.DeleteNotificationConfig(ctx, id); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// resolve loads the webhook secret and folds filter references into
+// name lists ahead of a write.
+func (e *external) resolve(ctx context.Context, mg *v1alpha1.NotificationConfig) (secrets.ResolvedSecret, string, *v1alpha1.NotificationFilter, error) {
+	sec, secret, err := resolveWebhookSecret(ctx, e.Kube, mg)
+	if err != nil {
+		return secrets.ResolvedSecret{}, "", nil, err
+	}
+	filter, err := resolveFilter(ctx, e.Kube, mg.Spec.ForProvider.Filter)
+	if err != nil {
+		return secrets.ResolvedSecret{}, "", nil, err
+	}
+	return sec, secret, filter, nil
+}
+
+// ping sends a test event when pingOnWrite is set and records the
+// outcome on the DeliveryTest condition. A failed ping never fails the
+// write that preceded it.
+func (e *external) ping(ctx context.Context, mg *v1alpha1.NotificationConfig, id string) {
+	if !mg.Spec.ForProvider.PingOnWrite {
+		return
+	}
+	if err := e.Client.PingNotificationConfig(ctx, id); err != nil {
+		e.Logger.Debug("Notification config test event failed", "id", id, "error", err)
+		mg.SetConditions(v1alpha1.DeliveryTestFailed(err))
+		return
+	}
+	mg.SetConditions(v1alpha1.DeliveryTestSucceeded())
+}
+
+func (e *external) suppressTerminalWrite(ctx context.Context, mg *v1alpha1.NotificationConfig) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	sec, _, err := resolveWebhookSecret(ctx, e.Kube, mg)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	key, err := notificationConfigTerminalWriteKey(mg, sec)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(ctx context.Context, mg *v1alpha1.NotificationConfig) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.NotificationConfigGroupVersionKind) {
+		return
+	}
+	sec, _, err := resolveWebhookSecret(ctx, e.Kube, mg)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	key, err := notificationConfigTerminalWriteKey(mg, sec)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func notificationConfigTerminalWriteKey(mg *v1alpha1.NotificationConfig, sec secrets.ResolvedSecret) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.NotificationConfigGroupVersionKind, meta.GetExternalName(mg), mg.Spec.ForProvider, sec.Hash())
+}
+
+// driftSpec is the resource's drift-detection recipe. The webhook
+// secret is tracked through SecretHash, pingOnWrite is controller-only,
+// and filter references are folded into name lists before comparison.
+// Name lists are sets on the platform, so both sides are sorted.
+func driftSpec() base.DriftSpec[v1alpha1.NotificationConfigParameters] {
+	return base.DriftSpec[v1alpha1.NotificationConfigParameters]{
+		Ignore: []cmp.Option{
+			cmpopts.IgnoreFields(v1alpha1.NotificationConfigParameters{}, "PingOnWrite"),
+			cmpopts.IgnoreFields(v1alpha1.NotificationWebhook{}, "SecretRef"),
+		},
+		Normalize: func(desired, observed *v1alpha1.NotificationConfigParameters) {
+			desired.Active = ptr.To(ptr.Deref(desired.Active, true))
+			observed.Active = ptr.To(ptr.Deref(observed.Active, true))
+			desired.Filter = normalizeFilter(desired.Filter)
+			observed.Filter = normalizeFilter(observed.Filter)
+		},
+	}
+}
+
+func normalizeFilter(f *v1alpha1.NotificationFilter) *v1alpha1.NotificationFilter {
+	if f == nil {
+		return nil
+	}
+	for _, names := range [][]string{f.Events, f.ArgoCDInstanceNames, f.KargoInstanceNames, f.ArgoCDClusterNames, f.KargoAgentNames} {
+		slices.Sort(names)
+	}
+	if len(f.Events) == 0 && len(f.ArgoCDInstanceNames) == 0 && len(f.KargoInstanceNames) == 0 &&
+		len(f.ArgoCDClusterNames) == 0 && len(f.KargoAgentNames) == 0 {
+		return nil
+	}
+	return f
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notificationconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
+)
+
+const configID = "nc-1"
+
+func newNotificationConfig() *v1alpha1.NotificationConfig {
+	mg := &v1alpha1.NotificationConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "alerts", UID: "alerts-uid"},
+		Spec: v1alpha1.NotificationConfigSpec{
+			ForProvider: v1alpha1.NotificationConfigParameters{
+				Name: "alerts",
+				Webhook: &v1alpha1.NotificationWebhook{
+					URL: "https://hooks.example.com/akuity",
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Namespace: "akuity", Name: "webhook"},
+						Key:             "secret",
+					},
+				},
+				Filter: &v1alpha1.NotificationFilter{
+					Events:             []string{"instance.updated", "instance.created"},
+					ArgoCDInstanceRefs: []v1alpha1.LocalReference{{Name: "prod"}},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(mg, configID)
+	return mg
+}
+
+func webhookSecret(value string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "akuity", Name: "webhook"},
+		Data:       map[string][]byte{"secret": []byte(value)},
+	}
+}
+
+func prodInstance() *v1alpha1.Instance {
+	inst := &v1alpha1.Instance{ObjectMeta: metav1.ObjectMeta{Name: "prod"}}
+	inst.Spec.ForProvider.Name = "argocd-prod"
+	return inst
+}
+
+func webhookConfig(active bool) *orgcv1.NotificationConfig {
+	return &orgcv1.NotificationConfig{
+		Id:   configID,
+		Name: "alerts",
+		Config: &orgcv1.NotificationConfig_Webhook{Webhook: &orgcv1.WebhookConfig{
+			Url:    "https://hooks.example.com/akuity",
+			Active: active,
+			Filter: &orgcv1.WebhookNotificationFilter{
+				Events:                    []string{"instance.created", "instance.updated"},
+				FilterArgocdInstanceNames: true,
+				ArgocdInstanceNames:       []string{"argocd-prod"},
+			},
+		}},
+	}
+}
+
+func secretHash(value string) string {
+	return secrets.ResolvedSecret{
+		Namespace: "akuity",
+		Name:      "webhook",
+		Data:      map[string]string{"secret": value},
+	}.Hash()
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_NoExternalName(t *testing.T) {
+	e, _ := newExt(t)
+	mg := newNotificationConfig()
+	meta.SetExternalName(mg, "")
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_NotFound(t *testing.T) {
+	e, mc := newExt(t)
+	mc.EXPECT().GetNotificationConfig(gomock.Any(), configID).Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), newNotificationConfig())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_UpToDateWithResolvedRefs(t *testing.T) {
+	e, mc := newExt(t, webhookSecret("s3cr3t"), prodInstance())
+	mg := newNotificationConfig()
+	mg.Status.AtProvider.SecretHash = secretHash("s3cr3t")
+	mc.EXPECT().GetNotificationConfig(gomock.Any(), configID).Return(webhookConfig(true), nil).Times(1)
+	mc.EXPECT().ListNotificationDeliveryHistory(gomock.Any(), configID, int64(deliveryHistoryLimit)).Return(nil, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, secretHash("s3cr3t"), mg.Status.AtProvider.SecretHash)
+	assert.Equal(t, deliveryMethodWebhook, mg.Status.AtProvider.DeliveryMethod)
+	assert.True(t, mg.Status.AtProvider.Active)
+}
+
+func TestObserve_InactiveDrifts(t *testing.T) {
+	e, mc := newExt(t, webhookSecret("s3cr3t"), prodInstance())
+	mg := newNotificationConfig()
+	mg.Status.AtProvider.SecretHash = secretHash("s3cr3t")
+	mc.EXPECT().GetNotificationConfig(gomock.Any(), configID).Return(webhookConfig(false), nil).Times(1)
+	mc.EXPECT().ListNotificationDeliveryHistory(gomock.Any(), configID, gomock.Any()).Return(nil, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_RotatedSecretDrifts(t *testing.T) {
+	e, mc := newExt(t, webhookSecret("rotated"), prodInstance())
+	mg := newNotificationConfig()
+	mg.Status.AtProvider.SecretHash = secretHash("s3cr3t")
+	mc.EXPECT().GetNotificationConfig(gomock.Any(), configID).Return(webhookConfig(true), nil).Times(1)
+	mc.EXPECT().ListNotificationDeliveryHistory(gomock.Any(), configID, gomock.Any()).Return(nil, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_MissingInstanceRef(t *testing.T) {
+	e, mc := newExt(t, webhookSecret("s3cr3t"))
+	mc.EXPECT().GetNotificationConfig(gomock.Any(), configID).Return(webhookConfig(true), nil).Times(1)
+	mc.EXPECT().ListNotificationDeliveryHistory(gomock.Any(), configID, gomock.Any()).Return(nil, nil).Times(1)
+
+	_, err := e.Observe(context.Background(), newNotificationConfig())
+	require.Error(t, err)
+}
+
+func TestObserve_SurfacesLatestDeliveryFailure(t *testing.T) {
+	e, mc := newExt(t, webhookSecret("s3cr3t"), prodInstance())
+	mg := newNotificationConfig()
+	mg.Status.AtProvider.SecretHash = secretHash("s3cr3t")
+	older := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	history := []*orgcv1.NotificationDelivery{
+		{
+			Id:                  "d-1",
+			DeliveryStatus:      orgcv1.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_FAILURE,
+			InitialDeliveryTime: timestamppb.New(older),
+		},
+		{
+			Id:                  "d-2",
+			EventType:           "instance.updated",
+			DeliveryStatus:      orgcv1.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_FAILURE,
+			InitialDeliveryTime: timestamppb.New(newer),
+			RetryCount:          3,
+			Metadata: &orgcv1.NotificationDelivery_Webhook{Webhook: &orgcv1.WebhookNotificationDeliveryMetadata{
+				StatusCode: ptr.To(int64(502)),
+				Error:      ptr.To("bad gateway"),
+			}},
+		},
+		{
+			Id:                  "d-3",
+			DeliveryStatus:      orgcv1.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SUCCESS,
+			InitialDeliveryTime: timestamppb.New(newer.Add(time.Hour)),
+		},
+	}
+	mc.EXPECT().GetNotificationConfig(gomock.Any(), configID).Return(webhookConfig(true), nil).Times(1)
+	mc.EXPECT().ListNotificationDeliveryHistory(gomock.Any(), configID, gomock.Any()).Return(history, nil).Times(1)
+
+	_, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	failure := mg.Status.AtProvider.LastDeliveryFailure
+	require.NotNil(t, failure)
+	assert.Equal(t, "d-2", failure.ID)
+	assert.Equal(t, "instance.updated", failure.EventType)
+	assert.Equal(t, int64(3), failure.RetryCount)
+	assert.Equal(t, ptr.To(int64(502)), failure.StatusCode)
+	assert.Equal(t, "bad gateway", failure.Error)
+	assert.Equal(t, newer.Format(time.RFC3339), failure.Time)
+}
+
+func TestObserve_HistoryErrorKeepsPreviousFailure(t *testing.T) {
+	e, mc := newExt(t, webhookSecret("s3cr3t"), prodInstance())
+	mg := newNotificationConfig()
+	mg.Status.AtProvider.SecretHash = secretHash("s3cr3t")
+	mg.Status.AtProvider.LastDeliveryFailure = &v1alpha1.NotificationDeliveryFailure{ID: "d-0"}
+	mc.EXPECT().GetNotificationConfig(gomock.Any(), configID).Return(webhookConfig(true), nil).Times(1)
+	mc.EXPECT().ListNotificationDeliveryHistory(gomock.Any(), configID, gomock.Any()).Return(nil, errors.New("unavailable")).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+	require.NotNil(t, mg.Status.AtProvider.LastDeliveryFailure)
+	assert.Equal(t, "d-0", mg.Status.AtProvider.LastDeliveryFailure.ID)
+}
+
+func TestCreate_StampsIDAndPings(t *testing.T) {
+	e, mc := newExt(t, webhookSecret("s3cr3t"), prodInstance())
+	mg := newNotificationConfig()
+	meta.SetExternalName(mg, "")
+	mg.Spec.ForProvider.PingOnWrite = true
+	mc.EXPECT().CreateNotificationConfig(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *orgcv1.CreateNotificationConfigRequest) (*orgcv1.NotificationConfig, error) {
+			wh := req.GetWebhook()
+			require.NotNil(t, wh)
+			assert.Equal(t, "s3cr3t", wh.GetSecret())
+			assert.True(t, wh.GetFilter().GetFilterArgocdInstanceNames())
+			assert.Equal(t, []string{"argocd-prod"}, wh.GetFilter().GetArgocdInstanceNames())
+			return webhookConfig(true), nil
+		}).Times(1)
+	mc.EXPECT().PingNotificationConfig(gomock.Any(), configID).Return(nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, configID, meta.GetExternalName(mg))
+	assert.Equal(t, secretHash("s3cr3t"), mg.Status.AtProvider.SecretHash)
+	assert.Equal(t, corev1.ConditionTrue, mg.GetCondition(v1alpha1.TypeDeliveryTest).Status)
+}
+
+func TestCreate_RejectedIsTerminal(t *testing.T) {
+	e, mc := newExt(t, webhookSecret("s3cr3t"), prodInstance())
+	mg := newNotificationConfig()
+	meta.SetExternalName(mg, "")
+	mc.EXPECT().CreateNotificationConfig(gomock.Any(), gomock.Any()).
+		Return(nil, reason.AsTerminal(errors.New("invalid url"))).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.Error(t, err)
+
+	// The next Observe suppresses the retry until the spec changes.
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestUpdate_PingFailureDoesNotFailWrite(t *testing.T) {
+	e, mc := newExt(t, webhookSecret("s3cr3t"), prodInstance())
+	mg := newNotificationConfig()
+	mg.Spec.ForProvider.Active = ptr.To(false)
+	mg.Spec.ForProvider.PingOnWrite = true
+	mc.EXPECT().UpdateNotificationConfig(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *orgcv1.UpdateNotificationConfigRequest) (*orgcv1.NotificationConfig, error) {
+			assert.Equal(t, configID, req.GetId())
+			assert.False(t, req.GetWebhook().GetActive())
+			return webhookConfig(false), nil
+		}).Times(1)
+	mc.EXPECT().PingNotificationConfig(gomock.Any(), configID).Return(errors.New("connection refused")).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+	cond := mg.GetCondition(v1alpha1.TypeDeliveryTest)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1alpha1.ReasonPingFailed, cond.Reason)
+}
+
+func TestDelete_IgnoresNotFound(t *testing.T) {
+	e, mc := newExt(t)
+	mc.EXPECT().DeleteNotificationConfig(gomock.Any(), configID).Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), newNotificationConfig())
+	require.NoError(t, err)
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package oidcmap is the OIDCMap controller. It owns the Akuity
+// organization's SSO group to organization role mapping through the
+// Organization gateway's Get/UpdateOIDCMap endpoints. The map is a
+// per-organization singleton, so the external-name is the MR name and
+// carries no platform identity.
+package oidcmap
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.OIDCMapGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.OIDCMap]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.OIDCMap] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.OIDCMapGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.OIDCMap](conn),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.OIDCMap{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.OIDCMap) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	if meta.GetExternalName(mg) == "" {
+		if obs, err, ok := e.suppressTerminalWrite(mg); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.Client.GetOIDCMap(ctx)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	mg.Status.AtProvider = v1alpha1.OIDCMapObservation{Entries: observed}
+	base.SetHealthCondition(mg, true)
+
+	// Delete clears the map rather than removing anything, so an empty
+	// map on a deleted MR is the post-delete state. Reporting it as
+	// absent releases the finalizer instead of re-entering Delete.
+	if meta.WasDeleted(mg) && len(observed) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	desired := mg.Spec.ForProvider.Entries
+	upToDate, err := base.EvaluateDrift(ctx, base.DriftSpec[map[string]string]{}, &desired, &observed, e.Logger, "OIDCMap")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.OIDCMap,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.OIDCMap) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	if err := e.update(ctx, mg); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(mg, mg.GetName())
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.OIDCMap) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	return managed.ExternalUpdate{}, e.update(ctx, mg)
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.OIDCMap) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.OIDCMapGroupVersionKind)
+
+	// Delete clears the map. This assumes the MR exclusively owns the
+	// organization's OIDC map.
+	if err := e.Client.UpdateOIDCMap(ctx, map[string]string{}); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+func (e *external) update(ctx context.Context, mg *v1alpha1.OIDCMap) error {
+	key, err := oidcMapTerminalWriteKey(mg)
+	if err != nil {
+		return err
+	}
+	entries := mg.Spec.ForProvider.Entries
+	if entries == nil {
+		entries = map[string]string{}
+	}
+	if err := e.Client.UpdateOIDCMap(ctx, entries); err != nil {
+		return e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	return nil
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.OIDCMap) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := oidcMapTerminalWriteKey(mg)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.OIDCMap) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.OIDCMapGroupVersionKind) {
+		return
+	}
+	key, err := oidcMapTerminalWriteKey(mg)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func oidcMapTerminalWriteKey(mg *v1alpha1.OIDCMap) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.OIDCMapGroupVersionKind, mg.Spec.ForProvider.Entries)
+}
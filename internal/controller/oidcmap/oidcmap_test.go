@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidcmap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func newMap(entries map[string]string) *v1alpha1.OIDCMap {
+	mg := &v1alpha1.OIDCMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "org-roles", UID: "map-uid"},
+		Spec: v1alpha1.OIDCMapSpec{
+			ForProvider: v1alpha1.OIDCMapParameters{Entries: entries},
+		},
+	}
+	meta.SetExternalName(mg, mg.GetName())
+	return mg
+}
+
+func newExt(t *testing.T) (*external, *mockclient.MockClient) {
+	t.Helper()
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_UpToDate(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMap(map[string]string{"admins": "owner"})
+
+	mc.EXPECT().GetOIDCMap(gomock.Any()).Return(map[string]string{"admins": "owner"}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, map[string]string{"admins": "owner"}, mg.Status.AtProvider.Entries)
+}
+
+func TestObserve_EntryAddedOutOfBandDrifts(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMap(map[string]string{"admins": "owner"})
+
+	mc.EXPECT().GetOIDCMap(gomock.Any()).Return(map[string]string{"admins": "owner", "devs": "member"}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_EmptyDesiredMatchesEmptyObserved(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMap(nil)
+
+	mc.EXPECT().GetOIDCMap(gomock.Any()).Return(map[string]string{}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_DeletedAndClearedIsAbsent(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMap(map[string]string{"admins": "owner"})
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+
+	mc.EXPECT().GetOIDCMap(gomock.Any()).Return(nil, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestUpdate_TerminalErrorSuppressesRetry(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMap(map[string]string{"admins": "superuser"})
+
+	mc.EXPECT().UpdateOIDCMap(gomock.Any(), map[string]string{"admins": "superuser"}).
+		Return(status.Error(codes.InvalidArgument, "unknown role")).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.Error(t, err)
+
+	mc.EXPECT().GetOIDCMap(gomock.Any()).Return(map[string]string{}, nil).Times(1)
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+}
+
+func TestDelete_ClearsMap(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMap(map[string]string{"admins": "owner"})
+
+	mc.EXPECT().UpdateOIDCMap(gomock.Any(), map[string]string{}).Return(nil).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
+
+func TestDelete_IgnoresNotFound(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMap(nil)
+
+	mc.EXPECT().UpdateOIDCMap(gomock.Any(), map[string]string{}).Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package organizationapikey is the OrganizationAPIKey controller. It
+// mints an Akuity organization API key through the Organization
+// gateway and deletes it through the APIKey gateway. The
+// platform-assigned key ID is the external-name.
+//
+// The key secret is only returned by the create call, so it is
+// published to the connection Secret exactly once. Observe never
+// republishes it; a key deleted out-of-band is recreated with a fresh
+// secret, which replaces the previously published one.
+package organizationapikey
+
+import (
+	"context"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+//
+// The external-name is the platform-assigned key ID, so the default
+// NameAsExternalName initializer is disabled.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.OrganizationAPIKeyGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.OrganizationAPIKey]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.OrganizationAPIKey] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.OrganizationAPIKeyGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.OrganizationAPIKey](conn),
+		managed.WithInitializers(),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.OrganizationAPIKey{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.OrganizationAPIKey) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		// A CreateOrganizationAPIKey rejected on bad input (unknown role,
+		// malformed duration) never stamps the external-name; suppress
+		// the retry loop until the spec changes.
+		if obs, err, ok := e.suppressTerminalWrite(mg); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	key, err := e.Client.GetAPIKey(ctx, id)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	mg.Status.AtProvider = base.APIKeyObservation(key)
+	// An expired key still exists on the platform but no longer
+	// authenticates; surface that as Unavailable rather than recreating
+	// it behind the user's back.
+	base.SetHealthCondition(mg, !base.APIKeyExpired(key, time.Now()))
+	e.clearTerminalWrite(mg)
+
+	// API keys have no update route and forProvider is immutable, so an
+	// existing key is always up to date.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.OrganizationAPIKey,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.OrganizationAPIKey) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	key, err := organizationAPIKeyTerminalWriteKey(mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	fp := mg.Spec.ForProvider
+	apiKey, err := e.Client.CreateOrganizationAPIKey(ctx, fp.Description, base.APIKeyPermissionsToProto(fp.Permissions), fp.ExpireInDuration)
+	if err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider = base.APIKeyObservation(apiKey)
+	meta.SetExternalName(mg, apiKey.GetId())
+
+	details, err := base.APIKeyConnectionDetails(apiKey.GetId(), apiKey.GetSecret())
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return managed.ExternalCreation{ConnectionDetails: details}, nil
+}
+
+// Update is a no-op: forProvider is immutable and Observe always
+// reports an existing key as up to date.
+func (e *external) Update(_ context.Context, mg *v1alpha1.OrganizationAPIKey) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.OrganizationAPIKey) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.OrganizationAPIKeyGroupVersionKind)
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	if err := e.Client.DeleteAPIKey(ctx, id); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.OrganizationAPIKey) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := organizationAPIKeyTerminalWriteKey(mg)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.OrganizationAPIKey) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.OrganizationAPIKeyGroupVersionKind) {
+		return
+	}
+	key, err := organizationAPIKeyTerminalWriteKey(mg)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func organizationAPIKeyTerminalWriteKey(mg *v1alpha1.OrganizationAPIKey) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.OrganizationAPIKeyGroupVersionKind, mg.Spec.ForProvider)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationapikey
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	accesscontrolv1 "github.com/akuity/api-client-go/pkg/api/gen/accesscontrol/v1"
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/config"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func newKey() *v1alpha1.OrganizationAPIKey {
+	return &v1alpha1.OrganizationAPIKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci", UID: "oak-uid"},
+		Spec: v1alpha1.OrganizationAPIKeySpec{
+			ForProvider: v1alpha1.OrganizationAPIKeyParameters{
+				Description:      "ci",
+				Permissions:      v1alpha1.APIKeyPermissions{Roles: []string{"organization/member"}},
+				ExpireInDuration: "720h",
+			},
+		},
+	}
+}
+
+func newExt(t *testing.T) (*external, *mockclient.MockClient) {
+	t.Helper()
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_NoExternalName(t *testing.T) {
+	e, _ := newExt(t)
+	obs, err := e.Observe(context.Background(), newKey())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_Exists(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newKey()
+	meta.SetExternalName(mg, "key-1")
+
+	expire := time.Now().Add(time.Hour)
+	mc.EXPECT().GetAPIKey(gomock.Any(), "key-1").Return(&apikeyv1.APIKey{
+		Id:          "key-1",
+		Description: "ci",
+		Permissions: &accesscontrolv1.Permissions{Roles: []string{"organization/member"}},
+		ExpireTime:  timestamppb.New(expire),
+	}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Empty(t, obs.ConnectionDetails)
+	assert.Equal(t, "key-1", mg.Status.AtProvider.ID)
+	assert.Equal(t, []string{"organization/member"}, mg.Status.AtProvider.Permissions.Roles)
+	assert.Equal(t, expire.UTC().Format(time.RFC3339), mg.Status.AtProvider.ExpireTime)
+	assert.Equal(t, corev1.ConditionTrue, mg.GetCondition(xpv1.TypeReady).Status)
+}
+
+func TestObserve_ExpiredIsUnavailable(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newKey()
+	meta.SetExternalName(mg, "key-1")
+
+	mc.EXPECT().GetAPIKey(gomock.Any(), "key-1").Return(&apikeyv1.APIKey{
+		Id:         "key-1",
+		ExpireTime: timestamppb.New(time.Now().Add(-time.Minute)),
+	}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.Equal(t, corev1.ConditionFalse, mg.GetCondition(xpv1.TypeReady).Status)
+}
+
+func TestObserve_DeletedOutOfBand(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newKey()
+	meta.SetExternalName(mg, "key-1")
+
+	mc.EXPECT().GetAPIKey(gomock.Any(), "key-1").Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestCreate_PublishesProviderConfigCredentials(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newKey()
+	secret := "key-secret"
+
+	mc.EXPECT().CreateOrganizationAPIKey(gomock.Any(), "ci",
+		&accesscontrolv1.Permissions{Roles: []string{"organization/member"}}, "720h").
+		Return(&apikeyv1.APIKey{Id: "key-1", Description: "ci", Secret: &secret}, nil).Times(1)
+
+	cre, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", meta.GetExternalName(mg))
+	assert.Equal(t, "key-1", mg.Status.AtProvider.ID)
+	assert.Equal(t, []byte("key-1"), cre.ConnectionDetails[config.CredentialsAPIKeyID])
+	assert.Equal(t, []byte(secret), cre.ConnectionDetails[config.CredentialsAPIKeySecret])
+
+	creds := map[string]string{}
+	require.NoError(t, json.Unmarshal(cre.ConnectionDetails[config.CredentialsKey], &creds))
+	assert.Equal(t, map[string]string{
+		config.CredentialsAPIKeyID:     "key-1",
+		config.CredentialsAPIKeySecret: secret,
+	}, creds)
+}
+
+func TestCreate_TerminalErrorSuppressesRetry(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newKey()
+
+	mc.EXPECT().CreateOrganizationAPIKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, reason.AsTerminal(errors.New("invalid role"))).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.Error(t, err)
+
+	_, err = e.Observe(context.Background(), mg)
+	require.Error(t, err)
+}
+
+func TestDelete_IgnoresNotFound(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newKey()
+	meta.SetExternalName(mg, "key-1")
+
+	mc.EXPECT().DeleteAPIKey(gomock.Any(), "key-1").Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
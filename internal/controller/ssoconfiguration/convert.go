@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssoconfiguration
+
+import (
+	"context"
+	"fmt"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
+)
+
+// Provider names reported on status.atProvider.provider.
+const (
+	providerAzureAD         = "azureAd"
+	providerOkta            = "okta"
+	providerGoogleWorkspace = "googleWorkspace"
+	providerOIDC            = "oidc"
+	providerSAML            = "saml"
+)
+
+// clientSecretRef returns the Secret key selector carrying the client
+// secret for the configured provider, plus the spec path used in error
+// messages. SAML and front-channel OIDC take no client secret and
+// return nil.
+func clientSecretRef(fp v1alpha1.SSOConfigurationParameters) (*xpv1.SecretKeySelector, string) {
+	switch {
+	case fp.AzureAD != nil:
+		return &fp.AzureAD.ClientSecretRef, "azureAd.clientSecretRef"
+	case fp.Okta != nil:
+		return &fp.Okta.ClientSecretRef, "okta.clientSecretRef"
+	case fp.GoogleWorkspace != nil:
+		return &fp.GoogleWorkspace.ClientSecretRef, "googleWorkspace.clientSecretRef"
+	case fp.OIDC != nil && fp.OIDC.BackChannel != nil:
+		return &fp.OIDC.BackChannel.ClientSecretRef, "oidc.backChannel.clientSecretRef"
+	}
+	return nil, ""
+}
+
+// resolveClientSecret loads the client secret referenced by the spec.
+// Missing or malformed references are terminal configuration errors.
+func resolveClientSecret(ctx context.Context, kube client.Client, mg *v1alpha1.SSOConfiguration) (secrets.ResolvedSecret, string, error) {
+	ref, label := clientSecretRef(mg.Spec.ForProvider)
+	resolved, value, err := secrets.ResolveKey(ctx, kube, ref)
+	if err != nil {
+		return secrets.ResolvedSecret{}, "", secrets.AsTerminalIfConfig(fmt.Errorf("%s: %w", label, err))
+	}
+	return resolved, value, nil
+}
+
+// buildEnsureRequest translates the spec into the gateway request. The
+// organization ID is stamped by the client.
+func buildEnsureRequest(fp v1alpha1.SSOConfigurationParameters, clientSecret string) *orgcv1.EnsureSSOConfigurationRequest {
+	req := &orgcv1.EnsureSSOConfigurationRequest{
+		AutoAddMember: fp.AutoAddMember,
+		EnforceSso:    fp.EnforceSSO,
+		GroupClaims:   fp.GroupClaims,
+	}
+	switch {
+	case fp.AzureAD != nil:
+		req.Options = &orgcv1.EnsureSSOConfigurationRequest_AzureAd{AzureAd: &orgcv1.AzureADSSOOptions{
+			ClientId:      fp.AzureAD.ClientID,
+			ClientSecret:  clientSecret,
+			AzureAdDomain: fp.AzureAD.Domain,
+			DomainAliases: fp.AzureAD.DomainAliases,
+		}}
+	case fp.Okta != nil:
+		req.Options = &orgcv1.EnsureSSOConfigurationRequest_Okta{Okta: &orgcv1.OktaSSOOptions{
+			ClientId:      fp.Okta.ClientID,
+			ClientSecret:  clientSecret,
+			OktaDomain:    fp.Okta.Domain,
+			DomainAliases: fp.Okta.DomainAliases,
+		}}
+	case fp.GoogleWorkspace != nil:
+		req.Options = &orgcv1.EnsureSSOConfigurationRequest_GoogleWorkspace{GoogleWorkspace: &orgcv1.GoogleWorkspaceSSOOptions{
+			ClientId:              fp.GoogleWorkspace.ClientID,
+			ClientSecret:          clientSecret,
+			GoogleWorkspaceDomain: fp.GoogleWorkspace.Domain,
+			DomainAliases:         fp.GoogleWorkspace.DomainAliases,
+		}}
+	case fp.OIDC != nil:
+		oidc := &orgcv1.OIDCSSOOptions{
+			DiscoveryUrl:  fp.OIDC.DiscoveryURL,
+			ClientId:      fp.OIDC.ClientID,
+			Domain:        fp.OIDC.Domain,
+			DomainAliases: fp.OIDC.DomainAliases,
+			Scopes:        fp.OIDC.Scopes,
+		}
+		switch {
+		case fp.OIDC.BackChannel != nil:
+			b := fp.OIDC.BackChannel
+			oidc.Channel = &orgcv1.OIDCSSOOptions_Back{Back: &orgcv1.OIDCSSOBackChannel{
+				ClientSecret:          clientSecret,
+				Issuer:                b.Issuer,
+				AuthorizationEndpoint: b.AuthorizationEndpoint,
+				TokenEndpoint:         b.TokenEndpoint,
+				JwksUri:               b.JWKSURI,
+			}}
+		case fp.OIDC.FrontChannel != nil:
+			f := fp.OIDC.FrontChannel
+			oidc.Channel = &orgcv1.OIDCSSOOptions_Front{Front: &orgcv1.OIDCSSOFrontChannel{
+				Issuer:                f.Issuer,
+				AuthorizationEndpoint: f.AuthorizationEndpoint,
+				JwksUri:               f.JWKSURI,
+			}}
+		}
+		req.Options = &orgcv1.EnsureSSOConfigurationRequest_Oidc{Oidc: oidc}
+	case fp.SAML != nil:
+		req.Options = &orgcv1.EnsureSSOConfigurationRequest_Saml{Saml: &orgcv1.SAMLSSOOptions{
+			Domain:        fp.SAML.Domain,
+			DomainAliases: fp.SAML.DomainAliases,
+			Options:       &orgcv1.SAMLSSOOptions_MetadataXml{MetadataXml: fp.SAML.MetadataXML},
+		}}
+	}
+	return req
+}
+
+// ssoParameters projects the gateway response back onto the spec shape
+// for drift comparison. Client secrets are not returned by the gateway,
+// so every ClientSecretRef is left zero and ignored by driftSpec.
+func ssoParameters(resp *orgcv1.GetSSOConfigurationResponse) v1alpha1.SSOConfigurationParameters {
+	out := v1alpha1.SSOConfigurationParameters{
+		AutoAddMember: resp.GetAutoAddMember(),
+		EnforceSSO:    resp.GetEnforceSso(),
+		GroupClaims:   resp.GetGroupClaims(),
+	}
+	if o := resp.GetAzureAd(); o != nil {
+		out.AzureAD = &v1alpha1.SSOClientOptions{ClientID: o.GetClientId(), Domain: o.GetAzureAdDomain(), DomainAliases: o.GetDomainAliases()}
+	}
+	if o := resp.GetOkta(); o != nil {
+		out.Okta = &v1alpha1.SSOClientOptions{ClientID: o.GetClientId(), Domain: o.GetOktaDomain(), DomainAliases: o.GetDomainAliases()}
+	}
+	if o := resp.GetGoogleWorkspace(); o != nil {
+		out.GoogleWorkspace = &v1alpha1.SSOClientOptions{ClientID: o.GetClientId(), Domain: o.GetGoogleWorkspaceDomain(), DomainAliases: o.GetDomainAliases()}
+	}
+	if o := resp.GetOidc(); o != nil {
+		out.OIDC = &v1alpha1.SSOOIDCOptions{
+			DiscoveryURL:  o.GetDiscoveryUrl(),
+			ClientID:      o.GetClientId(),
+			Domain:        o.GetDomain(),
+			DomainAliases: o.GetDomainAliases(),
+			Scopes:        o.GetScopes(),
+		}
+		if b := o.GetBack(); b != nil {
+			out.OIDC.BackChannel = &v1alpha1.SSOOIDCBackChannel{
+				Issuer:                b.GetIssuer(),
+				AuthorizationEndpoint: b.GetAuthorizationEndpoint(),
+				TokenEndpoint:         b.GetTokenEndpoint(),
+				JWKSURI:               b.GetJwksUri(),
+			}
+		}
+		if f := o.GetFront(); f != nil {
+			out.OIDC.FrontChannel = &v1alpha1.SSOOIDCFrontChannel{
+				Issuer:                f.GetIssuer(),
+				AuthorizationEndpoint: f.GetAuthorizationEndpoint(),
+				JWKSURI:               f.GetJwksUri(),
+			}
+		}
+	}
+	if o := resp.GetSaml(); o != nil {
+		out.SAML = &v1alpha1.SSOSAMLOptions{Domain: o.GetDomain(), DomainAliases: o.GetDomainAliases(), MetadataXML: o.GetMetadataXml()}
+	}
+	return out
+}
+
+// ssoObservation summarizes the gateway response for status. The
+// SecretHash is controller-owned and not set here.
+func ssoObservation(resp *orgcv1.GetSSOConfigurationResponse) v1alpha1.SSOConfigurationObservation {
+	out := v1alpha1.SSOConfigurationObservation{
+		AutoAddMember: resp.GetAutoAddMember(),
+		EnforceSSO:    resp.GetEnforceSso(),
+		GroupClaims:   resp.GetGroupClaims(),
+	}
+	switch {
+	case resp.GetAzureAd() != nil:
+		o := resp.GetAzureAd()
+		out.Provider, out.ClientID, out.Domain, out.DomainAliases = providerAzureAD, o.GetClientId(), o.GetAzureAdDomain(), o.GetDomainAliases()
+	case resp.GetOkta() != nil:
+		o := resp.GetOkta()
+		out.Provider, out.ClientID, out.Domain, out.DomainAliases = providerOkta, o.GetClientId(), o.GetOktaDomain(), o.GetDomainAliases()
+	case resp.GetGoogleWorkspace() != nil:
+		o := resp.GetGoogleWorkspace()
+		out.Provider, out.ClientID, out.Domain, out.DomainAliases = providerGoogleWorkspace, o.GetClientId(), o.GetGoogleWorkspaceDomain(), o.GetDomainAliases()
+	case resp.GetOidc() != nil:
+		o := resp.GetOidc()
+		out.Provider, out.ClientID, out.Domain, out.DomainAliases = providerOIDC, o.GetClientId(), o.GetDomain(), o.GetDomainAliases()
+	case resp.GetSaml() != nil:
+		o := resp.GetSaml()
+		out.Provider, out.Domain, out.DomainAliases = providerSAML, o.GetDomain(), o.GetDomainAliases()
+	}
+	return out
+}
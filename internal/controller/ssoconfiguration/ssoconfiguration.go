@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ssoconfiguration is the SSOConfiguration controller. It owns
+// the Akuity organization's single sign-on configuration through the
+// Organization gateway's Get/Ensure/DeleteSSOConfiguration endpoints.
+// The configuration is a per-organization singleton, so the
+// external-name is the MR name and carries no platform identity.
+//
+// Client secrets are read from kube Secrets at apply time. The gateway
+// never returns them, so rotation is detected by comparing a digest of
+// the resolved secret against status.atProvider.secretHash, the same
+// way the Instance controller handles its Secret references.
+package ssoconfiguration
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
+)
+
+// Setup registers the controller with the manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.SSOConfigurationGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.SSOConfiguration]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.SSOConfiguration] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SSOConfigurationGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.SSOConfiguration](conn),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.SSOConfiguration{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.SSOConfiguration) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	if meta.GetExternalName(mg) == "" {
+		if obs, err, ok := e.suppressTerminalWrite(ctx, mg); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	resp, err := e.Client.GetSSOConfiguration(ctx)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	// SecretHash is written by Create/Update after a successful Ensure
+	// and is not derivable from the gateway response. Preserve it
+	// across the assignment so rotation drift below compares against
+	// the last-applied digest.
+	preservedSecretHash := mg.Status.AtProvider.SecretHash
+	mg.Status.AtProvider = ssoObservation(resp)
+	mg.Status.AtProvider.SecretHash = preservedSecretHash
+	base.SetHealthCondition(mg, true)
+
+	desired := mg.Spec.ForProvider.DeepCopy()
+	observed := ssoParameters(resp)
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), desired, &observed, e.Logger, "SSOConfiguration")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Secret rotation drift: the struct compare ignores every
+	// ClientSecretRef because the gateway never echoes the secret.
+	// Re-resolve it and compare the digest against the last-applied
+	// hash; a difference means the user rotated the Secret.
+	if upToDate {
+		sec, _, serr := resolveClientSecret(ctx, e.Kube, mg)
+		if serr != nil {
+			mg.SetConditions(xpv1.ReconcileError(serr))
+			return managed.ExternalObservation{}, serr
+		}
+		if sec.Hash() != mg.Status.AtProvider.SecretHash {
+			e.Logger.Debug("SSOConfiguration secret hash changed; forcing re-Ensure",
+				"previous", mg.Status.AtProvider.SecretHash, "current", sec.Hash())
+			upToDate = false
+		}
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(ctx, mg); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(ctx, mg)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.SSOConfiguration,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.SSOConfiguration) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	if err := e.ensure(ctx, mg); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(mg, mg.GetName())
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.SSOConfiguration) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	return managed.ExternalUpdate{}, e.ensure(ctx, mg)
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.SSOConfiguration) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.SSOConfigurationGroupVersionKind)
+
+	if err := e.Client.DeleteSSOConfiguration(ctx); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// ensure resolves the client secret and writes the full configuration.
+// EnsureSSOConfiguration is an upsert, so Create and Update share it.
+func (e *external) ensure(ctx context.Context, mg *v1alpha1.SSOConfiguration) error {
+	sec, clientSecret, err := resolveClientSecret(ctx, e.Kube, mg)
+	if err != nil {
+		return err
+	}
+	key, err := ssoConfigurationTerminalWriteKey(mg, sec)
+	if err != nil {
+		return err
+	}
+	if err := e.Client.EnsureSSOConfiguration(ctx, buildEnsureRequest(mg.Spec.ForProvider, clientSecret)); err != nil {
+		return e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider.SecretHash = sec.Hash()
+	return nil
+}
+
+func (e *external) suppressTerminalWrite(ctx context.Context, mg *v1alpha1.SSOConfiguration) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	sec, _, err := resolveClientSecret(ctx, e.Kube, mg)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	key, err := ssoConfigurationTerminalWriteKey(mg, sec)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(ctx context.Context, mg *v1alpha1.SSOConfiguration) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.SSOConfigurationGroupVersionKind) {
+		return
+	}
+	sec, _, err := resolveClientSecret(ctx, e.Kube, mg)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	key, err := ssoConfigurationTerminalWriteKey(mg, sec)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+// ssoConfigurationTerminalWriteKey keys on the spec plus the secret
+// digest, never the plaintext secret, so rotating the Secret releases a
+// write held after a credential rejection.
+func ssoConfigurationTerminalWriteKey(mg *v1alpha1.SSOConfiguration, sec secrets.ResolvedSecret) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.SSOConfigurationGroupVersionKind, mg.Spec.ForProvider, sec.Hash())
+}
+
+// driftSpec is the resource's drift-detection recipe. Client secrets
+// are write-only on the gateway and tracked through SecretHash instead.
+func driftSpec() base.DriftSpec[v1alpha1.SSOConfigurationParameters] {
+	return base.DriftSpec[v1alpha1.SSOConfigurationParameters]{
+		Ignore: []cmp.Option{
+			cmpopts.IgnoreFields(v1alpha1.SSOClientOptions{}, "ClientSecretRef"),
+			cmpopts.IgnoreFields(v1alpha1.SSOOIDCBackChannel{}, "ClientSecretRef"),
+		},
+	}
+}
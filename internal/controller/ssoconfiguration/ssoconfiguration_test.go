@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssoconfiguration
+
+import (
+	"context"
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+	"github.com/akuityio/provider-crossplane-akuity/internal/secrets"
+)
+
+func newSSO() *v1alpha1.SSOConfiguration {
+	mg := &v1alpha1.SSOConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "sso", UID: "sso-uid"},
+		Spec: v1alpha1.SSOConfigurationSpec{
+			ForProvider: v1alpha1.SSOConfigurationParameters{
+				EnforceSSO:  true,
+				GroupClaims: []string{"groups"},
+				Okta: &v1alpha1.SSOClientOptions{
+					ClientID: "client-1",
+					ClientSecretRef: xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Namespace: "akuity", Name: "okta"},
+						Key:             "clientSecret",
+					},
+					Domain: "example.okta.com",
+				},
+			},
+		},
+	}
+	meta.SetExternalName(mg, mg.GetName())
+	return mg
+}
+
+func oktaSecret(value string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "akuity", Name: "okta"},
+		Data:       map[string][]byte{"clientSecret": []byte(value)},
+	}
+}
+
+func oktaResponse() *orgcv1.GetSSOConfigurationResponse {
+	return &orgcv1.GetSSOConfigurationResponse{
+		EnforceSso:  true,
+		GroupClaims: []string{"groups"},
+		Options: &orgcv1.GetSSOConfigurationResponse_Okta{Okta: &orgcv1.OktaSSOOptions{
+			ClientId:   "client-1",
+			OktaDomain: "example.okta.com",
+		}},
+	}
+}
+
+func secretHash(value string) string {
+	return secrets.ResolvedSecret{
+		Namespace: "akuity",
+		Name:      "okta",
+		Data:      map[string]string{"clientSecret": value},
+	}.Hash()
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_NotConfigured(t *testing.T) {
+	e, mc := newExt(t, oktaSecret("s3cr3t"))
+	mc.EXPECT().GetSSOConfiguration(gomock.Any()).Return(nil, reason.AsNotFound(errors.New("no provider"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), newSSO())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_UpToDatePreservesSecretHash(t *testing.T) {
+	e, mc := newExt(t, oktaSecret("s3cr3t"))
+	mg := newSSO()
+	mg.Status.AtProvider.SecretHash = secretHash("s3cr3t")
+	mc.EXPECT().GetSSOConfiguration(gomock.Any()).Return(oktaResponse(), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, "okta", mg.Status.AtProvider.Provider)
+	assert.Equal(t, "example.okta.com", mg.Status.AtProvider.Domain)
+	assert.Equal(t, secretHash("s3cr3t"), mg.Status.AtProvider.SecretHash)
+}
+
+func TestObserve_RotatedSecretDrifts(t *testing.T) {
+	e, mc := newExt(t, oktaSecret("rotated"))
+	mg := newSSO()
+	mg.Status.AtProvider.SecretHash = secretHash("s3cr3t")
+	mc.EXPECT().GetSSOConfiguration(gomock.Any()).Return(oktaResponse(), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_FieldDrift(t *testing.T) {
+	e, mc := newExt(t, oktaSecret("s3cr3t"))
+	mg := newSSO()
+	mg.Status.AtProvider.SecretHash = secretHash("s3cr3t")
+	resp := oktaResponse()
+	resp.EnforceSso = false
+	mc.EXPECT().GetSSOConfiguration(gomock.Any()).Return(resp, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_MissingSecretIsTerminal(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newSSO()
+	mc.EXPECT().GetSSOConfiguration(gomock.Any()).Return(oktaResponse(), nil).Times(1)
+
+	_, err := e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+}
+
+func TestCreate_EnsuresWithResolvedSecret(t *testing.T) {
+	e, mc := newExt(t, oktaSecret("s3cr3t"))
+	mg := newSSO()
+	meta.SetExternalName(mg, "")
+
+	mc.EXPECT().EnsureSSOConfiguration(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *orgcv1.EnsureSSOConfigurationRequest) error {
+			assert.True(t, req.GetEnforceSso())
+			assert.Equal(t, []string{"groups"}, req.GetGroupClaims())
+			assert.Equal(t, "client-1", req.GetOkta().GetClientId())
+			assert.Equal(t, "s3cr3t", req.GetOkta().GetClientSecret())
+			assert.Equal(t, "example.okta.com", req.GetOkta().GetOktaDomain())
+			return nil
+		}).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "sso", meta.GetExternalName(mg))
+	assert.Equal(t, secretHash("s3cr3t"), mg.Status.AtProvider.SecretHash)
+}
+
+func TestUpdate_OIDCBackChannel(t *testing.T) {
+	e, mc := newExt(t, oktaSecret("s3cr3t"))
+	mg := newSSO()
+	mg.Spec.ForProvider.Okta = nil
+	mg.Spec.ForProvider.OIDC = &v1alpha1.SSOOIDCOptions{
+		DiscoveryURL: "https://idp.example.com/.well-known/openid-configuration",
+		ClientID:     "client-1",
+		Domain:       "example.com",
+		BackChannel: &v1alpha1.SSOOIDCBackChannel{
+			ClientSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: "akuity", Name: "okta"},
+				Key:             "clientSecret",
+			},
+		},
+	}
+
+	mc.EXPECT().EnsureSSOConfiguration(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *orgcv1.EnsureSSOConfigurationRequest) error {
+			assert.Equal(t, "s3cr3t", req.GetOidc().GetBack().GetClientSecret())
+			assert.Equal(t, "example.com", req.GetOidc().GetDomain())
+			return nil
+		}).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+}
+
+func TestUpdate_SAMLTakesNoSecret(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newSSO()
+	mg.Spec.ForProvider.Okta = nil
+	mg.Spec.ForProvider.SAML = &v1alpha1.SSOSAMLOptions{Domain: "example.com", MetadataXML: "<EntityDescriptor/>"}
+
+	mc.EXPECT().EnsureSSOConfiguration(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *orgcv1.EnsureSSOConfigurationRequest) error {
+			assert.Equal(t, "<EntityDescriptor/>", req.GetSaml().GetMetadataXml())
+			return nil
+		}).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Empty(t, mg.Status.AtProvider.SecretHash)
+}
+
+func TestDelete_IgnoresNotFound(t *testing.T) {
+	e, mc := newExt(t)
+	mc.EXPECT().DeleteSSOConfiguration(gomock.Any()).Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), newSSO())
+	require.NoError(t, err)
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package team is the Team controller. It owns an Akuity organization
+// team through the Organization gateway's Create/Update/Delete team
+// endpoints. Teams are keyed by name, which doubles as the
+// external-name; TeamMember and WorkspaceMember resolve their
+// spec.forProvider.teamRef through status.atProvider.name.
+package team
+
+import (
+	"context"
+	"slices"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.TeamGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.Team]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.Team] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TeamGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.Team](conn),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Team{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.Team) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	// Short-circuit on a cached terminal write before any gateway round-
+	// trip. NameAsExternalName stamps the external-name before Create
+	// runs, so a CreateTeam rejected on bad input would otherwise loop
+	// GetTeam->NotFound->Create->reject at controller-runtime backoff.
+	if e.HasTerminalWriteResource(mg, v1alpha1.TeamGroupVersionKind) {
+		if obs, err, ok := e.suppressTerminalWrite(mg); ok {
+			return obs, err
+		}
+	}
+	if meta.GetExternalName(mg) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	ut, err := e.Client.GetTeam(ctx, meta.GetExternalName(mg))
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	mg.Status.AtProvider = teamObservation(ut)
+	base.SetHealthCondition(mg, true)
+
+	customRoles, err := e.resolveCustomRoles(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	desired := mg.Spec.ForProvider
+	desired.CustomRoles = customRoles
+	desired.CustomRoleRefs = nil
+	observed := teamParameters(ut)
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "Team")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.Team,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.Team) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	key, err := teamTerminalWriteKey(mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	customRoles, err := e.resolveCustomRoles(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	fp := mg.Spec.ForProvider
+	ut, err := e.Client.CreateTeam(ctx, fp.Name, fp.Description, customRoles)
+	if err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider = teamObservation(ut)
+	meta.SetExternalName(mg, fp.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.Team) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	key, err := teamTerminalWriteKey(mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	customRoles, err := e.resolveCustomRoles(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	ut, err := e.Client.UpdateTeam(ctx, meta.GetExternalName(mg), mg.Spec.ForProvider.Description, customRoles)
+	if err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider = teamObservation(ut)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.Team) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.TeamGroupVersionKind)
+
+	name := meta.GetExternalName(mg)
+	if name == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	if err := e.Client.DeleteTeam(ctx, name); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// resolveCustomRoles returns the custom role IDs to grant the team:
+// spec.forProvider.customRoles plus the IDs resolved from
+// customRoleRefs, without duplicates. An unobserved CustomRole blocks
+// the reconcile rather than silently dropping the grant.
+func (e *external) resolveCustomRoles(ctx context.Context, mg *v1alpha1.Team) ([]string, error) {
+	fp := mg.Spec.ForProvider
+	if len(fp.CustomRoleRefs) == 0 {
+		return fp.CustomRoles, nil
+	}
+	roles := append([]string(nil), fp.CustomRoles...)
+	for i := range fp.CustomRoleRefs {
+		id, err := base.ResolveCustomRoleRef(ctx, e.Kube, mg.GetNamespace(), &fp.CustomRoleRefs[i])
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(roles, id) {
+			roles = append(roles, id)
+		}
+	}
+	return roles, nil
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.Team) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := teamTerminalWriteKey(mg)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.Team) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.TeamGroupVersionKind) {
+		return
+	}
+	key, err := teamTerminalWriteKey(mg)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func teamTerminalWriteKey(mg *v1alpha1.Team) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.TeamGroupVersionKind, meta.GetExternalName(mg), mg.Spec.ForProvider)
+}
+
+// driftSpec is the resource's drift-detection recipe. Description and
+// the custom role set are fully owned by the MR, so edits made in the
+// Akuity UI are reverted on the next Update. The platform does not
+// promise a stable custom role order, so the slice compares as a set.
+func driftSpec() base.DriftSpec[v1alpha1.TeamParameters] {
+	return base.DriftSpec[v1alpha1.TeamParameters]{
+		Ignore: []cmp.Option{
+			cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		},
+	}
+}
+
+func teamParameters(ut *orgcv1.UserTeam) v1alpha1.TeamParameters {
+	return v1alpha1.TeamParameters{
+		Name:        ut.GetTeam().GetName(),
+		Description: ut.GetTeam().GetDescription(),
+		CustomRoles: ut.GetCustomRoles(),
+	}
+}
+
+func teamObservation(ut *orgcv1.UserTeam) v1alpha1.TeamObservation {
+	return v1alpha1.TeamObservation{
+		Name:        ut.GetTeam().GetName(),
+		Description: ut.GetTeam().GetDescription(),
+		CustomRoles: ut.GetCustomRoles(),
+		MemberCount: ut.GetTeam().GetMemberCount(),
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package team
+
+import (
+	"context"
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func newTeam() *v1alpha1.Team {
+	return &v1alpha1.Team{
+		ObjectMeta: metav1.ObjectMeta{Name: "platform", UID: "team-uid"},
+		Spec: v1alpha1.TeamSpec{
+			ForProvider: v1alpha1.TeamParameters{
+				Name:        "platform",
+				Description: "platform engineers",
+				CustomRoles: []string{"role-a", "role-b"},
+			},
+		},
+	}
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func userTeam(description string, roles ...string) *orgcv1.UserTeam {
+	return &orgcv1.UserTeam{
+		Team:        &orgcv1.Team{Name: "platform", Description: description, MemberCount: 4},
+		CustomRoles: roles,
+	}
+}
+
+func TestObserve_NoExternalName(t *testing.T) {
+	e, _ := newExt(t)
+	obs, err := e.Observe(context.Background(), newTeam())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_UpToDateIgnoresRoleOrder(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newTeam()
+	meta.SetExternalName(mg, "platform")
+
+	mc.EXPECT().GetTeam(gomock.Any(), "platform").Return(userTeam("platform engineers", "role-b", "role-a"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, "platform", mg.Status.AtProvider.Name)
+	assert.Equal(t, int64(4), mg.Status.AtProvider.MemberCount)
+}
+
+func TestObserve_RoleRemovedOutOfBandDrifts(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newTeam()
+	meta.SetExternalName(mg, "platform")
+
+	mc.EXPECT().GetTeam(gomock.Any(), "platform").Return(userTeam("platform engineers", "role-a"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_NotFound(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newTeam()
+	meta.SetExternalName(mg, "platform")
+
+	mc.EXPECT().GetTeam(gomock.Any(), "platform").Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestCreate_StampsTeamName(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newTeam()
+	mg.Name = "platform-mr"
+
+	mc.EXPECT().CreateTeam(gomock.Any(), "platform", "platform engineers", []string{"role-a", "role-b"}).
+		Return(userTeam("platform engineers", "role-a", "role-b"), nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "platform", meta.GetExternalName(mg))
+	assert.Equal(t, "platform", mg.Status.AtProvider.Name)
+}
+
+func TestCreate_InvalidArgument_SuppressedOnObserve(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newTeam()
+	meta.SetExternalName(mg, "platform")
+
+	mc.EXPECT().CreateTeam(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.InvalidArgument, "bad role")).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+
+	// No GetTeam expectation: the cached terminal write short-circuits
+	// Observe before any gateway round-trip.
+	obs, err := e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+}
+
+func TestUpdate_SendsDescriptionAndRoles(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newTeam()
+	meta.SetExternalName(mg, "platform")
+
+	mc.EXPECT().UpdateTeam(gomock.Any(), "platform", "platform engineers", []string{"role-a", "role-b"}).
+		Return(userTeam("platform engineers", "role-a", "role-b"), nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"role-a", "role-b"}, mg.Status.AtProvider.CustomRoles)
+}
+
+func TestDelete_NotFoundIsSuccess(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newTeam()
+	meta.SetExternalName(mg, "platform")
+
+	mc.EXPECT().DeleteTeam(gomock.Any(), "platform").Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
+
+func observedCustomRole(name, id string) *v1alpha1.CustomRole {
+	role := &v1alpha1.CustomRole{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	role.Status.AtProvider.ID = id
+	return role
+}
+
+func TestCreate_ResolvesCustomRoleRefs(t *testing.T) {
+	e, mc := newExt(t, observedCustomRole("deployer", "role-c"), observedCustomRole("duplicate", "role-a"))
+	mg := newTeam()
+	mg.Spec.ForProvider.CustomRoleRefs = []v1alpha1.LocalReference{{Name: "deployer"}, {Name: "duplicate"}}
+
+	mc.EXPECT().CreateTeam(gomock.Any(), "platform", "platform engineers", []string{"role-a", "role-b", "role-c"}).
+		Return(userTeam("platform engineers", "role-a", "role-b", "role-c"), nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"role-a", "role-b"}, mg.Spec.ForProvider.CustomRoles)
+}
+
+func TestObserve_CustomRoleRefsCompareAsResolvedIDs(t *testing.T) {
+	e, mc := newExt(t, observedCustomRole("deployer", "role-c"))
+	mg := newTeam()
+	mg.Spec.ForProvider.CustomRoleRefs = []v1alpha1.LocalReference{{Name: "deployer"}}
+	meta.SetExternalName(mg, "platform")
+
+	mc.EXPECT().GetTeam(gomock.Any(), "platform").Return(userTeam("platform engineers", "role-c", "role-a", "role-b"), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_UnobservedCustomRoleRefBlocks(t *testing.T) {
+	e, mc := newExt(t, &v1alpha1.CustomRole{ObjectMeta: metav1.ObjectMeta{Name: "deployer"}})
+	mg := newTeam()
+	mg.Spec.ForProvider.CustomRoleRefs = []v1alpha1.LocalReference{{Name: "deployer"}}
+	meta.SetExternalName(mg, "platform")
+
+	mc.EXPECT().GetTeam(gomock.Any(), "platform").Return(userTeam("platform engineers", "role-a", "role-b"), nil).Times(1)
+
+	_, err := e.Observe(context.Background(), mg)
+	require.Error(t, err)
+}
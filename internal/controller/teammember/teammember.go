@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package teammember is the TeamMember controller. It adds one user to
+// an Akuity team through the Organization gateway's AddTeamMember
+// endpoint. The platform-assigned member ID is the external-name; a
+// member removed out-of-band reads as NotFound on the next Observe and
+// is re-added by Create.
+package teammember
+
+import (
+	"context"
+	"fmt"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+//
+// The external-name is the platform-assigned member ID, so the default
+// NameAsExternalName initializer is disabled.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.TeamMemberGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.TeamMember]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.TeamMember] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TeamMemberGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.TeamMember](conn),
+		managed.WithInitializers(),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.TeamMember{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.TeamMember) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	teamName, err := e.resolveTeamName(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		// An AddTeamMember rejected on bad input (unknown user) never
+		// stamps the external-name; suppress the retry loop until the
+		// spec changes.
+		if obs, err, ok := e.suppressTerminalWrite(mg, teamName); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	member, err := e.Client.GetTeamMember(ctx, teamName, id)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	mg.Status.AtProvider = teamMemberObservation(member, teamName)
+	base.SetHealthCondition(mg, true)
+	e.clearTerminalWrite(mg, teamName)
+
+	// Every spec field is immutable, so an existing membership is always
+	// up to date. Drift here is purely presence: a member removed in the
+	// Akuity UI is reported NotFound above and re-added by Create.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.TeamMember,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.TeamMember) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	teamName, err := e.resolveTeamName(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	key, err := teamMemberTerminalWriteKey(mg, teamName)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	member, err := e.Client.AddTeamMember(ctx, teamName, mg.Spec.ForProvider.UserID)
+	if err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider = teamMemberObservation(member, teamName)
+	meta.SetExternalName(mg, member.GetId())
+	return managed.ExternalCreation{}, nil
+}
+
+// Update is a no-op: Observe never reports an existing membership as
+// out of date because every spec field is immutable.
+func (e *external) Update(_ context.Context, mg *v1alpha1.TeamMember) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.TeamMember) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.TeamMemberGroupVersionKind)
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	teamName, err := e.resolveTeamName(ctx, mg)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	if err := e.Client.RemoveTeamMember(ctx, teamName, id); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// resolveTeamName returns the name of the target team. TeamName takes
+// precedence; otherwise TeamRef is resolved through the referenced Team
+// MR's Status.AtProvider.Name.
+//
+// The cached Status.AtProvider.TeamName is consulted only during
+// deletion when the referenced Team MR has itself been removed, the
+// same last-resort fallback InstanceIpAllowList uses for its parent
+// Instance.
+func (e *external) resolveTeamName(ctx context.Context, mg *v1alpha1.TeamMember) (string, error) {
+	if name := mg.Spec.ForProvider.TeamName; name != "" {
+		return name, nil
+	}
+	if mg.Spec.ForProvider.TeamRef == nil || mg.Spec.ForProvider.TeamRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.teamName or spec.forProvider.teamRef must be set")
+	}
+	name, err := base.ResolveTeamRef(ctx, e.Kube, mg.GetNamespace(), mg.Spec.ForProvider.TeamRef)
+	if err != nil {
+		if apierrors.IsNotFound(err) && meta.WasDeleted(mg) {
+			if cached := mg.Status.AtProvider.TeamName; cached != "" {
+				return cached, nil
+			}
+		}
+		return "", err
+	}
+	return name, nil
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.TeamMember, teamName string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := teamMemberTerminalWriteKey(mg, teamName)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.TeamMember, teamName string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.TeamMemberGroupVersionKind) {
+		return
+	}
+	key, err := teamMemberTerminalWriteKey(mg, teamName)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func teamMemberTerminalWriteKey(mg *v1alpha1.TeamMember, teamName string) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.TeamMemberGroupVersionKind, teamName, mg.Spec.ForProvider.UserID)
+}
+
+func teamMemberObservation(member *orgcv1.TeamMember, teamName string) v1alpha1.TeamMemberObservation {
+	return v1alpha1.TeamMemberObservation{
+		ID:       member.GetId(),
+		Email:    member.GetEmail(),
+		TeamName: teamName,
+	}
+}
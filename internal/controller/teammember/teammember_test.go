@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teammember
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func newMember() *v1alpha1.TeamMember {
+	return &v1alpha1.TeamMember{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice-platform", UID: "tm-uid"},
+		Spec: v1alpha1.TeamMemberSpec{
+			ForProvider: v1alpha1.TeamMemberParameters{
+				TeamName: "platform",
+				UserID:   "user-alice",
+			},
+		},
+	}
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_NoExternalName(t *testing.T) {
+	e, _ := newExt(t)
+	obs, err := e.Observe(context.Background(), newMember())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_Present(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMember()
+	meta.SetExternalName(mg, "member-1")
+
+	mc.EXPECT().GetTeamMember(gomock.Any(), "platform", "member-1").
+		Return(&orgcv1.TeamMember{Id: "member-1", Email: "alice@example.com"}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, "alice@example.com", mg.Status.AtProvider.Email)
+	assert.Equal(t, "platform", mg.Status.AtProvider.TeamName)
+}
+
+// TestObserve_RemovedOutOfBandIsRecreated covers the UI-removal path:
+// the member lookup reports NotFound, so the reconciler is told the
+// membership is gone and calls Create, which re-adds it.
+func TestObserve_RemovedOutOfBandIsRecreated(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMember()
+	meta.SetExternalName(mg, "member-1")
+
+	mc.EXPECT().GetTeamMember(gomock.Any(), "platform", "member-1").
+		Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+	mc.EXPECT().AddTeamMember(gomock.Any(), "platform", "user-alice").
+		Return(&orgcv1.TeamMember{Id: "member-2"}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	require.False(t, obs.ResourceExists)
+
+	_, err = e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "member-2", meta.GetExternalName(mg))
+}
+
+func TestCreate_ResolvesTeamRef(t *testing.T) {
+	team := &v1alpha1.Team{ObjectMeta: metav1.ObjectMeta{Name: "platform-mr"}}
+	team.Status.AtProvider.Name = "platform"
+	e, mc := newExt(t, team)
+	mg := newMember()
+	mg.Spec.ForProvider.TeamName = ""
+	mg.Spec.ForProvider.TeamRef = &v1alpha1.LocalReference{Name: "platform-mr"}
+
+	mc.EXPECT().AddTeamMember(gomock.Any(), "platform", "user-alice").
+		Return(&orgcv1.TeamMember{Id: "member-1"}, nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "member-1", meta.GetExternalName(mg))
+}
+
+func TestCreate_UnobservedTeamRefBlocksAdd(t *testing.T) {
+	team := &v1alpha1.Team{ObjectMeta: metav1.ObjectMeta{Name: "platform-mr"}}
+	e, _ := newExt(t, team)
+	mg := newMember()
+	mg.Spec.ForProvider.TeamName = ""
+	mg.Spec.ForProvider.TeamRef = &v1alpha1.LocalReference{Name: "platform-mr"}
+
+	_, err := e.Create(context.Background(), mg)
+	require.Error(t, err)
+}
+
+// TestDelete_UsesCachedTeamNameWhenRefGone covers composition teardown
+// where the Team MR is removed before its members.
+func TestDelete_UsesCachedTeamNameWhenRefGone(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMember()
+	mg.Spec.ForProvider.TeamName = ""
+	mg.Spec.ForProvider.TeamRef = &v1alpha1.LocalReference{Name: "platform-mr"}
+	mg.Status.AtProvider.TeamName = "platform"
+	now := metav1.NewTime(time.Now())
+	mg.SetDeletionTimestamp(&now)
+	meta.SetExternalName(mg, "member-1")
+
+	mc.EXPECT().RemoveTeamMember(gomock.Any(), "platform", "member-1").Return(nil).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
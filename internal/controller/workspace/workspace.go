@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package workspace is the Workspace controller. It owns an Akuity
+// workspace through the Organization gateway's Create/Update/Delete
+// workspace endpoints and publishes the canonical workspace ID on
+// status.atProvider.id, which Instance, KargoInstance, and KargoAgent
+// resolve their spec.forProvider.workspaceRef through.
+package workspace
+
+import (
+	"context"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+//
+// The external-name is the platform-assigned workspace ID, so the
+// default NameAsExternalName initializer is disabled: an empty
+// external-name means "not created yet", and users adopt an existing
+// workspace by setting the annotation to its ID.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.WorkspaceGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.Workspace]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.Workspace] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.WorkspaceGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.Workspace](conn),
+		managed.WithInitializers(),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Workspace{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.Workspace) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		// A Create rejected on bad input (duplicate name, invalid
+		// characters) never stamps the external-name; without this
+		// suppress the reconciler would re-issue CreateWorkspace on
+		// every backoff tick until the spec changes.
+		if obs, err, ok := e.suppressTerminalWrite(mg); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	ws, err := e.Client.GetWorkspace(ctx, id)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	mg.Status.AtProvider = workspaceObservation(ws)
+	base.SetHealthCondition(mg, true)
+
+	desired := mg.Spec.ForProvider
+	observed := workspaceParameters(ws)
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "Workspace")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.Workspace,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.Workspace) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	key, err := workspaceTerminalWriteKey(mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	ws, err := e.Client.CreateWorkspace(ctx, mg.Spec.ForProvider.Name, mg.Spec.ForProvider.Description)
+	if err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider = workspaceObservation(ws)
+	meta.SetExternalName(mg, ws.GetId())
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.Workspace) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	key, err := workspaceTerminalWriteKey(mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	ws, err := e.Client.UpdateWorkspace(ctx, meta.GetExternalName(mg), mg.Spec.ForProvider.Name, mg.Spec.ForProvider.Description)
+	if err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider = workspaceObservation(ws)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.Workspace) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.WorkspaceGroupVersionKind)
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	// The platform refuses to delete a workspace that still holds
+	// instances; that error is returned as-is so the reconciler retries
+	// with backoff while the dependent Instance/KargoInstance MRs are
+	// torn down.
+	if err := e.Client.DeleteWorkspace(ctx, id); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.Workspace) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := workspaceTerminalWriteKey(mg)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.Workspace) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.WorkspaceGroupVersionKind) {
+		return
+	}
+	key, err := workspaceTerminalWriteKey(mg)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func workspaceTerminalWriteKey(mg *v1alpha1.Workspace) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.WorkspaceGroupVersionKind, meta.GetExternalName(mg), mg.Spec.ForProvider)
+}
+
+// driftSpec is the resource's drift-detection recipe: a straight
+// cmp.Equal on name and description. Both fields are fully owned by
+// the MR, so a rename or description edit in the Akuity UI is drift
+// and is reverted on the next Update.
+func driftSpec() base.DriftSpec[v1alpha1.WorkspaceParameters] {
+	return base.DriftSpec[v1alpha1.WorkspaceParameters]{}
+}
+
+func workspaceParameters(ws *orgcv1.Workspace) v1alpha1.WorkspaceParameters {
+	return v1alpha1.WorkspaceParameters{
+		Name:        ws.GetName(),
+		Description: ws.GetDescription(),
+	}
+}
+
+func workspaceObservation(ws *orgcv1.Workspace) v1alpha1.WorkspaceObservation {
+	obs := v1alpha1.WorkspaceObservation{
+		ID:              ws.GetId(),
+		Name:            ws.GetName(),
+		Description:     ws.GetDescription(),
+		IsDefault:       ws.GetIsDefault(),
+		TeamMemberCount: int64(ws.GetTeamMemberCount()),
+		UserMemberCount: int64(ws.GetUserMemberCount()),
+	}
+	for _, inst := range ws.GetArgocdInstances() {
+		obs.ArgoCDInstances = append(obs.ArgoCDInstances, inst.GetName())
+	}
+	for _, inst := range ws.GetKargoInstances() {
+		obs.KargoInstances = append(obs.KargoInstances, inst.GetName())
+	}
+	return obs
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func newWorkspace() *v1alpha1.Workspace {
+	return &v1alpha1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "ws", UID: "ws-uid"},
+		Spec: v1alpha1.WorkspaceSpec{
+			ForProvider: v1alpha1.WorkspaceParameters{
+				Name:        "platform",
+				Description: "platform team",
+			},
+		},
+	}
+}
+
+func newExt(t *testing.T) (*external, *mockclient.MockClient) {
+	t.Helper()
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_NoExternalName(t *testing.T) {
+	e, _ := newExt(t)
+	obs, err := e.Observe(context.Background(), newWorkspace())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_UpToDate(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newWorkspace()
+	meta.SetExternalName(mg, "ws-1")
+
+	mc.EXPECT().GetWorkspace(gomock.Any(), "ws-1").Return(&orgcv1.Workspace{
+		Id:              "ws-1",
+		Name:            "platform",
+		Description:     "platform team",
+		ArgocdInstances: []*orgcv1.WorkspaceArgoCDInstance{{Id: "inst-1", Name: "argo"}},
+		UserMemberCount: 3,
+	}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, "ws-1", mg.Status.AtProvider.ID)
+	assert.Equal(t, []string{"argo"}, mg.Status.AtProvider.ArgoCDInstances)
+	assert.Equal(t, int64(3), mg.Status.AtProvider.UserMemberCount)
+}
+
+// TestObserve_DescriptionDrift: a description edited in the Akuity UI
+// is drift; the MR owns both name and description.
+func TestObserve_DescriptionDrift(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newWorkspace()
+	meta.SetExternalName(mg, "ws-1")
+
+	mc.EXPECT().GetWorkspace(gomock.Any(), "ws-1").Return(&orgcv1.Workspace{
+		Id:          "ws-1",
+		Name:        "platform",
+		Description: "edited in the UI",
+	}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_NotFound(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newWorkspace()
+	meta.SetExternalName(mg, "ws-1")
+
+	mc.EXPECT().GetWorkspace(gomock.Any(), "ws-1").
+		Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestCreate_StampsWorkspaceID(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newWorkspace()
+
+	mc.EXPECT().CreateWorkspace(gomock.Any(), "platform", "platform team").
+		Return(&orgcv1.Workspace{Id: "ws-1", Name: "platform"}, nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "ws-1", meta.GetExternalName(mg))
+	assert.Equal(t, "ws-1", mg.Status.AtProvider.ID)
+}
+
+// TestCreate_InvalidArgument_SuppressedOnObserve locks the terminal
+// write guard: a rejected Create must not be re-issued by the next
+// Observe until the spec changes.
+func TestCreate_InvalidArgument_SuppressedOnObserve(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newWorkspace()
+
+	mc.EXPECT().CreateWorkspace(gomock.Any(), "platform", "platform team").
+		Return(nil, status.Error(codes.InvalidArgument, "invalid name")).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, reason.IsTerminal(err))
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.Error(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+
+	mg.Spec.ForProvider.Name = "platform-2"
+	obs, err = e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestUpdate_SendsNameAndDescription(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newWorkspace()
+	meta.SetExternalName(mg, "ws-1")
+
+	mc.EXPECT().UpdateWorkspace(gomock.Any(), "ws-1", "platform", "platform team").
+		Return(&orgcv1.Workspace{Id: "ws-1", Name: "platform", Description: "platform team"}, nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "platform team", mg.Status.AtProvider.Description)
+}
+
+func TestDelete_NotFoundIsSuccess(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newWorkspace()
+	meta.SetExternalName(mg, "ws-1")
+
+	mc.EXPECT().DeleteWorkspace(gomock.Any(), "ws-1").
+		Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
+
+func TestDelete_NoExternalNameSkipsGateway(t *testing.T) {
+	e, _ := newExt(t)
+	_, err := e.Delete(context.Background(), newWorkspace())
+	require.NoError(t, err)
+}
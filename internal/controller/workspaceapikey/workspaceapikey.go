@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package workspaceapikey is the WorkspaceAPIKey controller. It mints
+// an Akuity workspace API key through the Organization gateway and
+// deletes it through the APIKey gateway. The platform-assigned key ID
+// is the external-name.
+//
+// As with OrganizationAPIKey, the key secret is only returned by the
+// create call and is published to the connection Secret exactly once.
+package workspaceapikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+//
+// The external-name is the platform-assigned key ID, so the default
+// NameAsExternalName initializer is disabled.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.WorkspaceAPIKeyGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.WorkspaceAPIKey]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.WorkspaceAPIKey] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.WorkspaceAPIKeyGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.WorkspaceAPIKey](conn),
+		managed.WithInitializers(),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.WorkspaceAPIKey{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.WorkspaceAPIKey) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	workspaceID, err := e.resolveWorkspaceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		// A CreateWorkspaceAPIKey rejected on bad input never stamps the
+		// external-name; suppress the retry loop until the spec changes.
+		if obs, err, ok := e.suppressTerminalWrite(mg, workspaceID); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	key, err := e.Client.GetWorkspaceAPIKey(ctx, workspaceID, id)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	mg.Status.AtProvider = v1alpha1.WorkspaceAPIKeyObservation{
+		APIKeyObservation: base.APIKeyObservation(key),
+		WorkspaceID:       workspaceID,
+	}
+	base.SetHealthCondition(mg, !base.APIKeyExpired(key, time.Now()))
+	e.clearTerminalWrite(mg, workspaceID)
+
+	// API keys have no update route and forProvider is immutable, so an
+	// existing key is always up to date.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.WorkspaceAPIKey,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.WorkspaceAPIKey) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	workspaceID, err := e.resolveWorkspaceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	key, err := workspaceAPIKeyTerminalWriteKey(mg, workspaceID)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	fp := mg.Spec.ForProvider
+	apiKey, err := e.Client.CreateWorkspaceAPIKey(ctx, workspaceID, fp.Description, base.APIKeyPermissionsToProto(fp.Permissions), fp.ExpireInDuration)
+	if err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider = v1alpha1.WorkspaceAPIKeyObservation{
+		APIKeyObservation: base.APIKeyObservation(apiKey),
+		WorkspaceID:       workspaceID,
+	}
+	meta.SetExternalName(mg, apiKey.GetId())
+
+	details, err := base.APIKeyConnectionDetails(apiKey.GetId(), apiKey.GetSecret())
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return managed.ExternalCreation{ConnectionDetails: details}, nil
+}
+
+// Update is a no-op: forProvider is immutable and Observe always
+// reports an existing key as up to date.
+func (e *external) Update(_ context.Context, mg *v1alpha1.WorkspaceAPIKey) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.WorkspaceAPIKey) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.WorkspaceAPIKeyGroupVersionKind)
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	workspaceID, err := e.resolveWorkspaceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	if err := e.Client.DeleteWorkspaceAPIKey(ctx, workspaceID, id); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// resolveWorkspaceID returns the canonical ID of the owning workspace.
+// WorkspaceID takes precedence; otherwise WorkspaceRef is resolved
+// through the referenced Workspace MR's Status.AtProvider.ID.
+//
+// The cached Status.AtProvider.WorkspaceID is consulted only during
+// deletion when the referenced Workspace MR has itself been removed.
+func (e *external) resolveWorkspaceID(ctx context.Context, mg *v1alpha1.WorkspaceAPIKey) (string, error) {
+	if id := mg.Spec.ForProvider.WorkspaceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.WorkspaceRef == nil || mg.Spec.ForProvider.WorkspaceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.workspaceId or spec.forProvider.workspaceRef must be set")
+	}
+	id, err := base.ResolveWorkspaceRef(ctx, e.Kube, mg.GetNamespace(), mg.Spec.ForProvider.WorkspaceRef)
+	if err != nil {
+		if apierrors.IsNotFound(err) && meta.WasDeleted(mg) {
+			if cached := mg.Status.AtProvider.WorkspaceID; cached != "" {
+				return cached, nil
+			}
+		}
+		return "", err
+	}
+	return id, nil
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.WorkspaceAPIKey, workspaceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := workspaceAPIKeyTerminalWriteKey(mg, workspaceID)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.WorkspaceAPIKey, workspaceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.WorkspaceAPIKeyGroupVersionKind) {
+		return
+	}
+	key, err := workspaceAPIKeyTerminalWriteKey(mg, workspaceID)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func workspaceAPIKeyTerminalWriteKey(mg *v1alpha1.WorkspaceAPIKey, workspaceID string) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.WorkspaceAPIKeyGroupVersionKind, workspaceID, mg.Spec.ForProvider)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspaceapikey
+
+import (
+	"context"
+	"testing"
+
+	apikeyv1 "github.com/akuity/api-client-go/pkg/api/gen/apikey/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/config"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func newKey() *v1alpha1.WorkspaceAPIKey {
+	return &v1alpha1.WorkspaceAPIKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "ci", UID: "wak-uid"},
+		Spec: v1alpha1.WorkspaceAPIKeySpec{
+			ForProvider: v1alpha1.WorkspaceAPIKeyParameters{
+				WorkspaceID: "ws-1",
+				Description: "ci",
+			},
+		},
+	}
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_Exists(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newKey()
+	meta.SetExternalName(mg, "key-1")
+
+	mc.EXPECT().GetWorkspaceAPIKey(gomock.Any(), "ws-1", "key-1").
+		Return(&apikeyv1.APIKey{Id: "key-1", Description: "ci"}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, "key-1", mg.Status.AtProvider.ID)
+	assert.Equal(t, "ws-1", mg.Status.AtProvider.WorkspaceID)
+}
+
+func TestObserve_DeletedOutOfBand(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newKey()
+	meta.SetExternalName(mg, "key-1")
+
+	mc.EXPECT().GetWorkspaceAPIKey(gomock.Any(), "ws-1", "key-1").
+		Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestCreate_WorkspaceRefPublishesCredentials(t *testing.T) {
+	ws := &v1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "platform-ws"}}
+	ws.Status.AtProvider.ID = "ws-ref-id"
+	e, mc := newExt(t, ws)
+	mg := newKey()
+	mg.Spec.ForProvider.WorkspaceID = ""
+	mg.Spec.ForProvider.WorkspaceRef = &v1alpha1.LocalReference{Name: "platform-ws"}
+	secret := "key-secret"
+
+	mc.EXPECT().CreateWorkspaceAPIKey(gomock.Any(), "ws-ref-id", "ci", gomock.Any(), "").
+		Return(&apikeyv1.APIKey{Id: "key-1", Secret: &secret}, nil).Times(1)
+
+	cre, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", meta.GetExternalName(mg))
+	assert.Equal(t, "ws-ref-id", mg.Status.AtProvider.WorkspaceID)
+	assert.Equal(t, []byte(secret), cre.ConnectionDetails[config.CredentialsAPIKeySecret])
+	assert.NotEmpty(t, cre.ConnectionDetails[config.CredentialsKey])
+}
+
+func TestCreate_WorkspaceRefUnobservedBlocksCreate(t *testing.T) {
+	ws := &v1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "platform-ws"}}
+	e, _ := newExt(t, ws)
+	mg := newKey()
+	mg.Spec.ForProvider.WorkspaceID = ""
+	mg.Spec.ForProvider.WorkspaceRef = &v1alpha1.LocalReference{Name: "platform-ws"}
+
+	_, err := e.Create(context.Background(), mg)
+	require.Error(t, err)
+}
+
+func TestDelete_UsesCachedWorkspaceWhenRefGone(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newKey()
+	mg.Spec.ForProvider.WorkspaceID = ""
+	mg.Spec.ForProvider.WorkspaceRef = &v1alpha1.LocalReference{Name: "platform-ws"}
+	mg.Status.AtProvider.WorkspaceID = "ws-cached"
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+	meta.SetExternalName(mg, "key-1")
+
+	mc.EXPECT().DeleteWorkspaceAPIKey(gomock.Any(), "ws-cached", "key-1").Return(nil).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspacecustomrole
+
+import (
+	"context"
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+)
+
+func newRole() *v1alpha1.WorkspaceCustomRole {
+	return &v1alpha1.WorkspaceCustomRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployer", UID: "wcr-uid"},
+		Spec: v1alpha1.WorkspaceCustomRoleSpec{
+			ForProvider: v1alpha1.WorkspaceCustomRoleParameters{
+				WorkspaceID: "ws-1",
+				Name:        "deployer",
+				Policy:      "instances/get",
+			},
+		},
+	}
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func TestObserve_UpToDate(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newRole()
+	meta.SetExternalName(mg, "role-1")
+
+	mc.EXPECT().GetWorkspaceCustomRole(gomock.Any(), "ws-1", "role-1").
+		Return(&orgcv1.CustomRole{Id: "role-1", Name: "deployer", Policy: "instances/get\n"}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, "ws-1", mg.Status.AtProvider.WorkspaceID)
+}
+
+func TestObserve_RenamedOutOfBandDrifts(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newRole()
+	meta.SetExternalName(mg, "role-1")
+
+	mc.EXPECT().GetWorkspaceCustomRole(gomock.Any(), "ws-1", "role-1").
+		Return(&orgcv1.CustomRole{Id: "role-1", Name: "renamed", Policy: "instances/get"}, nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestCreate_WorkspaceRef(t *testing.T) {
+	ws := &v1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "platform-ws"}}
+	ws.Status.AtProvider.ID = "ws-ref-id"
+	e, mc := newExt(t, ws)
+	mg := newRole()
+	mg.Spec.ForProvider.WorkspaceID = ""
+	mg.Spec.ForProvider.WorkspaceRef = &v1alpha1.LocalReference{Name: "platform-ws"}
+
+	mc.EXPECT().CreateWorkspaceCustomRole(gomock.Any(), "ws-ref-id", "deployer", "", "instances/get").
+		Return(&orgcv1.CustomRole{Id: "role-1", Name: "deployer", Policy: "instances/get"}, nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "role-1", meta.GetExternalName(mg))
+	assert.Equal(t, "ws-ref-id", mg.Status.AtProvider.WorkspaceID)
+}
+
+func TestDelete_UsesCachedWorkspaceWhenRefGone(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newRole()
+	mg.Spec.ForProvider.WorkspaceID = ""
+	mg.Spec.ForProvider.WorkspaceRef = &v1alpha1.LocalReference{Name: "platform-ws"}
+	mg.Status.AtProvider.WorkspaceID = "ws-cached"
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+	meta.SetExternalName(mg, "role-1")
+
+	mc.EXPECT().DeleteWorkspaceCustomRole(gomock.Any(), "ws-cached", "role-1").Return(nil).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
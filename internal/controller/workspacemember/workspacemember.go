@@ -0,0 +1,348 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package workspacemember is the WorkspaceMember controller. It grants
+// a user or team a role in an Akuity workspace through the Organization
+// gateway's Add/Update/RemoveWorkspaceMember endpoints. The
+// platform-assigned member ID is the external-name; a member removed
+// out-of-band reads as NotFound on the next Observe and is re-added by
+// Create, and a role changed out-of-band is reverted through drift.
+package workspacemember
+
+import (
+	"context"
+	"fmt"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuityio/provider-crossplane-akuity/internal/event"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/akuityio/provider-crossplane-akuity/apis/v1alpha1"
+	"github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+// Setup registers the controller with the manager.
+//
+// The external-name is the platform-assigned member ID, so the default
+// NameAsExternalName initializer is disabled.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.WorkspaceMemberGroupKind)
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewRecorder(mgr, name)
+
+	conn := &base.Connector[*v1alpha1.WorkspaceMember]{
+		Kube:      mgr.GetClient(),
+		Usage:     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		Logger:    logger,
+		Recorder:  recorder,
+		NewClient: base.DefaultClientFactory,
+		Build: func(ac akuity.Client, kube client.Client, l logging.Logger, r event.Recorder) managed.TypedExternalClient[*v1alpha1.WorkspaceMember] {
+			return &external{ExternalClient: base.NewExternalClient(ac, kube, l, r)}
+		},
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.WorkspaceMemberGroupVersionKind),
+		managed.WithTypedExternalConnector[*v1alpha1.WorkspaceMember](conn),
+		managed.WithInitializers(),
+		managed.WithLogger(logger),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithManagementPolicies(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.WorkspaceMember{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type external struct {
+	base.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg *v1alpha1.WorkspaceMember) (managed.ExternalObservation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	workspaceID, err := e.resolveWorkspaceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		// An AddWorkspaceMember rejected on bad input (unknown user or
+		// team) never stamps the external-name; suppress the retry loop
+		// until the spec changes.
+		if obs, err, ok := e.suppressTerminalWrite(mg, workspaceID); ok {
+			return obs, err
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	member, err := e.Client.GetWorkspaceMember(ctx, workspaceID, id)
+	if outcome, obs, rerr := base.ClassifyGetError(err); outcome != base.GetOK {
+		return handleGetOutcome(mg, err, outcome, obs, rerr)
+	}
+
+	mg.Status.AtProvider = workspaceMemberObservation(member, workspaceID)
+	base.SetHealthCondition(mg, true)
+
+	desired := mg.Spec.ForProvider
+	observed := v1alpha1.WorkspaceMemberParameters{Role: roleFromProto(member.GetRole())}
+	upToDate, err := base.EvaluateDrift(ctx, driftSpec(), &desired, &observed, e.Logger, "WorkspaceMember")
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if !upToDate {
+		if obs, err, ok := e.suppressTerminalWrite(mg, workspaceID); ok {
+			return obs, err
+		}
+	} else {
+		e.clearTerminalWrite(mg, workspaceID)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func handleGetOutcome(
+	mg *v1alpha1.WorkspaceMember,
+	err error,
+	outcome base.GetOutcome,
+	obs managed.ExternalObservation,
+	rerr error,
+) (managed.ExternalObservation, error) {
+	switch outcome {
+	case base.GetOK, base.GetAbsent:
+		// GetOK is filtered by the caller; GetAbsent's pre-shaped
+		// obs (ResourceExists=false) is returned as-is.
+	case base.GetProvisioning:
+		base.SetHealthCondition(mg, false)
+	case base.GetTerminal:
+		mg.SetConditions(xpv1.ReconcileError(err))
+	}
+	return obs, rerr
+}
+
+func (e *external) Create(ctx context.Context, mg *v1alpha1.WorkspaceMember) (managed.ExternalCreation, error) {
+	defer base.PropagateObservedGeneration(mg)
+	workspaceID, err := e.resolveWorkspaceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	ref, err := e.memberRef(ctx, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	key, err := workspaceMemberTerminalWriteKey(mg, workspaceID)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	member, err := e.Client.AddWorkspaceMember(ctx, workspaceID, ref)
+	if err != nil {
+		return managed.ExternalCreation{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider = workspaceMemberObservation(member, workspaceID)
+	meta.SetExternalName(mg, member.GetId())
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg *v1alpha1.WorkspaceMember) (managed.ExternalUpdate, error) {
+	defer base.PropagateObservedGeneration(mg)
+	workspaceID, err := e.resolveWorkspaceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	key, err := workspaceMemberTerminalWriteKey(mg, workspaceID)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	member, err := e.Client.UpdateWorkspaceMember(ctx, workspaceID, meta.GetExternalName(mg), roleToProto(mg.Spec.ForProvider.Role))
+	if err != nil {
+		return managed.ExternalUpdate{}, e.RecordTerminalWrite(key, reason.ClassifyApplyError(err))
+	}
+	e.ClearTerminalWrite(key)
+	mg.Status.AtProvider = workspaceMemberObservation(member, workspaceID)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg *v1alpha1.WorkspaceMember) (managed.ExternalDelete, error) {
+	defer base.PropagateObservedGeneration(mg)
+	e.ClearTerminalWriteResource(mg, v1alpha1.WorkspaceMemberGroupVersionKind)
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return managed.ExternalDelete{}, nil
+	}
+	workspaceID, err := e.resolveWorkspaceID(ctx, mg)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	if err := e.Client.RemoveWorkspaceMember(ctx, workspaceID, id); err != nil && !reason.IsNotFound(err) {
+		return managed.ExternalDelete{}, err
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error { return nil }
+
+// resolveWorkspaceID returns the canonical ID of the target workspace.
+// WorkspaceID takes precedence; otherwise WorkspaceRef is resolved
+// through the referenced Workspace MR's Status.AtProvider.ID.
+//
+// The cached Status.AtProvider.WorkspaceID is consulted only during
+// deletion when the referenced Workspace MR has itself been removed.
+func (e *external) resolveWorkspaceID(ctx context.Context, mg *v1alpha1.WorkspaceMember) (string, error) {
+	if id := mg.Spec.ForProvider.WorkspaceID; id != "" {
+		return id, nil
+	}
+	if mg.Spec.ForProvider.WorkspaceRef == nil || mg.Spec.ForProvider.WorkspaceRef.Name == "" {
+		return "", fmt.Errorf("one of spec.forProvider.workspaceId or spec.forProvider.workspaceRef must be set")
+	}
+	id, err := base.ResolveWorkspaceRef(ctx, e.Kube, mg.GetNamespace(), mg.Spec.ForProvider.WorkspaceRef)
+	if err != nil {
+		if apierrors.IsNotFound(err) && meta.WasDeleted(mg) {
+			if cached := mg.Status.AtProvider.WorkspaceID; cached != "" {
+				return cached, nil
+			}
+		}
+		return "", err
+	}
+	return id, nil
+}
+
+// memberRef builds the AddWorkspaceMember member selector from the
+// spec. TeamRef is resolved through the referenced Team MR so a team
+// membership is only requested once the team exists.
+func (e *external) memberRef(ctx context.Context, mg *v1alpha1.WorkspaceMember) (*orgcv1.WorkspaceMemberRef, error) {
+	fp := mg.Spec.ForProvider
+	ref := &orgcv1.WorkspaceMemberRef{Role: roleToProto(fp.Role)}
+	switch {
+	case fp.UserID != "":
+		ref.Member = &orgcv1.WorkspaceMemberRef_UserId{UserId: fp.UserID}
+	case fp.UserEmail != "":
+		ref.Member = &orgcv1.WorkspaceMemberRef_UserEmail{UserEmail: fp.UserEmail}
+	case fp.TeamName != "":
+		ref.Member = &orgcv1.WorkspaceMemberRef_TeamName{TeamName: fp.TeamName}
+	case fp.TeamRef != nil:
+		name, err := base.ResolveTeamRef(ctx, e.Kube, mg.GetNamespace(), fp.TeamRef)
+		if err != nil {
+			return nil, err
+		}
+		ref.Member = &orgcv1.WorkspaceMemberRef_TeamName{TeamName: name}
+	default:
+		return nil, reason.AsTerminal(fmt.Errorf("one of spec.forProvider.userId, userEmail, teamName, or teamRef must be set"))
+	}
+	return ref, nil
+}
+
+func (e *external) suppressTerminalWrite(mg *v1alpha1.WorkspaceMember, workspaceID string) (managed.ExternalObservation, error, bool) {
+	if e.TerminalWrites == nil {
+		return managed.ExternalObservation{}, nil, false
+	}
+	key, err := workspaceMemberTerminalWriteKey(mg, workspaceID)
+	if err != nil {
+		return e.SkipTerminalWriteGuard(err)
+	}
+	return e.SuppressTerminalWrite(mg, key)
+}
+
+func (e *external) clearTerminalWrite(mg *v1alpha1.WorkspaceMember, workspaceID string) {
+	if !e.HasTerminalWriteResource(mg, v1alpha1.WorkspaceMemberGroupVersionKind) {
+		return
+	}
+	key, err := workspaceMemberTerminalWriteKey(mg, workspaceID)
+	if err != nil {
+		e.LogTerminalWriteGuardSkipped(err)
+		return
+	}
+	e.ClearTerminalWrite(key)
+}
+
+func workspaceMemberTerminalWriteKey(mg *v1alpha1.WorkspaceMember, workspaceID string) (base.TerminalWriteKey, error) {
+	return base.NewTerminalWriteKey(mg, v1alpha1.WorkspaceMemberGroupVersionKind, workspaceID, meta.GetExternalName(mg), mg.Spec.ForProvider)
+}
+
+// driftSpec is the resource's drift-detection recipe. Role is the only
+// mutable field; the workspace and member selectors are immutable
+// routing inputs the gateway does not echo back in the same shape, so
+// Normalize adopts them from desired. An unset role compares as the
+// CRD default "member".
+func driftSpec() base.DriftSpec[v1alpha1.WorkspaceMemberParameters] {
+	return base.DriftSpec[v1alpha1.WorkspaceMemberParameters]{
+		Normalize: func(desired, observed *v1alpha1.WorkspaceMemberParameters) {
+			role := observed.Role
+			*observed = *desired
+			observed.Role = role
+			if desired.Role == "" {
+				desired.Role = v1alpha1.WorkspaceMemberRoleMember
+			}
+		},
+	}
+}
+
+func roleToProto(role v1alpha1.WorkspaceMemberRole) orgcv1.WorkspaceMemberRole {
+	if role == v1alpha1.WorkspaceMemberRoleAdmin {
+		return orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_ADMIN
+	}
+	return orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_MEMBER
+}
+
+func roleFromProto(role orgcv1.WorkspaceMemberRole) v1alpha1.WorkspaceMemberRole {
+	switch role {
+	case orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_ADMIN:
+		return v1alpha1.WorkspaceMemberRoleAdmin
+	case orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_MEMBER:
+		return v1alpha1.WorkspaceMemberRoleMember
+	default:
+		return ""
+	}
+}
+
+func workspaceMemberObservation(member *orgcv1.WorkspaceMember, workspaceID string) v1alpha1.WorkspaceMemberObservation {
+	obs := v1alpha1.WorkspaceMemberObservation{
+		ID:          member.GetId(),
+		Role:        roleFromProto(member.GetRole()),
+		WorkspaceID: workspaceID,
+	}
+	if u := member.GetUser(); u != nil {
+		obs.UserID = u.GetId()
+		obs.UserEmail = u.GetEmail()
+	}
+	if t := member.GetTeam(); t != nil {
+		obs.TeamName = t.GetName()
+	}
+	return obs
+}
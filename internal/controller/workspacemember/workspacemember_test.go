@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspacemember
+
+import (
+	"context"
+	"testing"
+
+	orgcv1 "github.com/akuity/api-client-go/pkg/api/gen/organization/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
+	mockclient "github.com/akuityio/provider-crossplane-akuity/internal/clients/akuity/mock"
+	"github.com/akuityio/provider-crossplane-akuity/internal/controller/base"
+	"github.com/akuityio/provider-crossplane-akuity/internal/reason"
+)
+
+func newMember() *v1alpha1.WorkspaceMember {
+	return &v1alpha1.WorkspaceMember{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice-platform", UID: "wm-uid"},
+		Spec: v1alpha1.WorkspaceMemberSpec{
+			ForProvider: v1alpha1.WorkspaceMemberParameters{
+				WorkspaceID: "ws-1",
+				UserEmail:   "alice@example.com",
+				Role:        v1alpha1.WorkspaceMemberRoleAdmin,
+			},
+		},
+	}
+}
+
+func newExt(t *testing.T, objs ...runtime.Object) (*external, *mockclient.MockClient) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	mc := mockclient.NewMockClient(gomock.NewController(t))
+	return &external{ExternalClient: base.ExternalClient{
+		Client:         mc,
+		Kube:           fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		Logger:         logging.NewNopLogger(),
+		TerminalWrites: base.NewTerminalWriteGuard(),
+	}}, mc
+}
+
+func userMember(id string, role orgcv1.WorkspaceMemberRole) *orgcv1.WorkspaceMember {
+	return &orgcv1.WorkspaceMember{
+		Id:     id,
+		Role:   role,
+		Member: &orgcv1.WorkspaceMember_User{User: &orgcv1.WorkspaceUserMember{Id: "user-alice", Email: "alice@example.com"}},
+	}
+}
+
+func TestObserve_NoExternalName(t *testing.T) {
+	e, _ := newExt(t)
+	obs, err := e.Observe(context.Background(), newMember())
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestObserve_UpToDate(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMember()
+	meta.SetExternalName(mg, "wm-1")
+
+	mc.EXPECT().GetWorkspaceMember(gomock.Any(), "ws-1", "wm-1").
+		Return(userMember("wm-1", orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_ADMIN), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.True(t, obs.ResourceUpToDate)
+	assert.Equal(t, "user-alice", mg.Status.AtProvider.UserID)
+	assert.Equal(t, "ws-1", mg.Status.AtProvider.WorkspaceID)
+}
+
+func TestObserve_RoleDowngradedOutOfBandDrifts(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMember()
+	meta.SetExternalName(mg, "wm-1")
+
+	mc.EXPECT().GetWorkspaceMember(gomock.Any(), "ws-1", "wm-1").
+		Return(userMember("wm-1", orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_MEMBER), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceExists)
+	assert.False(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_UnsetRoleMatchesMember(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMember()
+	mg.Spec.ForProvider.Role = ""
+	meta.SetExternalName(mg, "wm-1")
+
+	mc.EXPECT().GetWorkspaceMember(gomock.Any(), "ws-1", "wm-1").
+		Return(userMember("wm-1", orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_MEMBER), nil).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.True(t, obs.ResourceUpToDate)
+}
+
+func TestObserve_RemovedOutOfBand(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMember()
+	meta.SetExternalName(mg, "wm-1")
+
+	mc.EXPECT().GetWorkspaceMember(gomock.Any(), "ws-1", "wm-1").
+		Return(nil, reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	obs, err := e.Observe(context.Background(), mg)
+	require.NoError(t, err)
+	assert.False(t, obs.ResourceExists)
+}
+
+func TestCreate_TeamRefAndWorkspaceRef(t *testing.T) {
+	ws := &v1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "platform-ws"}}
+	ws.Status.AtProvider.ID = "ws-ref-id"
+	team := &v1alpha1.Team{ObjectMeta: metav1.ObjectMeta{Name: "platform-team"}}
+	team.Status.AtProvider.Name = "platform"
+	e, mc := newExt(t, ws, team)
+
+	mg := newMember()
+	mg.Spec.ForProvider = v1alpha1.WorkspaceMemberParameters{
+		WorkspaceRef: &v1alpha1.LocalReference{Name: "platform-ws"},
+		TeamRef:      &v1alpha1.LocalReference{Name: "platform-team"},
+	}
+
+	mc.EXPECT().AddWorkspaceMember(gomock.Any(), "ws-ref-id", &orgcv1.WorkspaceMemberRef{
+		Role:   orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_MEMBER,
+		Member: &orgcv1.WorkspaceMemberRef_TeamName{TeamName: "platform"},
+	}).Return(&orgcv1.WorkspaceMember{
+		Id:     "wm-1",
+		Role:   orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_MEMBER,
+		Member: &orgcv1.WorkspaceMember_Team{Team: &orgcv1.WorkspaceTeamMember{Name: "platform"}},
+	}, nil).Times(1)
+
+	_, err := e.Create(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, "wm-1", meta.GetExternalName(mg))
+	assert.Equal(t, "platform", mg.Status.AtProvider.TeamName)
+}
+
+func TestUpdate_SendsRole(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMember()
+	meta.SetExternalName(mg, "wm-1")
+
+	mc.EXPECT().UpdateWorkspaceMember(gomock.Any(), "ws-1", "wm-1", orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_ADMIN).
+		Return(userMember("wm-1", orgcv1.WorkspaceMemberRole_WORKSPACE_MEMBER_ROLE_ADMIN), nil).Times(1)
+
+	_, err := e.Update(context.Background(), mg)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.WorkspaceMemberRoleAdmin, mg.Status.AtProvider.Role)
+}
+
+func TestDelete_NotFoundIsSuccess(t *testing.T) {
+	e, mc := newExt(t)
+	mg := newMember()
+	meta.SetExternalName(mg, "wm-1")
+
+	mc.EXPECT().RemoveWorkspaceMember(gomock.Any(), "ws-1", "wm-1").
+		Return(reason.AsNotFound(errors.New("gone"))).Times(1)
+
+	_, err := e.Delete(context.Background(), mg)
+	require.NoError(t, err)
+}
@@ -449,6 +449,58 @@ func TestKargoAgent_KubeConfigSourcesMutuallyExclusive(t *testing.T) {
 	t.Cleanup(func() { _ = kube.Delete(ctx, neither) })
 }
 
+// TestManifestsSink_MutuallyExclusiveWithKubeConfig covers the CEL rule
+// on Cluster and KargoAgent that rejects a manifests sink alongside
+// either kubeconfig source.
+func TestManifestsSink_MutuallyExclusiveWithKubeConfig(t *testing.T) {
+	ctx := context.Background()
+	sink := &v1alpha1.ManifestsSink{Name: "agent-manifests", Namespace: "default"}
+
+	clusterWithSecret := &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-sink-secret"},
+		Spec: v1alpha1.ClusterSpec{
+			ForProvider: v1alpha1.ClusterParameters{
+				InstanceID:          "inst-abc",
+				Name:                "c1",
+				KubeConfigSecretRef: xpv1.SecretReference{Name: "kc", Namespace: "default"},
+				ManifestsSink:       sink,
+			},
+		},
+	}
+	err := kube.Create(ctx, clusterWithSecret)
+	require.Error(t, err, "apiserver must reject Cluster with both manifestsSink and kubeconfigSecretRef")
+	assert.Contains(t, err.Error(), "manifestsSink is mutually exclusive")
+
+	agentInCluster := &v1alpha1.KargoAgent{
+		ObjectMeta: metav1.ObjectMeta{Name: "ka-sink-incluster"},
+		Spec: v1alpha1.KargoAgentSpec{
+			ForProvider: v1alpha1.KargoAgentParameters{
+				KargoInstanceID:           "ki-abc",
+				Name:                      "agent-a",
+				EnableInClusterKubeConfig: true,
+				ManifestsSink:             sink,
+			},
+		},
+	}
+	err = kube.Create(ctx, agentInCluster)
+	require.Error(t, err, "apiserver must reject KargoAgent with both manifestsSink and enableInClusterKubeconfig")
+	assert.Contains(t, err.Error(), "manifestsSink is mutually exclusive")
+
+	clusterSinkOnly := &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-sink-only"},
+		Spec: v1alpha1.ClusterSpec{
+			ForProvider: v1alpha1.ClusterParameters{
+				InstanceID:    "inst-abc",
+				Name:          "c1",
+				ManifestsSink: sink,
+			},
+		},
+	}
+	require.NoError(t, kube.Create(ctx, clusterSinkOnly), "manifestsSink alone must be accepted")
+	t.Cleanup(func() { _ = kube.Delete(ctx, clusterSinkOnly) })
+	assert.Equal(t, "Secret", clusterSinkOnly.Spec.ForProvider.ManifestsSink.Kind, "kind defaults to Secret")
+}
+
 // TestKargoAgent_AkuityManagedImmutable covers the UPDATE-time
 // immutability rule on kargoAgentSpec.data.akuityManaged. The platform
 // silently ignores updates to this field, so admission rejects them
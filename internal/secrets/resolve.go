@@ -48,6 +48,7 @@ var repoCredentialNameRE = regexp.MustCompile(`^repo-[a-z0-9][a-z0-9-]*$`)
 // failure.
 func IsConfigError(err error) bool {
 	return errors.Is(err, ErrMissingSecret) ||
+		errors.Is(err, ErrMissingSecretKey) ||
 		errors.Is(err, ErrInvalidSecretReference) ||
 		errors.Is(err, ErrEmptySecret)
 }
@@ -124,6 +125,34 @@ func ResolveAllKeys(ctx context.Context, c client.Client, ref *xpv1.SecretRefere
 	return resolved.Data, nil
 }
 
+// ErrMissingSecretKey is returned when a referenced Secret exists but
+// does not carry the key named by a SecretKeySelector.
+var ErrMissingSecretKey = errors.New("referenced secret key not found")
+
+// ResolveKey loads the Secret at ref and returns the value stored under
+// ref.Key. The returned ResolvedSecret carries only the selected key so
+// its Hash rotates when that value changes and not when unrelated keys
+// in the same Secret do. Returns the zero value with no error when ref
+// is nil.
+func ResolveKey(ctx context.Context, c client.Client, ref *xpv1.SecretKeySelector) (ResolvedSecret, string, error) {
+	if ref == nil {
+		return ResolvedSecret{}, "", nil
+	}
+	if ref.Key == "" {
+		return ResolvedSecret{}, "", fmt.Errorf("%w: key is required", ErrInvalidSecretReference)
+	}
+	resolved, err := Resolve(ctx, c, &ref.SecretReference)
+	if err != nil {
+		return ResolvedSecret{}, "", err
+	}
+	value, ok := resolved.Data[ref.Key]
+	if !ok {
+		return ResolvedSecret{}, "", fmt.Errorf("%w: %s/%s[%s]", ErrMissingSecretKey, ref.Namespace, ref.Name, ref.Key)
+	}
+	resolved.Data = map[string]string{ref.Key: value}
+	return resolved, value, nil
+}
+
 // ResolveNamed loads each referenced Secret and returns a map keyed by
 // the caller-provided Name field to the resolved key/value data. Empty
 // or nil refs yield a nil map to let callers skip setting the wire
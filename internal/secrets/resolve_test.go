@@ -90,6 +90,44 @@ func TestResolveAllKeys_EmptySecretWrapsSentinel(t *testing.T) {
 	}
 }
 
+func TestResolveKey_HashesOnlySelectedKey(t *testing.T) {
+	sec := newSecret("akuity", "sso", map[string]string{"clientSecret": "s3cr3t", "other": "v1"})
+	c := fakeClient(t, sec).Build()
+	ref := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "akuity", Name: "sso"},
+		Key:             "clientSecret",
+	}
+	got, value, err := ResolveKey(context.Background(), c, ref)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if value != "s3cr3t" {
+		t.Fatalf("value = %q, want s3cr3t", value)
+	}
+
+	sec.Data["other"] = []byte("v2")
+	c = fakeClient(t, sec).Build()
+	rotated, _, err := ResolveKey(context.Background(), c, ref)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if got.Hash() != rotated.Hash() {
+		t.Fatal("changing an unselected key must not rotate the hash")
+	}
+}
+
+func TestResolveKey_MissingKeyIsConfigError(t *testing.T) {
+	sec := newSecret("akuity", "sso", map[string]string{"other": "v1"})
+	c := fakeClient(t, sec).Build()
+	_, _, err := ResolveKey(context.Background(), c, &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "akuity", Name: "sso"},
+		Key:             "clientSecret",
+	})
+	if !errors.Is(err, ErrMissingSecretKey) || !IsConfigError(err) {
+		t.Fatalf("want ErrMissingSecretKey config error, got %v", err)
+	}
+}
+
 func TestResolveNamed_HappyPath(t *testing.T) {
 	a := newSecret("akuity", "creds-a", map[string]string{"url": "https://a", "password": "p1"})
 	b := newSecret("akuity", "repo-creds-b", map[string]string{"url": "https://b", "sshPrivateKey": "key"})
@@ -23,6 +23,7 @@ import (
 	argocdv1 "github.com/akuity/api-client-go/pkg/api/gen/argocd/v1"
 	health "github.com/akuity/api-client-go/pkg/api/gen/types/status/health/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/akuityio/provider-crossplane-akuity/apis/core/v1alpha1"
@@ -66,6 +67,17 @@ func Cluster(cluster *argocdv1.Cluster) (v1alpha1.ClusterObservation, error) {
 			Message: cluster.GetReconciliationStatus().GetMessage(),
 		},
 	}
+	if k8s := cluster.GetK8SStatus(); k8s != nil {
+		obs.ClusterInfo = &v1alpha1.ClusterInfo{
+			KubernetesVersion: k8s.GetKubernetesVersion(),
+			APIResourceCount:  int64(k8s.GetApiResourceCount()),
+			ObjectCount:       int64(k8s.GetObjectCount()),
+		}
+	}
+	if until := cluster.GetSupportAccessUntil(); until != nil && !until.AsTime().IsZero() {
+		t := metav1.NewTime(until.AsTime())
+		obs.SupportAccessUntil = &t
+	}
 	// Nested mirror: groups the observed payload under one block so
 	// consumers reading atProvider see the same shape as
 	// spec.forProvider.clusterSpec.
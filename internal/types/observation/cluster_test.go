@@ -183,3 +183,21 @@ func TestCluster_NilInput(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, v1alpha1.ClusterObservation{}, actual)
 }
+
+func TestCluster_K8SStatusMapsToClusterInfo(t *testing.T) {
+	actual, err := observation.Cluster(fixtures.ArgocdCluster)
+	require.NoError(t, err)
+	assert.Nil(t, actual.ClusterInfo, "clusterInfo stays unset until the agent reports Kubernetes status")
+
+	cluster := &argocdv1.Cluster{
+		Name: fixtures.ClusterName,
+		K8SStatus: &argocdv1.ClusterKubernetesStatus{
+			KubernetesVersion: "v1.31.2",
+			ApiResourceCount:  120,
+			ObjectCount:       4500,
+		},
+	}
+	actual, err = observation.Cluster(cluster)
+	require.NoError(t, err)
+	assert.Equal(t, &v1alpha1.ClusterInfo{KubernetesVersion: "v1.31.2", APIResourceCount: 120, ObjectCount: 4500}, actual.ClusterInfo)
+}
@@ -24,8 +24,7 @@ import (
 
 // KargoAgent projects the Kargo-plane agent response into the
 // KargoAgent AtProvider block. Workspace intentionally stays empty
-// here because the controller owns it locally and carries it via the
-// desired spec.
+// here because the controller records the inherited workspace itself.
 func KargoAgent(agent *kargov1.KargoAgent) v1alpha1.KargoAgentObservation {
 	if agent == nil {
 		return v1alpha1.KargoAgentObservation{}